@@ -1,31 +1,3 @@
 package main
 
-const competition = "competition"
-const country = "country"
-const events = "events"
-const eventsCurrentSeason = "events:current-season"
-const eventsBySeasonId = "events:by-season-id"
-const fixturesCurrentSeason = "fixtures:current-season"
-const fixturesBySeasonId = "fixtures:by-season-id"
-const fixturesByCompetitionId = "fixtures:by-competition-id"
-const fixtureXG = "fixture-xg"
-const fixtureXGCurrentSeason = "fixture-xg:current-season"
-const player = "player"
-const playerStatsByDate = "player-stats:by-date"
-const playerStatsBySeasonId = "player-stats:by-season-id"
-const playerStatsByCompetitionId = "player-stats:by-competition-id"
-const resultsCurrentSeason = "results:current-season"
-const resultsBySeasonId = "results:by-season-id"
-const resultsByCompetitionId = "results:by-competition-id"
-const round = "round"
-const roundCurrentSeason = "round:current-season"
-const season = "season"
-const squad = "squad"
-const squadCurrentSeason = "squad:current-season"
-const team = "team"
-const teamCurrentSeason = "team:current-season"
-const teamStatsByDate = "team-stats:by-date"
-const teamStatsBySeasonId = "team-stats:by-season-id"
-const teamStatsByCompetitionId = "team-stats:by-competition-id"
-const venue = "venue"
-const venueCurrentSeason = "venue:current-season"
+const reprocess = "reprocess"
@@ -5,79 +5,86 @@ import (
 	"fmt"
 	"github.com/statistico/statistico-football-data/internal/bootstrap"
 	"os"
+	"strings"
 	"time"
 )
 
 var command = flag.String("command", "", "Provide the command name to process")
 var option = flag.String("option", "", "Optional parameter to pass to command")
+var lock = flag.String("lock", "", "Override the strategy used if the command is already running: skip, wait or steal")
+var tier = flag.String("tier", "", "Only process competitions whose ingestion policy has the tier provided: high, standard or low")
+var resume = flag.Bool("resume", false, "Skip the seasons and fixtures completed by a previous run of the command and option")
 
 func main() {
-	app := bootstrap.BuildContainer(bootstrap.BuildConfig())
-
 	flag.Parse()
 
-	var processor bootstrap.Processor
-
-	switch *command {
-	case competition:
-		processor = app.CompetitionProcessor()
-		break
-	case country:
-		processor = app.CountryProcessor()
-		break
-	case events, eventsCurrentSeason, eventsBySeasonId:
-		processor = app.EventProcessor()
-		break
-	case fixturesCurrentSeason, fixturesBySeasonId, fixturesByCompetitionId:
-		processor = app.FixtureProcessor()
-		break
-	case fixtureXG, fixtureXGCurrentSeason:
-		processor = app.FixtureTeamXGProcessor()
-	case player:
-		processor = app.PlayerProcessor()
-		break
-	case playerStatsByDate, playerStatsBySeasonId, playerStatsByCompetitionId:
-		processor = app.PlayerStatsProcessor()
-		break
-	case resultsCurrentSeason, resultsBySeasonId, resultsByCompetitionId:
-		processor = app.ResultProcessor()
-		break
-	case round, roundCurrentSeason:
-		processor = app.RoundProcessor()
-		break
-	case season:
-		processor = app.SeasonProcessor()
-		break
-	case squad, squadCurrentSeason:
-		processor = app.SquadProcessor()
-		break
-	case team, teamCurrentSeason:
-		processor = app.TeamProcessor()
-		break
-	case teamStatsByDate, teamStatsBySeasonId, teamStatsByCompetitionId:
-		processor = app.TeamStatsProcessor()
-		break
-	case venue, venueCurrentSeason:
-		processor = app.VenueProcessor()
-		break
-	default:
+	config := bootstrap.BuildConfig()
+	config.Checkpoint.Resume = *resume
+
+	t, code := parseTier(*tier)
+
+	if code != 0 {
+		os.Exit(code)
+	}
+
+	config.Ingestion.Tier = t
+
+	name, opt := *command, *option
+
+	if name == reprocess {
+		name, opt = parseReprocessOption(*option)
+		config.RawPayload.Replay = true
+	}
+
+	app := bootstrap.BuildContainer(config)
+
+	processor := app.CommandProcessor(name)
+
+	if processor == nil {
 		fmt.Println("The command provided is not supported")
 		os.Exit(1)
 	}
 
+	l, code := acquireLock(app, name, opt, *lock)
+
+	if code != 0 {
+		os.Exit(code)
+	}
+
 	done := make(chan bool)
 
 	start := time.Now()
 
 	fmt.Printf("%s: Processing started for %s\n", start.String(), *command)
 
-	go processor.Process(*command, *option, done)
+	go processor.Process(name, opt, done)
 
-	<-done
+	select {
+	case <-done:
+	case <-l.Lost():
+		app.Logger.Errorf("Lock for '%s' was lost to another process, stopping processing", *command)
+		os.Exit(exitLockLost)
+	}
 
 	elapsed := time.Since(start)
 
 	fmt.Printf("Processing complete for %s: Duration %s\n", *command, elapsed)
 
+	if err := l.Release(); err != nil {
+		app.Logger.Warningf("Error '%s' occurred when releasing lock for '%s'", err.Error(), *command)
+	}
+
 	os.Exit(0)
 }
+
+// parseReprocessOption splits the reprocess option into the command to re-run over archived payloads and the
+// option for that command i.e. "results:by-season-id 16036".
+func parseReprocessOption(option string) (string, string) {
+	parts := strings.SplitN(strings.TrimSpace(option), " ", 2)
+
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+
+	return parts[0], strings.TrimSpace(parts[1])
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/bootstrap"
+)
+
+// Exit codes reported when a command does not run to completion because of its lock, allowing schedulers to
+// distinguish a skipped run from a failure.
+const (
+	exitLockHeld = 3
+	exitLockLost = 4
+)
+
+// acquireLock acquires the lock for the command using the strategy configured for the command unless overridden,
+// logging the outcome and returning a non-zero code the console should exit with if the lock is not acquired.
+// Commands that do not require a lock are given a lock that is never lost.
+func acquireLock(c *bootstrap.Container, name, option, override string) (app.Lock, int) {
+	strategy, required := bootstrap.CommandLockStrategy(name)
+
+	if override != "" {
+		s, err := app.ParseLockStrategy(override)
+
+		if err != nil {
+			fmt.Println(err.Error())
+			return nil, 1
+		}
+
+		strategy, required = s, true
+	}
+
+	if !required {
+		return noLock{}, 0
+	}
+
+	n := app.CommandLockName(name, option)
+
+	l, err := c.Locker().Acquire(n, strategy)
+
+	if err == app.ErrLockHeld {
+		c.Logger.Warningf("Skipping '%s' as the lock is held by another process", n)
+		return nil, exitLockHeld
+	}
+
+	if err != nil {
+		c.Logger.Errorf("Error '%s' occurred when acquiring lock '%s'", err.Error(), n)
+		return nil, 1
+	}
+
+	c.Logger.Infof("Lock '%s' acquired using strategy '%s'", n, strategy)
+
+	return l, 0
+}
+
+// noLock is used for commands that are safe to run concurrently.
+type noLock struct{}
+
+func (n noLock) Lost() <-chan struct{} {
+	return nil
+}
+
+func (n noLock) Release() error {
+	return nil
+}
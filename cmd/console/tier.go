@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+	"github.com/statistico/statistico-football-data/internal/app"
+)
+
+// parseTier returns the ingestion tier of the competitions processed by the console, returning a non-zero code the
+// console should exit with if the tier is not supported. Every tier is processed if no tier is provided.
+func parseTier(value string) (app.IngestionTier, int) {
+	if value == "" {
+		return "", 0
+	}
+
+	t, err := app.ParseIngestionTier(value)
+
+	if err != nil {
+		fmt.Println(err.Error())
+		return "", 1
+	}
+
+	return t, 0
+}
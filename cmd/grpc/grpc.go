@@ -1,6 +1,8 @@
 package main
 
 import (
+	appgrpc "github.com/statistico/statistico-football-data/internal/app/grpc"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
 	"github.com/statistico/statistico-football-data/internal/bootstrap"
 	"github.com/statistico/statistico-proto/go"
 	"google.golang.org/grpc"
@@ -24,14 +26,26 @@ func main() {
 	server := grpc.NewServer(opts)
 
 	statistico.RegisterCompetitionServiceServer(server, app.CompetitionService())
-	statistico.RegisterEventServiceServer(server, app.EventService())
-	statistico.RegisterFixtureServiceServer(server, app.FixtureService())
-	statistico.RegisterResultServiceServer(server, app.ResultService())
+	appgrpc.RegisterEventService(server, app.EventService())
+	appgrpc.RegisterFixtureService(server, app.FixtureService(), app.FixtureStageService())
+	appgrpc.RegisterResultService(server, app.ResultService(), app.ResultTieService())
 	statistico.RegisterPlayerStatsServiceServer(server, app.PlayerStatsService())
 	statistico.RegisterSeasonServiceServer(server, app.SeasonService())
 	statistico.RegisterTeamServiceServer(server, app.TeamService())
 	statistico.RegisterTeamStatsServiceServer(server, app.TeamStatsService())
 
+	proto.RegisterAdminServiceServer(server, app.AdminService())
+	proto.RegisterAvailabilityServiceServer(server, app.AvailabilityService())
+	proto.RegisterFixtureSearchServiceServer(server, app.FixtureSearchService())
+	proto.RegisterLineupServiceServer(server, app.LineupService())
+	proto.RegisterLiveServiceServer(server, app.LiveService())
+	proto.RegisterManagerServiceServer(server, app.ManagerService())
+	proto.RegisterOddsServiceServer(server, app.OddsService())
+	proto.RegisterPlayerServiceServer(server, app.PlayerService())
+	proto.RegisterRefereeServiceServer(server, app.RefereeService())
+	proto.RegisterStandingsServiceServer(server, app.StandingsService())
+	proto.RegisterWebhookServiceServer(server, app.WebhookService())
+
 	reflection.Register(server)
 
 	if err := server.Serve(lis); err != nil {
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/statistico/statistico-football-data/internal/bootstrap"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+var concurrency = flag.Int("concurrency", 1, "Provide the number of jobs to run at once")
+var console = flag.String("console", "/opt/console", "Provide the path of the console binary used to run jobs")
+
+func main() {
+	flag.Parse()
+
+	app := bootstrap.BuildContainer(bootstrap.BuildConfig())
+
+	host, err := os.Hostname()
+
+	if err != nil {
+		host = "worker"
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		<-signals
+		app.Logger.Info("Shutting down worker once running jobs are complete")
+		cancel()
+	}()
+
+	var wg sync.WaitGroup
+
+	for i := 1; i <= *concurrency; i++ {
+		id := fmt.Sprintf("%s-%d-%d", host, os.Getpid(), i)
+
+		wg.Add(1)
+
+		go func(id string) {
+			defer wg.Done()
+			app.Worker(id, *console).Run(ctx)
+		}(id)
+	}
+
+	app.Logger.Infof("Worker started running %d job(s) at once", *concurrency)
+
+	wg.Wait()
+}
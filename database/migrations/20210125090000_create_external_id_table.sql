@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE external_id (
+  entity_type VARCHAR NOT NULL,
+  entity_id INTEGER NOT NULL,
+  provider VARCHAR NOT NULL,
+  external_id VARCHAR NOT NULL,
+  created_at INTEGER NOT NULL,
+  updated_at INTEGER NOT NULL,
+  PRIMARY KEY (entity_type, provider, external_id)
+);
+
+CREATE INDEX ON external_id (entity_type, entity_id, provider);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE external_id
+-- +goose StatementEnd
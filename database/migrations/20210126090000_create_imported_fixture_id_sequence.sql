@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE SEQUENCE imported_fixture_id_seq START WITH 1000000000 MAXVALUE 2147483647;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP SEQUENCE imported_fixture_id_seq
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE odds (
+  id SERIAL PRIMARY KEY,
+  fixture_id INTEGER NOT NULL,
+  bookmaker_id INTEGER NOT NULL,
+  bookmaker VARCHAR NOT NULL,
+  market VARCHAR NOT NULL,
+  selection VARCHAR NOT NULL,
+  line VARCHAR NULL,
+  price REAL NOT NULL,
+  captured_at INTEGER NOT NULL,
+  created_at INTEGER NOT NULL
+);
+
+CREATE INDEX ON odds (fixture_id, market, captured_at);
+CREATE INDEX ON odds (fixture_id, bookmaker_id, market, selection, captured_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE odds
+-- +goose StatementEnd
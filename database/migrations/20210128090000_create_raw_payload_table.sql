@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE raw_payload (
+  id SERIAL PRIMARY KEY,
+  provider VARCHAR NOT NULL,
+  endpoint VARCHAR NOT NULL,
+  entity_id BIGINT NULL,
+  body BYTEA NOT NULL,
+  fetched_at INTEGER NOT NULL
+);
+
+CREATE INDEX ON raw_payload (provider, endpoint, fetched_at);
+CREATE INDEX ON raw_payload (provider, entity_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE raw_payload
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE data_override (
+  id SERIAL PRIMARY KEY,
+  entity VARCHAR NOT NULL,
+  key VARCHAR NOT NULL,
+  field VARCHAR NOT NULL,
+  value VARCHAR NOT NULL,
+  reason VARCHAR NOT NULL,
+  author VARCHAR NOT NULL,
+  created_at INTEGER NOT NULL,
+  expires_at INTEGER NULL
+);
+
+CREATE INDEX ON data_override (entity, key);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE data_override
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE sportmonks_manager_tenure (
+  team_id INTEGER NOT NULL,
+  manager_id INTEGER NOT NULL,
+  date_from INTEGER NOT NULL,
+  date_to INTEGER NOT NULL,
+  created_at INTEGER NOT NULL,
+  updated_at INTEGER NOT NULL,
+  PRIMARY KEY (team_id, date_from, manager_id)
+);
+
+CREATE INDEX ON sportmonks_manager_tenure (manager_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE sportmonks_manager_tenure
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE sportmonks_referee (
+  id INTEGER NOT NULL PRIMARY KEY,
+  common_name VARCHAR NOT NULL,
+  full_name VARCHAR NOT NULL,
+  first_name VARCHAR NOT NULL,
+  last_name VARCHAR NOT NULL,
+  created_at INTEGER NOT NULL,
+  updated_at INTEGER NOT NULL
+);
+
+CREATE INDEX ON sportmonks_fixture (referee_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS sportmonks_fixture_referee_id_idx;
+DROP TABLE sportmonks_referee
+-- +goose StatementEnd
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE sportmonks_stage (
+  id INTEGER NOT NULL PRIMARY KEY,
+  name VARCHAR NOT NULL,
+  type VARCHAR,
+  competition_id INTEGER NOT NULL,
+  season_id INTEGER NOT NULL,
+  sort_order INTEGER,
+  has_standings BOOLEAN NOT NULL,
+  created_at INTEGER NOT NULL,
+  updated_at INTEGER NOT NULL
+);
+
+CREATE INDEX ON sportmonks_stage (season_id);
+
+CREATE TABLE sportmonks_group (
+  id INTEGER NOT NULL PRIMARY KEY,
+  name VARCHAR NOT NULL,
+  stage_id INTEGER NOT NULL,
+  season_id INTEGER NOT NULL,
+  created_at INTEGER NOT NULL,
+  updated_at INTEGER NOT NULL
+);
+
+CREATE INDEX ON sportmonks_group (stage_id);
+
+ALTER TABLE sportmonks_fixture
+  ADD COLUMN stage_id INTEGER,
+  ADD COLUMN group_id INTEGER,
+  ADD COLUMN aggregate_id INTEGER;
+
+CREATE INDEX ON sportmonks_fixture (stage_id);
+CREATE INDEX ON sportmonks_fixture (aggregate_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE sportmonks_fixture
+  DROP COLUMN stage_id,
+  DROP COLUMN group_id,
+  DROP COLUMN aggregate_id;
+
+DROP TABLE sportmonks_group;
+DROP TABLE sportmonks_stage
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE standings_rules (
+  competition_id INTEGER NOT NULL PRIMARY KEY,
+  points_for_win INTEGER NOT NULL,
+  points_for_draw INTEGER NOT NULL,
+  points_for_loss INTEGER NOT NULL,
+  tie_breakers VARCHAR[] NOT NULL,
+  created_at INTEGER NOT NULL,
+  updated_at INTEGER NOT NULL
+);
+
+CREATE TABLE standings_snapshot (
+  season_id INTEGER NOT NULL,
+  round_id INTEGER NOT NULL,
+  table_type VARCHAR NOT NULL,
+  position INTEGER NOT NULL,
+  team_id INTEGER NOT NULL,
+  played INTEGER NOT NULL,
+  won INTEGER NOT NULL,
+  drawn INTEGER NOT NULL,
+  lost INTEGER NOT NULL,
+  goals_for INTEGER NOT NULL,
+  goals_against INTEGER NOT NULL,
+  points INTEGER NOT NULL,
+  created_at INTEGER NOT NULL,
+  PRIMARY KEY (season_id, round_id, table_type, team_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE standings_snapshot;
+DROP TABLE standings_rules;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE sportmonks_sidelined (
+  player_id INTEGER NOT NULL,
+  team_id INTEGER NOT NULL,
+  season_id INTEGER NOT NULL,
+  reason VARCHAR NOT NULL,
+  type VARCHAR NOT NULL,
+  start_date INTEGER NOT NULL,
+  expected_end_date INTEGER NULL,
+  created_at INTEGER NOT NULL,
+  updated_at INTEGER NOT NULL,
+  PRIMARY KEY (player_id, team_id, season_id, start_date, reason)
+);
+
+CREATE INDEX ON sportmonks_sidelined (team_id, season_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE sportmonks_sidelined
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE suspension_rules (
+  competition_id INTEGER NOT NULL PRIMARY KEY,
+  yellow_thresholds JSONB NOT NULL,
+  straight_red_matches INTEGER NOT NULL,
+  second_yellow_matches INTEGER NOT NULL,
+  created_at INTEGER NOT NULL,
+  updated_at INTEGER NOT NULL
+);
+
+CREATE TABLE suspension (
+  player_id INTEGER NOT NULL,
+  team_id INTEGER NOT NULL,
+  season_id INTEGER NOT NULL,
+  reason VARCHAR NOT NULL,
+  trigger_fixture_id INTEGER NOT NULL,
+  matches INTEGER NOT NULL,
+  fixture_ids INTEGER[] NOT NULL,
+  created_at INTEGER NOT NULL,
+  PRIMARY KEY (player_id, team_id, season_id, trigger_fixture_id, reason)
+);
+
+CREATE INDEX ON suspension (team_id, season_id);
+CREATE INDEX ON suspension USING GIN (fixture_ids);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE suspension;
+DROP TABLE suspension_rules;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE sportmonks_squad_movement (
+  id SERIAL PRIMARY KEY,
+  season_id INTEGER NOT NULL,
+  team_id INTEGER NOT NULL,
+  player_id INTEGER NOT NULL,
+  direction VARCHAR NOT NULL,
+  detected_at INTEGER NOT NULL
+);
+
+CREATE INDEX ON sportmonks_squad_movement (season_id, team_id);
+CREATE INDEX ON sportmonks_squad_movement (player_id);
+
+CREATE TABLE sportmonks_transfer (
+  player_id INTEGER NOT NULL,
+  from_team_id INTEGER NOT NULL,
+  to_team_id INTEGER NOT NULL,
+  season_id INTEGER NULL,
+  date INTEGER NOT NULL,
+  type VARCHAR NOT NULL,
+  fee VARCHAR NULL,
+  created_at INTEGER NOT NULL,
+  updated_at INTEGER NOT NULL,
+  PRIMARY KEY (player_id, from_team_id, to_team_id, date)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE sportmonks_transfer;
+DROP TABLE sportmonks_squad_movement;
+-- +goose StatementEnd
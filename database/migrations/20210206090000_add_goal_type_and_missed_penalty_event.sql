@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE sportmonks_goal_event
+ADD COLUMN type VARCHAR NOT NULL DEFAULT 'goal',
+ADD COLUMN extra_minute INTEGER;
+
+CREATE INDEX ON sportmonks_goal_event (type);
+
+CREATE TABLE sportmonks_missed_penalty_event (
+  id BIGINT NOT NULL PRIMARY KEY,
+  fixture_id INTEGER NOT NULL,
+  team_id INTEGER NOT NULL,
+  player_id INTEGER NOT NULL,
+  minute INTEGER NOT NULL,
+  extra_minute INTEGER,
+  created_at INTEGER NOT NULL
+);
+
+CREATE INDEX ON sportmonks_missed_penalty_event (fixture_id);
+CREATE INDEX ON sportmonks_missed_penalty_event (player_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE sportmonks_missed_penalty_event;
+
+ALTER TABLE sportmonks_goal_event
+DROP COLUMN type,
+DROP COLUMN extra_minute;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE sportmonks_lineup (
+  fixture_id INTEGER NOT NULL,
+  team_id INTEGER NOT NULL,
+  formation VARCHAR,
+  created_at INTEGER NOT NULL,
+  updated_at INTEGER NOT NULL,
+  PRIMARY KEY (fixture_id, team_id)
+);
+
+CREATE TABLE sportmonks_lineup_player (
+  fixture_id INTEGER NOT NULL,
+  team_id INTEGER NOT NULL,
+  player_id INTEGER NOT NULL,
+  shirt_number INTEGER NOT NULL,
+  position VARCHAR,
+  formation_position INTEGER,
+  grid_x INTEGER,
+  grid_y INTEGER,
+  captain BOOLEAN NOT NULL,
+  is_substitute BOOLEAN NOT NULL,
+  PRIMARY KEY (fixture_id, team_id, player_id)
+);
+
+CREATE INDEX ON sportmonks_lineup_player (player_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE sportmonks_lineup_player;
+DROP TABLE sportmonks_lineup;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE sportmonks_fixture
+  ADD COLUMN status VARCHAR;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE sportmonks_fixture
+  DROP COLUMN status;
+-- +goose StatementEnd
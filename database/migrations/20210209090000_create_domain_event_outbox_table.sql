@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE domain_event_outbox (
+  id SERIAL PRIMARY KEY,
+  name VARCHAR NOT NULL,
+  aggregate_id INTEGER NOT NULL,
+  payload JSONB NOT NULL,
+  attempts INTEGER NOT NULL DEFAULT 0,
+  last_error VARCHAR,
+  created_at INTEGER NOT NULL,
+  relayed_at INTEGER
+);
+
+CREATE INDEX ON domain_event_outbox (id) WHERE relayed_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE domain_event_outbox;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE webhook_subscription (
+  id SERIAL PRIMARY KEY,
+  url VARCHAR NOT NULL,
+  event_types VARCHAR[] NOT NULL,
+  competition_ids INTEGER[] NOT NULL DEFAULT '{}',
+  secret VARCHAR NOT NULL,
+  created_at INTEGER NOT NULL
+);
+
+CREATE TABLE webhook_delivery (
+  id SERIAL PRIMARY KEY,
+  subscription_id INTEGER NOT NULL REFERENCES webhook_subscription (id) ON DELETE CASCADE,
+  event_name VARCHAR NOT NULL,
+  payload JSONB NOT NULL,
+  status VARCHAR NOT NULL,
+  attempts INTEGER NOT NULL DEFAULT 0,
+  next_attempt_at INTEGER NOT NULL,
+  created_at INTEGER NOT NULL,
+  delivered_at INTEGER
+);
+
+CREATE INDEX ON webhook_delivery (next_attempt_at) WHERE status = 'pending';
+
+CREATE TABLE webhook_delivery_attempt (
+  id SERIAL PRIMARY KEY,
+  delivery_id INTEGER NOT NULL REFERENCES webhook_delivery (id) ON DELETE CASCADE,
+  attempt INTEGER NOT NULL,
+  status_code INTEGER,
+  error VARCHAR,
+  attempted_at INTEGER NOT NULL
+);
+
+CREATE INDEX ON webhook_delivery_attempt (delivery_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_delivery_attempt;
+DROP TABLE webhook_delivery;
+DROP TABLE webhook_subscription;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE fixture_status_anomaly (
+  id SERIAL PRIMARY KEY,
+  fixture_id INTEGER NOT NULL,
+  from_status VARCHAR NOT NULL,
+  to_status VARCHAR NOT NULL,
+  created_at INTEGER NOT NULL
+);
+
+CREATE INDEX ON fixture_status_anomaly (fixture_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE fixture_status_anomaly;
+-- +goose StatementEnd
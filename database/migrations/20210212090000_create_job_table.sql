@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE job (
+  id SERIAL PRIMARY KEY,
+  command VARCHAR NOT NULL,
+  option VARCHAR NOT NULL DEFAULT '',
+  priority INTEGER NOT NULL DEFAULT 0,
+  not_before INTEGER NOT NULL,
+  status VARCHAR NOT NULL,
+  attempts INTEGER NOT NULL DEFAULT 0,
+  max_attempts INTEGER NOT NULL,
+  last_error VARCHAR,
+  worker_id VARCHAR,
+  heartbeat_at INTEGER,
+  created_at INTEGER NOT NULL,
+  updated_at INTEGER NOT NULL
+);
+
+CREATE INDEX ON job (priority DESC, not_before) WHERE status = 'pending';
+CREATE INDEX ON job (heartbeat_at) WHERE status = 'running';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE job;
+-- +goose StatementEnd
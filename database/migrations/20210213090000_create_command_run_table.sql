@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE command_run (
+  id SERIAL PRIMARY KEY,
+  command VARCHAR NOT NULL,
+  option VARCHAR NOT NULL DEFAULT '',
+  status VARCHAR NOT NULL,
+  error VARCHAR,
+  started_at INTEGER NOT NULL,
+  finished_at INTEGER
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE command_run;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE checkpoint (
+  run VARCHAR NOT NULL,
+  unit VARCHAR NOT NULL,
+  unit_id INTEGER NOT NULL,
+  completed_at INTEGER NOT NULL,
+  PRIMARY KEY (run, unit, unit_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE checkpoint;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE ingestion_policy (
+  competition_id INTEGER PRIMARY KEY,
+  datasets VARCHAR[] NOT NULL DEFAULT '{}',
+  tier VARCHAR NOT NULL,
+  history_depth INTEGER NOT NULL DEFAULT 0,
+  updated_at INTEGER NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE ingestion_policy;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE domain_event_outbox ADD COLUMN dead_lettered_at INTEGER;
+
+DROP INDEX IF EXISTS domain_event_outbox_id_idx;
+
+CREATE INDEX ON domain_event_outbox (id) WHERE relayed_at IS NULL AND dead_lettered_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS domain_event_outbox_id_idx;
+
+CREATE INDEX ON domain_event_outbox (id) WHERE relayed_at IS NULL;
+
+ALTER TABLE domain_event_outbox DROP COLUMN dead_lettered_at;
+-- +goose StatementEnd
@@ -0,0 +1,68 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION notify_fixture_update() RETURNS TRIGGER AS $$
+DECLARE
+    update_fixture_id BIGINT;
+    update_event_id BIGINT;
+BEGIN
+    IF TG_TABLE_NAME = 'sportmonks_fixture' THEN
+        update_fixture_id := NEW.id;
+    ELSIF TG_TABLE_NAME = 'sportmonks_result' THEN
+        update_fixture_id := NEW.fixture_id;
+    ELSE
+        update_fixture_id := NEW.fixture_id;
+        update_event_id := NEW.id;
+    END IF;
+
+    -- The notification is delivered when the write commits, a failure to publish it must not fail the write.
+    BEGIN
+        PERFORM pg_notify(
+            'fixture_update',
+            json_build_object('fixture_id', update_fixture_id, 'type', TG_ARGV[0], 'event_id', update_event_id)::TEXT
+        );
+    EXCEPTION WHEN OTHERS THEN
+        RAISE WARNING 'Error % occurred when notifying fixture update for fixture %', SQLERRM, update_fixture_id;
+    END;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER sportmonks_fixture_status_notify AFTER UPDATE OF status ON sportmonks_fixture
+FOR EACH ROW WHEN (OLD.status IS DISTINCT FROM NEW.status) EXECUTE PROCEDURE notify_fixture_update('status');
+
+CREATE TRIGGER sportmonks_result_insert_notify AFTER INSERT ON sportmonks_result
+FOR EACH ROW EXECUTE PROCEDURE notify_fixture_update('result');
+
+CREATE TRIGGER sportmonks_result_score_notify AFTER UPDATE ON sportmonks_result
+FOR EACH ROW WHEN (
+    OLD.home_score IS DISTINCT FROM NEW.home_score OR
+    OLD.away_score IS DISTINCT FROM NEW.away_score OR
+    OLD.home_pen_score IS DISTINCT FROM NEW.home_pen_score OR
+    OLD.away_pen_score IS DISTINCT FROM NEW.away_pen_score
+) EXECUTE PROCEDURE notify_fixture_update('result');
+
+CREATE TRIGGER sportmonks_card_event_notify AFTER INSERT ON sportmonks_card_event
+FOR EACH ROW EXECUTE PROCEDURE notify_fixture_update('card');
+
+CREATE TRIGGER sportmonks_goal_event_notify AFTER INSERT ON sportmonks_goal_event
+FOR EACH ROW EXECUTE PROCEDURE notify_fixture_update('goal');
+
+CREATE TRIGGER sportmonks_missed_penalty_event_notify AFTER INSERT ON sportmonks_missed_penalty_event
+FOR EACH ROW EXECUTE PROCEDURE notify_fixture_update('missed-penalty');
+
+CREATE TRIGGER sportmonks_substitution_event_notify AFTER INSERT ON sportmonks_substitution_event
+FOR EACH ROW EXECUTE PROCEDURE notify_fixture_update('substitution');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS sportmonks_substitution_event_notify ON sportmonks_substitution_event;
+DROP TRIGGER IF EXISTS sportmonks_missed_penalty_event_notify ON sportmonks_missed_penalty_event;
+DROP TRIGGER IF EXISTS sportmonks_goal_event_notify ON sportmonks_goal_event;
+DROP TRIGGER IF EXISTS sportmonks_card_event_notify ON sportmonks_card_event;
+DROP TRIGGER IF EXISTS sportmonks_result_score_notify ON sportmonks_result;
+DROP TRIGGER IF EXISTS sportmonks_result_insert_notify ON sportmonks_result;
+DROP TRIGGER IF EXISTS sportmonks_fixture_status_notify ON sportmonks_fixture;
+DROP FUNCTION IF EXISTS notify_fixture_update();
+-- +goose StatementEnd
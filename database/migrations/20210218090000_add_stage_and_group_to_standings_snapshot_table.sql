@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE standings_snapshot ADD COLUMN stage_id INTEGER;
+ALTER TABLE standings_snapshot ADD COLUMN group_id INTEGER;
+
+ALTER TABLE standings_snapshot DROP CONSTRAINT standings_snapshot_pkey;
+
+CREATE UNIQUE INDEX standings_snapshot_team_idx ON standings_snapshot
+  (season_id, round_id, table_type, COALESCE(stage_id, 0), COALESCE(group_id, 0), team_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS standings_snapshot_team_idx;
+
+DELETE FROM standings_snapshot WHERE stage_id IS NOT NULL OR group_id IS NOT NULL;
+
+ALTER TABLE standings_snapshot ADD PRIMARY KEY (season_id, round_id, table_type, team_id);
+
+ALTER TABLE standings_snapshot DROP COLUMN group_id;
+ALTER TABLE standings_snapshot DROP COLUMN stage_id;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE sportmonks_fixture DISABLE TRIGGER sportmonks_fixture_status_notify;
+
+UPDATE sportmonks_fixture SET status = CASE
+    WHEN sportmonks_result.home_pen_score IS NOT NULL AND sportmonks_result.away_pen_score IS NOT NULL THEN 'FT_PEN'
+    WHEN NULLIF(sportmonks_result.extra_time_score, '') IS NOT NULL THEN 'AET'
+    ELSE 'FT'
+  END
+FROM sportmonks_result
+WHERE sportmonks_result.fixture_id = sportmonks_fixture.id
+  AND sportmonks_fixture.status IS NULL
+  AND sportmonks_result.home_score IS NOT NULL
+  AND sportmonks_result.away_score IS NOT NULL;
+
+UPDATE sportmonks_fixture SET status = 'NS'
+WHERE status IS NULL AND date > EXTRACT(EPOCH FROM NOW());
+
+ALTER TABLE sportmonks_fixture ENABLE TRIGGER sportmonks_fixture_status_notify;
+-- +goose StatementEnd
+
+-- +goose Down
+-- Backfilled statuses cannot be told apart from statuses provided by SportMonks so are left in place.
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE sportmonks_manager_sighting (
+  team_id INTEGER NOT NULL,
+  fixture_id INTEGER NOT NULL,
+  manager_id INTEGER NOT NULL,
+  date INTEGER NOT NULL,
+  created_at INTEGER NOT NULL,
+  updated_at INTEGER NOT NULL,
+  PRIMARY KEY (team_id, fixture_id)
+);
+
+-- Seed sightings from the fixtures within existing tenures so tenure histories are not lost when the next season
+-- processed rebuilds them from stored sightings.
+INSERT INTO sportmonks_manager_sighting (team_id, fixture_id, manager_id, date, created_at, updated_at)
+SELECT t.team_id, f.id, t.manager_id, f.date, t.created_at, t.updated_at
+FROM sportmonks_manager_tenure t
+JOIN sportmonks_fixture f ON t.team_id IN (f.home_team_id, f.away_team_id) AND f.date BETWEEN t.date_from AND t.date_to
+ON CONFLICT (team_id, fixture_id) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE sportmonks_manager_sighting
+-- +goose StatementEnd
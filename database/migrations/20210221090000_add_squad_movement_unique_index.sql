@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+DELETE FROM sportmonks_squad_movement a
+USING sportmonks_squad_movement b
+WHERE a.id > b.id
+AND a.season_id = b.season_id
+AND a.team_id = b.team_id
+AND a.player_id = b.player_id
+AND a.direction = b.direction
+AND a.detected_at = b.detected_at;
+
+CREATE UNIQUE INDEX sportmonks_squad_movement_unique_idx
+ON sportmonks_squad_movement (season_id, team_id, player_id, direction, detected_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX sportmonks_squad_movement_unique_idx
+-- +goose StatementEnd
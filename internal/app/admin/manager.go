@@ -0,0 +1,341 @@
+package admin
+
+import (
+	e "errors"
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"sync"
+)
+
+// maxTrackedRuns is the number of finished runs kept in memory so their log lines can be watched.
+const maxTrackedRuns = 20
+
+var (
+	ErrCommandNotSupported = e.New("the command provided is not supported")
+	ErrRunFinished         = e.New("the run has already finished")
+)
+
+// Processor runs a console command, signalling on the done channel once complete.
+type Processor interface {
+	Process(command string, option string, done chan bool)
+}
+
+// ProcessorFactory returns the processor responsible for the command provided logging to the logger provided,
+// alongside a function that stops the processor by closing the resources it uses. Each call returns a processor
+// with its own resources so stopping one run does not affect another. The stop function is called once the run
+// finishes, including when no processor is returned for the command.
+type ProcessorFactory func(command string, logger *logrus.Logger) (Processor, func())
+
+// LockPolicy returns the strategy used when the command provided is already running in another process, or false
+// if the command does not require a lock.
+type LockPolicy func(command string) (app.LockStrategy, bool)
+
+// Manager runs console command processors in-process on behalf of the admin API. Runs hold the same lock as the
+// console so a run started through the admin API does not race a scheduled console run. Log lines are recorded for
+// each run and can be watched whilst the run is in progress, the outcome of every run is stored using the
+// CommandRunRepository.
+type Manager struct {
+	commands []string
+	factory  ProcessorFactory
+	policy   LockPolicy
+	locker   app.Locker
+	runRepo  app.CommandRunRepository
+	clock    clockwork.Clock
+	logger   *logrus.Logger
+	mutex    sync.Mutex
+	runs     map[uint64]*run
+	order    []uint64
+}
+
+// Commands returns the commands that can be run.
+func (m *Manager) Commands() []string {
+	return m.commands
+}
+
+// Start starts a run of the command in the background and returns the run. The lock strategy configured for the
+// command is used unless a strategy is provided.
+func (m *Manager) Start(command, option string, strategy *app.LockStrategy) (*app.CommandRun, error) {
+	if !m.supports(command) {
+		return nil, ErrCommandNotSupported
+	}
+
+	s, locked := m.policy(command)
+
+	if strategy != nil {
+		s, locked = *strategy, true
+	}
+
+	c := app.CommandRun{
+		Command:   command,
+		Option:    option,
+		Status:    app.CommandRunRunning,
+		StartedAt: m.clock.Now(),
+	}
+
+	if err := m.runRepo.Insert(&c); err != nil {
+		return nil, err
+	}
+
+	r := newRun(c)
+
+	m.track(r)
+
+	go m.execute(r, s, locked)
+
+	return &c, nil
+}
+
+// Watch returns the lines logged by the run so far alongside a channel receiving lines logged from now on, closed
+// once the run finishes, and a function to stop watching. Only runs started by this Manager can be watched,
+// errors.ErrorNotFound is returned for any other run.
+func (m *Manager) Watch(id uint64) ([]app.CommandRunLog, <-chan app.CommandRunLog, func(), error) {
+	m.mutex.Lock()
+	r, ok := m.runs[id]
+	m.mutex.Unlock()
+
+	if !ok {
+		return nil, nil, nil, errors.ErrorNotFound
+	}
+
+	logs, ch, stop := r.subscribe()
+
+	return logs, ch, stop, nil
+}
+
+// Cancel stops a run in progress, returning ErrRunFinished if the run has already finished.
+func (m *Manager) Cancel(id uint64) (*app.CommandRun, error) {
+	m.mutex.Lock()
+	r, ok := m.runs[id]
+	m.mutex.Unlock()
+
+	if !ok {
+		c, err := m.runRepo.ByID(id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if c.IsFinished() {
+			return nil, ErrRunFinished
+		}
+
+		// The run was started by another application instance.
+		return nil, errors.ErrorNotFound
+	}
+
+	c := r.snapshot()
+
+	if c.IsFinished() {
+		return nil, ErrRunFinished
+	}
+
+	r.cancel()
+
+	return &c, nil
+}
+
+// Run returns the current state of a run.
+func (m *Manager) Run(id uint64) (*app.CommandRun, error) {
+	m.mutex.Lock()
+	r, ok := m.runs[id]
+	m.mutex.Unlock()
+
+	if ok {
+		c := r.snapshot()
+		return &c, nil
+	}
+
+	return m.runRepo.ByID(id)
+}
+
+// History returns the most recently started runs.
+func (m *Manager) History(limit uint64) ([]app.CommandRun, error) {
+	return m.runRepo.Recent(limit)
+}
+
+func (m *Manager) execute(r *run, s app.LockStrategy, locked bool) {
+	c := r.snapshot()
+	logger := m.runLogger(r)
+
+	logger.Infof("Run %d of '%s %s' started", c.ID, c.Command, c.Option)
+
+	if locked {
+		name := app.CommandLockName(c.Command, c.Option)
+
+		l, err := m.locker.Acquire(name, s)
+
+		if err == app.ErrLockHeld {
+			logger.Warningf("Skipping '%s' as the lock is held by another process", name)
+			m.finish(r, logger, app.CommandRunSkipped, nil)
+			return
+		}
+
+		if err != nil {
+			reason := err.Error()
+			logger.Errorf("Error '%s' occurred when acquiring lock '%s'", reason, name)
+			m.finish(r, logger, app.CommandRunFailed, &reason)
+			return
+		}
+
+		defer func() {
+			if err := l.Release(); err != nil {
+				logger.Warningf("Error '%s' occurred when releasing lock '%s'", err.Error(), name)
+			}
+		}()
+
+		if isClosed(r.cancelled) {
+			m.finish(r, logger, app.CommandRunCancelled, nil)
+			return
+		}
+
+		m.process(r, logger, l.Lost())
+		return
+	}
+
+	m.process(r, logger, nil)
+}
+
+// process runs the processor until it completes, logs a fatal error, the run is cancelled or the lock is lost. The
+// processor is stopped before returning so it cannot continue persisting data once the lock is released.
+func (m *Manager) process(r *run, logger *logrus.Logger, lost <-chan struct{}) {
+	c := r.snapshot()
+
+	processor, stop := m.factory(c.Command, logger)
+
+	if processor == nil {
+		stop()
+		reason := ErrCommandNotSupported.Error()
+		m.finish(r, logger, app.CommandRunFailed, &reason)
+		return
+	}
+
+	done := make(chan bool, 1)
+
+	go processor.Process(c.Command, c.Option, done)
+
+	select {
+	case <-done:
+	case <-r.fatal:
+	case <-r.cancelled:
+	case <-lost:
+	}
+
+	stop()
+
+	switch {
+	case isClosed(lost):
+		reason := "lock was lost to another process"
+		logger.Errorf("Lock for run %d was lost to another process, stopping processing", c.ID)
+		m.finish(r, logger, app.CommandRunFailed, &reason)
+	case isClosed(r.fatal):
+		r.mutex.Lock()
+		reason := r.fatalError
+		r.mutex.Unlock()
+		m.finish(r, logger, app.CommandRunFailed, &reason)
+	case isClosed(r.cancelled):
+		m.finish(r, logger, app.CommandRunCancelled, nil)
+	default:
+		m.finish(r, logger, app.CommandRunSucceeded, nil)
+	}
+}
+
+func (m *Manager) finish(r *run, logger *logrus.Logger, status string, reason *string) {
+	c := r.snapshot()
+	now := m.clock.Now()
+
+	c.Status = status
+	c.Error = reason
+	c.FinishedAt = &now
+
+	logger.Infof("Run %d of '%s %s' finished with status '%s'", c.ID, c.Command, c.Option, status)
+
+	if err := m.runRepo.Update(&c); err != nil {
+		m.logger.Errorf("Error '%s' occurred when storing outcome of run %d", err.Error(), c.ID)
+	}
+
+	r.finish(c)
+}
+
+// runLogger returns a logger for the processor of the run, writing to the same output and hooks as the application
+// logger whilst recording each line against the run. A fatal error ends the run rather than the process.
+func (m *Manager) runLogger(r *run) *logrus.Logger {
+	hooks := make(logrus.LevelHooks)
+
+	for level, h := range m.logger.Hooks {
+		hooks[level] = append([]logrus.Hook{}, h...)
+	}
+
+	hooks.Add(r)
+
+	logger := logrus.New()
+	logger.SetFormatter(m.logger.Formatter)
+	logger.SetOutput(m.logger.Out)
+	logger.SetLevel(m.logger.Level)
+	logger.ReplaceHooks(hooks)
+	logger.ExitFunc = r.exit
+
+	return logger
+}
+
+// track stores the run, discarding the oldest finished runs once more than maxTrackedRuns are stored.
+func (m *Manager) track(r *run) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.runs[r.id] = r
+	m.order = append(m.order, r.id)
+
+	for i := 0; len(m.order) > maxTrackedRuns && i < len(m.order); {
+		id := m.order[i]
+
+		if c := m.runs[id].snapshot(); c.IsFinished() {
+			delete(m.runs, id)
+			m.order = append(m.order[:i], m.order[i+1:]...)
+			continue
+		}
+
+		i++
+	}
+}
+
+func (m *Manager) supports(command string) bool {
+	for _, c := range m.commands {
+		if c == command {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isClosed(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+func NewManager(
+	commands []string,
+	f ProcessorFactory,
+	p LockPolicy,
+	l app.Locker,
+	r app.CommandRunRepository,
+	c clockwork.Clock,
+	log *logrus.Logger,
+) *Manager {
+	return &Manager{
+		commands: commands,
+		factory:  f,
+		policy:   p,
+		locker:   l,
+		runRepo:  r,
+		clock:    c,
+		logger:   log,
+		runs:     make(map[uint64]*run),
+	}
+}
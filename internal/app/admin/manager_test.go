@@ -0,0 +1,293 @@
+package admin_test
+
+import (
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/admin"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestManager_Start(t *testing.T) {
+	now := time.Date(2021, 2, 13, 9, 0, 0, 0, time.UTC)
+	commands := []string{"results:by-season-id", "season"}
+	policy := func(command string) (app.LockStrategy, bool) { return app.LockStrategySkip, true }
+
+	t.Run("runs processor holding the command lock and records its log lines and outcome", func(t *testing.T) {
+		t.Helper()
+
+		locker := new(mock.Locker)
+		lock := new(mock.Lock)
+		runRepo := new(mock.CommandRunRepository)
+		logger, _ := test.NewNullLogger()
+
+		stopped := false
+
+		factory := func(command string, log *logrus.Logger) (admin.Processor, func()) {
+			p := processorFunc(func(command, option string, done chan bool) {
+				log.Infof("Processing %s %s", command, option)
+				done <- true
+			})
+
+			return p, func() { stopped = true }
+		}
+
+		manager := admin.NewManager(commands, factory, policy, locker, runRepo, clockwork.NewFakeClockAt(now), logger)
+
+		finished := make(chan app.CommandRun, 1)
+
+		runRepo.On("Insert", m.AnythingOfType("*app.CommandRun")).Run(func(args m.Arguments) {
+			args.Get(0).(*app.CommandRun).ID = 5
+		}).Return(nil)
+		runRepo.On("Update", m.AnythingOfType("*app.CommandRun")).Run(func(args m.Arguments) {
+			finished <- *args.Get(0).(*app.CommandRun)
+		}).Return(nil)
+		locker.On("Acquire", "console:results:by-season-id 16036", app.LockStrategySkip).Return(lock, nil)
+		lock.On("Lost").Return(make(chan struct{}))
+		lock.On("Release").Return(nil)
+
+		run, err := manager.Start("results:by-season-id", "16036", nil)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(5), run.ID)
+		a.Equal(app.CommandRunRunning, run.Status)
+		a.Equal(now, run.StartedAt)
+
+		outcome := <-finished
+
+		a.Equal(app.CommandRunSucceeded, outcome.Status)
+		a.Nil(outcome.Error)
+		a.Equal(now, *outcome.FinishedAt)
+		a.True(stopped)
+		lock.AssertCalled(t, "Release")
+
+		logs, ch, _, err := manager.Watch(5)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		_, open := <-ch
+
+		a.False(open)
+		a.Equal(3, len(logs))
+		a.Equal("Run 5 of 'results:by-season-id 16036' started", logs[0].Message)
+		a.Equal("Processing results:by-season-id 16036", logs[1].Message)
+		a.Equal("info", logs[1].Level)
+		a.Equal("Run 5 of 'results:by-season-id 16036' finished with status 'succeeded'", logs[2].Message)
+	})
+
+	t.Run("marks run as failed if the processor logs a fatal error", func(t *testing.T) {
+		t.Helper()
+
+		locker := new(mock.Locker)
+		lock := new(mock.Lock)
+		runRepo := new(mock.CommandRunRepository)
+		logger, _ := test.NewNullLogger()
+
+		factory := func(command string, log *logrus.Logger) (admin.Processor, func()) {
+			p := processorFunc(func(command, option string, done chan bool) {
+				log.Fatalf("Error when retrieving season IDs: %s", "oh no")
+			})
+
+			return p, func() {}
+		}
+
+		manager := admin.NewManager(commands, factory, policy, locker, runRepo, clockwork.NewFakeClockAt(now), logger)
+
+		finished := make(chan app.CommandRun, 1)
+
+		runRepo.On("Insert", m.AnythingOfType("*app.CommandRun")).Return(nil)
+		runRepo.On("Update", m.AnythingOfType("*app.CommandRun")).Run(func(args m.Arguments) {
+			finished <- *args.Get(0).(*app.CommandRun)
+		}).Return(nil)
+		locker.On("Acquire", "console:season", app.LockStrategySkip).Return(lock, nil)
+		lock.On("Lost").Return(make(chan struct{}))
+		lock.On("Release").Return(nil)
+
+		if _, err := manager.Start("season", "", nil); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		outcome := <-finished
+
+		assert.Equal(t, app.CommandRunFailed, outcome.Status)
+		assert.Equal(t, "Error when retrieving season IDs: oh no", *outcome.Error)
+	})
+
+	t.Run("skips run if the lock is held by another process", func(t *testing.T) {
+		t.Helper()
+
+		locker := new(mock.Locker)
+		runRepo := new(mock.CommandRunRepository)
+		logger, _ := test.NewNullLogger()
+
+		factory := func(command string, log *logrus.Logger) (admin.Processor, func()) {
+			t.Fatal("Expected processor not to be created")
+			return nil, nil
+		}
+
+		manager := admin.NewManager(commands, factory, policy, locker, runRepo, clockwork.NewFakeClockAt(now), logger)
+
+		finished := make(chan app.CommandRun, 1)
+		steal := app.LockStrategySteal
+
+		runRepo.On("Insert", m.AnythingOfType("*app.CommandRun")).Return(nil)
+		runRepo.On("Update", m.AnythingOfType("*app.CommandRun")).Run(func(args m.Arguments) {
+			finished <- *args.Get(0).(*app.CommandRun)
+		}).Return(nil)
+		locker.On("Acquire", "console:season", steal).Return((*mock.Lock)(nil), app.ErrLockHeld)
+
+		if _, err := manager.Start("season", "", &steal); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		outcome := <-finished
+
+		assert.Equal(t, app.CommandRunSkipped, outcome.Status)
+	})
+
+	t.Run("stops the resources of the run if no processor is returned for the command", func(t *testing.T) {
+		t.Helper()
+
+		locker := new(mock.Locker)
+		lock := new(mock.Lock)
+		runRepo := new(mock.CommandRunRepository)
+		logger, _ := test.NewNullLogger()
+
+		stopped := false
+
+		factory := func(command string, log *logrus.Logger) (admin.Processor, func()) {
+			return nil, func() { stopped = true }
+		}
+
+		manager := admin.NewManager(commands, factory, policy, locker, runRepo, clockwork.NewFakeClockAt(now), logger)
+
+		finished := make(chan app.CommandRun, 1)
+
+		runRepo.On("Insert", m.AnythingOfType("*app.CommandRun")).Return(nil)
+		runRepo.On("Update", m.AnythingOfType("*app.CommandRun")).Run(func(args m.Arguments) {
+			finished <- *args.Get(0).(*app.CommandRun)
+		}).Return(nil)
+		locker.On("Acquire", "console:season", app.LockStrategySkip).Return(lock, nil)
+		lock.On("Lost").Return(make(chan struct{}))
+		lock.On("Release").Return(nil)
+
+		if _, err := manager.Start("season", "", nil); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		outcome := <-finished
+
+		assert.Equal(t, app.CommandRunFailed, outcome.Status)
+		assert.Equal(t, admin.ErrCommandNotSupported.Error(), *outcome.Error)
+		assert.True(t, stopped)
+	})
+
+	t.Run("returns error if command is not supported", func(t *testing.T) {
+		t.Helper()
+
+		runRepo := new(mock.CommandRunRepository)
+		logger, _ := test.NewNullLogger()
+
+		manager := admin.NewManager(commands, nil, policy, new(mock.Locker), runRepo, clockwork.NewFakeClockAt(now), logger)
+
+		_, err := manager.Start("teams", "", nil)
+
+		assert.Equal(t, admin.ErrCommandNotSupported, err)
+		runRepo.AssertNotCalled(t, "Insert", m.Anything)
+	})
+}
+
+func TestManager_Cancel(t *testing.T) {
+	now := time.Date(2021, 2, 13, 9, 0, 0, 0, time.UTC)
+	commands := []string{"results:by-season-id"}
+	policy := func(command string) (app.LockStrategy, bool) { return "", false }
+
+	t.Run("stops the processor of a run in progress and streams its log lines to watchers", func(t *testing.T) {
+		t.Helper()
+
+		runRepo := new(mock.CommandRunRepository)
+		logger, _ := test.NewNullLogger()
+
+		started := make(chan *logrus.Logger)
+		stop := make(chan struct{})
+
+		factory := func(command string, log *logrus.Logger) (admin.Processor, func()) {
+			p := processorFunc(func(command, option string, done chan bool) {
+				started <- log
+				<-stop
+			})
+
+			return p, func() { close(stop) }
+		}
+
+		manager := admin.NewManager(commands, factory, policy, new(mock.Locker), runRepo, clockwork.NewFakeClockAt(now), logger)
+
+		finished := make(chan app.CommandRun, 1)
+
+		runRepo.On("Insert", m.AnythingOfType("*app.CommandRun")).Run(func(args m.Arguments) {
+			args.Get(0).(*app.CommandRun).ID = 7
+		}).Return(nil)
+		runRepo.On("Update", m.AnythingOfType("*app.CommandRun")).Run(func(args m.Arguments) {
+			finished <- *args.Get(0).(*app.CommandRun)
+		}).Return(nil)
+
+		if _, err := manager.Start("results:by-season-id", "16036", nil); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		log := <-started
+
+		logs, ch, _, err := manager.Watch(7)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		log.Warning("Error when fetching fixture 45")
+
+		run, err := manager.Cancel(7)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(app.CommandRunRunning, run.Status)
+		a.Equal(app.CommandRunCancelled, (<-finished).Status)
+		a.Equal(1, len(logs))
+
+		var watched []string
+
+		for l := range ch {
+			watched = append(watched, l.Message)
+		}
+
+		a.Equal([]string{
+			"Error when fetching fixture 45",
+			"Run 7 of 'results:by-season-id 16036' finished with status 'cancelled'",
+		}, watched)
+
+		_, err = manager.Cancel(7)
+
+		a.Equal(admin.ErrRunFinished, err)
+	})
+}
+
+type processorFunc func(command, option string, done chan bool)
+
+func (p processorFunc) Process(command string, option string, done chan bool) {
+	p(command, option, done)
+}
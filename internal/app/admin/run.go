@@ -0,0 +1,128 @@
+package admin
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"sync"
+)
+
+// maxRunLogs is the number of lines kept for each run, sent to clients that start watching a run after it starts.
+const maxRunLogs = 1000
+
+// run tracks a CommandRun in progress, recording the lines logged by its processor and fanning them out to
+// watchers.
+type run struct {
+	id          uint64
+	mutex       sync.Mutex
+	run         app.CommandRun
+	logs        []app.CommandRunLog
+	subscribers map[chan app.CommandRunLog]bool
+	fatal       chan struct{}
+	fatalOnce   sync.Once
+	fatalError  string
+	cancelled   chan struct{}
+	cancelOnce  sync.Once
+}
+
+func (r *run) snapshot() app.CommandRun {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.run
+}
+
+// Levels and Fire implement logrus.Hook so every line logged by the processor of the run is recorded.
+func (r *run) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (r *run) Fire(e *logrus.Entry) error {
+	e.Data["run_id"] = r.id
+
+	l := app.CommandRunLog{Level: e.Level.String(), Message: e.Message, Time: e.Time}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if e.Level <= logrus.FatalLevel && r.fatalError == "" {
+		r.fatalError = e.Message
+	}
+
+	if len(r.logs) < maxRunLogs {
+		r.logs = append(r.logs, l)
+	}
+
+	for ch := range r.subscribers {
+		select {
+		case ch <- l:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// exit is used in place of exiting the process when the processor logs a fatal error.
+func (r *run) exit(int) {
+	r.fatalOnce.Do(func() {
+		close(r.fatal)
+	})
+}
+
+func (r *run) cancel() {
+	r.cancelOnce.Do(func() {
+		close(r.cancelled)
+	})
+}
+
+func (r *run) subscribe() ([]app.CommandRunLog, <-chan app.CommandRunLog, func()) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	logs := make([]app.CommandRunLog, len(r.logs))
+	copy(logs, r.logs)
+
+	ch := make(chan app.CommandRunLog, 100)
+
+	if r.run.IsFinished() {
+		close(ch)
+		return logs, ch, func() {}
+	}
+
+	r.subscribers[ch] = true
+
+	unsubscribe := func() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+
+		if r.subscribers[ch] {
+			delete(r.subscribers, ch)
+			close(ch)
+		}
+	}
+
+	return logs, ch, unsubscribe
+}
+
+// finish records the outcome of the run and closes the channel of each watcher.
+func (r *run) finish(c app.CommandRun) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.run = c
+
+	for ch := range r.subscribers {
+		delete(r.subscribers, ch)
+		close(ch)
+	}
+}
+
+func newRun(c app.CommandRun) *run {
+	return &run{
+		id:          c.ID,
+		run:         c,
+		subscribers: make(map[chan app.CommandRunLog]bool),
+		fatal:       make(chan struct{}),
+		cancelled:   make(chan struct{}),
+	}
+}
@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+)
+
+// CancelTransport sends requests with the context provided so requests in flight are aborted, and further requests
+// refused, once the context is cancelled. It allows a run to be cancelled whilst its processor awaits a provider.
+type CancelTransport struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+func (t *CancelTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return t.base.RoundTrip(req.WithContext(t.ctx))
+}
+
+func NewCancelTransport(ctx context.Context, base http.RoundTripper) *CancelTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &CancelTransport{ctx: ctx, base: base}
+}
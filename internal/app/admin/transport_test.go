@@ -0,0 +1,45 @@
+package admin_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data/internal/app/admin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCancelTransport_RoundTrip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	defer server.Close()
+
+	t.Run("sends requests until the context is cancelled", func(t *testing.T) {
+		t.Helper()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		client := http.Client{Transport: admin.NewCancelTransport(ctx, nil)}
+
+		res, err := client.Get(server.URL)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		res.Body.Close()
+
+		assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+		cancel()
+
+		_, err = client.Get(server.URL)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Contains(t, err.Error(), "context canceled")
+	})
+}
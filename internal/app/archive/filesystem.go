@@ -0,0 +1,137 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FilesystemStore archives payloads as gzip files on the local filesystem. Payloads are stored in a directory
+// per provider and endpoint with the endpoint, entity ID and fetch time recorded in the gzip header so each file
+// is self describing.
+type FilesystemStore struct {
+	root string
+}
+
+func (f *FilesystemStore) Save(p *app.RawPayload) error {
+	dir := f.dir(p.Provider, p.Endpoint)
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+
+	w := gzip.NewWriter(&buf)
+	w.Name = p.Endpoint
+	w.ModTime = p.FetchedAt
+
+	if p.EntityID != nil {
+		w.Comment = strconv.FormatUint(*p.EntityID, 10)
+	}
+
+	if _, err := w.Write(p.Body); err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	file := filepath.Join(dir, fmt.Sprintf("%020d.json.gz", p.FetchedAt.UnixNano()))
+
+	return ioutil.WriteFile(file, buf.Bytes(), 0644)
+}
+
+func (f *FilesystemStore) Latest(provider, endpoint string) (*app.RawPayload, error) {
+	dir := f.dir(provider, endpoint)
+
+	files, err := ioutil.ReadDir(dir)
+
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.ErrorNotFound
+		}
+
+		return nil, err
+	}
+
+	var names []string
+
+	for _, file := range files {
+		if strings.HasSuffix(file.Name(), ".json.gz") {
+			names = append(names, file.Name())
+		}
+	}
+
+	if len(names) == 0 {
+		return nil, errors.ErrorNotFound
+	}
+
+	sort.Strings(names)
+
+	return readPayload(provider, filepath.Join(dir, names[len(names)-1]))
+}
+
+func (f *FilesystemStore) dir(provider, endpoint string) string {
+	hash := sha1.Sum([]byte(endpoint))
+
+	return filepath.Join(f.root, provider, hex.EncodeToString(hash[:]))
+}
+
+func readPayload(provider, file string) (*app.RawPayload, error) {
+	in, err := os.Open(file)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer in.Close()
+
+	r, err := gzip.NewReader(in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.Close()
+
+	body, err := ioutil.ReadAll(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p := app.RawPayload{
+		Provider:  provider,
+		Endpoint:  r.Name,
+		Body:      body,
+		FetchedAt: r.ModTime,
+	}
+
+	if r.Comment != "" {
+		id, err := strconv.ParseUint(r.Comment, 10, 64)
+
+		if err != nil {
+			return nil, err
+		}
+
+		p.EntityID = &id
+	}
+
+	return &p, nil
+}
+
+func NewFilesystemStore(root string) *FilesystemStore {
+	return &FilesystemStore{root: root}
+}
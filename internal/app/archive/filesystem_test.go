@@ -0,0 +1,94 @@
+package archive_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/archive"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestFilesystemStore_Latest(t *testing.T) {
+	t.Run("returns the most recently fetched payload for endpoint", func(t *testing.T) {
+		t.Helper()
+
+		store := archive.NewFilesystemStore(tempDir(t))
+
+		id := uint64(11867285)
+
+		payloads := []*app.RawPayload{
+			{
+				Provider:  app.ProviderSportMonks,
+				Endpoint:  "/fixtures/11867285?include=stats",
+				EntityID:  &id,
+				Body:      []byte(`{"data": {"id": 1}}`),
+				FetchedAt: time.Unix(1548086929, 0),
+			},
+			{
+				Provider:  app.ProviderSportMonks,
+				Endpoint:  "/fixtures/11867285?include=stats",
+				EntityID:  &id,
+				Body:      []byte(`{"data": {"id": 2}}`),
+				FetchedAt: time.Unix(1548086990, 0),
+			},
+			{
+				Provider:  app.ProviderSportMonks,
+				Endpoint:  "/fixtures/11867285?include=odds",
+				Body:      []byte(`{"data": {"id": 3}}`),
+				FetchedAt: time.Unix(1548087000, 0),
+			},
+		}
+
+		for _, p := range payloads {
+			if err := store.Save(p); err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+		}
+
+		p, err := store.Latest(app.ProviderSportMonks, "/fixtures/11867285?include=stats")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+		a.Equal(app.ProviderSportMonks, p.Provider)
+		a.Equal("/fixtures/11867285?include=stats", p.Endpoint)
+		a.Equal(uint64(11867285), *p.EntityID)
+		a.Equal(`{"data": {"id": 2}}`, string(p.Body))
+		a.Equal(int64(1548086990), p.FetchedAt.Unix())
+
+		p, err = store.Latest(app.ProviderSportMonks, "/fixtures/11867285?include=odds")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a.Nil(p.EntityID)
+	})
+
+	t.Run("returns not found error if no payload exists for endpoint", func(t *testing.T) {
+		t.Helper()
+
+		store := archive.NewFilesystemStore(tempDir(t))
+
+		_, err := store.Latest(app.ProviderUnderstat, "/league/EPL/2020")
+
+		assert.Equal(t, errors.ErrorNotFound, err)
+	})
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "archive")
+
+	if err != nil {
+		t.Fatalf("Error creating temp dir: %s", err.Error())
+	}
+
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	return dir
+}
@@ -0,0 +1,37 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+)
+
+// Compress gzip compresses the payload body provided.
+func Compress(body []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := gzip.NewWriter(&buf)
+
+	if _, err := w.Write(body); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Decompress returns the original payload body from a body compressed using Compress.
+func Decompress(body []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.Close()
+
+	return ioutil.ReadAll(r)
+}
@@ -0,0 +1,123 @@
+package archive
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// Transport is a http.RoundTripper that archives successful response bodies returned by a data provider
+// before handing the response back to the client. Requests to hosts other than the provider host are passed
+// through untouched. Failing to archive a payload is logged but never fails the request.
+type Transport struct {
+	next     http.RoundTripper
+	store    app.RawPayloadStore
+	provider string
+	host     string
+	clock    clockwork.Clock
+	logger   *logrus.Logger
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.next.RoundTrip(req)
+
+	if err != nil || res.StatusCode != http.StatusOK || req.URL.Host != t.host {
+		return res, err
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	p := app.RawPayload{
+		Provider:  t.provider,
+		Endpoint:  app.PayloadEndpoint(req.URL),
+		EntityID:  app.PayloadEntityID(req.URL),
+		Body:      body,
+		FetchedAt: t.clock.Now(),
+	}
+
+	if err := t.store.Save(&p); err != nil {
+		t.logger.Errorf("Error archiving %s payload for endpoint %s: %s", p.Provider, p.Endpoint, err.Error())
+	}
+
+	return res, nil
+}
+
+// ReplayTransport is a http.RoundTripper that serves the latest archived payload for a request to the provider
+// host instead of making a network request, allowing processors to re-run transforms over archived payloads.
+// A 404 response is returned if no payload has been archived for the request.
+type ReplayTransport struct {
+	next     http.RoundTripper
+	store    app.RawPayloadStore
+	provider string
+	host     string
+}
+
+func (r *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host != r.host {
+		return r.next.RoundTrip(req)
+	}
+
+	endpoint := app.PayloadEndpoint(req.URL)
+
+	p, err := r.store.Latest(r.provider, endpoint)
+
+	if err != nil {
+		return response(req, http.StatusNotFound, []byte(fmt.Sprintf("no archived payload: %s", err.Error()))), nil
+	}
+
+	return response(req, http.StatusOK, p.Body), nil
+}
+
+func response(req *http.Request, status int, body []byte) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{},
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// NewTransport returns a Transport archiving responses returned from the host of the provider base URL provided.
+func NewTransport(
+	next http.RoundTripper,
+	s app.RawPayloadStore,
+	provider,
+	baseURL string,
+	c clockwork.Clock,
+	log *logrus.Logger,
+) *Transport {
+	return &Transport{next: next, store: s, provider: provider, host: host(baseURL), clock: c, logger: log}
+}
+
+// NewReplayTransport returns a ReplayTransport serving archived responses for the host of the provider base URL
+// provided.
+func NewReplayTransport(next http.RoundTripper, s app.RawPayloadStore, provider, baseURL string) *ReplayTransport {
+	return &ReplayTransport{next: next, store: s, provider: provider, host: host(baseURL)}
+}
+
+func host(baseURL string) string {
+	u, err := url.Parse(baseURL)
+
+	if err != nil {
+		return baseURL
+	}
+
+	return u.Host
+}
@@ -0,0 +1,171 @@
+package archive_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/archive"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+const fixturePayload = `{"data": {"id": 11867285, "league_id": 8, "season_id": 16036}}`
+
+func TestTransport_RoundTrip(t *testing.T) {
+	t.Run("archives response body and returns response unmodified", func(t *testing.T) {
+		t.Helper()
+
+		store := archive.NewFilesystemStore(tempDir(t))
+		clock := clockwork.NewFakeClockAt(time.Unix(1548086929, 0))
+		logger, hook := test.NewNullLogger()
+
+		client := sportMonksClient(archive.NewTransport(
+			providerResponse(200, fixturePayload),
+			store,
+			app.ProviderSportMonks,
+			"http://example.com/api/v2.0",
+			clock,
+			logger,
+		))
+
+		fixture, _, err := client.FixtureByID(context.Background(), 11867285, []string{"stats"}, nil)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		p, err := store.Latest(app.ProviderSportMonks, "/api/v2.0/fixtures/11867285?include=stats")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+		a.Equal(11867285, fixture.ID)
+		a.Equal(fixturePayload, string(p.Body))
+		a.Equal(uint64(11867285), *p.EntityID)
+		a.Equal(int64(1548086929), p.FetchedAt.Unix())
+		a.Nil(hook.LastEntry())
+	})
+
+	t.Run("does not archive unsuccessful responses", func(t *testing.T) {
+		t.Helper()
+
+		store := new(mock.RawPayloadStore)
+		logger, _ := test.NewNullLogger()
+
+		client := sportMonksClient(archive.NewTransport(
+			providerResponse(500, `{"error": "oh no"}`),
+			store,
+			app.ProviderSportMonks,
+			"http://example.com/api/v2.0",
+			clockwork.NewFakeClock(),
+			logger,
+		))
+
+		_, _, err := client.FixtureByID(context.Background(), 11867285, []string{"stats"}, nil)
+
+		assert.NotNil(t, err)
+		store.AssertNotCalled(t, "Save", m.Anything)
+	})
+
+	t.Run("logs error and returns response if payload cannot be archived", func(t *testing.T) {
+		t.Helper()
+
+		store := new(mock.RawPayloadStore)
+		logger, hook := test.NewNullLogger()
+
+		client := sportMonksClient(archive.NewTransport(
+			providerResponse(200, fixturePayload),
+			store,
+			app.ProviderSportMonks,
+			"http://example.com/api/v2.0",
+			clockwork.NewFakeClock(),
+			logger,
+		))
+
+		store.On("Save", m.AnythingOfType("*app.RawPayload")).Return(errors.New("disk full"))
+
+		fixture, _, err := client.FixtureByID(context.Background(), 11867285, []string{"stats"}, nil)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 11867285, fixture.ID)
+		assert.Equal(
+			t,
+			"Error archiving sportmonks payload for endpoint /api/v2.0/fixtures/11867285?include=stats: disk full",
+			hook.LastEntry().Message,
+		)
+	})
+}
+
+func TestReplayTransport_RoundTrip(t *testing.T) {
+	t.Run("serves archived payload without making a network request", func(t *testing.T) {
+		t.Helper()
+
+		store := archive.NewFilesystemStore(tempDir(t))
+
+		err := store.Save(&app.RawPayload{
+			Provider:  app.ProviderSportMonks,
+			Endpoint:  "/api/v2.0/fixtures/11867285?include=stats",
+			Body:      []byte(fixturePayload),
+			FetchedAt: time.Unix(1548086929, 0),
+		})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		network := mock.HttpClient(func(req *http.Request) (*http.Response, error) {
+			t.Fatal("Expected no network request to be made")
+			return nil, nil
+		})
+
+		client := sportMonksClient(archive.NewReplayTransport(
+			network.Transport,
+			store,
+			app.ProviderSportMonks,
+			"http://example.com/api/v2.0",
+		))
+
+		fixture, _, err := client.FixtureByID(context.Background(), 11867285, []string{"stats"}, nil)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 16036, fixture.SeasonID)
+
+		_, _, err = client.FixtureByID(context.Background(), 11867285, []string{"odds"}, nil)
+
+		assert.NotNil(t, err)
+	})
+}
+
+func sportMonksClient(transport http.RoundTripper) *spClient.HTTPClient {
+	return &spClient.HTTPClient{
+		HTTPClient: &http.Client{Transport: transport},
+		BaseURL:    "http://example.com/api/v2.0",
+		Key:        "my-key",
+	}
+}
+
+func providerResponse(status int, body string) http.RoundTripper {
+	return mock.HttpClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: status,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+		}, nil
+	}).Transport
+}
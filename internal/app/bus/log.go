@@ -0,0 +1,14 @@
+package bus
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+)
+
+// LogHandler returns an EventHandler logging each domain event it receives.
+func LogHandler(log *logrus.Logger) app.EventHandler {
+	return func(e app.DomainEvent) error {
+		log.Infof("Domain event '%s' published for fixture %d", e.Name(), e.AggregateID())
+		return nil
+	}
+}
@@ -0,0 +1,51 @@
+package bus
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"sync"
+)
+
+// MemoryPublisher delivers domain events synchronously to the handlers subscribed within the current process.
+// Events are not persisted so are lost if the process exits, events stored in the outbox alongside the writes
+// causing them are relayed to this publisher by the outbox relay for at-least-once delivery.
+type MemoryPublisher struct {
+	mutex    sync.RWMutex
+	handlers map[string][]app.EventHandler
+	all      []app.EventHandler
+}
+
+// Subscribe registers a handler for the domain event names provided, or for every domain event if no names are
+// provided.
+func (m *MemoryPublisher) Subscribe(h app.EventHandler, names ...string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if len(names) == 0 {
+		m.all = append(m.all, h)
+		return
+	}
+
+	for _, name := range names {
+		m.handlers[name] = append(m.handlers[name], h)
+	}
+}
+
+// Publish delivers the event to each subscribed handler in the order they subscribed, returning the first error
+// returned by a handler.
+func (m *MemoryPublisher) Publish(e app.DomainEvent) error {
+	m.mutex.RLock()
+	handlers := append(append([]app.EventHandler{}, m.all...), m.handlers[e.Name()]...)
+	m.mutex.RUnlock()
+
+	for _, h := range handlers {
+		if err := h(e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{handlers: make(map[string][]app.EventHandler)}
+}
@@ -0,0 +1,75 @@
+package bus_test
+
+import (
+	"errors"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/bus"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMemoryPublisher_Publish(t *testing.T) {
+	t.Run("delivers events to handlers subscribed to the event name and to all events", func(t *testing.T) {
+		t.Helper()
+
+		publisher := bus.NewMemoryPublisher()
+
+		var all, results, stats []app.DomainEvent
+
+		publisher.Subscribe(func(e app.DomainEvent) error {
+			all = append(all, e)
+			return nil
+		})
+
+		publisher.Subscribe(func(e app.DomainEvent) error {
+			results = append(results, e)
+			return nil
+		}, app.ResultFinalisedEvent)
+
+		publisher.Subscribe(func(e app.DomainEvent) error {
+			stats = append(stats, e)
+			return nil
+		}, app.TeamStatsUpdatedEvent)
+
+		if err := publisher.Publish(app.ResultFinalised{FixtureID: 45}); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		if err := publisher.Publish(app.FixtureRescheduled{FixtureID: 46}); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(all))
+		a.Equal(1, len(results))
+		a.Equal(uint64(45), results[0].AggregateID())
+		a.Equal(0, len(stats))
+	})
+
+	t.Run("returns the first error returned by a handler", func(t *testing.T) {
+		t.Helper()
+
+		publisher := bus.NewMemoryPublisher()
+
+		called := false
+
+		publisher.Subscribe(func(e app.DomainEvent) error {
+			return errors.New("oh no")
+		})
+
+		publisher.Subscribe(func(e app.DomainEvent) error {
+			called = true
+			return nil
+		})
+
+		err := publisher.Publish(app.TeamStatsUpdated{FixtureID: 45, TeamID: 1})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "oh no", err.Error())
+		assert.False(t, called)
+	})
+}
@@ -0,0 +1,23 @@
+package app
+
+import "strings"
+
+const (
+	CheckpointSeason  = "season"
+	CheckpointFixture = "fixture"
+)
+
+// CheckpointRepository stores the units of work, such as the seasons and fixtures persisted, completed by a run of a
+// command so a run that does not finish can be resumed without repeating the work already completed.
+type CheckpointRepository interface {
+	// Completed returns the IDs of the units of the type provided completed by the run.
+	Completed(run string, unit string) ([]uint64, error)
+	Complete(run string, unit string, id uint64) error
+	// Clear removes every checkpoint of the run.
+	Clear(run string) error
+}
+
+// CheckpointRun returns the name checkpoints are stored against for a run of the command and option provided.
+func CheckpointRun(command, option string) string {
+	return strings.TrimSpace(command + " " + option)
+}
@@ -0,0 +1,43 @@
+package app
+
+import "time"
+
+const (
+	CommandRunRunning   = "running"
+	CommandRunSucceeded = "succeeded"
+	CommandRunFailed    = "failed"
+	CommandRunSkipped   = "skipped"
+	CommandRunCancelled = "cancelled"
+)
+
+// CommandRun is a run of a console command started through the admin API.
+type CommandRun struct {
+	ID         uint64
+	Command    string
+	Option     string
+	Status     string
+	Error      *string
+	StartedAt  time.Time
+	FinishedAt *time.Time
+}
+
+// IsFinished returns true if the run is no longer running.
+func (c CommandRun) IsFinished() bool {
+	return c.Status != CommandRunRunning
+}
+
+// CommandRunLog is a line logged by the processor during a CommandRun.
+type CommandRunLog struct {
+	Level   string
+	Message string
+	Time    time.Time
+}
+
+// CommandRunRepository provides an interface to persist CommandRun domain struct objects to a storage engine.
+type CommandRunRepository interface {
+	Insert(r *CommandRun) error
+	Update(r *CommandRun) error
+	ByID(id uint64) (*CommandRun, error)
+	// Recent returns the most recently started runs ordered by ID descending.
+	Recent(limit uint64) ([]CommandRun, error)
+}
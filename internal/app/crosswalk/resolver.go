@@ -0,0 +1,95 @@
+package crosswalk
+
+import (
+	"fmt"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"sync"
+)
+
+// Resolver translates identifiers used by external data providers into SportMonks IDs using the
+// ExternalIDRepository. Resolved identifiers are cached for the lifetime of the Resolver as processors
+// resolve the same teams and fixtures many times during a single run.
+type Resolver struct {
+	repo  app.ExternalIDRepository
+	cache map[string]uint64
+	mutex sync.RWMutex
+}
+
+// Resolve returns the SportMonks ID linked to the external ID provided. errors.ErrorNotFound is returned if
+// the external ID has not been linked.
+func (r *Resolver) Resolve(entityType, provider, externalID string) (uint64, error) {
+	key := cacheKey(entityType, provider, externalID)
+
+	r.mutex.RLock()
+	id, ok := r.cache[key]
+	r.mutex.RUnlock()
+
+	if ok {
+		return id, nil
+	}
+
+	e, err := r.repo.ByExternalID(entityType, provider, externalID)
+
+	if err != nil {
+		return 0, err
+	}
+
+	r.store(key, e.EntityID)
+
+	return e.EntityID, nil
+}
+
+// Link records the external ID provided as belonging to the SportMonks entity ID provided, replacing any
+// existing link for the external ID.
+func (r *Resolver) Link(entityType string, entityID uint64, provider, externalID string) error {
+	e := app.ExternalID{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Provider:   provider,
+		ExternalID: externalID,
+	}
+
+	existing, err := r.repo.ByExternalID(entityType, provider, externalID)
+
+	switch {
+	case err == errors.ErrorNotFound:
+		err = r.repo.Insert(&e)
+	case err != nil:
+		return err
+	case existing.EntityID != entityID:
+		err = r.repo.Update(&e)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error linking %s %s ID %s to %d: %s", provider, entityType, externalID, entityID, err.Error())
+	}
+
+	r.store(cacheKey(entityType, provider, externalID), entityID)
+
+	return nil
+}
+
+// Fixture resolves a provider fixture ID into a SportMonks fixture ID.
+func (r *Resolver) Fixture(provider, externalID string) (uint64, error) {
+	return r.Resolve(app.EntityFixture, provider, externalID)
+}
+
+// Team resolves a provider team ID into a SportMonks team ID.
+func (r *Resolver) Team(provider, externalID string) (uint64, error) {
+	return r.Resolve(app.EntityTeam, provider, externalID)
+}
+
+func (r *Resolver) store(key string, id uint64) {
+	r.mutex.Lock()
+	r.cache[key] = id
+	r.mutex.Unlock()
+}
+
+func cacheKey(entityType, provider, externalID string) string {
+	return entityType + ":" + provider + ":" + externalID
+}
+
+func NewResolver(r app.ExternalIDRepository) *Resolver {
+	return &Resolver{repo: r, cache: map[string]uint64{}}
+}
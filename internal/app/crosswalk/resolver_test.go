@@ -0,0 +1,154 @@
+package crosswalk_test
+
+import (
+	e "errors"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/crosswalk"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestResolver_Resolve(t *testing.T) {
+	t.Run("returns SportMonks ID linked to external ID", func(t *testing.T) {
+		t.Helper()
+
+		repo := new(mock.ExternalIDRepository)
+		resolver := crosswalk.NewResolver(repo)
+
+		x := app.ExternalID{EntityType: app.EntityTeam, EntityID: 1, Provider: app.ProviderUnderstat, ExternalID: "88"}
+
+		repo.On("ByExternalID", app.EntityTeam, app.ProviderUnderstat, "88").Return(&x, nil)
+
+		id, err := resolver.Team(app.ProviderUnderstat, "88")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, uint64(1), id)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("caches resolved IDs so the repository is only queried once", func(t *testing.T) {
+		t.Helper()
+
+		repo := new(mock.ExternalIDRepository)
+		resolver := crosswalk.NewResolver(repo)
+
+		x := app.ExternalID{EntityType: app.EntityFixture, EntityID: 5601, Provider: app.ProviderUnderstat, ExternalID: "11430"}
+
+		repo.On("ByExternalID", app.EntityFixture, app.ProviderUnderstat, "11430").Once().Return(&x, nil)
+
+		for i := 0; i < 3; i++ {
+			id, err := resolver.Fixture(app.ProviderUnderstat, "11430")
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, uint64(5601), id)
+		}
+
+		repo.AssertNumberOfCalls(t, "ByExternalID", 1)
+	})
+
+	t.Run("returns error if external ID is not linked", func(t *testing.T) {
+		t.Helper()
+
+		repo := new(mock.ExternalIDRepository)
+		resolver := crosswalk.NewResolver(repo)
+
+		repo.On("ByExternalID", app.EntityFixture, app.ProviderUnderstat, "2371").Return(&app.ExternalID{}, errors.ErrorNotFound)
+
+		_, err := resolver.Fixture(app.ProviderUnderstat, "2371")
+
+		assert.Equal(t, errors.ErrorNotFound, err)
+	})
+}
+
+func TestResolver_Link(t *testing.T) {
+	t.Run("inserts a new link if external ID is not linked", func(t *testing.T) {
+		t.Helper()
+
+		repo := new(mock.ExternalIDRepository)
+		resolver := crosswalk.NewResolver(repo)
+
+		x := app.ExternalID{EntityType: app.EntityTeam, EntityID: 1, Provider: app.ProviderUnderstat, ExternalID: "88"}
+
+		repo.On("ByExternalID", app.EntityTeam, app.ProviderUnderstat, "88").Return(&app.ExternalID{}, errors.ErrorNotFound)
+		repo.On("Insert", &x).Return(nil)
+
+		if err := resolver.Link(app.EntityTeam, 1, app.ProviderUnderstat, "88"); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		id, err := resolver.Team(app.ProviderUnderstat, "88")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, uint64(1), id)
+		repo.AssertExpectations(t)
+		repo.AssertNumberOfCalls(t, "ByExternalID", 1)
+	})
+
+	t.Run("updates existing link if external ID is linked to a different entity", func(t *testing.T) {
+		t.Helper()
+
+		repo := new(mock.ExternalIDRepository)
+		resolver := crosswalk.NewResolver(repo)
+
+		existing := app.ExternalID{EntityType: app.EntityTeam, EntityID: 14, Provider: app.ProviderUnderstat, ExternalID: "88"}
+		x := app.ExternalID{EntityType: app.EntityTeam, EntityID: 1, Provider: app.ProviderUnderstat, ExternalID: "88"}
+
+		repo.On("ByExternalID", app.EntityTeam, app.ProviderUnderstat, "88").Return(&existing, nil)
+		repo.On("Update", &x).Return(nil)
+
+		if err := resolver.Link(app.EntityTeam, 1, app.ProviderUnderstat, "88"); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("does not write if external ID is already linked to entity", func(t *testing.T) {
+		t.Helper()
+
+		repo := new(mock.ExternalIDRepository)
+		resolver := crosswalk.NewResolver(repo)
+
+		existing := app.ExternalID{EntityType: app.EntityTeam, EntityID: 1, Provider: app.ProviderUnderstat, ExternalID: "88"}
+
+		repo.On("ByExternalID", app.EntityTeam, app.ProviderUnderstat, "88").Return(&existing, nil)
+
+		if err := resolver.Link(app.EntityTeam, 1, app.ProviderUnderstat, "88"); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		repo.AssertNotCalled(t, "Insert")
+		repo.AssertNotCalled(t, "Update")
+	})
+
+	t.Run("returns error if repository returns an error", func(t *testing.T) {
+		t.Helper()
+
+		repo := new(mock.ExternalIDRepository)
+		resolver := crosswalk.NewResolver(repo)
+
+		x := app.ExternalID{EntityType: app.EntityTeam, EntityID: 1, Provider: app.ProviderUnderstat, ExternalID: "88"}
+
+		repo.On("ByExternalID", app.EntityTeam, app.ProviderUnderstat, "88").Return(&app.ExternalID{}, errors.ErrorNotFound)
+		repo.On("Insert", &x).Return(e.New("oh no"))
+
+		err := resolver.Link(app.EntityTeam, 1, app.ProviderUnderstat, "88")
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "error linking understat team ID 88 to 1: oh no", err.Error())
+	})
+}
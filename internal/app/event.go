@@ -16,18 +16,39 @@ type CardEvent struct {
 	CreatedAt   time.Time `json:"created_at"`
 }
 
-// GoalEvent domain entity.
+// Goal types recorded against a GoalEvent by the data provider.
+const (
+	GoalTypeGoal    = "goal"
+	GoalTypePenalty = "penalty"
+	GoalTypeOwnGoal = "own-goal"
+)
+
+// GoalEvent domain entity. TeamID is the team credited with the goal, for an own goal this is the opposition of
+// the scoring player's team.
 type GoalEvent struct {
 	ID             uint64    `json:"id"`
 	FixtureID      uint64    `json:"fixture_id"`
 	TeamID         uint64    `json:"team_id"`
 	PlayerID       uint64    `json:"player_id"`
 	PlayerAssistID *uint64   `json:"player_assist_id"`
+	Type           string    `json:"type"`
 	Minute         int       `json:"minute"`
+	ExtraMinute    *int      `json:"extra_minute"`
 	Score          string    `json:"score"`
 	CreatedAt      time.Time `json:"created_at"`
 }
 
+// MissedPenaltyEvent domain entity.
+type MissedPenaltyEvent struct {
+	ID          uint64    `json:"id"`
+	FixtureID   uint64    `json:"fixture_id"`
+	TeamID      uint64    `json:"team_id"`
+	PlayerID    uint64    `json:"player_id"`
+	Minute      int       `json:"minute"`
+	ExtraMinute *int      `json:"extra_minute"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
 // SubstitutionEvent domain entity.
 type SubstitutionEvent struct {
 	ID          uint64    `json:"id"`
@@ -40,22 +61,47 @@ type SubstitutionEvent struct {
 	CreatedAt   time.Time `json:"created_at"`
 }
 
+// FixtureEventIDs contains the IDs of each type of event of a fixture. A nil list of IDs leaves the events of that
+// type untouched whereas an empty list refers to none of them.
+type FixtureEventIDs struct {
+	Goals           []uint64
+	Cards           []uint64
+	Substitutions   []uint64
+	MissedPenalties []uint64
+}
+
 // EventRepository provides an interface to persist event domain struct objects to a storage engine.
 type EventRepository interface {
 	InsertCardEvent(e *CardEvent) error
+	UpdateCardEvent(e *CardEvent) error
 	InsertGoalEvent(e *GoalEvent) error
+	// UpdateGoalEvent updates an existing goal, keeping the type and added time of goals reclassified by the
+	// data provider up to date.
+	UpdateGoalEvent(e *GoalEvent) error
 	InsertSubstitutionEvent(e *SubstitutionEvent) error
+	UpdateSubstitutionEvent(e *SubstitutionEvent) error
+	InsertMissedPenaltyEvent(e *MissedPenaltyEvent) error
+	UpdateMissedPenaltyEvent(e *MissedPenaltyEvent) error
+	// DeleteFixtureEventsExcept deletes the events of the fixture not included in the IDs provided, removing events
+	// the data provider no longer returns for the fixture. Event types with a nil list of IDs are not deleted.
+	DeleteFixtureEventsExcept(fixtureID uint64, keep FixtureEventIDs) error
 	CardEventsForFixture(fixtureID uint64) ([]*CardEvent, error)
 	GoalEventsForFixture(fixtureID uint64) ([]*GoalEvent, error)
+	MissedPenaltyEventsForFixture(fixtureID uint64) ([]*MissedPenaltyEvent, error)
+	SubstitutionEventsForFixture(fixtureID uint64) ([]*SubstitutionEvent, error)
+	SubstitutionEventsForTeam(teamID uint64) ([]*SubstitutionEvent, error)
+	// SubstitutionEventsForPlayer returns the substitutions the player was either brought on or taken off in.
+	SubstitutionEventsForPlayer(playerID uint64) ([]*SubstitutionEvent, error)
 	CardEventByID(id uint64) (*CardEvent, error)
 	GoalEventByID(id uint64) (*GoalEvent, error)
 	SubstitutionEventByID(id uint64) (*SubstitutionEvent, error)
+	MissedPenaltyEventByID(id uint64) (*MissedPenaltyEvent, error)
 }
 
 // EventRequester provides an interface allowing this application to request data from an external
 // data provider. The requester implementation is responsible for creating the channel, filtering struct data into
 // the channel before closing the channel once successful execution is complete.
 type EventRequester interface {
-	EventsByFixtureIDs(ids []uint64) (<-chan GoalEvent, <-chan SubstitutionEvent, <-chan CardEvent)
-	EventsBySeasonIDs(ids []uint64) (<-chan GoalEvent, <-chan SubstitutionEvent, <-chan CardEvent)
+	EventsByFixtureIDs(ids []uint64) (<-chan GoalEvent, <-chan SubstitutionEvent, <-chan CardEvent, <-chan MissedPenaltyEvent)
+	EventsBySeasonIDs(ids []uint64) (<-chan GoalEvent, <-chan SubstitutionEvent, <-chan CardEvent, <-chan MissedPenaltyEvent)
 }
@@ -0,0 +1,38 @@
+package app
+
+import "time"
+
+const (
+	EntityFixture = "fixture"
+	EntityTeam    = "team"
+
+	ProviderFootballData = "football-data"
+	ProviderSportMonks   = "sportmonks"
+	ProviderUnderstat    = "understat"
+)
+
+// ExternalID domain entity mapping an entity stored by this application, keyed by its SportMonks ID,
+// to the identifier used for the same entity by another data provider.
+type ExternalID struct {
+	EntityType string    `json:"entity_type"`
+	EntityID   uint64    `json:"entity_id"`
+	Provider   string    `json:"provider"`
+	ExternalID string    `json:"external_id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// ExternalIDRepository provides an interface to persist ExternalID domain struct objects to a storage engine.
+type ExternalIDRepository interface {
+	Insert(e *ExternalID) error
+	Update(e *ExternalID) error
+	ByExternalID(entityType, provider, externalID string) (*ExternalID, error)
+	ByEntityID(entityType string, entityID uint64, provider string) ([]ExternalID, error)
+}
+
+// ExternalIDResolver resolves identifiers used by external data providers into the SportMonks IDs used
+// throughout this application.
+type ExternalIDResolver interface {
+	Resolve(entityType, provider, externalID string) (uint64, error)
+	Link(entityType string, entityID uint64, provider, externalID string) error
+}
@@ -6,23 +6,53 @@ import (
 
 // Fixture domain entity.
 type Fixture struct {
-	ID         uint64    `json:"id"`
-	SeasonID   uint64    `json:"season_id"`
-	RoundID    *uint64   `json:"round_id"`
-	VenueID    *uint64   `json:"venue_id"`
-	HomeTeamID uint64    `json:"home_team_id"`
-	AwayTeamID uint64    `json:"away_team_id"`
-	RefereeID  *uint64   `json:"referee_id"`
-	Date       time.Time `json:"date"`
-	Status     *string   `json:"status"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+	ID          uint64         `json:"id"`
+	SeasonID    uint64         `json:"season_id"`
+	RoundID     *uint64        `json:"round_id"`
+	StageID     *uint64        `json:"stage_id"`
+	GroupID     *uint64        `json:"group_id"`
+	AggregateID *uint64        `json:"aggregate_id"`
+	VenueID     *uint64        `json:"venue_id"`
+	HomeTeamID  uint64         `json:"home_team_id"`
+	AwayTeamID  uint64         `json:"away_team_id"`
+	RefereeID   *uint64        `json:"referee_id"`
+	Date        time.Time      `json:"date"`
+	Status      *FixtureStatus `json:"status"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
 }
 
-// FixtureRepository provides an interface to persist Fixture domain struct objects to a storage engine.
+// IsFinal returns true if the fixture has a status that will not change, either because the fixture has been
+// completed or because it will not be played.
+func (f Fixture) IsFinal() bool {
+	if f.Status == nil {
+		return false
+	}
+
+	g := f.Status.Group()
+
+	return g == FixtureStatusGroupFinished || g == FixtureStatusGroupCancelled
+}
+
+// StatusGroup returns the group of the fixture status or the unknown group if the fixture has no status.
+func (f Fixture) StatusGroup() FixtureStatusGroup {
+	if f.Status == nil {
+		return FixtureStatusGroupUnknown
+	}
+
+	return f.Status.Group()
+}
+
+// IsFinished returns true if the fixture has been played to completion or awarded and its result will not change.
+func (f Fixture) IsFinished() bool {
+	return f.Status != nil && f.Status.Group() == FixtureStatusGroupFinished
+}
+
+// FixtureRepository provides an interface to persist Fixture domain struct objects to a storage engine. The
+// DomainEvent structs provided to Insert and Update are published atomically with the write.
 type FixtureRepository interface {
-	Insert(f *Fixture) error
-	Update(f *Fixture) error
+	Insert(f *Fixture, events ...DomainEvent) error
+	Update(f *Fixture, events ...DomainEvent) error
 	Delete(id uint64) error
 	ByID(id uint64) (*Fixture, error)
 	ByTeamID(id uint64, query FixtureFilterQuery) ([]Fixture, error)
@@ -35,6 +65,14 @@ type FixtureRepository interface {
 // the channel before closing the channel once successful execution is complete.
 type FixtureRequester interface {
 	FixturesBySeasonIDs(ids []uint64) <-chan Fixture
+	// FixturesBySeasonID requests the fixtures of a single season, returning an error if the request fails.
+	FixturesBySeasonID(seasonID uint64) ([]Fixture, error)
+}
+
+// FixtureIDGenerator provides IDs for fixtures that originate from a data source other than SportMonks.
+// Generated IDs are allocated from a range above the IDs used by SportMonks.
+type FixtureIDGenerator interface {
+	NextID() (uint64, error)
 }
 
 type FixtureFilterQuery struct {
@@ -50,12 +88,16 @@ type FixtureRepositoryQuery struct {
 	LeagueIDs        []uint64
 	Filters          []FixtureStatFilter
 	SeasonIDs        []uint64
+	StageIDs         []uint64
+	GroupIDs         []uint64
+	AggregateID      *uint64
 	HomeTeamID       *uint64
 	AwayTeamID       *uint64
 	HomeTeamNameLike *string
 	AwayTeamNameLike *string
 	DateFrom         *time.Time
 	DateTo           *time.Time
+	StatusGroups     []FixtureStatusGroup
 	Limit            *uint64
 	SortBy           *string
 }
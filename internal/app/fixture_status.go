@@ -0,0 +1,181 @@
+package app
+
+import "time"
+
+// FixtureStatus is the status of a fixture as provided by SportMonks i.e. "NS" or "FT".
+type FixtureStatus string
+
+const (
+	FixtureStatusNotStarted        FixtureStatus = "NS"
+	FixtureStatusToBeAnnounced     FixtureStatus = "TBA"
+	FixtureStatusDelayed           FixtureStatus = "DELAYED"
+	FixtureStatusLive              FixtureStatus = "LIVE"
+	FixtureStatusHalfTime          FixtureStatus = "HT"
+	FixtureStatusBreak             FixtureStatus = "BREAK"
+	FixtureStatusExtraTime         FixtureStatus = "ET"
+	FixtureStatusPenaltiesLive     FixtureStatus = "PEN_LIVE"
+	FixtureStatusInterrupted       FixtureStatus = "INT"
+	FixtureStatusSuspended         FixtureStatus = "SUSP"
+	FixtureStatusAwaitingUpdates   FixtureStatus = "AU"
+	FixtureStatusFullTime          FixtureStatus = "FT"
+	FixtureStatusAfterExtraTime    FixtureStatus = "AET"
+	FixtureStatusFullTimePenalties FixtureStatus = "FT_PEN"
+	FixtureStatusAwarded           FixtureStatus = "AWARDED"
+	FixtureStatusWalkOver          FixtureStatus = "WO"
+	FixtureStatusPostponed         FixtureStatus = "POSTP"
+	FixtureStatusCancelled         FixtureStatus = "CANCL"
+	FixtureStatusAbandoned         FixtureStatus = "ABAN"
+	FixtureStatusDeleted           FixtureStatus = "Deleted"
+)
+
+// FixtureStatusGroup groups statuses with the same meaning to consumers of fixture data.
+type FixtureStatusGroup string
+
+const (
+	// FixtureStatusGroupScheduled contains fixtures yet to kick off.
+	FixtureStatusGroupScheduled FixtureStatusGroup = "scheduled"
+	// FixtureStatusGroupLive contains fixtures that have kicked off without finishing, including fixtures that
+	// have been interrupted or suspended.
+	FixtureStatusGroupLive FixtureStatusGroup = "live"
+	// FixtureStatusGroupFinished contains fixtures with a final result, including awarded results.
+	FixtureStatusGroupFinished FixtureStatusGroup = "finished"
+	// FixtureStatusGroupCancelled contains fixtures that will not be completed as scheduled.
+	FixtureStatusGroupCancelled FixtureStatusGroup = "cancelled"
+	// FixtureStatusGroupUnknown contains fixtures without a status, stored before statuses were ingested and
+	// neither finished nor scheduled when statuses were backfilled. The status is set the next time the fixture is
+	// ingested.
+	FixtureStatusGroupUnknown FixtureStatusGroup = "unknown"
+)
+
+var fixtureStatuses = []FixtureStatus{
+	FixtureStatusNotStarted,
+	FixtureStatusToBeAnnounced,
+	FixtureStatusDelayed,
+	FixtureStatusLive,
+	FixtureStatusHalfTime,
+	FixtureStatusBreak,
+	FixtureStatusExtraTime,
+	FixtureStatusPenaltiesLive,
+	FixtureStatusInterrupted,
+	FixtureStatusSuspended,
+	FixtureStatusAwaitingUpdates,
+	FixtureStatusFullTime,
+	FixtureStatusAfterExtraTime,
+	FixtureStatusFullTimePenalties,
+	FixtureStatusAwarded,
+	FixtureStatusWalkOver,
+	FixtureStatusPostponed,
+	FixtureStatusCancelled,
+	FixtureStatusAbandoned,
+	FixtureStatusDeleted,
+}
+
+var fixtureStatusGroups = map[FixtureStatus]FixtureStatusGroup{
+	FixtureStatusNotStarted:        FixtureStatusGroupScheduled,
+	FixtureStatusToBeAnnounced:     FixtureStatusGroupScheduled,
+	FixtureStatusDelayed:           FixtureStatusGroupScheduled,
+	FixtureStatusLive:              FixtureStatusGroupLive,
+	FixtureStatusHalfTime:          FixtureStatusGroupLive,
+	FixtureStatusBreak:             FixtureStatusGroupLive,
+	FixtureStatusExtraTime:         FixtureStatusGroupLive,
+	FixtureStatusPenaltiesLive:     FixtureStatusGroupLive,
+	FixtureStatusInterrupted:       FixtureStatusGroupLive,
+	FixtureStatusSuspended:         FixtureStatusGroupLive,
+	FixtureStatusAwaitingUpdates:   FixtureStatusGroupLive,
+	FixtureStatusFullTime:          FixtureStatusGroupFinished,
+	FixtureStatusAfterExtraTime:    FixtureStatusGroupFinished,
+	FixtureStatusFullTimePenalties: FixtureStatusGroupFinished,
+	FixtureStatusAwarded:           FixtureStatusGroupFinished,
+	FixtureStatusWalkOver:          FixtureStatusGroupFinished,
+	FixtureStatusPostponed:         FixtureStatusGroupCancelled,
+	FixtureStatusCancelled:         FixtureStatusGroupCancelled,
+	FixtureStatusAbandoned:         FixtureStatusGroupCancelled,
+	FixtureStatusDeleted:           FixtureStatusGroupCancelled,
+}
+
+// IsValid returns true if the status is one of the statuses known to this application.
+func (s FixtureStatus) IsValid() bool {
+	_, ok := fixtureStatusGroups[s]
+	return ok
+}
+
+// Group returns the group the status belongs to or an empty string if the status is not valid.
+func (s FixtureStatus) Group() FixtureStatusGroup {
+	return fixtureStatusGroups[s]
+}
+
+// CanTransitionTo returns true if a fixture can move from the status to the next status provided. Statuses may be
+// skipped as the data provider is polled periodically, so a scheduled fixture can move straight to a finished
+// status, but a fixture cannot return to a scheduled status once it has kicked off and a finished result can only
+// be replaced by an awarded result. Transitions from an unknown status are always allowed.
+func (s FixtureStatus) CanTransitionTo(next FixtureStatus) bool {
+	if s == next || !s.IsValid() {
+		return true
+	}
+
+	if !next.IsValid() {
+		return false
+	}
+
+	switch s {
+	case FixtureStatusFullTime, FixtureStatusAfterExtraTime, FixtureStatusFullTimePenalties:
+		return next == FixtureStatusAwarded
+	case FixtureStatusAwarded, FixtureStatusWalkOver, FixtureStatusDeleted:
+		return false
+	case FixtureStatusCancelled:
+		return next.Group() == FixtureStatusGroupScheduled ||
+			next == FixtureStatusDeleted ||
+			next == FixtureStatusAwarded ||
+			next == FixtureStatusWalkOver
+	}
+
+	if s.Group() == FixtureStatusGroupLive {
+		return next.Group() != FixtureStatusGroupScheduled
+	}
+
+	return true
+}
+
+// FixtureStatusGroups returns every FixtureStatusGroup.
+func FixtureStatusGroups() []FixtureStatusGroup {
+	return []FixtureStatusGroup{
+		FixtureStatusGroupScheduled,
+		FixtureStatusGroupLive,
+		FixtureStatusGroupFinished,
+		FixtureStatusGroupCancelled,
+		FixtureStatusGroupUnknown,
+	}
+}
+
+// FixtureStatusesInGroups returns the statuses belonging to the groups provided. No status belongs to the unknown
+// group.
+func FixtureStatusesInGroups(groups ...FixtureStatusGroup) []FixtureStatus {
+	var statuses []FixtureStatus
+
+	for _, g := range groups {
+		for _, s := range fixtureStatuses {
+			if fixtureStatusGroups[s] == g {
+				statuses = append(statuses, s)
+			}
+		}
+	}
+
+	return statuses
+}
+
+// FixtureStatusAnomaly records a fixture status change that is not an allowed transition, typically caused by the
+// data provider correcting or reverting a status.
+type FixtureStatusAnomaly struct {
+	ID        uint64
+	FixtureID uint64
+	From      FixtureStatus
+	To        FixtureStatus
+	CreatedAt time.Time
+}
+
+// FixtureStatusAnomalyRepository provides an interface to persist FixtureStatusAnomaly domain struct objects to a
+// storage engine.
+type FixtureStatusAnomalyRepository interface {
+	Insert(a *FixtureStatusAnomaly) error
+	ByFixtureID(id uint64) ([]FixtureStatusAnomaly, error)
+}
@@ -0,0 +1,171 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/admin"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/factory"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultRunLimit = 20
+
+// AdminService runs console commands in-process on behalf of operators. Every request must provide the admin
+// token configured for the application, all requests are rejected if no token is configured.
+type AdminService struct {
+	manager *admin.Manager
+	token   string
+	logger  *logrus.Logger
+	proto.UnimplementedAdminServiceServer
+}
+
+func (s *AdminService) ListCommands(c context.Context, r *proto.ListCommandsRequest) (*proto.ListCommandsResponse, error) {
+	if err := authorizeAdmin(c, s.token); err != nil {
+		return nil, err
+	}
+
+	return &proto.ListCommandsResponse{Commands: s.manager.Commands()}, nil
+}
+
+func (s *AdminService) StartRun(c context.Context, r *proto.StartRunRequest) (*proto.CommandRun, error) {
+	if err := authorizeAdmin(c, s.token); err != nil {
+		return nil, err
+	}
+
+	var strategy *app.LockStrategy
+
+	if r.GetLock() != nil {
+		ls, err := app.ParseLockStrategy(r.GetLock().GetValue())
+
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		strategy = &ls
+	}
+
+	run, err := s.manager.Start(r.GetCommand(), r.GetOption(), strategy)
+
+	if err == admin.ErrCommandNotSupported {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Command '%s' is not supported", r.GetCommand()))
+	}
+
+	if err != nil {
+		s.logger.Errorf("Error starting run in admin service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	return factory.CommandRunToProto(run), nil
+}
+
+func (s *AdminService) WatchRun(r *proto.WatchRunRequest, stream proto.AdminService_WatchRunServer) error {
+	if err := authorizeAdmin(stream.Context(), s.token); err != nil {
+		return err
+	}
+
+	logs, ch, stop, err := s.manager.Watch(r.GetId())
+
+	if err == errors.ErrorNotFound {
+		return status.Error(codes.NotFound, fmt.Sprintf("Run with ID %d was not started by this application instance", r.GetId()))
+	}
+
+	if err != nil {
+		s.logger.Errorf("Error watching run in admin service. Error: %s", err.Error())
+		return status.Error(codes.Internal, "Internal server error")
+	}
+
+	defer stop()
+
+	for i := range logs {
+		if err := sendRunLog(stream, &logs[i]); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case l, ok := <-ch:
+			if ok {
+				if err := sendRunLog(stream, &l); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			run, err := s.manager.Run(r.GetId())
+
+			if err != nil {
+				s.logger.Errorf("Error retrieving run in admin service. Error: %s", err.Error())
+				return status.Error(codes.Internal, "Internal server error")
+			}
+
+			return stream.Send(&proto.RunProgress{Progress: &proto.RunProgress_Run{Run: factory.CommandRunToProto(run)}})
+		}
+	}
+}
+
+func (s *AdminService) CancelRun(c context.Context, r *proto.CancelRunRequest) (*proto.CommandRun, error) {
+	if err := authorizeAdmin(c, s.token); err != nil {
+		return nil, err
+	}
+
+	run, err := s.manager.Cancel(r.GetId())
+
+	if err == errors.ErrorNotFound {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("Run with ID %d is not running in this application instance", r.GetId()))
+	}
+
+	if err == admin.ErrRunFinished {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Run with ID %d has already finished", r.GetId()))
+	}
+
+	if err != nil {
+		s.logger.Errorf("Error cancelling run in admin service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	return factory.CommandRunToProto(run), nil
+}
+
+func (s *AdminService) ListRuns(c context.Context, r *proto.ListRunsRequest) (*proto.ListRunsResponse, error) {
+	if err := authorizeAdmin(c, s.token); err != nil {
+		return nil, err
+	}
+
+	limit := uint64(defaultRunLimit)
+
+	if r.GetLimit() != nil {
+		limit = r.GetLimit().GetValue()
+	}
+
+	runs, err := s.manager.History(limit)
+
+	if err != nil {
+		s.logger.Errorf("Error retrieving runs in admin service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	res := proto.ListRunsResponse{}
+
+	for i := range runs {
+		res.Runs = append(res.Runs, factory.CommandRunToProto(&runs[i]))
+	}
+
+	return &res, nil
+}
+
+func sendRunLog(stream proto.AdminService_WatchRunServer, l *app.CommandRunLog) error {
+	return stream.Send(&proto.RunProgress{Progress: &proto.RunProgress_Log{Log: factory.CommandRunLogToProto(l)}})
+}
+
+func NewAdminService(m *admin.Manager, token string, log *logrus.Logger) *AdminService {
+	return &AdminService{manager: m, token: token, logger: log}
+}
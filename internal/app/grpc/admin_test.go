@@ -0,0 +1,280 @@
+package grpc_test
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/admin"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/grpc"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	g "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"testing"
+	"time"
+)
+
+var adminClock = clockwork.NewFakeClockAt(time.Date(2021, 2, 13, 9, 0, 0, 0, time.UTC))
+
+func TestAdminService_ListCommands(t *testing.T) {
+	t.Run("returns the commands supported", func(t *testing.T) {
+		t.Helper()
+
+		service := newAdminService(new(mock.CommandRunRepository), nil, "secret")
+
+		res, err := service.ListCommands(adminContext("Bearer secret"), &proto.ListCommandsRequest{})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []string{"results:by-season-id", "season"}, res.GetCommands())
+	})
+
+	t.Run("returns unauthenticated error if the admin token provided is invalid", func(t *testing.T) {
+		t.Helper()
+
+		service := newAdminService(new(mock.CommandRunRepository), nil, "secret")
+
+		for _, c := range []context.Context{context.Background(), adminContext("Bearer wrong"), adminContext("secret")} {
+			_, err := service.ListCommands(c, &proto.ListCommandsRequest{})
+
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+
+			assert.Equal(t, "rpc error: code = Unauthenticated desc = A valid admin token must be provided", err.Error())
+		}
+	})
+
+	t.Run("returns unauthenticated error if no admin token is configured", func(t *testing.T) {
+		t.Helper()
+
+		service := newAdminService(new(mock.CommandRunRepository), nil, "")
+
+		_, err := service.ListCommands(adminContext("Bearer "), &proto.ListCommandsRequest{})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = Unauthenticated desc = Admin access is not configured", err.Error())
+	})
+}
+
+func TestAdminService_StartRun(t *testing.T) {
+	t.Run("starts run and streams its log lines followed by the finished run to watchers", func(t *testing.T) {
+		t.Helper()
+
+		runRepo := new(mock.CommandRunRepository)
+		release := make(chan struct{})
+
+		service := newAdminService(runRepo, func(command, option string, done chan bool, log *logrus.Logger) {
+			<-release
+			log.Infof("Processing season %s", option)
+			done <- true
+		}, "secret")
+
+		var stored app.CommandRun
+
+		runRepo.On("Insert", m.AnythingOfType("*app.CommandRun")).Run(func(args m.Arguments) {
+			args.Get(0).(*app.CommandRun).ID = 12
+		}).Return(nil)
+		runRepo.On("Update", m.AnythingOfType("*app.CommandRun")).Run(func(args m.Arguments) {
+			stored = *args.Get(0).(*app.CommandRun)
+		}).Return(nil)
+
+		run, err := service.StartRun(adminContext("Bearer secret"), &proto.StartRunRequest{Command: "results:by-season-id", Option: "16036"})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(12), run.GetId())
+		a.Equal("running", run.GetStatus())
+		a.Equal("2021-02-13T09:00:00Z", run.GetStartedAt())
+		a.Nil(run.GetFinishedAt())
+
+		stream := &runProgressStream{ctx: adminContext("Bearer secret")}
+
+		close(release)
+
+		if err := service.WatchRun(&proto.WatchRunRequest{Id: 12}, stream); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a.Equal(4, len(stream.sent))
+		a.Equal("Run 12 of 'results:by-season-id 16036' started", stream.sent[0].GetLog().GetMessage())
+		a.Equal("Processing season 16036", stream.sent[1].GetLog().GetMessage())
+		a.Equal("info", stream.sent[1].GetLog().GetLevel())
+		a.Equal("Run 12 of 'results:by-season-id 16036' finished with status 'succeeded'", stream.sent[2].GetLog().GetMessage())
+		a.Equal("succeeded", stream.sent[3].GetRun().GetStatus())
+		a.Equal("2021-02-13T09:00:00Z", stream.sent[3].GetRun().GetFinishedAt().GetValue())
+		a.Equal(app.CommandRunSucceeded, stored.Status)
+	})
+
+	t.Run("returns invalid argument error if the command is not supported", func(t *testing.T) {
+		t.Helper()
+
+		service := newAdminService(new(mock.CommandRunRepository), nil, "secret")
+
+		_, err := service.StartRun(adminContext("Bearer secret"), &proto.StartRunRequest{Command: "teams"})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = InvalidArgument desc = Command 'teams' is not supported", err.Error())
+	})
+
+	t.Run("returns invalid argument error if the lock strategy is not supported", func(t *testing.T) {
+		t.Helper()
+
+		service := newAdminService(new(mock.CommandRunRepository), nil, "secret")
+
+		req := proto.StartRunRequest{Command: "season", Lock: &wrappers.StringValue{Value: "ignore"}}
+
+		_, err := service.StartRun(adminContext("Bearer secret"), &req)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = InvalidArgument desc = lock strategy 'ignore' is not supported, use skip, wait or steal", err.Error())
+	})
+}
+
+func TestAdminService_WatchRun(t *testing.T) {
+	t.Run("returns not found error if the run was not started by the service", func(t *testing.T) {
+		t.Helper()
+
+		service := newAdminService(new(mock.CommandRunRepository), nil, "secret")
+
+		err := service.WatchRun(&proto.WatchRunRequest{Id: 4}, &runProgressStream{ctx: adminContext("Bearer secret")})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = NotFound desc = Run with ID 4 was not started by this application instance", err.Error())
+	})
+}
+
+func TestAdminService_CancelRun(t *testing.T) {
+	t.Run("returns failed precondition error if the run has finished", func(t *testing.T) {
+		t.Helper()
+
+		runRepo := new(mock.CommandRunRepository)
+		service := newAdminService(runRepo, nil, "secret")
+
+		runRepo.On("ByID", uint64(4)).Return(&app.CommandRun{ID: 4, Status: app.CommandRunFailed}, nil)
+
+		_, err := service.CancelRun(adminContext("Bearer secret"), &proto.CancelRunRequest{Id: 4})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = FailedPrecondition desc = Run with ID 4 has already finished", err.Error())
+	})
+
+	t.Run("returns not found error if the run does not exist", func(t *testing.T) {
+		t.Helper()
+
+		runRepo := new(mock.CommandRunRepository)
+		service := newAdminService(runRepo, nil, "secret")
+
+		runRepo.On("ByID", uint64(4)).Return(&app.CommandRun{}, errors.ErrorNotFound)
+
+		_, err := service.CancelRun(adminContext("Bearer secret"), &proto.CancelRunRequest{Id: 4})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = NotFound desc = Run with ID 4 is not running in this application instance", err.Error())
+	})
+}
+
+func TestAdminService_ListRuns(t *testing.T) {
+	t.Run("returns the most recent runs", func(t *testing.T) {
+		t.Helper()
+
+		runRepo := new(mock.CommandRunRepository)
+		service := newAdminService(runRepo, nil, "secret")
+
+		reason := "Error when retrieving season IDs: oh no"
+		finished := adminClock.Now().Add(time.Minute)
+
+		runRepo.On("Recent", uint64(20)).Return([]app.CommandRun{
+			{ID: 2, Command: "season", Status: app.CommandRunFailed, Error: &reason, StartedAt: adminClock.Now(), FinishedAt: &finished},
+			{ID: 1, Command: "results:by-season-id", Option: "16036", Status: app.CommandRunRunning, StartedAt: adminClock.Now()},
+		}, nil)
+
+		res, err := service.ListRuns(adminContext("Bearer secret"), &proto.ListRunsRequest{})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(res.GetRuns()))
+		a.Equal(uint64(2), res.GetRuns()[0].GetId())
+		a.Equal("failed", res.GetRuns()[0].GetStatus())
+		a.Equal(reason, res.GetRuns()[0].GetError().GetValue())
+		a.Equal("2021-02-13T09:01:00Z", res.GetRuns()[0].GetFinishedAt().GetValue())
+		a.Equal("16036", res.GetRuns()[1].GetOption())
+		a.Nil(res.GetRuns()[1].GetError())
+	})
+}
+
+type adminProcessor struct {
+	process func(command, option string, done chan bool, log *logrus.Logger)
+	logger  *logrus.Logger
+}
+
+func (p adminProcessor) Process(command string, option string, done chan bool) {
+	go p.process(command, option, done, p.logger)
+}
+
+func newAdminService(r app.CommandRunRepository, process func(command, option string, done chan bool, log *logrus.Logger), token string) *grpc.AdminService {
+	factory := func(command string, log *logrus.Logger) (admin.Processor, func()) {
+		return adminProcessor{process: process, logger: log}, func() {}
+	}
+
+	policy := func(command string) (app.LockStrategy, bool) { return "", false }
+	logger, _ := test.NewNullLogger()
+
+	commands := []string{"results:by-season-id", "season"}
+	manager := admin.NewManager(commands, factory, policy, new(mock.Locker), r, adminClock, logger)
+
+	return grpc.NewAdminService(manager, token, logger)
+}
+
+func adminContext(authorization string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", authorization))
+}
+
+type runProgressStream struct {
+	g.ServerStream
+	ctx  context.Context
+	sent []*proto.RunProgress
+}
+
+func (r *runProgressStream) Context() context.Context {
+	return r.ctx
+}
+
+func (r *runProgressStream) Send(p *proto.RunProgress) error {
+	r.sent = append(r.sent, p)
+	return nil
+}
@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// authorizeAdmin checks the request provides the admin token as bearer token authorization metadata. Every request
+// is rejected if no admin token is configured.
+func authorizeAdmin(c context.Context, token string) error {
+	if token == "" {
+		return status.Error(codes.Unauthenticated, "Admin access is not configured")
+	}
+
+	md, _ := metadata.FromIncomingContext(c)
+
+	for _, v := range md.Get("authorization") {
+		if !strings.HasPrefix(v, "Bearer ") {
+			continue
+		}
+
+		provided := strings.TrimPrefix(v, "Bearer ")
+
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(token)) == 1 {
+			return nil
+		}
+	}
+
+	return status.Error(codes.Unauthenticated, "A valid admin token must be provided")
+}
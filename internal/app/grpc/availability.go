@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/factory"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+type AvailabilityService struct {
+	fixtureRepo    app.FixtureRepository
+	sidelinedRepo  app.SidelinedRepository
+	suspensionRepo app.SuspensionRepository
+	logger         *logrus.Logger
+	proto.UnimplementedAvailabilityServiceServer
+}
+
+func (s *AvailabilityService) GetTeamAvailability(c context.Context, r *proto.TeamAvailabilityRequest) (*proto.TeamAvailabilityResponse, error) {
+	fix, err := s.fixtureRepo.ByID(r.GetFixtureId())
+
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("fixture with ID %d does not exist", r.GetFixtureId()))
+	}
+
+	if fix.HomeTeamID != r.GetTeamId() && fix.AwayTeamID != r.GetTeamId() {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			fmt.Sprintf("team %d does not play in fixture %d", r.GetTeamId(), fix.ID),
+		)
+	}
+
+	unavailable, err := s.sidelinedRepo.ByTeamAtDate(r.GetTeamId(), fix.SeasonID, fix.Date)
+
+	if err != nil {
+		s.logger.Errorf("Error retrieving sidelined players in availability service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	res := proto.TeamAvailabilityResponse{
+		TeamId:      r.GetTeamId(),
+		FixtureId:   fix.ID,
+		Date:        fix.Date.UTC().Format(time.RFC3339),
+		Unavailable: []*proto.UnavailablePlayer{},
+	}
+
+	for _, x := range unavailable {
+		res.Unavailable = append(res.Unavailable, factory.SidelinedToProto(&x))
+	}
+
+	return &res, nil
+}
+
+func (s *AvailabilityService) GetFixtureSuspensions(c context.Context, r *proto.FixtureSuspensionsRequest) (*proto.FixtureSuspensionsResponse, error) {
+	fix, err := s.fixtureRepo.ByID(r.GetFixtureId())
+
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("fixture with ID %d does not exist", r.GetFixtureId()))
+	}
+
+	suspensions, err := s.suspensionRepo.ByFixtureID(fix.ID)
+
+	if err != nil {
+		s.logger.Errorf("Error retrieving suspensions in availability service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	res := proto.FixtureSuspensionsResponse{
+		FixtureId:   fix.ID,
+		Suspensions: []*proto.Suspension{},
+	}
+
+	for _, x := range suspensions {
+		res.Suspensions = append(res.Suspensions, factory.SuspensionToProto(&x))
+	}
+
+	return &res, nil
+}
+
+func NewAvailabilityService(
+	f app.FixtureRepository,
+	s app.SidelinedRepository,
+	sr app.SuspensionRepository,
+	log *logrus.Logger,
+) *AvailabilityService {
+	return &AvailabilityService{fixtureRepo: f, sidelinedRepo: s, suspensionRepo: sr, logger: log}
+}
@@ -0,0 +1,238 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestAvailabilityService_GetTeamAvailability(t *testing.T) {
+	date := time.Date(2020, 2, 1, 15, 0, 0, 0, time.UTC)
+	fixture := app.Fixture{ID: 16475287, SeasonID: 16036, HomeTeamID: 1, AwayTeamID: 14, Date: date}
+
+	t.Run("returns players unavailable to team on fixture date", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		sidelinedRepo := new(mock.SidelinedRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewAvailabilityService(fixtureRepo, sidelinedRepo, nil, logger)
+
+		end := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+
+		unavailable := []app.Sidelined{
+			{
+				PlayerID:        37429,
+				Reason:          "Hamstring Injury",
+				Type:            app.SidelinedTypeInjury,
+				StartDate:       time.Date(2020, 1, 12, 0, 0, 0, 0, time.UTC),
+				ExpectedEndDate: &end,
+			},
+			{
+				PlayerID:  1452,
+				Reason:    "Red Card Suspension",
+				Type:      app.SidelinedTypeSuspension,
+				StartDate: time.Date(2020, 1, 25, 0, 0, 0, 0, time.UTC),
+			},
+		}
+
+		fixtureRepo.On("ByID", uint64(16475287)).Return(&fixture, nil)
+		sidelinedRepo.On("ByTeamAtDate", uint64(14), uint64(16036), date).Return(unavailable, nil)
+
+		res, err := service.GetTeamAvailability(context.Background(), &proto.TeamAvailabilityRequest{
+			TeamId:    14,
+			FixtureId: 16475287,
+		})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(14), res.TeamId)
+		a.Equal(uint64(16475287), res.FixtureId)
+		a.Equal("2020-02-01T15:00:00Z", res.Date)
+		a.Equal(2, len(res.Unavailable))
+		a.Equal(uint64(37429), res.Unavailable[0].PlayerId)
+		a.Equal("Hamstring Injury", res.Unavailable[0].Reason)
+		a.Equal("injury", res.Unavailable[0].Type)
+		a.Equal("2020-01-12T00:00:00Z", res.Unavailable[0].StartDate)
+		a.Equal("2020-03-01T00:00:00Z", res.Unavailable[0].ExpectedEndDate.GetValue())
+		a.Equal("suspension", res.Unavailable[1].Type)
+		a.Nil(res.Unavailable[1].ExpectedEndDate)
+	})
+
+	t.Run("returns invalid argument error if team does not play in fixture", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		sidelinedRepo := new(mock.SidelinedRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewAvailabilityService(fixtureRepo, sidelinedRepo, nil, logger)
+
+		fixtureRepo.On("ByID", uint64(16475287)).Return(&fixture, nil)
+
+		_, err := service.GetTeamAvailability(context.Background(), &proto.TeamAvailabilityRequest{
+			TeamId:    9,
+			FixtureId: 16475287,
+		})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = InvalidArgument desc = team 9 does not play in fixture 16475287", err.Error())
+		sidelinedRepo.AssertNotCalled(t, "ByTeamAtDate", uint64(9), uint64(16036), date)
+	})
+
+	t.Run("returns not found error if fixture does not exist", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		sidelinedRepo := new(mock.SidelinedRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewAvailabilityService(fixtureRepo, sidelinedRepo, nil, logger)
+
+		fixtureRepo.On("ByID", uint64(16475287)).Return(&app.Fixture{}, errors.New("not found"))
+
+		_, err := service.GetTeamAvailability(context.Background(), &proto.TeamAvailabilityRequest{
+			TeamId:    14,
+			FixtureId: 16475287,
+		})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = NotFound desc = fixture with ID 16475287 does not exist", err.Error())
+	})
+
+	t.Run("logs error and returns internal server error if error returned from sidelined repository", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		sidelinedRepo := new(mock.SidelinedRepository)
+		logger, hook := test.NewNullLogger()
+		service := grpc.NewAvailabilityService(fixtureRepo, sidelinedRepo, nil, logger)
+
+		fixtureRepo.On("ByID", uint64(16475287)).Return(&fixture, nil)
+		sidelinedRepo.On("ByTeamAtDate", uint64(1), uint64(16036), date).Return([]app.Sidelined{}, errors.New("oh no"))
+
+		_, err := service.GetTeamAvailability(context.Background(), &proto.TeamAvailabilityRequest{
+			TeamId:    1,
+			FixtureId: 16475287,
+		})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = Internal desc = Internal server error", err.Error())
+		assert.Equal(t, "Error retrieving sidelined players in availability service. Error: oh no", hook.LastEntry().Message)
+	})
+}
+
+func TestAvailabilityService_GetFixtureSuspensions(t *testing.T) {
+	fixture := app.Fixture{ID: 16475287, SeasonID: 16036, HomeTeamID: 1, AwayTeamID: 14}
+
+	t.Run("returns players suspended for fixture", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		suspensionRepo := new(mock.SuspensionRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewAvailabilityService(fixtureRepo, nil, suspensionRepo, logger)
+
+		suspensions := []app.Suspension{
+			{
+				PlayerID:         37429,
+				TeamID:           1,
+				SeasonID:         16036,
+				Reason:           app.SuspensionReasonStraightRed,
+				TriggerFixtureID: 16475200,
+				Matches:          3,
+				FixtureIDs:       []uint64{16475250, 16475287, 16475300},
+			},
+			{
+				PlayerID:         1452,
+				TeamID:           14,
+				SeasonID:         16036,
+				Reason:           app.SuspensionReasonYellowAccumulation,
+				TriggerFixtureID: 16475260,
+				Matches:          1,
+				FixtureIDs:       []uint64{16475287},
+			},
+		}
+
+		fixtureRepo.On("ByID", uint64(16475287)).Return(&fixture, nil)
+		suspensionRepo.On("ByFixtureID", uint64(16475287)).Return(suspensions, nil)
+
+		res, err := service.GetFixtureSuspensions(context.Background(), &proto.FixtureSuspensionsRequest{FixtureId: 16475287})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(16475287), res.FixtureId)
+		a.Equal(2, len(res.Suspensions))
+		a.Equal(uint64(37429), res.Suspensions[0].PlayerId)
+		a.Equal(uint64(1), res.Suspensions[0].TeamId)
+		a.Equal("straight_red", res.Suspensions[0].Reason)
+		a.Equal(uint64(16475200), res.Suspensions[0].TriggerFixtureId)
+		a.Equal(uint32(3), res.Suspensions[0].Matches)
+		a.Equal([]uint64{16475250, 16475287, 16475300}, res.Suspensions[0].FixtureIds)
+		a.Equal(uint64(1452), res.Suspensions[1].PlayerId)
+		a.Equal("yellow_accumulation", res.Suspensions[1].Reason)
+	})
+
+	t.Run("returns not found error if fixture does not exist", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		suspensionRepo := new(mock.SuspensionRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewAvailabilityService(fixtureRepo, nil, suspensionRepo, logger)
+
+		fixtureRepo.On("ByID", uint64(16475287)).Return(&app.Fixture{}, errors.New("not found"))
+
+		_, err := service.GetFixtureSuspensions(context.Background(), &proto.FixtureSuspensionsRequest{FixtureId: 16475287})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = NotFound desc = fixture with ID 16475287 does not exist", err.Error())
+		suspensionRepo.AssertNotCalled(t, "ByFixtureID", uint64(16475287))
+	})
+
+	t.Run("logs error and returns internal server error if error returned from suspension repository", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		suspensionRepo := new(mock.SuspensionRepository)
+		logger, hook := test.NewNullLogger()
+		service := grpc.NewAvailabilityService(fixtureRepo, nil, suspensionRepo, logger)
+
+		fixtureRepo.On("ByID", uint64(16475287)).Return(&fixture, nil)
+		suspensionRepo.On("ByFixtureID", uint64(16475287)).Return([]app.Suspension{}, errors.New("oh no"))
+
+		_, err := service.GetFixtureSuspensions(context.Background(), &proto.FixtureSuspensionsRequest{FixtureId: 16475287})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = Internal desc = Internal server error", err.Error())
+		assert.Equal(t, "Error retrieving suspensions in availability service. Error: oh no", hook.LastEntry().Message)
+	})
+}
@@ -5,19 +5,23 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/statistico/statistico-football-data/internal/app"
 	"github.com/statistico/statistico-football-data/internal/app/grpc/factory"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
 	statistico "github.com/statistico/statistico-proto/go"
+	grpclib "google.golang.org/grpc"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// EventService implements the statistico.EventService, returning the statistico.data FixtureEventsResponse which
+// extends the statistico response with goal types, added time, missed penalties and substitutions. Register the service using
+// RegisterEventService.
 type EventService struct {
 	eventRepo  app.EventRepository
 	logger     *logrus.Logger
-	statistico.UnimplementedEventServiceServer
 }
 
-func (e *EventService) FixtureEvents(ctx context.Context, req *statistico.FixtureRequest) (*statistico.FixtureEventsResponse, error) {
+func (e *EventService) FixtureEvents(ctx context.Context, req *statistico.FixtureRequest) (*proto.FixtureEventsResponse, error) {
 	cards, err := e.eventRepo.CardEventsForFixture(req.FixtureId)
 
 	if err != nil {
@@ -32,14 +36,36 @@ func (e *EventService) FixtureEvents(ctx context.Context, req *statistico.Fixtur
 		return nil, status.Error(codes.Internal, "Internal server error")
 	}
 
-	res := statistico.FixtureEventsResponse{FixtureId: req.FixtureId}
+	missed, err := e.eventRepo.MissedPenaltyEventsForFixture(req.FixtureId)
+
+	if err != nil {
+		e.logger.Errorf("Error retrieving missed penalty events in Event Service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	subs, err := e.eventRepo.SubstitutionEventsForFixture(req.FixtureId)
+
+	if err != nil {
+		e.logger.Errorf("Error retrieving substitution events in Event Service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	res := proto.FixtureEventsResponse{FixtureId: req.FixtureId}
 
 	for _, c := range cards {
-		res.Cards = append(res.Cards, factory.CardEventToProto(c))
+		res.Cards = append(res.Cards, factory.CardEventToDataProto(c))
 	}
 
 	for _, g := range goals {
-		res.Goals = append(res.Goals, factory.GoalEventToProto(g))
+		res.Goals = append(res.Goals, factory.GoalEventToDataProto(g))
+	}
+
+	for _, m := range missed {
+		res.MissedPenalties = append(res.MissedPenalties, factory.MissedPenaltyEventToProto(m))
+	}
+
+	for _, sub := range subs {
+		res.Substitutions = append(res.Substitutions, factory.SubstitutionEventToProto(sub))
 	}
 
 	return &res, nil
@@ -51,3 +77,52 @@ func NewEventService(r app.EventRepository, l *logrus.Logger) *EventService {
 		logger:    l,
 	}
 }
+
+// RegisterEventService registers the EventService as the statistico.EventService. The statistico.data
+// FixtureEventsResponse is a wire compatible superset of the statistico response so the existing FixtureEvents RPC
+// is extended in place rather than served by a second service.
+func RegisterEventService(s grpclib.ServiceRegistrar, e *EventService) {
+	s.RegisterService(&eventServiceDesc, e)
+}
+
+type fixtureEventsServer interface {
+	FixtureEvents(ctx context.Context, req *statistico.FixtureRequest) (*proto.FixtureEventsResponse, error)
+}
+
+var eventServiceDesc = grpclib.ServiceDesc{
+	ServiceName: "statistico.EventService",
+	HandlerType: (*fixtureEventsServer)(nil),
+	Methods: []grpclib.MethodDesc{
+		{
+			MethodName: "FixtureEvents",
+			Handler:    fixtureEventsHandler,
+		},
+	},
+	Streams:  []grpclib.StreamDesc{},
+	Metadata: "event.proto",
+}
+
+func fixtureEventsHandler(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpclib.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(statistico.FixtureRequest)
+
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(fixtureEventsServer).FixtureEvents(ctx, in)
+	}
+
+	info := &grpclib.UnaryServerInfo{Server: srv, FullMethod: "/statistico.EventService/FixtureEvents"}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(fixtureEventsServer).FixtureEvents(ctx, req.(*statistico.FixtureRequest))
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/statistico/statistico-football-data/internal/app"
 	"github.com/statistico/statistico-football-data/internal/app/grpc"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
 	"github.com/statistico/statistico-football-data/internal/app/mock"
 	"github.com/statistico/statistico-proto/go"
 	"github.com/stretchr/testify/assert"
@@ -35,6 +36,8 @@ func TestEventService_FixtureEvents(t *testing.T) {
 
 		repo.On("CardEventsForFixture", uint64(45)).Return(cards, nil)
 		repo.On("GoalEventsForFixture", uint64(45)).Return(goals, nil)
+		repo.On("MissedPenaltyEventsForFixture", uint64(45)).Return([]*app.MissedPenaltyEvent{}, nil)
+		repo.On("SubstitutionEventsForFixture", uint64(45)).Return([]*app.SubstitutionEvent{}, nil)
 
 		req := statistico.FixtureRequest{FixtureId: uint64(45)}
 
@@ -44,7 +47,7 @@ func TestEventService_FixtureEvents(t *testing.T) {
 			t.Fatalf("Expected nil, got %s", err)
 		}
 
-		expectedCards := []*statistico.CardEvent{
+		expectedCards := []*proto.CardEvent{
 			{
 				Id: 1,
 				TeamId: 4509,
@@ -61,7 +64,7 @@ func TestEventService_FixtureEvents(t *testing.T) {
 			},
 		}
 
-		expectedGoals := []*statistico.GoalEvent{
+		expectedGoals := []*proto.GoalEvent{
 			{
 				Id: 3,
 				TeamId: 4509,
@@ -121,6 +124,7 @@ func TestEventService_FixtureEvents(t *testing.T) {
 
 		repo.On("CardEventsForFixture", uint64(45)).Return([]*app.CardEvent{}, nil)
 		repo.On("GoalEventsForFixture", uint64(45)).Return([]*app.GoalEvent{}, errors.New("oh no"))
+		repo.AssertNotCalled(t, "MissedPenaltyEventsForFixture", uint64(45))
 
 		req := statistico.FixtureRequest{FixtureId: uint64(45)}
 
@@ -135,8 +139,154 @@ func TestEventService_FixtureEvents(t *testing.T) {
 		assert.Equal(t, "Error retrieving goal events in Event Service. Error: oh no", hook.LastEntry().Message)
 		repo.AssertExpectations(t)
 	})
+
+	t.Run("returns goal types, added time, missed penalties and substitutions", func(t *testing.T) {
+		t.Helper()
+
+		repo := new(mock.EventRepository)
+		logger, _ := test.NewNullLogger()
+
+		service := grpc.NewEventService(repo, logger)
+
+		extra := 2
+		assist := uint64(901)
+
+		penalty := newGoalEvent(3)
+		penalty.Type = app.GoalTypePenalty
+		penalty.ExtraMinute = &extra
+
+		ownGoal := newGoalEvent(4)
+		ownGoal.Type = app.GoalTypeOwnGoal
+		ownGoal.PlayerAssistID = &assist
+
+		missed := &app.MissedPenaltyEvent{
+			ID:        5,
+			FixtureID: 45,
+			TeamID:    4509,
+			PlayerID:  3401,
+			Minute:    31,
+		}
+
+		injured := true
+
+		injury := &app.SubstitutionEvent{
+			ID:          6,
+			FixtureID:   45,
+			TeamID:      4509,
+			PlayerInID:  12,
+			PlayerOutID: 3401,
+			Minute:      34,
+			Injured:     &injured,
+		}
+
+		tactical := &app.SubstitutionEvent{
+			ID:          7,
+			FixtureID:   45,
+			TeamID:      22,
+			PlayerInID:  14,
+			PlayerOutID: 15,
+			Minute:      71,
+		}
+
+		repo.On("CardEventsForFixture", uint64(45)).Return([]*app.CardEvent{newCardEvent(1)}, nil)
+		repo.On("GoalEventsForFixture", uint64(45)).Return([]*app.GoalEvent{penalty, ownGoal}, nil)
+		repo.On("MissedPenaltyEventsForFixture", uint64(45)).Return([]*app.MissedPenaltyEvent{missed}, nil)
+		repo.On("SubstitutionEventsForFixture", uint64(45)).Return([]*app.SubstitutionEvent{injury, tactical}, nil)
+
+		res, err := service.FixtureEvents(context.Background(), &statistico.FixtureRequest{FixtureId: 45})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err)
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(45), res.GetFixtureId())
+		a.Equal(1, len(res.GetCards()))
+		a.Equal("redcard", res.GetCards()[0].GetType())
+
+		a.Equal(2, len(res.GetGoals()))
+		a.Equal(uint64(3), res.GetGoals()[0].GetId())
+		a.Equal("penalty", res.GetGoals()[0].GetType())
+		a.Equal(uint32(82), res.GetGoals()[0].GetMinute())
+		a.Equal(uint32(2), res.GetGoals()[0].GetExtraMinute().GetValue())
+		a.Nil(res.GetGoals()[0].GetPlayerAssistId())
+		a.Equal("0-1", res.GetGoals()[0].GetScore())
+		a.Equal("own-goal", res.GetGoals()[1].GetType())
+		a.Nil(res.GetGoals()[1].GetExtraMinute())
+		a.Equal(uint64(901), res.GetGoals()[1].GetPlayerAssistId().GetValue())
+
+		a.Equal(1, len(res.GetMissedPenalties()))
+		a.Equal(uint64(5), res.GetMissedPenalties()[0].GetId())
+		a.Equal(uint64(4509), res.GetMissedPenalties()[0].GetTeamId())
+		a.Equal(uint64(3401), res.GetMissedPenalties()[0].GetPlayerId())
+		a.Equal(uint32(31), res.GetMissedPenalties()[0].GetMinute())
+		a.Nil(res.GetMissedPenalties()[0].GetExtraMinute())
+
+		a.Equal(2, len(res.GetSubstitutions()))
+		a.Equal(uint64(6), res.GetSubstitutions()[0].GetId())
+		a.Equal(uint64(4509), res.GetSubstitutions()[0].GetTeamId())
+		a.Equal(uint64(12), res.GetSubstitutions()[0].GetPlayerInId())
+		a.Equal(uint64(3401), res.GetSubstitutions()[0].GetPlayerOutId())
+		a.Equal(uint32(34), res.GetSubstitutions()[0].GetMinute())
+		a.True(res.GetSubstitutions()[0].GetInjured().GetValue())
+		a.Equal(uint64(7), res.GetSubstitutions()[1].GetId())
+		a.Nil(res.GetSubstitutions()[1].GetInjured())
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("logs error if error returned by repository when fetching missed penalty events", func(t *testing.T) {
+		t.Helper()
+
+		repo := new(mock.EventRepository)
+		logger, hook := test.NewNullLogger()
+
+		service := grpc.NewEventService(repo, logger)
+
+		repo.On("CardEventsForFixture", uint64(45)).Return([]*app.CardEvent{}, nil)
+		repo.On("GoalEventsForFixture", uint64(45)).Return([]*app.GoalEvent{}, nil)
+		repo.On("MissedPenaltyEventsForFixture", uint64(45)).Return([]*app.MissedPenaltyEvent{}, errors.New("oh no"))
+		repo.AssertNotCalled(t, "SubstitutionEventsForFixture", uint64(45))
+
+		_, err := service.FixtureEvents(context.Background(), &statistico.FixtureRequest{FixtureId: 45})
+
+		if err == nil {
+			t.Fatalf("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = Internal desc = Internal server error", err.Error())
+		assert.Equal(t, 1, len(hook.Entries))
+		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, "Error retrieving missed penalty events in Event Service. Error: oh no", hook.LastEntry().Message)
+		repo.AssertExpectations(t)
+	})
+	t.Run("logs error if error returned by repository when fetching substitution events", func(t *testing.T) {
+		t.Helper()
+
+		repo := new(mock.EventRepository)
+		logger, hook := test.NewNullLogger()
+
+		service := grpc.NewEventService(repo, logger)
+
+		repo.On("CardEventsForFixture", uint64(45)).Return([]*app.CardEvent{}, nil)
+		repo.On("GoalEventsForFixture", uint64(45)).Return([]*app.GoalEvent{}, nil)
+		repo.On("MissedPenaltyEventsForFixture", uint64(45)).Return([]*app.MissedPenaltyEvent{}, nil)
+		repo.On("SubstitutionEventsForFixture", uint64(45)).Return([]*app.SubstitutionEvent{}, errors.New("oh no"))
+
+		_, err := service.FixtureEvents(context.Background(), &statistico.FixtureRequest{FixtureId: 45})
+
+		if err == nil {
+			t.Fatalf("Expected error, got nil")
+		}
+
+		assert.Equal(t, 1, len(hook.Entries))
+		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, "Error retrieving substitution events in Event Service. Error: oh no", hook.LastEntry().Message)
+		repo.AssertExpectations(t)
+	})
 }
 
+
 func newCardEvent(id uint64) *app.CardEvent {
 	return &app.CardEvent{
 		ID:          id,
@@ -0,0 +1,38 @@
+package factory
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"time"
+)
+
+// Convert a domain CommandRun struct into a proto CommandRun struct
+func CommandRunToProto(r *app.CommandRun) *proto.CommandRun {
+	pr := proto.CommandRun{
+		Id:        r.ID,
+		Command:   r.Command,
+		Option:    r.Option,
+		Status:    r.Status,
+		StartedAt: r.StartedAt.UTC().Format(time.RFC3339),
+	}
+
+	if r.Error != nil {
+		pr.Error = &wrappers.StringValue{Value: *r.Error}
+	}
+
+	if r.FinishedAt != nil {
+		pr.FinishedAt = &wrappers.StringValue{Value: r.FinishedAt.UTC().Format(time.RFC3339)}
+	}
+
+	return &pr
+}
+
+// Convert a domain CommandRunLog struct into a proto RunLogLine struct
+func CommandRunLogToProto(l *app.CommandRunLog) *proto.RunLogLine {
+	return &proto.RunLogLine{
+		Level:   l.Level,
+		Message: l.Message,
+		Time:    l.Time.UTC().Format(time.RFC3339),
+	}
+}
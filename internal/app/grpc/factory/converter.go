@@ -7,34 +7,6 @@ import (
 	"time"
 )
 
-// Convert a domain CardEvent struct into a proto CardEvent struct
-func CardEventToProto(e *app.CardEvent) *statistico.CardEvent {
-	return &statistico.CardEvent{
-		Id:       e.ID,
-		TeamId:   e.TeamID,
-		Type:     e.Type,
-		PlayerId: e.PlayerID,
-		Minute:   uint32(e.Minute),
-	}
-}
-
-// Convert a domain GoalEvent struct into a proto GoalEvent struct
-func GoalEventToProto(e *app.GoalEvent) *statistico.GoalEvent {
-	pe := statistico.GoalEvent{
-		Id:             e.ID,
-		TeamId:         e.TeamID,
-		PlayerId:       e.PlayerID,
-		Minute:         uint32(e.Minute),
-		Score:          e.Score,
-	}
-
-	if e.PlayerAssistID != nil {
-		pe.PlayerAssistId = &wrappers.UInt64Value{Value: *e.PlayerAssistID}
-	}
-
-	return &pe
-}
-
 // Convert a domain Team struct into a proto Team struct
 func TeamToProto(t *app.Team) *statistico.Team {
 	team := statistico.Team{
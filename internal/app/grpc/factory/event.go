@@ -0,0 +1,73 @@
+package factory
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+)
+
+// Convert a domain CardEvent struct into a statistico.data proto CardEvent struct
+func CardEventToDataProto(e *app.CardEvent) *proto.CardEvent {
+	return &proto.CardEvent{
+		Id:       e.ID,
+		TeamId:   e.TeamID,
+		Type:     e.Type,
+		PlayerId: e.PlayerID,
+		Minute:   uint32(e.Minute),
+	}
+}
+
+// Convert a domain GoalEvent struct into a statistico.data proto GoalEvent struct
+func GoalEventToDataProto(e *app.GoalEvent) *proto.GoalEvent {
+	pe := proto.GoalEvent{
+		Id:       e.ID,
+		TeamId:   e.TeamID,
+		PlayerId: e.PlayerID,
+		Type:     e.Type,
+		Minute:   uint32(e.Minute),
+		Score:    e.Score,
+	}
+
+	if e.PlayerAssistID != nil {
+		pe.PlayerAssistId = &wrappers.UInt64Value{Value: *e.PlayerAssistID}
+	}
+
+	if e.ExtraMinute != nil {
+		pe.ExtraMinute = &wrappers.UInt32Value{Value: uint32(*e.ExtraMinute)}
+	}
+
+	return &pe
+}
+
+// Convert a domain MissedPenaltyEvent struct into a proto MissedPenaltyEvent struct
+func MissedPenaltyEventToProto(e *app.MissedPenaltyEvent) *proto.MissedPenaltyEvent {
+	pe := proto.MissedPenaltyEvent{
+		Id:       e.ID,
+		TeamId:   e.TeamID,
+		PlayerId: e.PlayerID,
+		Minute:   uint32(e.Minute),
+	}
+
+	if e.ExtraMinute != nil {
+		pe.ExtraMinute = &wrappers.UInt32Value{Value: uint32(*e.ExtraMinute)}
+	}
+
+	return &pe
+}
+
+// Convert a domain SubstitutionEvent struct into a proto SubstitutionEvent struct
+func SubstitutionEventToProto(e *app.SubstitutionEvent) *proto.SubstitutionEvent {
+	pe := proto.SubstitutionEvent{
+		Id:          e.ID,
+		TeamId:      e.TeamID,
+		PlayerInId:  e.PlayerInID,
+		PlayerOutId: e.PlayerOutID,
+		Minute:      uint32(e.Minute),
+	}
+
+	if e.Injured != nil {
+		pe.Injured = &wrappers.BoolValue{Value: *e.Injured}
+	}
+
+	return &pe
+}
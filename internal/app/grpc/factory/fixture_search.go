@@ -0,0 +1,41 @@
+package factory
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"time"
+)
+
+// Convert a domain Fixture struct into a proto FixtureSummary struct
+func FixtureSummaryToProto(f *app.Fixture) *proto.FixtureSummary {
+	pf := proto.FixtureSummary{
+		Id:         f.ID,
+		SeasonId:   f.SeasonID,
+		HomeTeamId: f.HomeTeamID,
+		AwayTeamId: f.AwayTeamID,
+		Date:       f.Date.UTC().Format(time.RFC3339),
+	}
+
+	if f.RoundID != nil {
+		pf.RoundId = &wrappers.UInt64Value{Value: *f.RoundID}
+	}
+
+	if f.VenueID != nil {
+		pf.VenueId = &wrappers.UInt64Value{Value: *f.VenueID}
+	}
+
+	if f.RefereeID != nil {
+		pf.RefereeId = &wrappers.UInt64Value{Value: *f.RefereeID}
+	}
+
+	if f.Status != nil {
+		pf.Status = &wrappers.StringValue{Value: string(*f.Status)}
+	}
+
+	if g := f.StatusGroup(); g != "" {
+		pf.StatusGroup = &wrappers.StringValue{Value: string(g)}
+	}
+
+	return &pf
+}
@@ -0,0 +1,52 @@
+package factory
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+)
+
+// Convert a domain Lineup struct into a proto TeamLineup struct
+func LineupToProto(l *app.Lineup) *proto.TeamLineup {
+	x := proto.TeamLineup{TeamId: l.TeamID}
+
+	if l.Formation != nil {
+		x.Formation = &wrappers.StringValue{Value: *l.Formation}
+	}
+
+	for _, p := range l.Starting() {
+		x.Start = append(x.Start, lineupPlayerToProto(&p))
+	}
+
+	for _, p := range l.Bench() {
+		x.Bench = append(x.Bench, lineupPlayerToProto(&p))
+	}
+
+	return &x
+}
+
+func lineupPlayerToProto(p *app.LineupPlayer) *proto.LineupPlayer {
+	x := proto.LineupPlayer{
+		PlayerId:    p.PlayerID,
+		ShirtNumber: uint32(p.ShirtNumber),
+		Captain:     p.Captain,
+	}
+
+	if p.Position != nil {
+		x.Position = &wrappers.StringValue{Value: *p.Position}
+	}
+
+	if p.FormationPosition != nil {
+		x.FormationPosition = &wrappers.UInt32Value{Value: uint32(*p.FormationPosition)}
+	}
+
+	if p.GridX != nil {
+		x.GridX = &wrappers.UInt32Value{Value: uint32(*p.GridX)}
+	}
+
+	if p.GridY != nil {
+		x.GridY = &wrappers.UInt32Value{Value: uint32(*p.GridY)}
+	}
+
+	return &x
+}
@@ -0,0 +1,38 @@
+package factory
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+)
+
+// Convert a domain FixtureUpdate struct into a proto FixtureUpdate struct using the current state of the fixture
+// and its result, the result is nil for fixtures yet to kick off
+func FixtureUpdateToProto(u *app.FixtureUpdate, f *app.Fixture, r *app.Result) *proto.FixtureUpdate {
+	pu := proto.FixtureUpdate{
+		FixtureId: u.FixtureID,
+		Type:      u.Type,
+	}
+
+	if f.Status != nil {
+		pu.Status = &wrappers.StringValue{Value: string(*f.Status)}
+	}
+
+	if r == nil {
+		return &pu
+	}
+
+	if r.HomeScore != nil {
+		pu.HomeScore = &wrappers.UInt32Value{Value: uint32(*r.HomeScore)}
+	}
+
+	if r.AwayScore != nil {
+		pu.AwayScore = &wrappers.UInt32Value{Value: uint32(*r.AwayScore)}
+	}
+
+	if r.Minutes != nil {
+		pu.Minute = &wrappers.UInt32Value{Value: uint32(*r.Minutes)}
+	}
+
+	return &pu
+}
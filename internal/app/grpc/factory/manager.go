@@ -0,0 +1,63 @@
+package factory
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"time"
+)
+
+// Convert a domain Manager struct into a proto Manager struct
+func ManagerToProto(m *app.Manager) *proto.Manager {
+	x := proto.Manager{
+		Id:          m.ID,
+		FirstName:   m.FirstName,
+		LastName:    m.LastName,
+		Nationality: m.Nationality,
+	}
+
+	if m.Image != nil {
+		x.Image = &wrappers.StringValue{Value: *m.Image}
+	}
+
+	return &x
+}
+
+// Convert a domain ManagerTenure struct and the Manager it belongs to into a proto ManagerTenure struct
+func ManagerTenureToProto(t *app.ManagerTenure, m *app.Manager) *proto.ManagerTenure {
+	return &proto.ManagerTenure{
+		TeamId:   t.TeamID,
+		Manager:  ManagerToProto(m),
+		DateFrom: t.From.UTC().Format(time.RFC3339),
+		DateTo:   t.To.UTC().Format(time.RFC3339),
+	}
+}
+
+// Convert a Fixture and its Result into a proto ManagerResult struct from the perspective of the team provided
+func ManagerResultToProto(teamID uint64, f *app.Fixture, r *app.Result) *proto.ManagerResult {
+	x := proto.ManagerResult{
+		FixtureId:    f.ID,
+		TeamId:       teamID,
+		OpponentId:   f.HomeTeamID,
+		IsHome:       f.HomeTeamID == teamID,
+		Date:         f.Date.UTC().Format(time.RFC3339),
+		GoalsFor:     uint32(*r.AwayScore),
+		GoalsAgainst: uint32(*r.HomeScore),
+	}
+
+	if x.IsHome {
+		x.OpponentId = f.AwayTeamID
+		x.GoalsFor, x.GoalsAgainst = x.GoalsAgainst, x.GoalsFor
+	}
+
+	switch {
+	case x.GoalsFor > x.GoalsAgainst:
+		x.Outcome = "W"
+	case x.GoalsFor < x.GoalsAgainst:
+		x.Outcome = "L"
+	default:
+		x.Outcome = "D"
+	}
+
+	return &x
+}
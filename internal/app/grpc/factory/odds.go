@@ -0,0 +1,31 @@
+package factory
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"time"
+)
+
+// Convert a slice of domain Odds structs into a slice of proto OddsPrice structs
+func OddsToProto(odds []app.Odds) []*proto.OddsPrice {
+	prices := []*proto.OddsPrice{}
+
+	for _, o := range odds {
+		p := proto.OddsPrice{
+			BookmakerId: o.BookmakerID,
+			Bookmaker:   o.Bookmaker,
+			Selection:   o.Selection,
+			Price:       o.Price,
+			CapturedAt:  o.CapturedAt.UTC().Format(time.RFC3339),
+		}
+
+		if o.Line != nil {
+			p.Line = &wrappers.StringValue{Value: *o.Line}
+		}
+
+		prices = append(prices, &p)
+	}
+
+	return prices
+}
@@ -0,0 +1,32 @@
+package factory
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+)
+
+// Convert a domain Referee struct into a proto Referee struct
+func RefereeToProto(r *app.Referee) *proto.Referee {
+	return &proto.Referee{
+		Id:         r.ID,
+		CommonName: r.CommonName,
+		FullName:   r.FullName,
+		FirstName:  r.FirstName,
+		LastName:   r.LastName,
+	}
+}
+
+// Convert a domain RefereeStats struct into a proto RefereeStats struct
+func RefereeStatsToProto(s *app.RefereeStats) *proto.RefereeStats {
+	return &proto.RefereeStats{
+		Fixtures:           s.Fixtures,
+		YellowCards:        s.YellowCards,
+		RedCards:           s.RedCards,
+		Fouls:              s.Fouls,
+		Penalties:          s.Penalties,
+		YellowCardsPerGame: s.PerGame(s.YellowCards),
+		RedCardsPerGame:    s.PerGame(s.RedCards),
+		FoulsPerGame:       s.PerGame(s.Fouls),
+		PenaltiesPerGame:   s.PerGame(s.Penalties),
+	}
+}
@@ -0,0 +1,24 @@
+package factory
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"time"
+)
+
+// Convert a domain Sidelined struct into a proto UnavailablePlayer struct
+func SidelinedToProto(s *app.Sidelined) *proto.UnavailablePlayer {
+	x := proto.UnavailablePlayer{
+		PlayerId:  s.PlayerID,
+		Reason:    s.Reason,
+		Type:      s.Type,
+		StartDate: s.StartDate.UTC().Format(time.RFC3339),
+	}
+
+	if s.ExpectedEndDate != nil {
+		x.ExpectedEndDate = &wrappers.StringValue{Value: s.ExpectedEndDate.UTC().Format(time.RFC3339)}
+	}
+
+	return &x
+}
@@ -0,0 +1,69 @@
+package factory
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+)
+
+// Convert a domain Stage struct and the Groups belonging to it into a proto Stage struct
+func StageToProto(s *app.Stage, groups []app.Group) *proto.Stage {
+	x := proto.Stage{
+		Id:            s.ID,
+		Name:          s.Name,
+		CompetitionId: s.CompetitionID,
+		SeasonId:      s.SeasonID,
+		HasStandings:  s.HasStandings,
+	}
+
+	if s.Type != nil {
+		x.Type = &wrappers.StringValue{Value: *s.Type}
+	}
+
+	if s.SortOrder != nil {
+		x.SortOrder = &wrappers.Int32Value{Value: int32(*s.SortOrder)}
+	}
+
+	for _, g := range groups {
+		x.Groups = append(x.Groups, GroupToProto(&g))
+	}
+
+	return &x
+}
+
+// Convert a domain Group struct into a proto Group struct
+func GroupToProto(g *app.Group) *proto.Group {
+	return &proto.Group{
+		Id:       g.ID,
+		Name:     g.Name,
+		StageId:  g.StageID,
+		SeasonId: g.SeasonID,
+	}
+}
+
+// Convert a domain Tie struct into a proto Tie struct
+func TieToProto(t *app.Tie) *proto.Tie {
+	x := proto.Tie{
+		FixtureIds: t.FixtureIDs,
+		HomeTeamId: t.HomeTeamID,
+		AwayTeamId: t.AwayTeamID,
+		HomeScore:  uint32(t.HomeScore),
+		AwayScore:  uint32(t.AwayScore),
+		Complete:   t.Complete,
+		DecidedBy:  t.DecidedBy,
+	}
+
+	if t.AggregateID != nil {
+		x.AggregateId = &wrappers.UInt64Value{Value: *t.AggregateID}
+	}
+
+	if t.StageID != nil {
+		x.StageId = &wrappers.UInt64Value{Value: *t.StageID}
+	}
+
+	if t.WinnerID != nil {
+		x.WinnerId = &wrappers.UInt64Value{Value: *t.WinnerID}
+	}
+
+	return &x
+}
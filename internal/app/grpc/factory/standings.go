@@ -0,0 +1,33 @@
+package factory
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+)
+
+// Convert a domain Standing struct into a proto Standing struct
+func StandingToProto(s *app.Standing) *proto.Standing {
+	x := proto.Standing{
+		Position:       uint32(s.Position),
+		TeamId:         s.TeamID,
+		Played:         uint32(s.Played),
+		Won:            uint32(s.Won),
+		Drawn:          uint32(s.Drawn),
+		Lost:           uint32(s.Lost),
+		GoalsFor:       uint32(s.GoalsFor),
+		GoalsAgainst:   uint32(s.GoalsAgainst),
+		GoalDifference: int32(s.GoalDifference()),
+		Points:         uint32(s.Points),
+	}
+
+	if s.StageID != nil {
+		x.StageId = &wrappers.UInt64Value{Value: *s.StageID}
+	}
+
+	if s.GroupID != nil {
+		x.GroupId = &wrappers.UInt64Value{Value: *s.GroupID}
+	}
+
+	return &x
+}
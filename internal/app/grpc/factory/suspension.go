@@ -0,0 +1,18 @@
+package factory
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+)
+
+// Convert a domain Suspension struct into a proto Suspension struct
+func SuspensionToProto(s *app.Suspension) *proto.Suspension {
+	return &proto.Suspension{
+		PlayerId:         s.PlayerID,
+		TeamId:           s.TeamID,
+		Reason:           s.Reason,
+		TriggerFixtureId: s.TriggerFixtureID,
+		Matches:          uint32(s.Matches),
+		FixtureIds:       s.FixtureIDs,
+	}
+}
@@ -0,0 +1,27 @@
+package factory
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"time"
+)
+
+// Convert a domain ClubSpell struct into a proto ClubSpell struct
+func ClubSpellToProto(c *app.ClubSpell) *proto.ClubSpell {
+	x := proto.ClubSpell{
+		TeamId:     c.TeamID,
+		JoinedDate: c.JoinedDate.UTC().Format(time.RFC3339),
+		Type:       c.Type,
+	}
+
+	if c.LeftDate != nil {
+		x.LeftDate = &wrappers.StringValue{Value: c.LeftDate.UTC().Format(time.RFC3339)}
+	}
+
+	if c.Fee != nil {
+		x.Fee = &wrappers.StringValue{Value: *c.Fee}
+	}
+
+	return &x
+}
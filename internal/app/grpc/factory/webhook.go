@@ -0,0 +1,18 @@
+package factory
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"time"
+)
+
+// Convert a domain WebhookSubscription struct into a proto WebhookSubscription struct
+func WebhookSubscriptionToProto(s *app.WebhookSubscription) *proto.WebhookSubscription {
+	return &proto.WebhookSubscription{
+		Id:             s.ID,
+		Url:            s.URL,
+		EventTypes:     s.EventTypes,
+		CompetitionIds: s.CompetitionIDs,
+		CreatedAt:      s.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/factory"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// FixtureSearchService implements the statistico.data.FixtureSearchService, extending the fixture search of the
+// statistico.FixtureService with a filter on fixture status group.
+type FixtureSearchService struct {
+	fixtureRepo app.FixtureRepository
+	logger      *logrus.Logger
+	proto.UnimplementedFixtureSearchServiceServer
+}
+
+func (s *FixtureSearchService) Search(r *proto.FixtureSearchRequest, stream proto.FixtureSearchService_SearchServer) error {
+	query, err := buildFixtureSearchQuery(r)
+
+	if err != nil {
+		return err
+	}
+
+	fixtures, err := s.fixtureRepo.Get(query)
+
+	if err != nil {
+		s.logger.Errorf("Error retrieving Fixture(s) in fixture search service. Error: %s", err.Error())
+		return status.Error(codes.Internal, "Internal server error")
+	}
+
+	for _, f := range fixtures {
+		if err := stream.Send(factory.FixtureSummaryToProto(&f)); err != nil {
+			s.logger.Errorf("Error streaming Fixture back to client. Error: %s", err.Error())
+			return status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return nil
+}
+
+func NewFixtureSearchService(r app.FixtureRepository, log *logrus.Logger) *FixtureSearchService {
+	return &FixtureSearchService{fixtureRepo: r, logger: log}
+}
+
+func buildFixtureSearchQuery(r *proto.FixtureSearchRequest) (app.FixtureRepositoryQuery, error) {
+	var query app.FixtureRepositoryQuery
+
+	if r.GetDateBefore() != nil {
+		date, err := time.Parse(time.RFC3339, r.GetDateBefore().GetValue())
+
+		if err != nil {
+			return query, status.Error(
+				codes.InvalidArgument,
+				fmt.Sprintf("Date provided '%s' is not a valid RFC3339 date", r.GetDateBefore().GetValue()),
+			)
+		}
+
+		query.DateTo = &date
+	}
+
+	if r.GetDateAfter() != nil {
+		date, err := time.Parse(time.RFC3339, r.GetDateAfter().GetValue())
+
+		if err != nil {
+			return query, status.Error(
+				codes.InvalidArgument,
+				fmt.Sprintf("Date provided '%s' is not a valid RFC3339 date", r.GetDateAfter().GetValue()),
+			)
+		}
+
+		query.DateFrom = &date
+	}
+
+	if r.GetLimit() != nil {
+		v := r.GetLimit().GetValue()
+		query.Limit = &v
+	}
+
+	if len(r.GetSeasonIds()) > 0 {
+		query.SeasonIDs = r.GetSeasonIds()
+	}
+
+	if r.GetSort() != nil {
+		v := r.GetSort().GetValue()
+		query.SortBy = &v
+	}
+
+	for _, g := range r.GetStatusGroups() {
+		group := app.FixtureStatusGroup(g)
+
+		if !isFixtureStatusGroup(group) {
+			return query, status.Error(
+				codes.InvalidArgument,
+				fmt.Sprintf("Status group '%s' is not valid, supported groups are scheduled, live, finished, cancelled and unknown", g),
+			)
+		}
+
+		query.StatusGroups = append(query.StatusGroups, group)
+	}
+
+	return query, nil
+}
+
+func isFixtureStatusGroup(g app.FixtureStatusGroup) bool {
+	for _, group := range app.FixtureStatusGroups() {
+		if group == g {
+			return true
+		}
+	}
+
+	return false
+}
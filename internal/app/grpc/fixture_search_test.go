@@ -0,0 +1,115 @@
+package grpc_test
+
+import (
+	"errors"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	g "google.golang.org/grpc"
+	"testing"
+	"time"
+)
+
+func TestFixtureSearchService_Search(t *testing.T) {
+	t.Run("streams fixtures matching the status groups requested", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewFixtureSearchService(fixtureRepo, logger)
+
+		live, cancelled := app.FixtureStatusHalfTime, app.FixtureStatusPostponed
+		round := uint64(12)
+		date := time.Date(2021, 2, 11, 15, 0, 0, 0, time.UTC)
+
+		fixtures := []app.Fixture{
+			{ID: 45, SeasonID: 16036, RoundID: &round, HomeTeamID: 1, AwayTeamID: 14, Date: date, Status: &live},
+			{ID: 46, SeasonID: 16036, HomeTeamID: 2, AwayTeamID: 3, Date: date, Status: &cancelled},
+		}
+
+		fixtureRepo.On("Get", m.MatchedBy(func(q app.FixtureRepositoryQuery) bool {
+			return q.SeasonIDs[0] == 16036 && q.DateFrom.Equal(date) && len(q.StatusGroups) == 2 &&
+				q.StatusGroups[0] == app.FixtureStatusGroupLive && q.StatusGroups[1] == app.FixtureStatusGroupCancelled
+		})).Return(fixtures, nil)
+
+		stream := new(fixtureSummaryStream)
+
+		req := proto.FixtureSearchRequest{
+			SeasonIds:    []uint64{16036},
+			DateAfter:    &wrappers.StringValue{Value: "2021-02-11T15:00:00Z"},
+			StatusGroups: []string{"live", "cancelled"},
+		}
+
+		if err := service.Search(&req, stream); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(stream.sent))
+		a.Equal(uint64(45), stream.sent[0].GetId())
+		a.Equal(uint64(12), stream.sent[0].GetRoundId().GetValue())
+		a.Equal("2021-02-11T15:00:00Z", stream.sent[0].GetDate())
+		a.Equal("HT", stream.sent[0].GetStatus().GetValue())
+		a.Equal("live", stream.sent[0].GetStatusGroup().GetValue())
+		a.Equal(uint64(46), stream.sent[1].GetId())
+		a.Nil(stream.sent[1].GetRoundId())
+		a.Equal("POSTP", stream.sent[1].GetStatus().GetValue())
+		a.Equal("cancelled", stream.sent[1].GetStatusGroup().GetValue())
+	})
+
+	t.Run("returns invalid argument error if status group is not supported", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewFixtureSearchService(fixtureRepo, logger)
+
+		err := service.Search(&proto.FixtureSearchRequest{StatusGroups: []string{"played"}}, new(fixtureSummaryStream))
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(
+			t,
+			"rpc error: code = InvalidArgument desc = Status group 'played' is not valid, supported groups are scheduled, live, finished, cancelled and unknown",
+			err.Error(),
+		)
+		fixtureRepo.AssertNotCalled(t, "Get", m.Anything)
+	})
+
+	t.Run("returns internal server error if fixtures cannot be retrieved", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		logger, hook := test.NewNullLogger()
+		service := grpc.NewFixtureSearchService(fixtureRepo, logger)
+
+		fixtureRepo.On("Get", m.Anything).Return([]app.Fixture{}, errors.New("oh no"))
+
+		err := service.Search(&proto.FixtureSearchRequest{StatusGroups: []string{"finished"}}, new(fixtureSummaryStream))
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = Internal desc = Internal server error", err.Error())
+		assert.Equal(t, "Error retrieving Fixture(s) in fixture search service. Error: oh no", hook.LastEntry().Message)
+	})
+}
+
+type fixtureSummaryStream struct {
+	g.ServerStream
+	sent []*proto.FixtureSummary
+}
+
+func (f *fixtureSummaryStream) Send(s *proto.FixtureSummary) error {
+	f.sent = append(f.sent, s)
+	return nil
+}
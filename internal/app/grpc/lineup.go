@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/factory"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LineupService struct {
+	fixtureRepo app.FixtureRepository
+	lineupRepo  app.LineupRepository
+	logger      *logrus.Logger
+	proto.UnimplementedLineupServiceServer
+}
+
+func (s *LineupService) GetFixtureLineup(c context.Context, r *proto.FixtureLineupRequest) (*proto.FixtureLineupResponse, error) {
+	fix, err := s.fixtureRepo.ByID(r.GetFixtureId())
+
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("fixture with ID %d does not exist", r.GetFixtureId()))
+	}
+
+	lineups, err := s.lineupRepo.ByFixtureID(fix.ID)
+
+	if err != nil {
+		s.logger.Errorf("Error retrieving lineups in lineup service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	res := proto.FixtureLineupResponse{FixtureId: fix.ID}
+
+	for _, l := range lineups {
+		switch l.TeamID {
+		case fix.HomeTeamID:
+			res.Home = factory.LineupToProto(&l)
+		case fix.AwayTeamID:
+			res.Away = factory.LineupToProto(&l)
+		}
+	}
+
+	return &res, nil
+}
+
+func NewLineupService(f app.FixtureRepository, l app.LineupRepository, log *logrus.Logger) *LineupService {
+	return &LineupService{fixtureRepo: f, lineupRepo: l, logger: log}
+}
@@ -0,0 +1,115 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLineupService_GetFixtureLineup(t *testing.T) {
+	t.Run("returns home and away lineups split into starting players and bench", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		lineupRepo := new(mock.LineupRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewLineupService(fixtureRepo, lineupRepo, logger)
+
+		formation := "4-2-3-1"
+		goalkeeper := "G"
+		one, five := 1, 5
+
+		home := app.Lineup{
+			FixtureID: 45,
+			TeamID:    1,
+			Formation: &formation,
+			Players: []app.LineupPlayer{
+				{
+					PlayerID:          10,
+					ShirtNumber:       1,
+					Position:          &goalkeeper,
+					FormationPosition: &one,
+					GridX:             &one,
+					GridY:             &five,
+					Captain:           true,
+				},
+				{PlayerID: 12, ShirtNumber: 13, IsSubstitute: true},
+			},
+		}
+
+		fixtureRepo.On("ByID", uint64(45)).Return(&app.Fixture{ID: 45, HomeTeamID: 1, AwayTeamID: 14}, nil)
+		lineupRepo.On("ByFixtureID", uint64(45)).Return([]app.Lineup{home}, nil)
+
+		res, err := service.GetFixtureLineup(context.Background(), &proto.FixtureLineupRequest{FixtureId: 45})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(45), res.GetFixtureId())
+		a.Nil(res.GetAway())
+		a.Equal(uint64(1), res.GetHome().GetTeamId())
+		a.Equal("4-2-3-1", res.GetHome().GetFormation().GetValue())
+		a.Equal(1, len(res.GetHome().GetStart()))
+		a.Equal(uint64(10), res.GetHome().GetStart()[0].GetPlayerId())
+		a.Equal(uint32(1), res.GetHome().GetStart()[0].GetShirtNumber())
+		a.Equal("G", res.GetHome().GetStart()[0].GetPosition().GetValue())
+		a.Equal(uint32(1), res.GetHome().GetStart()[0].GetFormationPosition().GetValue())
+		a.Equal(uint32(1), res.GetHome().GetStart()[0].GetGridX().GetValue())
+		a.Equal(uint32(5), res.GetHome().GetStart()[0].GetGridY().GetValue())
+		a.True(res.GetHome().GetStart()[0].GetCaptain())
+		a.Equal(1, len(res.GetHome().GetBench()))
+		a.Equal(uint64(12), res.GetHome().GetBench()[0].GetPlayerId())
+		a.Nil(res.GetHome().GetBench()[0].GetPosition())
+		a.Nil(res.GetHome().GetBench()[0].GetGridX())
+	})
+
+	t.Run("returns not found error if fixture does not exist", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		lineupRepo := new(mock.LineupRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewLineupService(fixtureRepo, lineupRepo, logger)
+
+		fixtureRepo.On("ByID", uint64(45)).Return(&app.Fixture{}, errors.New("not found"))
+
+		_, err := service.GetFixtureLineup(context.Background(), &proto.FixtureLineupRequest{FixtureId: 45})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = NotFound desc = fixture with ID 45 does not exist", err.Error())
+		lineupRepo.AssertNotCalled(t, "ByFixtureID", uint64(45))
+	})
+
+	t.Run("returns internal server error if lineups cannot be retrieved", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		lineupRepo := new(mock.LineupRepository)
+		logger, hook := test.NewNullLogger()
+		service := grpc.NewLineupService(fixtureRepo, lineupRepo, logger)
+
+		fixtureRepo.On("ByID", uint64(45)).Return(&app.Fixture{ID: 45, HomeTeamID: 1, AwayTeamID: 14}, nil)
+		lineupRepo.On("ByFixtureID", uint64(45)).Return([]app.Lineup{}, errors.New("oh no"))
+
+		_, err := service.GetFixtureLineup(context.Background(), &proto.FixtureLineupRequest{FixtureId: 45})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = Internal desc = Internal server error", err.Error())
+		assert.Equal(t, "Error retrieving lineups in lineup service. Error: oh no", hook.LastEntry().Message)
+	})
+}
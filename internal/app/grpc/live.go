@@ -0,0 +1,192 @@
+package grpc
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/factory"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LiveService struct {
+	fixtureRepo app.FixtureRepository
+	seasonRepo  app.SeasonRepository
+	resultRepo  app.ResultRepository
+	eventRepo   app.EventRepository
+	listener    app.FixtureUpdateListener
+	logger      *logrus.Logger
+	proto.UnimplementedLiveServiceServer
+}
+
+func (s *LiveService) WatchFixtures(r *proto.WatchFixturesRequest, stream proto.LiveService_WatchFixturesServer) error {
+	if len(r.GetFixtureIds()) == 0 && len(r.GetTeamIds()) == 0 && len(r.GetCompetitionIds()) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one fixture, team or competition ID must be provided")
+	}
+
+	updates, cancel := s.listener.Subscribe()
+	defer cancel()
+
+	watch := newFixtureWatch(r)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case u, ok := <-updates:
+			if !ok {
+				return status.Error(codes.Unavailable, "Fixture updates are unavailable")
+			}
+
+			if !s.matches(watch, u.FixtureID) {
+				continue
+			}
+
+			res, err := s.fixtureUpdate(&u)
+
+			if err != nil {
+				s.logger.Errorf("Error building fixture update in live service. Error: %s", err.Error())
+				continue
+			}
+
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// matches returns true if the fixture is one of, or involves a team or competition in, the IDs being watched. The
+// outcome is cached for each fixture so the fixture and season are retrieved at most once per stream.
+func (s *LiveService) matches(w *fixtureWatch, fixtureID uint64) bool {
+	if match, ok := w.matched[fixtureID]; ok {
+		return match
+	}
+
+	if w.fixtures[fixtureID] {
+		w.matched[fixtureID] = true
+		return true
+	}
+
+	if len(w.teams) == 0 && len(w.competitions) == 0 {
+		w.matched[fixtureID] = false
+		return false
+	}
+
+	fix, err := s.fixtureRepo.ByID(fixtureID)
+
+	if err != nil {
+		return false
+	}
+
+	match := w.teams[fix.HomeTeamID] || w.teams[fix.AwayTeamID]
+
+	if !match && len(w.competitions) > 0 {
+		season, err := s.seasonRepo.ByID(fix.SeasonID)
+
+		if err != nil {
+			return false
+		}
+
+		match = w.competitions[season.CompetitionID]
+	}
+
+	w.matched[fixtureID] = match
+
+	return match
+}
+
+func (s *LiveService) fixtureUpdate(u *app.FixtureUpdate) (*proto.FixtureUpdate, error) {
+	fix, err := s.fixtureRepo.ByID(u.FixtureID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A fixture yet to kick off has no result, the update is sent without a score.
+	res, err := s.resultRepo.ByFixtureID(u.FixtureID)
+
+	if err != nil {
+		res = nil
+	}
+
+	pu := factory.FixtureUpdateToProto(u, fix, res)
+
+	if u.EventID == nil {
+		return pu, nil
+	}
+
+	switch u.Type {
+	case app.FixtureUpdateCard:
+		e, err := s.eventRepo.CardEventByID(*u.EventID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		pu.Event = &proto.FixtureUpdate_Card{Card: factory.CardEventToDataProto(e)}
+	case app.FixtureUpdateGoal:
+		e, err := s.eventRepo.GoalEventByID(*u.EventID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		pu.Event = &proto.FixtureUpdate_Goal{Goal: factory.GoalEventToDataProto(e)}
+	case app.FixtureUpdateMissedPenalty:
+		e, err := s.eventRepo.MissedPenaltyEventByID(*u.EventID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		pu.Event = &proto.FixtureUpdate_MissedPenalty{MissedPenalty: factory.MissedPenaltyEventToProto(e)}
+	case app.FixtureUpdateSubstitution:
+		e, err := s.eventRepo.SubstitutionEventByID(*u.EventID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		pu.Event = &proto.FixtureUpdate_Substitution{Substitution: factory.SubstitutionEventToProto(e)}
+	}
+
+	return pu, nil
+}
+
+type fixtureWatch struct {
+	fixtures     map[uint64]bool
+	teams        map[uint64]bool
+	competitions map[uint64]bool
+	matched      map[uint64]bool
+}
+
+func newFixtureWatch(r *proto.WatchFixturesRequest) *fixtureWatch {
+	toMap := func(ids []uint64) map[uint64]bool {
+		m := make(map[uint64]bool, len(ids))
+
+		for _, id := range ids {
+			m[id] = true
+		}
+
+		return m
+	}
+
+	return &fixtureWatch{
+		fixtures:     toMap(r.GetFixtureIds()),
+		teams:        toMap(r.GetTeamIds()),
+		competitions: toMap(r.GetCompetitionIds()),
+		matched:      make(map[uint64]bool),
+	}
+}
+
+func NewLiveService(
+	f app.FixtureRepository,
+	s app.SeasonRepository,
+	r app.ResultRepository,
+	e app.EventRepository,
+	l app.FixtureUpdateListener,
+	log *logrus.Logger,
+) *LiveService {
+	return &LiveService{fixtureRepo: f, seasonRepo: s, resultRepo: r, eventRepo: e, listener: l, logger: log}
+}
@@ -0,0 +1,191 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/stretchr/testify/assert"
+	g "google.golang.org/grpc"
+	"testing"
+)
+
+func TestLiveService_WatchFixtures(t *testing.T) {
+	t.Run("streams updates for fixtures matching the fixture, team and competition IDs requested", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		resultRepo := new(mock.ResultRepository)
+		eventRepo := new(mock.EventRepository)
+		listener := new(mock.FixtureUpdateListener)
+		logger, hook := test.NewNullLogger()
+		service := grpc.NewLiveService(fixtureRepo, seasonRepo, resultRepo, eventRepo, listener, logger)
+
+		live, home, away, minute := app.FixtureStatusLive, 1, 0, 12
+		goalID := uint64(4501)
+
+		updates := make(chan app.FixtureUpdate, 4)
+		updates <- app.FixtureUpdate{FixtureID: 99, Type: app.FixtureUpdateResult}
+		updates <- app.FixtureUpdate{FixtureID: 45, Type: app.FixtureUpdateStatus}
+		updates <- app.FixtureUpdate{FixtureID: 46, Type: app.FixtureUpdateGoal, EventID: &goalID}
+		updates <- app.FixtureUpdate{FixtureID: 47, Type: app.FixtureUpdateResult}
+
+		cancelled := false
+
+		listener.On("Subscribe").Return(updates, func() { cancelled = true })
+		fixtureRepo.On("ByID", uint64(99)).Return(&app.Fixture{ID: 99, HomeTeamID: 2, AwayTeamID: 3, SeasonID: 10}, nil)
+		seasonRepo.On("ByID", uint64(10)).Return(&app.Season{ID: 10, CompetitionID: 564}, nil)
+		fixtureRepo.On("ByID", uint64(45)).Return(&app.Fixture{ID: 45, Status: &live}, nil)
+		resultRepo.On("ByFixtureID", uint64(45)).Return(&app.Result{}, errors.New("not found"))
+		fixtureRepo.On("ByID", uint64(46)).Return(&app.Fixture{ID: 46, HomeTeamID: 1, AwayTeamID: 14, Status: &live}, nil)
+		resultRepo.On("ByFixtureID", uint64(46)).Return(&app.Result{HomeScore: &home, AwayScore: &away, Minutes: &minute}, nil)
+		eventRepo.On("GoalEventByID", goalID).Return(&app.GoalEvent{ID: goalID, TeamID: 1, Minute: 12, Score: "1-0"}, nil)
+		fixtureRepo.On("ByID", uint64(47)).Return(&app.Fixture{ID: 47, HomeTeamID: 20, AwayTeamID: 21, SeasonID: 11}, nil)
+		seasonRepo.On("ByID", uint64(11)).Return(&app.Season{ID: 11, CompetitionID: 8}, nil)
+		resultRepo.On("ByFixtureID", uint64(47)).Return(&app.Result{HomeScore: &home, AwayScore: &away}, nil)
+
+		stream := newFixtureUpdateStream(3)
+
+		req := proto.WatchFixturesRequest{FixtureIds: []uint64{45}, TeamIds: []uint64{14}, CompetitionIds: []uint64{8}}
+
+		if err := service.WatchFixtures(&req, stream); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(3, len(stream.sent))
+		a.True(cancelled)
+		a.Nil(hook.LastEntry())
+
+		a.Equal(uint64(45), stream.sent[0].GetFixtureId())
+		a.Equal("status", stream.sent[0].GetType())
+		a.Equal("LIVE", stream.sent[0].GetStatus().GetValue())
+		a.Nil(stream.sent[0].GetHomeScore())
+		a.Nil(stream.sent[0].GetEvent())
+
+		a.Equal(uint64(46), stream.sent[1].GetFixtureId())
+		a.Equal("goal", stream.sent[1].GetType())
+		a.Equal(uint32(1), stream.sent[1].GetHomeScore().GetValue())
+		a.Equal(uint32(0), stream.sent[1].GetAwayScore().GetValue())
+		a.Equal(uint32(12), stream.sent[1].GetMinute().GetValue())
+		a.Equal(goalID, stream.sent[1].GetGoal().GetId())
+		a.Equal("1-0", stream.sent[1].GetGoal().GetScore())
+
+		a.Equal(uint64(47), stream.sent[2].GetFixtureId())
+		a.Nil(stream.sent[2].GetStatus())
+		a.Equal(uint32(1), stream.sent[2].GetHomeScore().GetValue())
+	})
+
+	t.Run("returns invalid argument error if no IDs are provided", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		resultRepo := new(mock.ResultRepository)
+		eventRepo := new(mock.EventRepository)
+		listener := new(mock.FixtureUpdateListener)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewLiveService(fixtureRepo, seasonRepo, resultRepo, eventRepo, listener, logger)
+
+		err := service.WatchFixtures(&proto.WatchFixturesRequest{}, newFixtureUpdateStream(1))
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = InvalidArgument desc = at least one fixture, team or competition ID must be provided", err.Error())
+		listener.AssertNotCalled(t, "Subscribe")
+	})
+
+	t.Run("logs error and continues streaming if an update event cannot be retrieved", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		resultRepo := new(mock.ResultRepository)
+		eventRepo := new(mock.EventRepository)
+		listener := new(mock.FixtureUpdateListener)
+		logger, hook := test.NewNullLogger()
+		service := grpc.NewLiveService(fixtureRepo, seasonRepo, resultRepo, eventRepo, listener, logger)
+
+		cardID := uint64(7801)
+
+		updates := make(chan app.FixtureUpdate, 2)
+		updates <- app.FixtureUpdate{FixtureID: 45, Type: app.FixtureUpdateCard, EventID: &cardID}
+		updates <- app.FixtureUpdate{FixtureID: 45, Type: app.FixtureUpdateResult}
+
+		listener.On("Subscribe").Return(updates, func() {})
+		fixtureRepo.On("ByID", uint64(45)).Return(&app.Fixture{ID: 45}, nil)
+		resultRepo.On("ByFixtureID", uint64(45)).Return(&app.Result{}, nil)
+		eventRepo.On("CardEventByID", cardID).Return(&app.CardEvent{}, errors.New("oh no"))
+
+		stream := newFixtureUpdateStream(1)
+
+		if err := service.WatchFixtures(&proto.WatchFixturesRequest{FixtureIds: []uint64{45}}, stream); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(stream.sent))
+		assert.Equal(t, "result", stream.sent[0].GetType())
+		assert.Equal(t, "Error building fixture update in live service. Error: oh no", hook.LastEntry().Message)
+	})
+
+	t.Run("returns unavailable error if fixture updates stop", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		resultRepo := new(mock.ResultRepository)
+		eventRepo := new(mock.EventRepository)
+		listener := new(mock.FixtureUpdateListener)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewLiveService(fixtureRepo, seasonRepo, resultRepo, eventRepo, listener, logger)
+
+		updates := make(chan app.FixtureUpdate)
+		close(updates)
+
+		listener.On("Subscribe").Return(updates, func() {})
+
+		err := service.WatchFixtures(&proto.WatchFixturesRequest{FixtureIds: []uint64{45}}, newFixtureUpdateStream(1))
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = Unavailable desc = Fixture updates are unavailable", err.Error())
+	})
+}
+
+// fixtureUpdateStream records the updates sent, cancelling its context once the expected number have been sent.
+type fixtureUpdateStream struct {
+	g.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	limit  int
+	sent   []*proto.FixtureUpdate
+}
+
+func (f *fixtureUpdateStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fixtureUpdateStream) Send(u *proto.FixtureUpdate) error {
+	f.sent = append(f.sent, u)
+
+	if len(f.sent) == f.limit {
+		f.cancel()
+	}
+
+	return nil
+}
+
+func newFixtureUpdateStream(limit int) *fixtureUpdateStream {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &fixtureUpdateStream{ctx: ctx, cancel: cancel, limit: limit}
+}
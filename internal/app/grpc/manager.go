@@ -0,0 +1,189 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/factory"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+type ManagerService struct {
+	managerRepo app.ManagerRepository
+	tenureRepo  app.ManagerTenureRepository
+	fixtureRepo app.FixtureRepository
+	resultRepo  app.ResultRepository
+	logger      *logrus.Logger
+	proto.UnimplementedManagerServiceServer
+}
+
+func (s *ManagerService) GetTeamManager(c context.Context, r *proto.TeamManagerRequest) (*proto.ManagerTenure, error) {
+	date, err := time.Parse(time.RFC3339, r.GetDate())
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "date provided is not a valid RFC3339 date")
+	}
+
+	tenure, err := s.tenureRepo.AtDate(r.GetTeamId(), date)
+
+	if err != nil {
+		if err == errors.ErrorNotFound {
+			return nil, status.Error(
+				codes.NotFound,
+				fmt.Sprintf("no manager found for team %d on %s", r.GetTeamId(), r.GetDate()),
+			)
+		}
+
+		s.logger.Errorf("Error retrieving manager tenure in manager service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	return s.tenureToProto(tenure)
+}
+
+func (s *ManagerService) GetTeamManagerHistory(c context.Context, r *proto.TeamManagerHistoryRequest) (*proto.TeamManagerHistoryResponse, error) {
+	tenures, err := s.tenureRepo.ByTeamID(r.GetTeamId())
+
+	if err != nil {
+		s.logger.Errorf("Error retrieving manager tenures in manager service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	res := proto.TeamManagerHistoryResponse{Tenures: []*proto.ManagerTenure{}}
+
+	for _, t := range tenures {
+		x, err := s.tenureToProto(&t)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.Tenures = append(res.Tenures, x)
+	}
+
+	return &res, nil
+}
+
+func (s *ManagerService) GetManagerResults(c context.Context, r *proto.ManagerResultsRequest) (*proto.ManagerResultsResponse, error) {
+	manager, err := s.managerRepo.ByID(r.GetManagerId())
+
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("manager with ID %d does not exist", r.GetManagerId()))
+	}
+
+	tenures, err := s.tenureRepo.ByManagerID(manager.ID)
+
+	if err != nil {
+		s.logger.Errorf("Error retrieving manager tenures in manager service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	res := proto.ManagerResultsResponse{
+		Manager: factory.ManagerToProto(manager),
+		Record:  &proto.ManagerRecord{},
+		Results: []*proto.ManagerResult{},
+	}
+
+	sort := "date_asc"
+	results := map[uint64]map[uint64]app.Result{}
+
+	for _, t := range tenures {
+		after := t.From.Add(-time.Second)
+		before := t.To.Add(time.Second)
+
+		query := app.FixtureFilterQuery{DateAfter: &after, DateBefore: &before, SortBy: &sort}
+
+		fixtures, err := s.fixtureRepo.ByTeamID(t.TeamID, query)
+
+		if err != nil {
+			s.logger.Errorf("Error retrieving fixtures in manager service. Error: %s", err.Error())
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+
+		for _, f := range fixtures {
+			if _, ok := results[f.SeasonID]; !ok {
+				season, err := s.seasonResults(f.SeasonID)
+
+				if err != nil {
+					s.logger.Errorf("Error retrieving results in manager service. Error: %s", err.Error())
+					return nil, status.Error(codes.Internal, "Internal server error")
+				}
+
+				results[f.SeasonID] = season
+			}
+
+			result, ok := results[f.SeasonID][f.ID]
+
+			if !ok || result.HomeScore == nil || result.AwayScore == nil {
+				continue
+			}
+
+			x := factory.ManagerResultToProto(t.TeamID, &f, &result)
+
+			addToManagerRecord(res.Record, x)
+
+			res.Results = append(res.Results, x)
+		}
+	}
+
+	return &res, nil
+}
+
+// seasonResults returns the results of a season keyed by fixture ID so the results of every fixture in a season are
+// retrieved using a single query.
+func (s *ManagerService) seasonResults(seasonID uint64) (map[uint64]app.Result, error) {
+	results, err := s.resultRepo.BySeasonID(seasonID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	keyed := map[uint64]app.Result{}
+
+	for _, r := range results {
+		keyed[r.FixtureID] = r
+	}
+
+	return keyed, nil
+}
+
+func (s *ManagerService) tenureToProto(t *app.ManagerTenure) (*proto.ManagerTenure, error) {
+	manager, err := s.managerRepo.ByID(t.ManagerID)
+
+	if err != nil {
+		s.logger.Errorf("Error retrieving manager %d in manager service. Error: %s", t.ManagerID, err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	return factory.ManagerTenureToProto(t, manager), nil
+}
+
+func addToManagerRecord(record *proto.ManagerRecord, r *proto.ManagerResult) {
+	record.Played++
+	record.GoalsFor += r.GoalsFor
+	record.GoalsAgainst += r.GoalsAgainst
+
+	switch r.Outcome {
+	case "W":
+		record.Won++
+	case "L":
+		record.Lost++
+	default:
+		record.Drawn++
+	}
+}
+
+func NewManagerService(
+	m app.ManagerRepository,
+	t app.ManagerTenureRepository,
+	f app.FixtureRepository,
+	r app.ResultRepository,
+	log *logrus.Logger,
+) *ManagerService {
+	return &ManagerService{managerRepo: m, tenureRepo: t, fixtureRepo: f, resultRepo: r, logger: log}
+}
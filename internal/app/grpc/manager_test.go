@@ -0,0 +1,232 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	e "github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/grpc"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestManagerService_GetTeamManager(t *testing.T) {
+	t.Run("returns manager in charge of team on date", func(t *testing.T) {
+		t.Helper()
+
+		managerRepo := new(mock.ManagerRepository)
+		tenureRepo := new(mock.ManagerTenureRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewManagerService(managerRepo, tenureRepo, new(mock.FixtureRepository), new(mock.ResultRepository), logger)
+
+		date := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+		tenure := newManagerTenure(1, 455360, 1577836800, 1590969600)
+
+		tenureRepo.On("AtDate", uint64(1), date).Return(&tenure, nil)
+		managerRepo.On("ByID", uint64(455360)).Return(&app.Manager{ID: 455360, FirstName: "David", LastName: "Moyes"}, nil)
+
+		res, err := service.GetTeamManager(context.Background(), &proto.TeamManagerRequest{
+			TeamId: 1,
+			Date:   "2020-01-01T12:00:00Z",
+		})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(1), res.TeamId)
+		a.Equal(uint64(455360), res.Manager.Id)
+		a.Equal("David", res.Manager.FirstName)
+		a.Equal("Moyes", res.Manager.LastName)
+		a.Nil(res.Manager.Image)
+		a.Equal("2020-01-01T00:00:00Z", res.DateFrom)
+		a.Equal("2020-06-01T00:00:00Z", res.DateTo)
+	})
+
+	t.Run("returns invalid argument error if date is invalid", func(t *testing.T) {
+		t.Helper()
+
+		tenureRepo := new(mock.ManagerTenureRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewManagerService(new(mock.ManagerRepository), tenureRepo, new(mock.FixtureRepository), new(mock.ResultRepository), logger)
+
+		_, err := service.GetTeamManager(context.Background(), &proto.TeamManagerRequest{TeamId: 1, Date: "2020-01-01"})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = InvalidArgument desc = date provided is not a valid RFC3339 date", err.Error())
+		tenureRepo.AssertNotCalled(t, "AtDate", uint64(1), m.Anything)
+	})
+
+	t.Run("returns not found error if no manager was in charge on date", func(t *testing.T) {
+		t.Helper()
+
+		tenureRepo := new(mock.ManagerTenureRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewManagerService(new(mock.ManagerRepository), tenureRepo, new(mock.FixtureRepository), new(mock.ResultRepository), logger)
+
+		tenureRepo.On("AtDate", uint64(1), m.AnythingOfType("time.Time")).Return(&app.ManagerTenure{}, e.ErrorNotFound)
+
+		_, err := service.GetTeamManager(context.Background(), &proto.TeamManagerRequest{
+			TeamId: 1,
+			Date:   "2020-01-01T12:00:00Z",
+		})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = NotFound desc = no manager found for team 1 on 2020-01-01T12:00:00Z", err.Error())
+	})
+}
+
+func TestManagerService_GetTeamManagerHistory(t *testing.T) {
+	t.Run("returns every tenure for team", func(t *testing.T) {
+		t.Helper()
+
+		managerRepo := new(mock.ManagerRepository)
+		tenureRepo := new(mock.ManagerTenureRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewManagerService(managerRepo, tenureRepo, new(mock.FixtureRepository), new(mock.ResultRepository), logger)
+
+		tenures := []app.ManagerTenure{
+			newManagerTenure(1, 10, 1546300800, 1575158400),
+			newManagerTenure(1, 20, 1577836800, 1590969600),
+		}
+
+		tenureRepo.On("ByTeamID", uint64(1)).Return(tenures, nil)
+		managerRepo.On("ByID", uint64(10)).Return(&app.Manager{ID: 10}, nil)
+		managerRepo.On("ByID", uint64(20)).Return(&app.Manager{ID: 20}, nil)
+
+		res, err := service.GetTeamManagerHistory(context.Background(), &proto.TeamManagerHistoryRequest{TeamId: 1})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(res.Tenures))
+		assert.Equal(t, uint64(10), res.Tenures[0].Manager.Id)
+		assert.Equal(t, uint64(20), res.Tenures[1].Manager.Id)
+	})
+
+	t.Run("logs error and returns internal server error if error returned from tenure repository", func(t *testing.T) {
+		t.Helper()
+
+		tenureRepo := new(mock.ManagerTenureRepository)
+		logger, hook := test.NewNullLogger()
+		service := grpc.NewManagerService(new(mock.ManagerRepository), tenureRepo, new(mock.FixtureRepository), new(mock.ResultRepository), logger)
+
+		tenureRepo.On("ByTeamID", uint64(1)).Return([]app.ManagerTenure{}, errors.New("oh no"))
+
+		_, err := service.GetTeamManagerHistory(context.Background(), &proto.TeamManagerHistoryRequest{TeamId: 1})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = Internal desc = Internal server error", err.Error())
+		assert.Equal(t, "Error retrieving manager tenures in manager service. Error: oh no", hook.LastEntry().Message)
+	})
+}
+
+func TestManagerService_GetManagerResults(t *testing.T) {
+	t.Run("returns results and record for every fixture in charge", func(t *testing.T) {
+		t.Helper()
+
+		managerRepo := new(mock.ManagerRepository)
+		tenureRepo := new(mock.ManagerTenureRepository)
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewManagerService(managerRepo, tenureRepo, fixtureRepo, resultRepo, logger)
+
+		tenures := []app.ManagerTenure{
+			newManagerTenure(1, 10, 1546300800, 1575158400),
+			newManagerTenure(14, 10, 1577836800, 1590969600),
+		}
+
+		managerRepo.On("ByID", uint64(10)).Return(&app.Manager{ID: 10}, nil)
+		tenureRepo.On("ByManagerID", uint64(10)).Return(tenures, nil)
+		fixtureRepo.On("ByTeamID", uint64(1), m.MatchedBy(func(q app.FixtureFilterQuery) bool {
+			return q.DateAfter.Unix() == 1546300799 && q.DateBefore.Unix() == 1575158401 && *q.SortBy == "date_asc"
+		})).Return([]app.Fixture{
+			{ID: 100, SeasonID: 16036, HomeTeamID: 1, AwayTeamID: 6, Date: time.Unix(1546300800, 0)},
+			{ID: 101, SeasonID: 16036, HomeTeamID: 3, AwayTeamID: 1, Date: time.Unix(1575158400, 0)},
+		}, nil)
+		fixtureRepo.On("ByTeamID", uint64(14), m.AnythingOfType("app.FixtureFilterQuery")).Return([]app.Fixture{
+			{ID: 102, SeasonID: 16036, HomeTeamID: 14, AwayTeamID: 8, Date: time.Unix(1577836800, 0)},
+			{ID: 103, SeasonID: 17420, HomeTeamID: 14, AwayTeamID: 9, Date: time.Unix(1590969600, 0)},
+		}, nil)
+		resultRepo.On("BySeasonID", uint64(16036)).Return([]app.Result{
+			newManagerResult(100, 2, 0),
+			newManagerResult(101, 3, 1),
+			newManagerResult(102, 1, 1),
+		}, nil).Once()
+		resultRepo.On("BySeasonID", uint64(17420)).Return([]app.Result{}, nil).Once()
+
+		res, err := service.GetManagerResults(context.Background(), &proto.ManagerResultsRequest{ManagerId: 10})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(10), res.Manager.Id)
+		a.Equal(3, len(res.Results))
+		a.Equal("W", res.Results[0].Outcome)
+		a.Equal(uint64(6), res.Results[0].OpponentId)
+		a.True(res.Results[0].IsHome)
+		a.Equal("L", res.Results[1].Outcome)
+		a.Equal(uint64(3), res.Results[1].OpponentId)
+		a.Equal(uint32(1), res.Results[1].GoalsFor)
+		a.Equal(uint32(3), res.Results[1].GoalsAgainst)
+		a.False(res.Results[1].IsHome)
+		a.Equal("D", res.Results[2].Outcome)
+		a.Equal(uint64(14), res.Results[2].TeamId)
+		a.Equal(&proto.ManagerRecord{Played: 3, Won: 1, Drawn: 1, Lost: 1, GoalsFor: 4, GoalsAgainst: 4}, res.Record)
+		resultRepo.AssertExpectations(t)
+	})
+
+	t.Run("returns not found error if manager does not exist", func(t *testing.T) {
+		t.Helper()
+
+		managerRepo := new(mock.ManagerRepository)
+		tenureRepo := new(mock.ManagerTenureRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewManagerService(managerRepo, tenureRepo, new(mock.FixtureRepository), new(mock.ResultRepository), logger)
+
+		managerRepo.On("ByID", uint64(10)).Return(&app.Manager{}, e.ErrorNotFound)
+
+		_, err := service.GetManagerResults(context.Background(), &proto.ManagerResultsRequest{ManagerId: 10})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = NotFound desc = manager with ID 10 does not exist", err.Error())
+		tenureRepo.AssertNotCalled(t, "ByManagerID", uint64(10))
+	})
+}
+
+func newManagerTenure(teamID, managerID uint64, from, to int64) app.ManagerTenure {
+	return app.ManagerTenure{
+		TeamID:    teamID,
+		ManagerID: managerID,
+		From:      time.Unix(from, 0),
+		To:        time.Unix(to, 0),
+	}
+}
+
+func newManagerResult(fixtureID uint64, home, away int) app.Result {
+	return app.Result{FixtureID: fixtureID, HomeScore: &home, AwayScore: &away}
+}
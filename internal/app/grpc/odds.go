@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/factory"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type OddsService struct {
+	fixtureRepo app.FixtureRepository
+	oddsRepo    app.OddsRepository
+	logger      *logrus.Logger
+	proto.UnimplementedOddsServiceServer
+}
+
+func (s *OddsService) GetMarketOdds(c context.Context, r *proto.MarketOddsRequest) (*proto.MarketOddsResponse, error) {
+	if r.GetMarket() == "" {
+		return nil, status.Error(codes.InvalidArgument, "market is required")
+	}
+
+	fix, err := s.fixtureRepo.ByID(r.GetFixtureId())
+
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("fixture with ID %d does not exist", r.GetFixtureId()))
+	}
+
+	history, err := s.oddsRepo.ByFixtureAndMarket(fix.ID, r.GetMarket())
+
+	if err != nil {
+		s.logger.Errorf("Error retrieving odds in odds service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	history = filterOddsByBookmaker(history, r.GetBookmakerIds())
+
+	res := proto.MarketOddsResponse{
+		FixtureId: fix.ID,
+		Market:    r.GetMarket(),
+		Opening:   factory.OddsToProto(app.OpeningOdds(history)),
+		Closing:   factory.OddsToProto(app.ClosingOdds(history, fix.Date)),
+		History:   factory.OddsToProto(history),
+	}
+
+	return &res, nil
+}
+
+func filterOddsByBookmaker(odds []app.Odds, bookmakerIDs []uint64) []app.Odds {
+	if len(bookmakerIDs) == 0 {
+		return odds
+	}
+
+	ids := map[uint64]bool{}
+
+	for _, id := range bookmakerIDs {
+		ids[id] = true
+	}
+
+	var filtered []app.Odds
+
+	for _, o := range odds {
+		if ids[o.BookmakerID] {
+			filtered = append(filtered, o)
+		}
+	}
+
+	return filtered
+}
+
+func NewOddsService(f app.FixtureRepository, o app.OddsRepository, log *logrus.Logger) *OddsService {
+	return &OddsService{fixtureRepo: f, oddsRepo: o, logger: log}
+}
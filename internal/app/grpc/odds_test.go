@@ -0,0 +1,144 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestOddsService_GetMarketOdds(t *testing.T) {
+	kickOff := time.Date(2020, 9, 12, 11, 30, 0, 0, time.UTC)
+
+	history := []app.Odds{
+		newMarketOdds(2, "1", 2.20, kickOff.Add(-48*time.Hour)),
+		newMarketOdds(5, "1", 2.25, kickOff.Add(-24*time.Hour)),
+		newMarketOdds(2, "1", 2.10, kickOff.Add(-time.Hour)),
+		newMarketOdds(2, "1", 1.95, kickOff.Add(10*time.Minute)),
+	}
+
+	t.Run("returns opening, closing and full price history for market", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		oddsRepo := new(mock.OddsRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewOddsService(fixtureRepo, oddsRepo, logger)
+
+		fixtureRepo.On("ByID", uint64(16475287)).Return(&app.Fixture{ID: 16475287, Date: kickOff}, nil)
+		oddsRepo.On("ByFixtureAndMarket", uint64(16475287), "3Way Result").Return(history, nil)
+
+		res, err := service.GetMarketOdds(context.Background(), &proto.MarketOddsRequest{
+			FixtureId: 16475287,
+			Market:    "3Way Result",
+		})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(16475287), res.FixtureId)
+		a.Equal("3Way Result", res.Market)
+		a.Equal(2, len(res.Opening))
+		a.Equal(float32(2.20), res.Opening[0].Price)
+		a.Equal("2020-09-10T11:30:00Z", res.Opening[0].CapturedAt)
+		a.Equal(float32(2.25), res.Opening[1].Price)
+		a.Equal(2, len(res.Closing))
+		a.Equal(float32(2.10), res.Closing[0].Price)
+		a.Equal(float32(2.25), res.Closing[1].Price)
+		a.Equal(4, len(res.History))
+		a.Equal(float32(1.95), res.History[3].Price)
+	})
+
+	t.Run("filters prices by bookmaker IDs provided", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		oddsRepo := new(mock.OddsRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewOddsService(fixtureRepo, oddsRepo, logger)
+
+		fixtureRepo.On("ByID", uint64(16475287)).Return(&app.Fixture{ID: 16475287, Date: kickOff}, nil)
+		oddsRepo.On("ByFixtureAndMarket", uint64(16475287), "3Way Result").Return(history, nil)
+
+		res, err := service.GetMarketOdds(context.Background(), &proto.MarketOddsRequest{
+			FixtureId:    16475287,
+			Market:       "3Way Result",
+			BookmakerIds: []uint64{5},
+		})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(res.History))
+		assert.Equal(t, uint64(5), res.History[0].BookmakerId)
+	})
+
+	t.Run("returns not found error if fixture does not exist", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		oddsRepo := new(mock.OddsRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewOddsService(fixtureRepo, oddsRepo, logger)
+
+		fixtureRepo.On("ByID", uint64(16475287)).Return(&app.Fixture{}, errors.New("not found"))
+
+		_, err := service.GetMarketOdds(context.Background(), &proto.MarketOddsRequest{
+			FixtureId: 16475287,
+			Market:    "3Way Result",
+		})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = NotFound desc = fixture with ID 16475287 does not exist", err.Error())
+		oddsRepo.AssertNotCalled(t, "ByFixtureAndMarket", uint64(16475287), "3Way Result")
+	})
+
+	t.Run("logs error and returns internal server error if error returned from odds repository", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		oddsRepo := new(mock.OddsRepository)
+		logger, hook := test.NewNullLogger()
+		service := grpc.NewOddsService(fixtureRepo, oddsRepo, logger)
+
+		fixtureRepo.On("ByID", uint64(16475287)).Return(&app.Fixture{ID: 16475287, Date: kickOff}, nil)
+		oddsRepo.On("ByFixtureAndMarket", uint64(16475287), "3Way Result").Return([]app.Odds{}, errors.New("oh no"))
+
+		_, err := service.GetMarketOdds(context.Background(), &proto.MarketOddsRequest{
+			FixtureId: 16475287,
+			Market:    "3Way Result",
+		})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = Internal desc = Internal server error", err.Error())
+		assert.Equal(t, "Error retrieving odds in odds service. Error: oh no", hook.LastEntry().Message)
+	})
+}
+
+func newMarketOdds(bookmakerID uint64, selection string, price float32, captured time.Time) app.Odds {
+	return app.Odds{
+		FixtureID:   16475287,
+		BookmakerID: bookmakerID,
+		Bookmaker:   "bookmaker",
+		Market:      "3Way Result",
+		Selection:   selection,
+		Price:       price,
+		CapturedAt:  captured,
+	}
+}
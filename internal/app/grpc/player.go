@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/factory"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PlayerService struct {
+	playerRepo   app.PlayerRepository
+	transferRepo app.TransferRepository
+	logger       *logrus.Logger
+	proto.UnimplementedPlayerServiceServer
+}
+
+func (s *PlayerService) GetPlayerClubHistory(c context.Context, r *proto.PlayerClubHistoryRequest) (*proto.PlayerClubHistoryResponse, error) {
+	if _, err := s.playerRepo.ByID(r.GetPlayerId()); err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("player with ID %d does not exist", r.GetPlayerId()))
+	}
+
+	transfers, err := s.transferRepo.ByPlayerID(r.GetPlayerId())
+
+	if err != nil {
+		s.logger.Errorf("Error retrieving transfers in player service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	res := proto.PlayerClubHistoryResponse{
+		PlayerId: r.GetPlayerId(),
+		Clubs:    []*proto.ClubSpell{},
+	}
+
+	for _, x := range app.ClubHistory(transfers) {
+		res.Clubs = append(res.Clubs, factory.ClubSpellToProto(&x))
+	}
+
+	return &res, nil
+}
+
+func NewPlayerService(p app.PlayerRepository, t app.TransferRepository, log *logrus.Logger) *PlayerService {
+	return &PlayerService{playerRepo: p, transferRepo: t, logger: log}
+}
@@ -0,0 +1,110 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPlayerService_GetPlayerClubHistory(t *testing.T) {
+	t.Run("returns club history built from player transfers", func(t *testing.T) {
+		t.Helper()
+
+		playerRepo := new(mock.PlayerRepository)
+		transferRepo := new(mock.TransferRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewPlayerService(playerRepo, transferRepo, logger)
+
+		fee := "€20M"
+
+		transfers := []app.Transfer{
+			{
+				PlayerID:   219591,
+				FromTeamID: 1,
+				ToTeamID:   14,
+				Date:       time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC),
+				Type:       "Loan",
+			},
+			{
+				PlayerID:   219591,
+				FromTeamID: 20,
+				ToTeamID:   1,
+				Date:       time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC),
+				Type:       "Transfer",
+				Fee:        &fee,
+			},
+		}
+
+		playerRepo.On("ByID", uint64(219591)).Return(&app.Player{ID: 219591}, nil)
+		transferRepo.On("ByPlayerID", uint64(219591)).Return(transfers, nil)
+
+		res, err := service.GetPlayerClubHistory(context.Background(), &proto.PlayerClubHistoryRequest{PlayerId: 219591})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(219591), res.PlayerId)
+		a.Equal(2, len(res.Clubs))
+		a.Equal(uint64(1), res.Clubs[0].TeamId)
+		a.Equal("2019-07-01T00:00:00Z", res.Clubs[0].JoinedDate)
+		a.Equal("2020-01-31T00:00:00Z", res.Clubs[0].LeftDate.GetValue())
+		a.Equal("Transfer", res.Clubs[0].Type)
+		a.Equal("€20M", res.Clubs[0].Fee.GetValue())
+		a.Equal(uint64(14), res.Clubs[1].TeamId)
+		a.Equal("2020-01-31T00:00:00Z", res.Clubs[1].JoinedDate)
+		a.Nil(res.Clubs[1].LeftDate)
+		a.Nil(res.Clubs[1].Fee)
+		a.Equal("Loan", res.Clubs[1].Type)
+	})
+
+	t.Run("returns not found error if player does not exist", func(t *testing.T) {
+		t.Helper()
+
+		playerRepo := new(mock.PlayerRepository)
+		transferRepo := new(mock.TransferRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewPlayerService(playerRepo, transferRepo, logger)
+
+		playerRepo.On("ByID", uint64(219591)).Return(&app.Player{}, errors.New("not found"))
+
+		_, err := service.GetPlayerClubHistory(context.Background(), &proto.PlayerClubHistoryRequest{PlayerId: 219591})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = NotFound desc = player with ID 219591 does not exist", err.Error())
+		transferRepo.AssertNotCalled(t, "ByPlayerID", uint64(219591))
+	})
+
+	t.Run("logs error and returns internal server error if error returned from transfer repository", func(t *testing.T) {
+		t.Helper()
+
+		playerRepo := new(mock.PlayerRepository)
+		transferRepo := new(mock.TransferRepository)
+		logger, hook := test.NewNullLogger()
+		service := grpc.NewPlayerService(playerRepo, transferRepo, logger)
+
+		playerRepo.On("ByID", uint64(219591)).Return(&app.Player{ID: 219591}, nil)
+		transferRepo.On("ByPlayerID", uint64(219591)).Return([]app.Transfer{}, errors.New("oh no"))
+
+		_, err := service.GetPlayerClubHistory(context.Background(), &proto.PlayerClubHistoryRequest{PlayerId: 219591})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = Internal desc = Internal server error", err.Error())
+		assert.Equal(t, "Error retrieving transfers in player service. Error: oh no", hook.LastEntry().Message)
+	})
+}
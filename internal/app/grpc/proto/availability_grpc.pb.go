@@ -0,0 +1,145 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.14.0
+// source: availability.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AvailabilityServiceClient is the client API for AvailabilityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AvailabilityServiceClient interface {
+	// Returns the players unavailable to a team through injury or suspension on the date of a fixture
+	GetTeamAvailability(ctx context.Context, in *TeamAvailabilityRequest, opts ...grpc.CallOption) (*TeamAvailabilityResponse, error)
+	// Returns the players banned from a fixture through suspensions calculated from card accumulation and dismissals
+	GetFixtureSuspensions(ctx context.Context, in *FixtureSuspensionsRequest, opts ...grpc.CallOption) (*FixtureSuspensionsResponse, error)
+}
+
+type availabilityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAvailabilityServiceClient(cc grpc.ClientConnInterface) AvailabilityServiceClient {
+	return &availabilityServiceClient{cc}
+}
+
+func (c *availabilityServiceClient) GetTeamAvailability(ctx context.Context, in *TeamAvailabilityRequest, opts ...grpc.CallOption) (*TeamAvailabilityResponse, error) {
+	out := new(TeamAvailabilityResponse)
+	err := c.cc.Invoke(ctx, "/statistico.data.AvailabilityService/GetTeamAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *availabilityServiceClient) GetFixtureSuspensions(ctx context.Context, in *FixtureSuspensionsRequest, opts ...grpc.CallOption) (*FixtureSuspensionsResponse, error) {
+	out := new(FixtureSuspensionsResponse)
+	err := c.cc.Invoke(ctx, "/statistico.data.AvailabilityService/GetFixtureSuspensions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AvailabilityServiceServer is the server API for AvailabilityService service.
+// All implementations must embed UnimplementedAvailabilityServiceServer
+// for forward compatibility
+type AvailabilityServiceServer interface {
+	// Returns the players unavailable to a team through injury or suspension on the date of a fixture
+	GetTeamAvailability(context.Context, *TeamAvailabilityRequest) (*TeamAvailabilityResponse, error)
+	// Returns the players banned from a fixture through suspensions calculated from card accumulation and dismissals
+	GetFixtureSuspensions(context.Context, *FixtureSuspensionsRequest) (*FixtureSuspensionsResponse, error)
+	mustEmbedUnimplementedAvailabilityServiceServer()
+}
+
+// UnimplementedAvailabilityServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAvailabilityServiceServer struct {
+}
+
+func (UnimplementedAvailabilityServiceServer) GetTeamAvailability(context.Context, *TeamAvailabilityRequest) (*TeamAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamAvailability not implemented")
+}
+func (UnimplementedAvailabilityServiceServer) GetFixtureSuspensions(context.Context, *FixtureSuspensionsRequest) (*FixtureSuspensionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFixtureSuspensions not implemented")
+}
+func (UnimplementedAvailabilityServiceServer) mustEmbedUnimplementedAvailabilityServiceServer() {}
+
+// UnsafeAvailabilityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AvailabilityServiceServer will
+// result in compilation errors.
+type UnsafeAvailabilityServiceServer interface {
+	mustEmbedUnimplementedAvailabilityServiceServer()
+}
+
+func RegisterAvailabilityServiceServer(s grpc.ServiceRegistrar, srv AvailabilityServiceServer) {
+	s.RegisterService(&AvailabilityService_ServiceDesc, srv)
+}
+
+func _AvailabilityService_GetTeamAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityServiceServer).GetTeamAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/statistico.data.AvailabilityService/GetTeamAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityServiceServer).GetTeamAvailability(ctx, req.(*TeamAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvailabilityService_GetFixtureSuspensions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FixtureSuspensionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityServiceServer).GetFixtureSuspensions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/statistico.data.AvailabilityService/GetFixtureSuspensions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityServiceServer).GetFixtureSuspensions(ctx, req.(*FixtureSuspensionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AvailabilityService_ServiceDesc is the grpc.ServiceDesc for AvailabilityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AvailabilityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "statistico.data.AvailabilityService",
+	HandlerType: (*AvailabilityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTeamAvailability",
+			Handler:    _AvailabilityService_GetTeamAvailability_Handler,
+		},
+		{
+			MethodName: "GetFixtureSuspensions",
+			Handler:    _AvailabilityService_GetFixtureSuspensions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "availability.proto",
+}
@@ -0,0 +1,674 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: fixture_events.proto
+
+package proto
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// FixtureEventsResponse is returned by the FixtureEvents RPC of statistico.EventService. The messages in this file
+// are a wire compatible superset of the statistico.EventService messages, fields are only ever added so clients built
+// against statistico-proto keep working and ignore the fields they do not know.
+type FixtureEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FixtureId       uint64                `protobuf:"varint,1,opt,name=fixture_id,json=fixtureId,proto3" json:"fixture_id,omitempty"`
+	Cards           []*CardEvent          `protobuf:"bytes,2,rep,name=cards,proto3" json:"cards,omitempty"`
+	Goals           []*GoalEvent          `protobuf:"bytes,3,rep,name=goals,proto3" json:"goals,omitempty"`
+	MissedPenalties []*MissedPenaltyEvent `protobuf:"bytes,4,rep,name=missed_penalties,json=missedPenalties,proto3" json:"missed_penalties,omitempty"`
+	Substitutions   []*SubstitutionEvent  `protobuf:"bytes,5,rep,name=substitutions,proto3" json:"substitutions,omitempty"`
+}
+
+func (x *FixtureEventsResponse) Reset() {
+	*x = FixtureEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fixture_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FixtureEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixtureEventsResponse) ProtoMessage() {}
+
+func (x *FixtureEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fixture_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixtureEventsResponse.ProtoReflect.Descriptor instead.
+func (*FixtureEventsResponse) Descriptor() ([]byte, []int) {
+	return file_fixture_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FixtureEventsResponse) GetFixtureId() uint64 {
+	if x != nil {
+		return x.FixtureId
+	}
+	return 0
+}
+
+func (x *FixtureEventsResponse) GetCards() []*CardEvent {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *FixtureEventsResponse) GetGoals() []*GoalEvent {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+func (x *FixtureEventsResponse) GetMissedPenalties() []*MissedPenaltyEvent {
+	if x != nil {
+		return x.MissedPenalties
+	}
+	return nil
+}
+
+func (x *FixtureEventsResponse) GetSubstitutions() []*SubstitutionEvent {
+	if x != nil {
+		return x.Substitutions
+	}
+	return nil
+}
+
+type CardEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeamId uint64 `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// Card type as provided by the data provider i.e. "yellowcard", "redcard" or "yellowred"
+	Type     string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	PlayerId uint64 `protobuf:"varint,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Minute   uint32 `protobuf:"varint,5,opt,name=minute,proto3" json:"minute,omitempty"`
+}
+
+func (x *CardEvent) Reset() {
+	*x = CardEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fixture_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardEvent) ProtoMessage() {}
+
+func (x *CardEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_fixture_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardEvent.ProtoReflect.Descriptor instead.
+func (*CardEvent) Descriptor() ([]byte, []int) {
+	return file_fixture_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CardEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CardEvent) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *CardEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CardEvent) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *CardEvent) GetMinute() uint32 {
+	if x != nil {
+		return x.Minute
+	}
+	return 0
+}
+
+type GoalEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the team credited with the goal, for an own goal this is the opposition of the scoring player's team
+	TeamId         uint64                  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	PlayerId       uint64                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerAssistId *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=player_assist_id,json=playerAssistId,proto3" json:"player_assist_id,omitempty"`
+	Minute         uint32                  `protobuf:"varint,5,opt,name=minute,proto3" json:"minute,omitempty"`
+	Score          string                  `protobuf:"bytes,6,opt,name=score,proto3" json:"score,omitempty"`
+	// Goal type as provided by the data provider i.e. "goal", "penalty" or "own-goal"
+	Type string `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	// Minutes of added time the goal was scored in, null if scored in regulation time
+	ExtraMinute *wrapperspb.UInt32Value `protobuf:"bytes,8,opt,name=extra_minute,json=extraMinute,proto3" json:"extra_minute,omitempty"`
+}
+
+func (x *GoalEvent) Reset() {
+	*x = GoalEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fixture_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoalEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalEvent) ProtoMessage() {}
+
+func (x *GoalEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_fixture_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalEvent.ProtoReflect.Descriptor instead.
+func (*GoalEvent) Descriptor() ([]byte, []int) {
+	return file_fixture_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GoalEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoalEvent) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *GoalEvent) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *GoalEvent) GetPlayerAssistId() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.PlayerAssistId
+	}
+	return nil
+}
+
+func (x *GoalEvent) GetMinute() uint32 {
+	if x != nil {
+		return x.Minute
+	}
+	return 0
+}
+
+func (x *GoalEvent) GetScore() string {
+	if x != nil {
+		return x.Score
+	}
+	return ""
+}
+
+func (x *GoalEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GoalEvent) GetExtraMinute() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.ExtraMinute
+	}
+	return nil
+}
+
+type MissedPenaltyEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeamId   uint64 `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	PlayerId uint64 `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Minute   uint32 `protobuf:"varint,4,opt,name=minute,proto3" json:"minute,omitempty"`
+	// Minutes of added time the penalty was missed in, null if missed in regulation time
+	ExtraMinute *wrapperspb.UInt32Value `protobuf:"bytes,5,opt,name=extra_minute,json=extraMinute,proto3" json:"extra_minute,omitempty"`
+}
+
+func (x *MissedPenaltyEvent) Reset() {
+	*x = MissedPenaltyEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fixture_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissedPenaltyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissedPenaltyEvent) ProtoMessage() {}
+
+func (x *MissedPenaltyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_fixture_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissedPenaltyEvent.ProtoReflect.Descriptor instead.
+func (*MissedPenaltyEvent) Descriptor() ([]byte, []int) {
+	return file_fixture_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MissedPenaltyEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MissedPenaltyEvent) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *MissedPenaltyEvent) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *MissedPenaltyEvent) GetMinute() uint32 {
+	if x != nil {
+		return x.Minute
+	}
+	return 0
+}
+
+func (x *MissedPenaltyEvent) GetExtraMinute() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.ExtraMinute
+	}
+	return nil
+}
+
+type SubstitutionEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeamId      uint64 `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	PlayerInId  uint64 `protobuf:"varint,3,opt,name=player_in_id,json=playerInId,proto3" json:"player_in_id,omitempty"`
+	PlayerOutId uint64 `protobuf:"varint,4,opt,name=player_out_id,json=playerOutId,proto3" json:"player_out_id,omitempty"`
+	Minute      uint32 `protobuf:"varint,5,opt,name=minute,proto3" json:"minute,omitempty"`
+	// Whether the player taken off was injured, null if not reported by the data provider
+	Injured *wrapperspb.BoolValue `protobuf:"bytes,6,opt,name=injured,proto3" json:"injured,omitempty"`
+}
+
+func (x *SubstitutionEvent) Reset() {
+	*x = SubstitutionEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fixture_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubstitutionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubstitutionEvent) ProtoMessage() {}
+
+func (x *SubstitutionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_fixture_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubstitutionEvent.ProtoReflect.Descriptor instead.
+func (*SubstitutionEvent) Descriptor() ([]byte, []int) {
+	return file_fixture_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SubstitutionEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SubstitutionEvent) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *SubstitutionEvent) GetPlayerInId() uint64 {
+	if x != nil {
+		return x.PlayerInId
+	}
+	return 0
+}
+
+func (x *SubstitutionEvent) GetPlayerOutId() uint64 {
+	if x != nil {
+		return x.PlayerOutId
+	}
+	return 0
+}
+
+func (x *SubstitutionEvent) GetMinute() uint32 {
+	if x != nil {
+		return x.Minute
+	}
+	return 0
+}
+
+func (x *SubstitutionEvent) GetInjured() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Injured
+	}
+	return nil
+}
+
+var File_fixture_events_proto protoreflect.FileDescriptor
+
+var file_fixture_events_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4, 0x02, 0x0a, 0x15, 0x46, 0x69, 0x78, 0x74,
+	0x75, 0x72, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64,
+	0x12, 0x30, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x63, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x67,
+	0x6f, 0x61, 0x6c, 0x73, 0x12, 0x4e, 0x0a, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x70,
+	0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x50, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x0d, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7d,
+	0x0a, 0x09, 0x43, 0x61, 0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65,
+	0x61, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x22, 0x9c, 0x02,
+	0x0a, 0x09, 0x47, 0x6f, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65,
+	0x61, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x46, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0b, 0x65, 0x78, 0x74, 0x72, 0x61, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x22, 0xb3, 0x01, 0x0a,
+	0x12, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x72, 0x61, 0x4d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6f, 0x75,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4f, 0x75, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12,
+	0x34, 0x0a, 0x07, 0x69, 0x6e, 0x6a, 0x75, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x69, 0x6e,
+	0x6a, 0x75, 0x72, 0x65, 0x64, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2f, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2d, 0x66, 0x6f, 0x6f, 0x74, 0x62, 0x61,
+	0x6c, 0x6c, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x61, 0x70, 0x70, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fixture_events_proto_rawDescOnce sync.Once
+	file_fixture_events_proto_rawDescData = file_fixture_events_proto_rawDesc
+)
+
+func file_fixture_events_proto_rawDescGZIP() []byte {
+	file_fixture_events_proto_rawDescOnce.Do(func() {
+		file_fixture_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_fixture_events_proto_rawDescData)
+	})
+	return file_fixture_events_proto_rawDescData
+}
+
+var file_fixture_events_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_fixture_events_proto_goTypes = []interface{}{
+	(*FixtureEventsResponse)(nil),  // 0: statistico.data.FixtureEventsResponse
+	(*CardEvent)(nil),              // 1: statistico.data.CardEvent
+	(*GoalEvent)(nil),              // 2: statistico.data.GoalEvent
+	(*MissedPenaltyEvent)(nil),     // 3: statistico.data.MissedPenaltyEvent
+	(*SubstitutionEvent)(nil),      // 4: statistico.data.SubstitutionEvent
+	(*wrapperspb.UInt64Value)(nil), // 5: google.protobuf.UInt64Value
+	(*wrapperspb.UInt32Value)(nil), // 6: google.protobuf.UInt32Value
+	(*wrapperspb.BoolValue)(nil),   // 7: google.protobuf.BoolValue
+}
+var file_fixture_events_proto_depIdxs = []int32{
+	1, // 0: statistico.data.FixtureEventsResponse.cards:type_name -> statistico.data.CardEvent
+	2, // 1: statistico.data.FixtureEventsResponse.goals:type_name -> statistico.data.GoalEvent
+	3, // 2: statistico.data.FixtureEventsResponse.missed_penalties:type_name -> statistico.data.MissedPenaltyEvent
+	4, // 3: statistico.data.FixtureEventsResponse.substitutions:type_name -> statistico.data.SubstitutionEvent
+	5, // 4: statistico.data.GoalEvent.player_assist_id:type_name -> google.protobuf.UInt64Value
+	6, // 5: statistico.data.GoalEvent.extra_minute:type_name -> google.protobuf.UInt32Value
+	6, // 6: statistico.data.MissedPenaltyEvent.extra_minute:type_name -> google.protobuf.UInt32Value
+	7, // 7: statistico.data.SubstitutionEvent.injured:type_name -> google.protobuf.BoolValue
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_fixture_events_proto_init() }
+func file_fixture_events_proto_init() {
+	if File_fixture_events_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_fixture_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixtureEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fixture_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fixture_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoalEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fixture_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissedPenaltyEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fixture_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubstitutionEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fixture_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fixture_events_proto_goTypes,
+		DependencyIndexes: file_fixture_events_proto_depIdxs,
+		MessageInfos:      file_fixture_events_proto_msgTypes,
+	}.Build()
+	File_fixture_events_proto = out.File
+	file_fixture_events_proto_rawDesc = nil
+	file_fixture_events_proto_goTypes = nil
+	file_fixture_events_proto_depIdxs = nil
+}
@@ -0,0 +1,400 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: fixture_search.proto
+
+package proto
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type FixtureSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SeasonIds []uint64                `protobuf:"varint,1,rep,packed,name=season_ids,json=seasonIds,proto3" json:"season_ids,omitempty"`
+	Limit     *wrapperspb.UInt64Value `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// RFC3339 formatted string i.e. "2006-01-02T15:04:05Z07:00"
+	DateBefore *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=date_before,json=dateBefore,proto3" json:"date_before,omitempty"`
+	// RFC3339 formatted string i.e. "2006-01-02T15:04:05Z07:00"
+	DateAfter *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=date_after,json=dateAfter,proto3" json:"date_after,omitempty"`
+	// Either "date_asc" or "date_desc"
+	Sort *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
+	// Optional filter to limit fixtures to statuses in the groups "scheduled", "live", "finished", "cancelled" or
+	// "unknown", the unknown group containing fixtures without a status
+	StatusGroups []string `protobuf:"bytes,6,rep,name=status_groups,json=statusGroups,proto3" json:"status_groups,omitempty"`
+}
+
+func (x *FixtureSearchRequest) Reset() {
+	*x = FixtureSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fixture_search_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FixtureSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixtureSearchRequest) ProtoMessage() {}
+
+func (x *FixtureSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fixture_search_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixtureSearchRequest.ProtoReflect.Descriptor instead.
+func (*FixtureSearchRequest) Descriptor() ([]byte, []int) {
+	return file_fixture_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FixtureSearchRequest) GetSeasonIds() []uint64 {
+	if x != nil {
+		return x.SeasonIds
+	}
+	return nil
+}
+
+func (x *FixtureSearchRequest) GetLimit() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *FixtureSearchRequest) GetDateBefore() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DateBefore
+	}
+	return nil
+}
+
+func (x *FixtureSearchRequest) GetDateAfter() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DateAfter
+	}
+	return nil
+}
+
+func (x *FixtureSearchRequest) GetSort() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *FixtureSearchRequest) GetStatusGroups() []string {
+	if x != nil {
+		return x.StatusGroups
+	}
+	return nil
+}
+
+type FixtureSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeasonId   uint64                  `protobuf:"varint,2,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	RoundId    *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	VenueId    *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	HomeTeamId uint64                  `protobuf:"varint,5,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
+	AwayTeamId uint64                  `protobuf:"varint,6,opt,name=away_team_id,json=awayTeamId,proto3" json:"away_team_id,omitempty"`
+	RefereeId  *wrapperspb.UInt64Value `protobuf:"bytes,7,opt,name=referee_id,json=refereeId,proto3" json:"referee_id,omitempty"`
+	// RFC3339 formatted string
+	Date string `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`
+	// Provider status i.e. "NS", "LIVE" or "FT"
+	Status *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	// One of "scheduled", "live", "finished", "cancelled" or "unknown" if the fixture has no status
+	StatusGroup *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=status_group,json=statusGroup,proto3" json:"status_group,omitempty"`
+}
+
+func (x *FixtureSummary) Reset() {
+	*x = FixtureSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fixture_search_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FixtureSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixtureSummary) ProtoMessage() {}
+
+func (x *FixtureSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_fixture_search_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixtureSummary.ProtoReflect.Descriptor instead.
+func (*FixtureSummary) Descriptor() ([]byte, []int) {
+	return file_fixture_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FixtureSummary) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FixtureSummary) GetSeasonId() uint64 {
+	if x != nil {
+		return x.SeasonId
+	}
+	return 0
+}
+
+func (x *FixtureSummary) GetRoundId() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.RoundId
+	}
+	return nil
+}
+
+func (x *FixtureSummary) GetVenueId() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.VenueId
+	}
+	return nil
+}
+
+func (x *FixtureSummary) GetHomeTeamId() uint64 {
+	if x != nil {
+		return x.HomeTeamId
+	}
+	return 0
+}
+
+func (x *FixtureSummary) GetAwayTeamId() uint64 {
+	if x != nil {
+		return x.AwayTeamId
+	}
+	return 0
+}
+
+func (x *FixtureSummary) GetRefereeId() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.RefereeId
+	}
+	return nil
+}
+
+func (x *FixtureSummary) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *FixtureSummary) GetStatus() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *FixtureSummary) GetStatusGroup() *wrapperspb.StringValue {
+	if x != nil {
+		return x.StatusGroup
+	}
+	return nil
+}
+
+var File_fixture_search_proto protoreflect.FileDescriptor
+
+var file_fixture_search_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x02, 0x0a, 0x14, 0x46, 0x69, 0x78, 0x74,
+	0x75, 0x72, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12,
+	0x32, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12,
+	0x30, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6f, 0x72,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xbb, 0x03, 0x0a, 0x0e, 0x46, 0x69, 0x78, 0x74, 0x75,
+	0x72, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12,
+	0x37, 0x0a, 0x08, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x07, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x6d, 0x65,
+	0x5f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x68, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x77,
+	0x61, 0x79, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x61, 0x77, 0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0a,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x32, 0x6c, 0x0a, 0x14, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x54, 0x0a, 0x06,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x25, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x00,
+	0x30, 0x01, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2d, 0x66, 0x6f, 0x6f, 0x74, 0x62, 0x61, 0x6c, 0x6c, 0x2d,
+	0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70,
+	0x70, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fixture_search_proto_rawDescOnce sync.Once
+	file_fixture_search_proto_rawDescData = file_fixture_search_proto_rawDesc
+)
+
+func file_fixture_search_proto_rawDescGZIP() []byte {
+	file_fixture_search_proto_rawDescOnce.Do(func() {
+		file_fixture_search_proto_rawDescData = protoimpl.X.CompressGZIP(file_fixture_search_proto_rawDescData)
+	})
+	return file_fixture_search_proto_rawDescData
+}
+
+var file_fixture_search_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_fixture_search_proto_goTypes = []interface{}{
+	(*FixtureSearchRequest)(nil),   // 0: statistico.data.FixtureSearchRequest
+	(*FixtureSummary)(nil),         // 1: statistico.data.FixtureSummary
+	(*wrapperspb.UInt64Value)(nil), // 2: google.protobuf.UInt64Value
+	(*wrapperspb.StringValue)(nil), // 3: google.protobuf.StringValue
+}
+var file_fixture_search_proto_depIdxs = []int32{
+	2,  // 0: statistico.data.FixtureSearchRequest.limit:type_name -> google.protobuf.UInt64Value
+	3,  // 1: statistico.data.FixtureSearchRequest.date_before:type_name -> google.protobuf.StringValue
+	3,  // 2: statistico.data.FixtureSearchRequest.date_after:type_name -> google.protobuf.StringValue
+	3,  // 3: statistico.data.FixtureSearchRequest.sort:type_name -> google.protobuf.StringValue
+	2,  // 4: statistico.data.FixtureSummary.round_id:type_name -> google.protobuf.UInt64Value
+	2,  // 5: statistico.data.FixtureSummary.venue_id:type_name -> google.protobuf.UInt64Value
+	2,  // 6: statistico.data.FixtureSummary.referee_id:type_name -> google.protobuf.UInt64Value
+	3,  // 7: statistico.data.FixtureSummary.status:type_name -> google.protobuf.StringValue
+	3,  // 8: statistico.data.FixtureSummary.status_group:type_name -> google.protobuf.StringValue
+	0,  // 9: statistico.data.FixtureSearchService.Search:input_type -> statistico.data.FixtureSearchRequest
+	1,  // 10: statistico.data.FixtureSearchService.Search:output_type -> statistico.data.FixtureSummary
+	10, // [10:11] is the sub-list for method output_type
+	9,  // [9:10] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_fixture_search_proto_init() }
+func file_fixture_search_proto_init() {
+	if File_fixture_search_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_fixture_search_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixtureSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fixture_search_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixtureSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fixture_search_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_fixture_search_proto_goTypes,
+		DependencyIndexes: file_fixture_search_proto_depIdxs,
+		MessageInfos:      file_fixture_search_proto_msgTypes,
+	}.Build()
+	File_fixture_search_proto = out.File
+	file_fixture_search_proto_rawDesc = nil
+	file_fixture_search_proto_goTypes = nil
+	file_fixture_search_proto_depIdxs = nil
+}
@@ -0,0 +1,134 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.14.0
+// source: fixture_search.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// FixtureSearchServiceClient is the client API for FixtureSearchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FixtureSearchServiceClient interface {
+	// Returns fixtures matching the filters provided alongside their normalized status and status group
+	Search(ctx context.Context, in *FixtureSearchRequest, opts ...grpc.CallOption) (FixtureSearchService_SearchClient, error)
+}
+
+type fixtureSearchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFixtureSearchServiceClient(cc grpc.ClientConnInterface) FixtureSearchServiceClient {
+	return &fixtureSearchServiceClient{cc}
+}
+
+func (c *fixtureSearchServiceClient) Search(ctx context.Context, in *FixtureSearchRequest, opts ...grpc.CallOption) (FixtureSearchService_SearchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FixtureSearchService_ServiceDesc.Streams[0], "/statistico.data.FixtureSearchService/Search", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fixtureSearchServiceSearchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FixtureSearchService_SearchClient interface {
+	Recv() (*FixtureSummary, error)
+	grpc.ClientStream
+}
+
+type fixtureSearchServiceSearchClient struct {
+	grpc.ClientStream
+}
+
+func (x *fixtureSearchServiceSearchClient) Recv() (*FixtureSummary, error) {
+	m := new(FixtureSummary)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// FixtureSearchServiceServer is the server API for FixtureSearchService service.
+// All implementations must embed UnimplementedFixtureSearchServiceServer
+// for forward compatibility
+type FixtureSearchServiceServer interface {
+	// Returns fixtures matching the filters provided alongside their normalized status and status group
+	Search(*FixtureSearchRequest, FixtureSearchService_SearchServer) error
+	mustEmbedUnimplementedFixtureSearchServiceServer()
+}
+
+// UnimplementedFixtureSearchServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFixtureSearchServiceServer struct {
+}
+
+func (UnimplementedFixtureSearchServiceServer) Search(*FixtureSearchRequest, FixtureSearchService_SearchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedFixtureSearchServiceServer) mustEmbedUnimplementedFixtureSearchServiceServer() {}
+
+// UnsafeFixtureSearchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FixtureSearchServiceServer will
+// result in compilation errors.
+type UnsafeFixtureSearchServiceServer interface {
+	mustEmbedUnimplementedFixtureSearchServiceServer()
+}
+
+func RegisterFixtureSearchServiceServer(s grpc.ServiceRegistrar, srv FixtureSearchServiceServer) {
+	s.RegisterService(&FixtureSearchService_ServiceDesc, srv)
+}
+
+func _FixtureSearchService_Search_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FixtureSearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FixtureSearchServiceServer).Search(m, &fixtureSearchServiceSearchServer{stream})
+}
+
+type FixtureSearchService_SearchServer interface {
+	Send(*FixtureSummary) error
+	grpc.ServerStream
+}
+
+type fixtureSearchServiceSearchServer struct {
+	grpc.ServerStream
+}
+
+func (x *fixtureSearchServiceSearchServer) Send(m *FixtureSummary) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// FixtureSearchService_ServiceDesc is the grpc.ServiceDesc for FixtureSearchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FixtureSearchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "statistico.data.FixtureSearchService",
+	HandlerType: (*FixtureSearchServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Search",
+			Handler:       _FixtureSearchService_Search_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "fixture_search.proto",
+}
@@ -0,0 +1,494 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: lineup.proto
+
+package proto
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type FixtureLineupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FixtureId uint64 `protobuf:"varint,1,opt,name=fixture_id,json=fixtureId,proto3" json:"fixture_id,omitempty"`
+}
+
+func (x *FixtureLineupRequest) Reset() {
+	*x = FixtureLineupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lineup_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FixtureLineupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixtureLineupRequest) ProtoMessage() {}
+
+func (x *FixtureLineupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lineup_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixtureLineupRequest.ProtoReflect.Descriptor instead.
+func (*FixtureLineupRequest) Descriptor() ([]byte, []int) {
+	return file_lineup_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FixtureLineupRequest) GetFixtureId() uint64 {
+	if x != nil {
+		return x.FixtureId
+	}
+	return 0
+}
+
+type FixtureLineupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FixtureId uint64 `protobuf:"varint,1,opt,name=fixture_id,json=fixtureId,proto3" json:"fixture_id,omitempty"`
+	// Null if the home team is yet to announce their lineup
+	Home *TeamLineup `protobuf:"bytes,2,opt,name=home,proto3" json:"home,omitempty"`
+	// Null if the away team is yet to announce their lineup
+	Away *TeamLineup `protobuf:"bytes,3,opt,name=away,proto3" json:"away,omitempty"`
+}
+
+func (x *FixtureLineupResponse) Reset() {
+	*x = FixtureLineupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lineup_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FixtureLineupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixtureLineupResponse) ProtoMessage() {}
+
+func (x *FixtureLineupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lineup_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixtureLineupResponse.ProtoReflect.Descriptor instead.
+func (*FixtureLineupResponse) Descriptor() ([]byte, []int) {
+	return file_lineup_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FixtureLineupResponse) GetFixtureId() uint64 {
+	if x != nil {
+		return x.FixtureId
+	}
+	return 0
+}
+
+func (x *FixtureLineupResponse) GetHome() *TeamLineup {
+	if x != nil {
+		return x.Home
+	}
+	return nil
+}
+
+func (x *FixtureLineupResponse) GetAway() *TeamLineup {
+	if x != nil {
+		return x.Away
+	}
+	return nil
+}
+
+type TeamLineup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeamId uint64 `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// Formation i.e. "4-2-3-1", null until provided
+	Formation *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=formation,proto3" json:"formation,omitempty"`
+	// Starting players ordered by formation position
+	Start []*LineupPlayer `protobuf:"bytes,3,rep,name=start,proto3" json:"start,omitempty"`
+	// Substitutes ordered by shirt number
+	Bench []*LineupPlayer `protobuf:"bytes,4,rep,name=bench,proto3" json:"bench,omitempty"`
+}
+
+func (x *TeamLineup) Reset() {
+	*x = TeamLineup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lineup_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeamLineup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamLineup) ProtoMessage() {}
+
+func (x *TeamLineup) ProtoReflect() protoreflect.Message {
+	mi := &file_lineup_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamLineup.ProtoReflect.Descriptor instead.
+func (*TeamLineup) Descriptor() ([]byte, []int) {
+	return file_lineup_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TeamLineup) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *TeamLineup) GetFormation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Formation
+	}
+	return nil
+}
+
+func (x *TeamLineup) GetStart() []*LineupPlayer {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *TeamLineup) GetBench() []*LineupPlayer {
+	if x != nil {
+		return x.Bench
+	}
+	return nil
+}
+
+type LineupPlayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId          uint64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	ShirtNumber       uint32                  `protobuf:"varint,2,opt,name=shirt_number,json=shirtNumber,proto3" json:"shirt_number,omitempty"`
+	Position          *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	FormationPosition *wrapperspb.UInt32Value `protobuf:"bytes,4,opt,name=formation_position,json=formationPosition,proto3" json:"formation_position,omitempty"`
+	// Coordinates on the formation grid, null for substitutes
+	GridX   *wrapperspb.UInt32Value `protobuf:"bytes,5,opt,name=grid_x,json=gridX,proto3" json:"grid_x,omitempty"`
+	GridY   *wrapperspb.UInt32Value `protobuf:"bytes,6,opt,name=grid_y,json=gridY,proto3" json:"grid_y,omitempty"`
+	Captain bool                    `protobuf:"varint,7,opt,name=captain,proto3" json:"captain,omitempty"`
+}
+
+func (x *LineupPlayer) Reset() {
+	*x = LineupPlayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lineup_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LineupPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineupPlayer) ProtoMessage() {}
+
+func (x *LineupPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_lineup_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineupPlayer.ProtoReflect.Descriptor instead.
+func (*LineupPlayer) Descriptor() ([]byte, []int) {
+	return file_lineup_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LineupPlayer) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *LineupPlayer) GetShirtNumber() uint32 {
+	if x != nil {
+		return x.ShirtNumber
+	}
+	return 0
+}
+
+func (x *LineupPlayer) GetPosition() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *LineupPlayer) GetFormationPosition() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.FormationPosition
+	}
+	return nil
+}
+
+func (x *LineupPlayer) GetGridX() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.GridX
+	}
+	return nil
+}
+
+func (x *LineupPlayer) GetGridY() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.GridY
+	}
+	return nil
+}
+
+func (x *LineupPlayer) GetCaptain() bool {
+	if x != nil {
+		return x.Captain
+	}
+	return false
+}
+
+var File_lineup_proto protoreflect.FileDescriptor
+
+var file_lineup_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x6c, 0x69, 0x6e, 0x65, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x35, 0x0a, 0x14, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x78, 0x74, 0x75,
+	0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x69, 0x78,
+	0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x15, 0x46, 0x69, 0x78, 0x74, 0x75,
+	0x72, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12,
+	0x2f, 0x0a, 0x04, 0x68, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x54, 0x65, 0x61, 0x6d, 0x4c, 0x69, 0x6e, 0x65, 0x75, 0x70, 0x52, 0x04, 0x68, 0x6f, 0x6d, 0x65,
+	0x12, 0x2f, 0x0a, 0x04, 0x61, 0x77, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x54, 0x65, 0x61, 0x6d, 0x4c, 0x69, 0x6e, 0x65, 0x75, 0x70, 0x52, 0x04, 0x61, 0x77, 0x61,
+	0x79, 0x22, 0xcb, 0x01, 0x0a, 0x0a, 0x54, 0x65, 0x61, 0x6d, 0x4c, 0x69, 0x6e, 0x65, 0x75, 0x70,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x75, 0x70, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x69, 0x6e, 0x65,
+	0x75, 0x70, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x05, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x22,
+	0xd9, 0x02, 0x0a, 0x0c, 0x4c, 0x69, 0x6e, 0x65, 0x75, 0x70, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x68, 0x69, 0x72, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x68, 0x69, 0x72, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x38, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x12, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x06, 0x67, 0x72, 0x69, 0x64, 0x5f,
+	0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x67, 0x72, 0x69, 0x64, 0x58, 0x12, 0x33, 0x0a, 0x06,
+	0x67, 0x72, 0x69, 0x64, 0x5f, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x67, 0x72, 0x69, 0x64,
+	0x59, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74, 0x61, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x70, 0x74, 0x61, 0x69, 0x6e, 0x32, 0x74, 0x0a, 0x0d, 0x4c,
+	0x69, 0x6e, 0x65, 0x75, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x63, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x75, 0x70,
+	0x12, 0x25, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72,
+	0x65, 0x4c, 0x69, 0x6e, 0x65, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x6f, 0x2d, 0x66, 0x6f, 0x6f, 0x74, 0x62, 0x61, 0x6c, 0x6c, 0x2d, 0x64,
+	0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x70,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_lineup_proto_rawDescOnce sync.Once
+	file_lineup_proto_rawDescData = file_lineup_proto_rawDesc
+)
+
+func file_lineup_proto_rawDescGZIP() []byte {
+	file_lineup_proto_rawDescOnce.Do(func() {
+		file_lineup_proto_rawDescData = protoimpl.X.CompressGZIP(file_lineup_proto_rawDescData)
+	})
+	return file_lineup_proto_rawDescData
+}
+
+var file_lineup_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_lineup_proto_goTypes = []interface{}{
+	(*FixtureLineupRequest)(nil),   // 0: statistico.data.FixtureLineupRequest
+	(*FixtureLineupResponse)(nil),  // 1: statistico.data.FixtureLineupResponse
+	(*TeamLineup)(nil),             // 2: statistico.data.TeamLineup
+	(*LineupPlayer)(nil),           // 3: statistico.data.LineupPlayer
+	(*wrapperspb.StringValue)(nil), // 4: google.protobuf.StringValue
+	(*wrapperspb.UInt32Value)(nil), // 5: google.protobuf.UInt32Value
+}
+var file_lineup_proto_depIdxs = []int32{
+	2,  // 0: statistico.data.FixtureLineupResponse.home:type_name -> statistico.data.TeamLineup
+	2,  // 1: statistico.data.FixtureLineupResponse.away:type_name -> statistico.data.TeamLineup
+	4,  // 2: statistico.data.TeamLineup.formation:type_name -> google.protobuf.StringValue
+	3,  // 3: statistico.data.TeamLineup.start:type_name -> statistico.data.LineupPlayer
+	3,  // 4: statistico.data.TeamLineup.bench:type_name -> statistico.data.LineupPlayer
+	4,  // 5: statistico.data.LineupPlayer.position:type_name -> google.protobuf.StringValue
+	5,  // 6: statistico.data.LineupPlayer.formation_position:type_name -> google.protobuf.UInt32Value
+	5,  // 7: statistico.data.LineupPlayer.grid_x:type_name -> google.protobuf.UInt32Value
+	5,  // 8: statistico.data.LineupPlayer.grid_y:type_name -> google.protobuf.UInt32Value
+	0,  // 9: statistico.data.LineupService.GetFixtureLineup:input_type -> statistico.data.FixtureLineupRequest
+	1,  // 10: statistico.data.LineupService.GetFixtureLineup:output_type -> statistico.data.FixtureLineupResponse
+	10, // [10:11] is the sub-list for method output_type
+	9,  // [9:10] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_lineup_proto_init() }
+func file_lineup_proto_init() {
+	if File_lineup_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_lineup_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixtureLineupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lineup_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixtureLineupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lineup_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeamLineup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lineup_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LineupPlayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_lineup_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lineup_proto_goTypes,
+		DependencyIndexes: file_lineup_proto_depIdxs,
+		MessageInfos:      file_lineup_proto_msgTypes,
+	}.Build()
+	File_lineup_proto = out.File
+	file_lineup_proto_rawDesc = nil
+	file_lineup_proto_goTypes = nil
+	file_lineup_proto_depIdxs = nil
+}
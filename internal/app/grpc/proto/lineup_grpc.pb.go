@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.14.0
+// source: lineup.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// LineupServiceClient is the client API for LineupService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LineupServiceClient interface {
+	// Returns the announced lineups for both teams in a fixture, available ahead of kick-off once announced
+	GetFixtureLineup(ctx context.Context, in *FixtureLineupRequest, opts ...grpc.CallOption) (*FixtureLineupResponse, error)
+}
+
+type lineupServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLineupServiceClient(cc grpc.ClientConnInterface) LineupServiceClient {
+	return &lineupServiceClient{cc}
+}
+
+func (c *lineupServiceClient) GetFixtureLineup(ctx context.Context, in *FixtureLineupRequest, opts ...grpc.CallOption) (*FixtureLineupResponse, error) {
+	out := new(FixtureLineupResponse)
+	err := c.cc.Invoke(ctx, "/statistico.data.LineupService/GetFixtureLineup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LineupServiceServer is the server API for LineupService service.
+// All implementations must embed UnimplementedLineupServiceServer
+// for forward compatibility
+type LineupServiceServer interface {
+	// Returns the announced lineups for both teams in a fixture, available ahead of kick-off once announced
+	GetFixtureLineup(context.Context, *FixtureLineupRequest) (*FixtureLineupResponse, error)
+	mustEmbedUnimplementedLineupServiceServer()
+}
+
+// UnimplementedLineupServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLineupServiceServer struct {
+}
+
+func (UnimplementedLineupServiceServer) GetFixtureLineup(context.Context, *FixtureLineupRequest) (*FixtureLineupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFixtureLineup not implemented")
+}
+func (UnimplementedLineupServiceServer) mustEmbedUnimplementedLineupServiceServer() {}
+
+// UnsafeLineupServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LineupServiceServer will
+// result in compilation errors.
+type UnsafeLineupServiceServer interface {
+	mustEmbedUnimplementedLineupServiceServer()
+}
+
+func RegisterLineupServiceServer(s grpc.ServiceRegistrar, srv LineupServiceServer) {
+	s.RegisterService(&LineupService_ServiceDesc, srv)
+}
+
+func _LineupService_GetFixtureLineup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FixtureLineupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LineupServiceServer).GetFixtureLineup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/statistico.data.LineupService/GetFixtureLineup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LineupServiceServer).GetFixtureLineup(ctx, req.(*FixtureLineupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LineupService_ServiceDesc is the grpc.ServiceDesc for LineupService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LineupService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "statistico.data.LineupService",
+	HandlerType: (*LineupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFixtureLineup",
+			Handler:    _LineupService_GetFixtureLineup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lineup.proto",
+}
@@ -0,0 +1,411 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: live.proto
+
+package proto
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type WatchFixturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FixtureIds     []uint64 `protobuf:"varint,1,rep,packed,name=fixture_ids,json=fixtureIds,proto3" json:"fixture_ids,omitempty"`
+	TeamIds        []uint64 `protobuf:"varint,2,rep,packed,name=team_ids,json=teamIds,proto3" json:"team_ids,omitempty"`
+	CompetitionIds []uint64 `protobuf:"varint,3,rep,packed,name=competition_ids,json=competitionIds,proto3" json:"competition_ids,omitempty"`
+}
+
+func (x *WatchFixturesRequest) Reset() {
+	*x = WatchFixturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchFixturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchFixturesRequest) ProtoMessage() {}
+
+func (x *WatchFixturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchFixturesRequest.ProtoReflect.Descriptor instead.
+func (*WatchFixturesRequest) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchFixturesRequest) GetFixtureIds() []uint64 {
+	if x != nil {
+		return x.FixtureIds
+	}
+	return nil
+}
+
+func (x *WatchFixturesRequest) GetTeamIds() []uint64 {
+	if x != nil {
+		return x.TeamIds
+	}
+	return nil
+}
+
+func (x *WatchFixturesRequest) GetCompetitionIds() []uint64 {
+	if x != nil {
+		return x.CompetitionIds
+	}
+	return nil
+}
+
+type FixtureUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FixtureId uint64 `protobuf:"varint,1,opt,name=fixture_id,json=fixtureId,proto3" json:"fixture_id,omitempty"`
+	// The change causing the update i.e. "status", "result", "goal", "card", "substitution" or "missed-penalty"
+	Type      string                  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Status    *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	HomeScore *wrapperspb.UInt32Value `protobuf:"bytes,4,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore *wrapperspb.UInt32Value `protobuf:"bytes,5,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	Minute    *wrapperspb.UInt32Value `protobuf:"bytes,6,opt,name=minute,proto3" json:"minute,omitempty"`
+	// Types that are assignable to Event:
+	//	*FixtureUpdate_Card
+	//	*FixtureUpdate_Goal
+	//	*FixtureUpdate_MissedPenalty
+	//	*FixtureUpdate_Substitution
+	Event isFixtureUpdate_Event `protobuf_oneof:"event"`
+}
+
+func (x *FixtureUpdate) Reset() {
+	*x = FixtureUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FixtureUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixtureUpdate) ProtoMessage() {}
+
+func (x *FixtureUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixtureUpdate.ProtoReflect.Descriptor instead.
+func (*FixtureUpdate) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FixtureUpdate) GetFixtureId() uint64 {
+	if x != nil {
+		return x.FixtureId
+	}
+	return 0
+}
+
+func (x *FixtureUpdate) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FixtureUpdate) GetStatus() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *FixtureUpdate) GetHomeScore() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.HomeScore
+	}
+	return nil
+}
+
+func (x *FixtureUpdate) GetAwayScore() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.AwayScore
+	}
+	return nil
+}
+
+func (x *FixtureUpdate) GetMinute() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Minute
+	}
+	return nil
+}
+
+func (m *FixtureUpdate) GetEvent() isFixtureUpdate_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *FixtureUpdate) GetCard() *CardEvent {
+	if x, ok := x.GetEvent().(*FixtureUpdate_Card); ok {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *FixtureUpdate) GetGoal() *GoalEvent {
+	if x, ok := x.GetEvent().(*FixtureUpdate_Goal); ok {
+		return x.Goal
+	}
+	return nil
+}
+
+func (x *FixtureUpdate) GetMissedPenalty() *MissedPenaltyEvent {
+	if x, ok := x.GetEvent().(*FixtureUpdate_MissedPenalty); ok {
+		return x.MissedPenalty
+	}
+	return nil
+}
+
+func (x *FixtureUpdate) GetSubstitution() *SubstitutionEvent {
+	if x, ok := x.GetEvent().(*FixtureUpdate_Substitution); ok {
+		return x.Substitution
+	}
+	return nil
+}
+
+type isFixtureUpdate_Event interface {
+	isFixtureUpdate_Event()
+}
+
+type FixtureUpdate_Card struct {
+	Card *CardEvent `protobuf:"bytes,7,opt,name=card,proto3,oneof"`
+}
+
+type FixtureUpdate_Goal struct {
+	Goal *GoalEvent `protobuf:"bytes,8,opt,name=goal,proto3,oneof"`
+}
+
+type FixtureUpdate_MissedPenalty struct {
+	MissedPenalty *MissedPenaltyEvent `protobuf:"bytes,9,opt,name=missed_penalty,json=missedPenalty,proto3,oneof"`
+}
+
+type FixtureUpdate_Substitution struct {
+	Substitution *SubstitutionEvent `protobuf:"bytes,10,opt,name=substitution,proto3,oneof"`
+}
+
+func (*FixtureUpdate_Card) isFixtureUpdate_Event() {}
+
+func (*FixtureUpdate_Goal) isFixtureUpdate_Event() {}
+
+func (*FixtureUpdate_MissedPenalty) isFixtureUpdate_Event() {}
+
+func (*FixtureUpdate_Substitution) isFixtureUpdate_Event() {}
+
+var File_live_proto protoreflect.FileDescriptor
+
+var file_live_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x66,
+	0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x7b, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x78, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
+	0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x0a, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07,
+	0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73,
+	0x22, 0xad, 0x04, 0x0a, 0x0d, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x68,
+	0x6f, 0x6d, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x68,
+	0x6f, 0x6d, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x61, 0x77, 0x61, 0x79,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x61, 0x77, 0x61, 0x79,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x63,
+	0x61, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x61, 0x72, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x12, 0x30, 0x0a,
+	0x04, 0x67, 0x6f, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x6f,
+	0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x12,
+	0x4c, 0x0a, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x48, 0x0a,
+	0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x32, 0x69, 0x0a, 0x0b, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x5a, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x25, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72,
+	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x48, 0x5a, 0x46, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x6f, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2d,
+	0x66, 0x6f, 0x6f, 0x74, 0x62, 0x61, 0x6c, 0x6c, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_live_proto_rawDescOnce sync.Once
+	file_live_proto_rawDescData = file_live_proto_rawDesc
+)
+
+func file_live_proto_rawDescGZIP() []byte {
+	file_live_proto_rawDescOnce.Do(func() {
+		file_live_proto_rawDescData = protoimpl.X.CompressGZIP(file_live_proto_rawDescData)
+	})
+	return file_live_proto_rawDescData
+}
+
+var file_live_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_live_proto_goTypes = []interface{}{
+	(*WatchFixturesRequest)(nil),   // 0: statistico.data.WatchFixturesRequest
+	(*FixtureUpdate)(nil),          // 1: statistico.data.FixtureUpdate
+	(*wrapperspb.StringValue)(nil), // 2: google.protobuf.StringValue
+	(*wrapperspb.UInt32Value)(nil), // 3: google.protobuf.UInt32Value
+	(*CardEvent)(nil),              // 4: statistico.data.CardEvent
+	(*GoalEvent)(nil),              // 5: statistico.data.GoalEvent
+	(*MissedPenaltyEvent)(nil),     // 6: statistico.data.MissedPenaltyEvent
+	(*SubstitutionEvent)(nil),      // 7: statistico.data.SubstitutionEvent
+}
+var file_live_proto_depIdxs = []int32{
+	2, // 0: statistico.data.FixtureUpdate.status:type_name -> google.protobuf.StringValue
+	3, // 1: statistico.data.FixtureUpdate.home_score:type_name -> google.protobuf.UInt32Value
+	3, // 2: statistico.data.FixtureUpdate.away_score:type_name -> google.protobuf.UInt32Value
+	3, // 3: statistico.data.FixtureUpdate.minute:type_name -> google.protobuf.UInt32Value
+	4, // 4: statistico.data.FixtureUpdate.card:type_name -> statistico.data.CardEvent
+	5, // 5: statistico.data.FixtureUpdate.goal:type_name -> statistico.data.GoalEvent
+	6, // 6: statistico.data.FixtureUpdate.missed_penalty:type_name -> statistico.data.MissedPenaltyEvent
+	7, // 7: statistico.data.FixtureUpdate.substitution:type_name -> statistico.data.SubstitutionEvent
+	0, // 8: statistico.data.LiveService.WatchFixtures:input_type -> statistico.data.WatchFixturesRequest
+	1, // 9: statistico.data.LiveService.WatchFixtures:output_type -> statistico.data.FixtureUpdate
+	9, // [9:10] is the sub-list for method output_type
+	8, // [8:9] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_live_proto_init() }
+func file_live_proto_init() {
+	if File_live_proto != nil {
+		return
+	}
+	file_fixture_events_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_live_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchFixturesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixtureUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_live_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*FixtureUpdate_Card)(nil),
+		(*FixtureUpdate_Goal)(nil),
+		(*FixtureUpdate_MissedPenalty)(nil),
+		(*FixtureUpdate_Substitution)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_live_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_live_proto_goTypes,
+		DependencyIndexes: file_live_proto_depIdxs,
+		MessageInfos:      file_live_proto_msgTypes,
+	}.Build()
+	File_live_proto = out.File
+	file_live_proto_rawDesc = nil
+	file_live_proto_goTypes = nil
+	file_live_proto_depIdxs = nil
+}
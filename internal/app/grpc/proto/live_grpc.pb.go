@@ -0,0 +1,136 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.14.0
+// source: live.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// LiveServiceClient is the client API for LiveService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LiveServiceClient interface {
+	// Streams updates for fixtures matching any of the fixture, team or competition IDs provided as status, score
+	// and event changes are persisted. The stream remains open until the client cancels the request
+	WatchFixtures(ctx context.Context, in *WatchFixturesRequest, opts ...grpc.CallOption) (LiveService_WatchFixturesClient, error)
+}
+
+type liveServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLiveServiceClient(cc grpc.ClientConnInterface) LiveServiceClient {
+	return &liveServiceClient{cc}
+}
+
+func (c *liveServiceClient) WatchFixtures(ctx context.Context, in *WatchFixturesRequest, opts ...grpc.CallOption) (LiveService_WatchFixturesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LiveService_ServiceDesc.Streams[0], "/statistico.data.LiveService/WatchFixtures", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &liveServiceWatchFixturesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LiveService_WatchFixturesClient interface {
+	Recv() (*FixtureUpdate, error)
+	grpc.ClientStream
+}
+
+type liveServiceWatchFixturesClient struct {
+	grpc.ClientStream
+}
+
+func (x *liveServiceWatchFixturesClient) Recv() (*FixtureUpdate, error) {
+	m := new(FixtureUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// LiveServiceServer is the server API for LiveService service.
+// All implementations must embed UnimplementedLiveServiceServer
+// for forward compatibility
+type LiveServiceServer interface {
+	// Streams updates for fixtures matching any of the fixture, team or competition IDs provided as status, score
+	// and event changes are persisted. The stream remains open until the client cancels the request
+	WatchFixtures(*WatchFixturesRequest, LiveService_WatchFixturesServer) error
+	mustEmbedUnimplementedLiveServiceServer()
+}
+
+// UnimplementedLiveServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLiveServiceServer struct {
+}
+
+func (UnimplementedLiveServiceServer) WatchFixtures(*WatchFixturesRequest, LiveService_WatchFixturesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchFixtures not implemented")
+}
+func (UnimplementedLiveServiceServer) mustEmbedUnimplementedLiveServiceServer() {}
+
+// UnsafeLiveServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LiveServiceServer will
+// result in compilation errors.
+type UnsafeLiveServiceServer interface {
+	mustEmbedUnimplementedLiveServiceServer()
+}
+
+func RegisterLiveServiceServer(s grpc.ServiceRegistrar, srv LiveServiceServer) {
+	s.RegisterService(&LiveService_ServiceDesc, srv)
+}
+
+func _LiveService_WatchFixtures_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchFixturesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LiveServiceServer).WatchFixtures(m, &liveServiceWatchFixturesServer{stream})
+}
+
+type LiveService_WatchFixturesServer interface {
+	Send(*FixtureUpdate) error
+	grpc.ServerStream
+}
+
+type liveServiceWatchFixturesServer struct {
+	grpc.ServerStream
+}
+
+func (x *liveServiceWatchFixturesServer) Send(m *FixtureUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// LiveService_ServiceDesc is the grpc.ServiceDesc for LiveService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LiveService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "statistico.data.LiveService",
+	HandlerType: (*LiveServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchFixtures",
+			Handler:       _LiveService_WatchFixtures_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "live.proto",
+}
@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.14.0
+// source: manager.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ManagerServiceClient is the client API for ManagerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ManagerServiceClient interface {
+	// Returns the manager in charge of a team on a given date
+	GetTeamManager(ctx context.Context, in *TeamManagerRequest, opts ...grpc.CallOption) (*ManagerTenure, error)
+	// Returns every manager tenure for a team ordered by date_from ascending
+	GetTeamManagerHistory(ctx context.Context, in *TeamManagerHistoryRequest, opts ...grpc.CallOption) (*TeamManagerHistoryResponse, error)
+	// Returns the results of every fixture a manager has been in charge of alongside their overall record
+	GetManagerResults(ctx context.Context, in *ManagerResultsRequest, opts ...grpc.CallOption) (*ManagerResultsResponse, error)
+}
+
+type managerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewManagerServiceClient(cc grpc.ClientConnInterface) ManagerServiceClient {
+	return &managerServiceClient{cc}
+}
+
+func (c *managerServiceClient) GetTeamManager(ctx context.Context, in *TeamManagerRequest, opts ...grpc.CallOption) (*ManagerTenure, error) {
+	out := new(ManagerTenure)
+	err := c.cc.Invoke(ctx, "/statistico.data.ManagerService/GetTeamManager", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) GetTeamManagerHistory(ctx context.Context, in *TeamManagerHistoryRequest, opts ...grpc.CallOption) (*TeamManagerHistoryResponse, error) {
+	out := new(TeamManagerHistoryResponse)
+	err := c.cc.Invoke(ctx, "/statistico.data.ManagerService/GetTeamManagerHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) GetManagerResults(ctx context.Context, in *ManagerResultsRequest, opts ...grpc.CallOption) (*ManagerResultsResponse, error) {
+	out := new(ManagerResultsResponse)
+	err := c.cc.Invoke(ctx, "/statistico.data.ManagerService/GetManagerResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ManagerServiceServer is the server API for ManagerService service.
+// All implementations must embed UnimplementedManagerServiceServer
+// for forward compatibility
+type ManagerServiceServer interface {
+	// Returns the manager in charge of a team on a given date
+	GetTeamManager(context.Context, *TeamManagerRequest) (*ManagerTenure, error)
+	// Returns every manager tenure for a team ordered by date_from ascending
+	GetTeamManagerHistory(context.Context, *TeamManagerHistoryRequest) (*TeamManagerHistoryResponse, error)
+	// Returns the results of every fixture a manager has been in charge of alongside their overall record
+	GetManagerResults(context.Context, *ManagerResultsRequest) (*ManagerResultsResponse, error)
+	mustEmbedUnimplementedManagerServiceServer()
+}
+
+// UnimplementedManagerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedManagerServiceServer struct {
+}
+
+func (UnimplementedManagerServiceServer) GetTeamManager(context.Context, *TeamManagerRequest) (*ManagerTenure, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamManager not implemented")
+}
+func (UnimplementedManagerServiceServer) GetTeamManagerHistory(context.Context, *TeamManagerHistoryRequest) (*TeamManagerHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamManagerHistory not implemented")
+}
+func (UnimplementedManagerServiceServer) GetManagerResults(context.Context, *ManagerResultsRequest) (*ManagerResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManagerResults not implemented")
+}
+func (UnimplementedManagerServiceServer) mustEmbedUnimplementedManagerServiceServer() {}
+
+// UnsafeManagerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ManagerServiceServer will
+// result in compilation errors.
+type UnsafeManagerServiceServer interface {
+	mustEmbedUnimplementedManagerServiceServer()
+}
+
+func RegisterManagerServiceServer(s grpc.ServiceRegistrar, srv ManagerServiceServer) {
+	s.RegisterService(&ManagerService_ServiceDesc, srv)
+}
+
+func _ManagerService_GetTeamManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamManagerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).GetTeamManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/statistico.data.ManagerService/GetTeamManager",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).GetTeamManager(ctx, req.(*TeamManagerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_GetTeamManagerHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamManagerHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).GetTeamManagerHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/statistico.data.ManagerService/GetTeamManagerHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).GetTeamManagerHistory(ctx, req.(*TeamManagerHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_GetManagerResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManagerResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).GetManagerResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/statistico.data.ManagerService/GetManagerResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).GetManagerResults(ctx, req.(*ManagerResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ManagerService_ServiceDesc is the grpc.ServiceDesc for ManagerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ManagerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "statistico.data.ManagerService",
+	HandlerType: (*ManagerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTeamManager",
+			Handler:    _ManagerService_GetTeamManager_Handler,
+		},
+		{
+			MethodName: "GetTeamManagerHistory",
+			Handler:    _ManagerService_GetTeamManagerHistory_Handler,
+		},
+		{
+			MethodName: "GetManagerResults",
+			Handler:    _ManagerService_GetManagerResults_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "manager.proto",
+}
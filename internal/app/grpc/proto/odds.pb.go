@@ -0,0 +1,414 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: odds.proto
+
+package proto
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type MarketOddsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FixtureId uint64 `protobuf:"varint,1,opt,name=fixture_id,json=fixtureId,proto3" json:"fixture_id,omitempty"`
+	Market    string `protobuf:"bytes,2,opt,name=market,proto3" json:"market,omitempty"`
+	// Optional filter to limit the prices returned to specific bookmakers
+	BookmakerIds []uint64 `protobuf:"varint,3,rep,packed,name=bookmaker_ids,json=bookmakerIds,proto3" json:"bookmaker_ids,omitempty"`
+}
+
+func (x *MarketOddsRequest) Reset() {
+	*x = MarketOddsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odds_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketOddsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketOddsRequest) ProtoMessage() {}
+
+func (x *MarketOddsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_odds_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketOddsRequest.ProtoReflect.Descriptor instead.
+func (*MarketOddsRequest) Descriptor() ([]byte, []int) {
+	return file_odds_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MarketOddsRequest) GetFixtureId() uint64 {
+	if x != nil {
+		return x.FixtureId
+	}
+	return 0
+}
+
+func (x *MarketOddsRequest) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *MarketOddsRequest) GetBookmakerIds() []uint64 {
+	if x != nil {
+		return x.BookmakerIds
+	}
+	return nil
+}
+
+type MarketOddsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FixtureId uint64 `protobuf:"varint,1,opt,name=fixture_id,json=fixtureId,proto3" json:"fixture_id,omitempty"`
+	Market    string `protobuf:"bytes,2,opt,name=market,proto3" json:"market,omitempty"`
+	// The first price captured for each bookmaker and selection
+	Opening []*OddsPrice `protobuf:"bytes,3,rep,name=opening,proto3" json:"opening,omitempty"`
+	// The last price captured before kick off for each bookmaker and selection
+	Closing []*OddsPrice `protobuf:"bytes,4,rep,name=closing,proto3" json:"closing,omitempty"`
+	// Every price captured ordered by captured_at ascending
+	History []*OddsPrice `protobuf:"bytes,5,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *MarketOddsResponse) Reset() {
+	*x = MarketOddsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odds_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketOddsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketOddsResponse) ProtoMessage() {}
+
+func (x *MarketOddsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_odds_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketOddsResponse.ProtoReflect.Descriptor instead.
+func (*MarketOddsResponse) Descriptor() ([]byte, []int) {
+	return file_odds_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MarketOddsResponse) GetFixtureId() uint64 {
+	if x != nil {
+		return x.FixtureId
+	}
+	return 0
+}
+
+func (x *MarketOddsResponse) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *MarketOddsResponse) GetOpening() []*OddsPrice {
+	if x != nil {
+		return x.Opening
+	}
+	return nil
+}
+
+func (x *MarketOddsResponse) GetClosing() []*OddsPrice {
+	if x != nil {
+		return x.Closing
+	}
+	return nil
+}
+
+func (x *MarketOddsResponse) GetHistory() []*OddsPrice {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type OddsPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookmakerId uint64                  `protobuf:"varint,1,opt,name=bookmaker_id,json=bookmakerId,proto3" json:"bookmaker_id,omitempty"`
+	Bookmaker   string                  `protobuf:"bytes,2,opt,name=bookmaker,proto3" json:"bookmaker,omitempty"`
+	Selection   string                  `protobuf:"bytes,3,opt,name=selection,proto3" json:"selection,omitempty"`
+	Line        *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=line,proto3" json:"line,omitempty"`
+	Price       float32                 `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
+	// RFC3339 formatted string i.e "2006-01-02T15:04:05Z07:00"
+	CapturedAt string `protobuf:"bytes,6,opt,name=captured_at,json=capturedAt,proto3" json:"captured_at,omitempty"`
+}
+
+func (x *OddsPrice) Reset() {
+	*x = OddsPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odds_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OddsPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OddsPrice) ProtoMessage() {}
+
+func (x *OddsPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_odds_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OddsPrice.ProtoReflect.Descriptor instead.
+func (*OddsPrice) Descriptor() ([]byte, []int) {
+	return file_odds_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OddsPrice) GetBookmakerId() uint64 {
+	if x != nil {
+		return x.BookmakerId
+	}
+	return 0
+}
+
+func (x *OddsPrice) GetBookmaker() string {
+	if x != nil {
+		return x.Bookmaker
+	}
+	return ""
+}
+
+func (x *OddsPrice) GetSelection() string {
+	if x != nil {
+		return x.Selection
+	}
+	return ""
+}
+
+func (x *OddsPrice) GetLine() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Line
+	}
+	return nil
+}
+
+func (x *OddsPrice) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OddsPrice) GetCapturedAt() string {
+	if x != nil {
+		return x.CapturedAt
+	}
+	return ""
+}
+
+var File_odds_proto protoreflect.FileDescriptor
+
+var file_odds_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x6f, 0x64, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6f, 0x0a,
+	0x11, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4f, 0x64, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x0c, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0xed,
+	0x01, 0x0a, 0x12, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4f, 0x64, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x69, 0x78, 0x74, 0x75,
+	0x72, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x07,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x4f, 0x64, 0x64, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4f, 0x64, 0x64, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x07, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4f, 0x64, 0x64, 0x73,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xd3,
+	0x01, 0x0a, 0x09, 0x4f, 0x64, 0x64, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x04, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72,
+	0x65, 0x64, 0x41, 0x74, 0x32, 0x69, 0x0a, 0x0b, 0x4f, 0x64, 0x64, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x4f, 0x64, 0x64, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4f, 0x64, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x4f, 0x64, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x6f, 0x2d, 0x66, 0x6f, 0x6f, 0x74, 0x62, 0x61, 0x6c, 0x6c, 0x2d, 0x64, 0x61, 0x74,
+	0x61, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x67,
+	0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_odds_proto_rawDescOnce sync.Once
+	file_odds_proto_rawDescData = file_odds_proto_rawDesc
+)
+
+func file_odds_proto_rawDescGZIP() []byte {
+	file_odds_proto_rawDescOnce.Do(func() {
+		file_odds_proto_rawDescData = protoimpl.X.CompressGZIP(file_odds_proto_rawDescData)
+	})
+	return file_odds_proto_rawDescData
+}
+
+var file_odds_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_odds_proto_goTypes = []interface{}{
+	(*MarketOddsRequest)(nil),      // 0: statistico.data.MarketOddsRequest
+	(*MarketOddsResponse)(nil),     // 1: statistico.data.MarketOddsResponse
+	(*OddsPrice)(nil),              // 2: statistico.data.OddsPrice
+	(*wrapperspb.StringValue)(nil), // 3: google.protobuf.StringValue
+}
+var file_odds_proto_depIdxs = []int32{
+	2, // 0: statistico.data.MarketOddsResponse.opening:type_name -> statistico.data.OddsPrice
+	2, // 1: statistico.data.MarketOddsResponse.closing:type_name -> statistico.data.OddsPrice
+	2, // 2: statistico.data.MarketOddsResponse.history:type_name -> statistico.data.OddsPrice
+	3, // 3: statistico.data.OddsPrice.line:type_name -> google.protobuf.StringValue
+	0, // 4: statistico.data.OddsService.GetMarketOdds:input_type -> statistico.data.MarketOddsRequest
+	1, // 5: statistico.data.OddsService.GetMarketOdds:output_type -> statistico.data.MarketOddsResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_odds_proto_init() }
+func file_odds_proto_init() {
+	if File_odds_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_odds_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketOddsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odds_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketOddsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odds_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OddsPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_odds_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_odds_proto_goTypes,
+		DependencyIndexes: file_odds_proto_depIdxs,
+		MessageInfos:      file_odds_proto_msgTypes,
+	}.Build()
+	File_odds_proto = out.File
+	file_odds_proto_rawDesc = nil
+	file_odds_proto_goTypes = nil
+	file_odds_proto_depIdxs = nil
+}
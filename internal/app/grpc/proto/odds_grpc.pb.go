@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.14.0
+// source: odds.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// OddsServiceClient is the client API for OddsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OddsServiceClient interface {
+	// Returns opening, closing and the full price history for a fixture and market
+	GetMarketOdds(ctx context.Context, in *MarketOddsRequest, opts ...grpc.CallOption) (*MarketOddsResponse, error)
+}
+
+type oddsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOddsServiceClient(cc grpc.ClientConnInterface) OddsServiceClient {
+	return &oddsServiceClient{cc}
+}
+
+func (c *oddsServiceClient) GetMarketOdds(ctx context.Context, in *MarketOddsRequest, opts ...grpc.CallOption) (*MarketOddsResponse, error) {
+	out := new(MarketOddsResponse)
+	err := c.cc.Invoke(ctx, "/statistico.data.OddsService/GetMarketOdds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OddsServiceServer is the server API for OddsService service.
+// All implementations must embed UnimplementedOddsServiceServer
+// for forward compatibility
+type OddsServiceServer interface {
+	// Returns opening, closing and the full price history for a fixture and market
+	GetMarketOdds(context.Context, *MarketOddsRequest) (*MarketOddsResponse, error)
+	mustEmbedUnimplementedOddsServiceServer()
+}
+
+// UnimplementedOddsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedOddsServiceServer struct {
+}
+
+func (UnimplementedOddsServiceServer) GetMarketOdds(context.Context, *MarketOddsRequest) (*MarketOddsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketOdds not implemented")
+}
+func (UnimplementedOddsServiceServer) mustEmbedUnimplementedOddsServiceServer() {}
+
+// UnsafeOddsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OddsServiceServer will
+// result in compilation errors.
+type UnsafeOddsServiceServer interface {
+	mustEmbedUnimplementedOddsServiceServer()
+}
+
+func RegisterOddsServiceServer(s grpc.ServiceRegistrar, srv OddsServiceServer) {
+	s.RegisterService(&OddsService_ServiceDesc, srv)
+}
+
+func _OddsService_GetMarketOdds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketOddsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OddsServiceServer).GetMarketOdds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/statistico.data.OddsService/GetMarketOdds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OddsServiceServer).GetMarketOdds(ctx, req.(*MarketOddsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OddsService_ServiceDesc is the grpc.ServiceDesc for OddsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OddsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "statistico.data.OddsService",
+	HandlerType: (*OddsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMarketOdds",
+			Handler:    _OddsService_GetMarketOdds_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "odds.proto",
+}
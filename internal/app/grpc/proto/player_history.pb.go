@@ -0,0 +1,353 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: player_history.proto
+
+package proto
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type PlayerClubHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId uint64 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *PlayerClubHistoryRequest) Reset() {
+	*x = PlayerClubHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_player_history_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerClubHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerClubHistoryRequest) ProtoMessage() {}
+
+func (x *PlayerClubHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_history_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerClubHistoryRequest.ProtoReflect.Descriptor instead.
+func (*PlayerClubHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_player_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlayerClubHistoryRequest) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+type PlayerClubHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId uint64       `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Clubs    []*ClubSpell `protobuf:"bytes,2,rep,name=clubs,proto3" json:"clubs,omitempty"`
+}
+
+func (x *PlayerClubHistoryResponse) Reset() {
+	*x = PlayerClubHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_player_history_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerClubHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerClubHistoryResponse) ProtoMessage() {}
+
+func (x *PlayerClubHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_history_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerClubHistoryResponse.ProtoReflect.Descriptor instead.
+func (*PlayerClubHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_player_history_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlayerClubHistoryResponse) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayerClubHistoryResponse) GetClubs() []*ClubSpell {
+	if x != nil {
+		return x.Clubs
+	}
+	return nil
+}
+
+type ClubSpell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeamId uint64 `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// RFC3339 formatted string of the date the player joined the team
+	JoinedDate string `protobuf:"bytes,2,opt,name=joined_date,json=joinedDate,proto3" json:"joined_date,omitempty"`
+	// RFC3339 formatted string of the date the player left the team, null if the player remains at the team
+	LeftDate *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=left_date,json=leftDate,proto3" json:"left_date,omitempty"`
+	// Description of the transfer the player joined on provided by the data provider i.e. "Transfer" or "Loan"
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// Transfer fee as reported by the data provider, null if undisclosed
+	Fee *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (x *ClubSpell) Reset() {
+	*x = ClubSpell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_player_history_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClubSpell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClubSpell) ProtoMessage() {}
+
+func (x *ClubSpell) ProtoReflect() protoreflect.Message {
+	mi := &file_player_history_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClubSpell.ProtoReflect.Descriptor instead.
+func (*ClubSpell) Descriptor() ([]byte, []int) {
+	return file_player_history_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClubSpell) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *ClubSpell) GetJoinedDate() string {
+	if x != nil {
+		return x.JoinedDate
+	}
+	return ""
+}
+
+func (x *ClubSpell) GetLeftDate() *wrapperspb.StringValue {
+	if x != nil {
+		return x.LeftDate
+	}
+	return nil
+}
+
+func (x *ClubSpell) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ClubSpell) GetFee() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+var File_player_history_proto protoreflect.FileDescriptor
+
+var file_player_history_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x37, 0x0a, 0x18, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x43, 0x6c, 0x75, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x6a, 0x0a, 0x19, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6c, 0x75, 0x62, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x63, 0x6c,
+	0x75, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x6c, 0x75, 0x62,
+	0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x6c, 0x75, 0x62, 0x73, 0x22, 0xc4, 0x01, 0x0a,
+	0x09, 0x43, 0x6c, 0x75, 0x62, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65,
+	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03,
+	0x66, 0x65, 0x65, 0x32, 0x80, 0x01, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x43, 0x6c, 0x75, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6c, 0x75, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x43, 0x6c, 0x75, 0x62, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2d, 0x66, 0x6f, 0x6f, 0x74, 0x62,
+	0x61, 0x6c, 0x6c, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_player_history_proto_rawDescOnce sync.Once
+	file_player_history_proto_rawDescData = file_player_history_proto_rawDesc
+)
+
+func file_player_history_proto_rawDescGZIP() []byte {
+	file_player_history_proto_rawDescOnce.Do(func() {
+		file_player_history_proto_rawDescData = protoimpl.X.CompressGZIP(file_player_history_proto_rawDescData)
+	})
+	return file_player_history_proto_rawDescData
+}
+
+var file_player_history_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_player_history_proto_goTypes = []interface{}{
+	(*PlayerClubHistoryRequest)(nil),  // 0: statistico.data.PlayerClubHistoryRequest
+	(*PlayerClubHistoryResponse)(nil), // 1: statistico.data.PlayerClubHistoryResponse
+	(*ClubSpell)(nil),                 // 2: statistico.data.ClubSpell
+	(*wrapperspb.StringValue)(nil),    // 3: google.protobuf.StringValue
+}
+var file_player_history_proto_depIdxs = []int32{
+	2, // 0: statistico.data.PlayerClubHistoryResponse.clubs:type_name -> statistico.data.ClubSpell
+	3, // 1: statistico.data.ClubSpell.left_date:type_name -> google.protobuf.StringValue
+	3, // 2: statistico.data.ClubSpell.fee:type_name -> google.protobuf.StringValue
+	0, // 3: statistico.data.PlayerService.GetPlayerClubHistory:input_type -> statistico.data.PlayerClubHistoryRequest
+	1, // 4: statistico.data.PlayerService.GetPlayerClubHistory:output_type -> statistico.data.PlayerClubHistoryResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_player_history_proto_init() }
+func file_player_history_proto_init() {
+	if File_player_history_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_player_history_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerClubHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_player_history_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerClubHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_player_history_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClubSpell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_player_history_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_player_history_proto_goTypes,
+		DependencyIndexes: file_player_history_proto_depIdxs,
+		MessageInfos:      file_player_history_proto_msgTypes,
+	}.Build()
+	File_player_history_proto = out.File
+	file_player_history_proto_rawDesc = nil
+	file_player_history_proto_goTypes = nil
+	file_player_history_proto_depIdxs = nil
+}
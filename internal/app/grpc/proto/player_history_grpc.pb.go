@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.14.0
+// source: player_history.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PlayerServiceClient is the client API for PlayerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PlayerServiceClient interface {
+	// Returns the clubs a player has played for built from their transfers ordered by joined_date ascending
+	GetPlayerClubHistory(ctx context.Context, in *PlayerClubHistoryRequest, opts ...grpc.CallOption) (*PlayerClubHistoryResponse, error)
+}
+
+type playerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlayerServiceClient(cc grpc.ClientConnInterface) PlayerServiceClient {
+	return &playerServiceClient{cc}
+}
+
+func (c *playerServiceClient) GetPlayerClubHistory(ctx context.Context, in *PlayerClubHistoryRequest, opts ...grpc.CallOption) (*PlayerClubHistoryResponse, error) {
+	out := new(PlayerClubHistoryResponse)
+	err := c.cc.Invoke(ctx, "/statistico.data.PlayerService/GetPlayerClubHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlayerServiceServer is the server API for PlayerService service.
+// All implementations must embed UnimplementedPlayerServiceServer
+// for forward compatibility
+type PlayerServiceServer interface {
+	// Returns the clubs a player has played for built from their transfers ordered by joined_date ascending
+	GetPlayerClubHistory(context.Context, *PlayerClubHistoryRequest) (*PlayerClubHistoryResponse, error)
+	mustEmbedUnimplementedPlayerServiceServer()
+}
+
+// UnimplementedPlayerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPlayerServiceServer struct {
+}
+
+func (UnimplementedPlayerServiceServer) GetPlayerClubHistory(context.Context, *PlayerClubHistoryRequest) (*PlayerClubHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerClubHistory not implemented")
+}
+func (UnimplementedPlayerServiceServer) mustEmbedUnimplementedPlayerServiceServer() {}
+
+// UnsafePlayerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlayerServiceServer will
+// result in compilation errors.
+type UnsafePlayerServiceServer interface {
+	mustEmbedUnimplementedPlayerServiceServer()
+}
+
+func RegisterPlayerServiceServer(s grpc.ServiceRegistrar, srv PlayerServiceServer) {
+	s.RegisterService(&PlayerService_ServiceDesc, srv)
+}
+
+func _PlayerService_GetPlayerClubHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerClubHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).GetPlayerClubHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/statistico.data.PlayerService/GetPlayerClubHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).GetPlayerClubHistory(ctx, req.(*PlayerClubHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlayerService_ServiceDesc is the grpc.ServiceDesc for PlayerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlayerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "statistico.data.PlayerService",
+	HandlerType: (*PlayerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPlayerClubHistory",
+			Handler:    _PlayerService_GetPlayerClubHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "player_history.proto",
+}
@@ -0,0 +1,509 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: referee.proto
+
+package proto
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type RefereeStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefereeId uint64 `protobuf:"varint,1,opt,name=referee_id,json=refereeId,proto3" json:"referee_id,omitempty"`
+	// Optional filter to limit the fixtures stats are calculated from to specific seasons
+	SeasonIds []uint64 `protobuf:"varint,2,rep,packed,name=season_ids,json=seasonIds,proto3" json:"season_ids,omitempty"`
+	// Optional filter to limit the fixtures stats are calculated from to specific competitions
+	CompetitionIds []uint64 `protobuf:"varint,3,rep,packed,name=competition_ids,json=competitionIds,proto3" json:"competition_ids,omitempty"`
+}
+
+func (x *RefereeStatsRequest) Reset() {
+	*x = RefereeStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_referee_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefereeStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefereeStatsRequest) ProtoMessage() {}
+
+func (x *RefereeStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_referee_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefereeStatsRequest.ProtoReflect.Descriptor instead.
+func (*RefereeStatsRequest) Descriptor() ([]byte, []int) {
+	return file_referee_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RefereeStatsRequest) GetRefereeId() uint64 {
+	if x != nil {
+		return x.RefereeId
+	}
+	return 0
+}
+
+func (x *RefereeStatsRequest) GetSeasonIds() []uint64 {
+	if x != nil {
+		return x.SeasonIds
+	}
+	return nil
+}
+
+func (x *RefereeStatsRequest) GetCompetitionIds() []uint64 {
+	if x != nil {
+		return x.CompetitionIds
+	}
+	return nil
+}
+
+type RefereeStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Referee *Referee      `protobuf:"bytes,1,opt,name=referee,proto3" json:"referee,omitempty"`
+	Stats   *RefereeStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *RefereeStatsResponse) Reset() {
+	*x = RefereeStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_referee_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefereeStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefereeStatsResponse) ProtoMessage() {}
+
+func (x *RefereeStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_referee_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefereeStatsResponse.ProtoReflect.Descriptor instead.
+func (*RefereeStatsResponse) Descriptor() ([]byte, []int) {
+	return file_referee_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RefereeStatsResponse) GetReferee() *Referee {
+	if x != nil {
+		return x.Referee
+	}
+	return nil
+}
+
+func (x *RefereeStatsResponse) GetStats() *RefereeStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type Referee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CommonName string `protobuf:"bytes,2,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	FullName   string `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	FirstName  string `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName   string `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+}
+
+func (x *Referee) Reset() {
+	*x = Referee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_referee_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Referee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Referee) ProtoMessage() {}
+
+func (x *Referee) ProtoReflect() protoreflect.Message {
+	mi := &file_referee_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Referee.ProtoReflect.Descriptor instead.
+func (*Referee) Descriptor() ([]byte, []int) {
+	return file_referee_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Referee) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Referee) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *Referee) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Referee) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Referee) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+type RefereeStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fixtures    uint32 `protobuf:"varint,1,opt,name=fixtures,proto3" json:"fixtures,omitempty"`
+	YellowCards uint32 `protobuf:"varint,2,opt,name=yellow_cards,json=yellowCards,proto3" json:"yellow_cards,omitempty"`
+	// Includes second yellow cards
+	RedCards           uint32  `protobuf:"varint,3,opt,name=red_cards,json=redCards,proto3" json:"red_cards,omitempty"`
+	Fouls              uint32  `protobuf:"varint,4,opt,name=fouls,proto3" json:"fouls,omitempty"`
+	Penalties          uint32  `protobuf:"varint,5,opt,name=penalties,proto3" json:"penalties,omitempty"`
+	YellowCardsPerGame float32 `protobuf:"fixed32,6,opt,name=yellow_cards_per_game,json=yellowCardsPerGame,proto3" json:"yellow_cards_per_game,omitempty"`
+	RedCardsPerGame    float32 `protobuf:"fixed32,7,opt,name=red_cards_per_game,json=redCardsPerGame,proto3" json:"red_cards_per_game,omitempty"`
+	FoulsPerGame       float32 `protobuf:"fixed32,8,opt,name=fouls_per_game,json=foulsPerGame,proto3" json:"fouls_per_game,omitempty"`
+	PenaltiesPerGame   float32 `protobuf:"fixed32,9,opt,name=penalties_per_game,json=penaltiesPerGame,proto3" json:"penalties_per_game,omitempty"`
+}
+
+func (x *RefereeStats) Reset() {
+	*x = RefereeStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_referee_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefereeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefereeStats) ProtoMessage() {}
+
+func (x *RefereeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_referee_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefereeStats.ProtoReflect.Descriptor instead.
+func (*RefereeStats) Descriptor() ([]byte, []int) {
+	return file_referee_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RefereeStats) GetFixtures() uint32 {
+	if x != nil {
+		return x.Fixtures
+	}
+	return 0
+}
+
+func (x *RefereeStats) GetYellowCards() uint32 {
+	if x != nil {
+		return x.YellowCards
+	}
+	return 0
+}
+
+func (x *RefereeStats) GetRedCards() uint32 {
+	if x != nil {
+		return x.RedCards
+	}
+	return 0
+}
+
+func (x *RefereeStats) GetFouls() uint32 {
+	if x != nil {
+		return x.Fouls
+	}
+	return 0
+}
+
+func (x *RefereeStats) GetPenalties() uint32 {
+	if x != nil {
+		return x.Penalties
+	}
+	return 0
+}
+
+func (x *RefereeStats) GetYellowCardsPerGame() float32 {
+	if x != nil {
+		return x.YellowCardsPerGame
+	}
+	return 0
+}
+
+func (x *RefereeStats) GetRedCardsPerGame() float32 {
+	if x != nil {
+		return x.RedCardsPerGame
+	}
+	return 0
+}
+
+func (x *RefereeStats) GetFoulsPerGame() float32 {
+	if x != nil {
+		return x.FoulsPerGame
+	}
+	return 0
+}
+
+func (x *RefereeStats) GetPenaltiesPerGame() float32 {
+	if x != nil {
+		return x.PenaltiesPerGame
+	}
+	return 0
+}
+
+var File_referee_proto protoreflect.FileDescriptor
+
+var file_referee_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x7c, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x73, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0e,
+	0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x7f,
+	0x0a, 0x14, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x65, 0x52, 0x07, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22,
+	0x93, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd2, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x79, 0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x61, 0x72,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x79, 0x65, 0x6c, 0x6c, 0x6f, 0x77,
+	0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x72,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x64, 0x43, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x79, 0x65, 0x6c, 0x6c, 0x6f, 0x77,
+	0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x79, 0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x61, 0x72,
+	0x64, 0x73, 0x50, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x72, 0x65, 0x64,
+	0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x72, 0x65, 0x64, 0x43, 0x61, 0x72, 0x64, 0x73, 0x50,
+	0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x6f, 0x75, 0x6c, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c,
+	0x66, 0x6f, 0x75, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x61,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x32, 0x72, 0x0a, 0x0e, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x60, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x24, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x48,
+	0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x6f, 0x2d, 0x66, 0x6f, 0x6f, 0x74, 0x62, 0x61, 0x6c, 0x6c, 0x2d, 0x64, 0x61, 0x74, 0x61,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_referee_proto_rawDescOnce sync.Once
+	file_referee_proto_rawDescData = file_referee_proto_rawDesc
+)
+
+func file_referee_proto_rawDescGZIP() []byte {
+	file_referee_proto_rawDescOnce.Do(func() {
+		file_referee_proto_rawDescData = protoimpl.X.CompressGZIP(file_referee_proto_rawDescData)
+	})
+	return file_referee_proto_rawDescData
+}
+
+var file_referee_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_referee_proto_goTypes = []interface{}{
+	(*RefereeStatsRequest)(nil),  // 0: statistico.data.RefereeStatsRequest
+	(*RefereeStatsResponse)(nil), // 1: statistico.data.RefereeStatsResponse
+	(*Referee)(nil),              // 2: statistico.data.Referee
+	(*RefereeStats)(nil),         // 3: statistico.data.RefereeStats
+}
+var file_referee_proto_depIdxs = []int32{
+	2, // 0: statistico.data.RefereeStatsResponse.referee:type_name -> statistico.data.Referee
+	3, // 1: statistico.data.RefereeStatsResponse.stats:type_name -> statistico.data.RefereeStats
+	0, // 2: statistico.data.RefereeService.GetRefereeStats:input_type -> statistico.data.RefereeStatsRequest
+	1, // 3: statistico.data.RefereeService.GetRefereeStats:output_type -> statistico.data.RefereeStatsResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_referee_proto_init() }
+func file_referee_proto_init() {
+	if File_referee_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_referee_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefereeStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_referee_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefereeStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_referee_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Referee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_referee_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefereeStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_referee_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_referee_proto_goTypes,
+		DependencyIndexes: file_referee_proto_depIdxs,
+		MessageInfos:      file_referee_proto_msgTypes,
+	}.Build()
+	File_referee_proto = out.File
+	file_referee_proto_rawDesc = nil
+	file_referee_proto_goTypes = nil
+	file_referee_proto_depIdxs = nil
+}
@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.14.0
+// source: referee.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RefereeServiceClient is the client API for RefereeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RefereeServiceClient interface {
+	// Returns referee details alongside card, foul and penalty tendencies from the fixtures they have officiated
+	GetRefereeStats(ctx context.Context, in *RefereeStatsRequest, opts ...grpc.CallOption) (*RefereeStatsResponse, error)
+}
+
+type refereeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRefereeServiceClient(cc grpc.ClientConnInterface) RefereeServiceClient {
+	return &refereeServiceClient{cc}
+}
+
+func (c *refereeServiceClient) GetRefereeStats(ctx context.Context, in *RefereeStatsRequest, opts ...grpc.CallOption) (*RefereeStatsResponse, error) {
+	out := new(RefereeStatsResponse)
+	err := c.cc.Invoke(ctx, "/statistico.data.RefereeService/GetRefereeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RefereeServiceServer is the server API for RefereeService service.
+// All implementations must embed UnimplementedRefereeServiceServer
+// for forward compatibility
+type RefereeServiceServer interface {
+	// Returns referee details alongside card, foul and penalty tendencies from the fixtures they have officiated
+	GetRefereeStats(context.Context, *RefereeStatsRequest) (*RefereeStatsResponse, error)
+	mustEmbedUnimplementedRefereeServiceServer()
+}
+
+// UnimplementedRefereeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRefereeServiceServer struct {
+}
+
+func (UnimplementedRefereeServiceServer) GetRefereeStats(context.Context, *RefereeStatsRequest) (*RefereeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRefereeStats not implemented")
+}
+func (UnimplementedRefereeServiceServer) mustEmbedUnimplementedRefereeServiceServer() {}
+
+// UnsafeRefereeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RefereeServiceServer will
+// result in compilation errors.
+type UnsafeRefereeServiceServer interface {
+	mustEmbedUnimplementedRefereeServiceServer()
+}
+
+func RegisterRefereeServiceServer(s grpc.ServiceRegistrar, srv RefereeServiceServer) {
+	s.RegisterService(&RefereeService_ServiceDesc, srv)
+}
+
+func _RefereeService_GetRefereeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefereeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RefereeServiceServer).GetRefereeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/statistico.data.RefereeService/GetRefereeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RefereeServiceServer).GetRefereeStats(ctx, req.(*RefereeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RefereeService_ServiceDesc is the grpc.ServiceDesc for RefereeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RefereeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "statistico.data.RefereeService",
+	HandlerType: (*RefereeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRefereeStats",
+			Handler:    _RefereeService_GetRefereeStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "referee.proto",
+}
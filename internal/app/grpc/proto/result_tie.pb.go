@@ -0,0 +1,452 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: result_tie.proto
+
+package proto
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// The messages in this file are used by the GetTie and ListStageTies RPCs added to statistico.ResultService. GetTie
+// returns the knockout tie a fixture is a leg of with aggregate score and winner. ListStageTies returns every knockout tie
+// of a stage ordered by the date of the first leg.
+type TieRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FixtureId uint64 `protobuf:"varint,1,opt,name=fixture_id,json=fixtureId,proto3" json:"fixture_id,omitempty"`
+}
+
+func (x *TieRequest) Reset() {
+	*x = TieRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_result_tie_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TieRequest) ProtoMessage() {}
+
+func (x *TieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_result_tie_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TieRequest.ProtoReflect.Descriptor instead.
+func (*TieRequest) Descriptor() ([]byte, []int) {
+	return file_result_tie_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TieRequest) GetFixtureId() uint64 {
+	if x != nil {
+		return x.FixtureId
+	}
+	return 0
+}
+
+type StageTiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StageId uint64 `protobuf:"varint,1,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+}
+
+func (x *StageTiesRequest) Reset() {
+	*x = StageTiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_result_tie_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StageTiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageTiesRequest) ProtoMessage() {}
+
+func (x *StageTiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_result_tie_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageTiesRequest.ProtoReflect.Descriptor instead.
+func (*StageTiesRequest) Descriptor() ([]byte, []int) {
+	return file_result_tie_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StageTiesRequest) GetStageId() uint64 {
+	if x != nil {
+		return x.StageId
+	}
+	return 0
+}
+
+type StageTiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ties []*Tie `protobuf:"bytes,1,rep,name=ties,proto3" json:"ties,omitempty"`
+}
+
+func (x *StageTiesResponse) Reset() {
+	*x = StageTiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_result_tie_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StageTiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageTiesResponse) ProtoMessage() {}
+
+func (x *StageTiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_result_tie_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageTiesResponse.ProtoReflect.Descriptor instead.
+func (*StageTiesResponse) Descriptor() ([]byte, []int) {
+	return file_result_tie_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StageTiesResponse) GetTies() []*Tie {
+	if x != nil {
+		return x.Ties
+	}
+	return nil
+}
+
+type Tie struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Null for single leg ties
+	AggregateId *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=aggregate_id,json=aggregateId,proto3" json:"aggregate_id,omitempty"`
+	StageId     *wrapperspb.UInt64Value `protobuf:"bytes,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	// Fixture IDs ordered by date
+	FixtureIds []uint64 `protobuf:"varint,3,rep,packed,name=fixture_ids,json=fixtureIds,proto3" json:"fixture_ids,omitempty"`
+	// Home team of the first leg
+	HomeTeamId uint64 `protobuf:"varint,4,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
+	AwayTeamId uint64 `protobuf:"varint,5,opt,name=away_team_id,json=awayTeamId,proto3" json:"away_team_id,omitempty"`
+	// Aggregate score across every leg played from the perspective of the first leg
+	HomeScore uint32                  `protobuf:"varint,6,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore uint32                  `protobuf:"varint,7,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	Complete  bool                    `protobuf:"varint,8,opt,name=complete,proto3" json:"complete,omitempty"`
+	WinnerId  *wrapperspb.UInt64Value `protobuf:"bytes,9,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	// One of aggregate, away_goals or penalties. Empty if the tie has no winner
+	DecidedBy string `protobuf:"bytes,10,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+}
+
+func (x *Tie) Reset() {
+	*x = Tie{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_result_tie_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tie) ProtoMessage() {}
+
+func (x *Tie) ProtoReflect() protoreflect.Message {
+	mi := &file_result_tie_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tie.ProtoReflect.Descriptor instead.
+func (*Tie) Descriptor() ([]byte, []int) {
+	return file_result_tie_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Tie) GetAggregateId() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.AggregateId
+	}
+	return nil
+}
+
+func (x *Tie) GetStageId() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.StageId
+	}
+	return nil
+}
+
+func (x *Tie) GetFixtureIds() []uint64 {
+	if x != nil {
+		return x.FixtureIds
+	}
+	return nil
+}
+
+func (x *Tie) GetHomeTeamId() uint64 {
+	if x != nil {
+		return x.HomeTeamId
+	}
+	return 0
+}
+
+func (x *Tie) GetAwayTeamId() uint64 {
+	if x != nil {
+		return x.AwayTeamId
+	}
+	return 0
+}
+
+func (x *Tie) GetHomeScore() uint32 {
+	if x != nil {
+		return x.HomeScore
+	}
+	return 0
+}
+
+func (x *Tie) GetAwayScore() uint32 {
+	if x != nil {
+		return x.AwayScore
+	}
+	return 0
+}
+
+func (x *Tie) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *Tie) GetWinnerId() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.WinnerId
+	}
+	return nil
+}
+
+func (x *Tie) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+var File_result_tie_proto protoreflect.FileDescriptor
+
+var file_result_tie_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x69, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x0a, 0x54, 0x69, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64,
+	0x22, 0x2d, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x67, 0x65, 0x54, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22,
+	0x3d, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x67, 0x65, 0x54, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x69, 0x65, 0x52, 0x04, 0x74, 0x69, 0x65, 0x73, 0x22, 0x98,
+	0x03, 0x0a, 0x03, 0x54, 0x69, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x73, 0x74, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64,
+	0x73, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x77, 0x61, 0x79, 0x5f, 0x74, 0x65, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x77, 0x61, 0x79, 0x54,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x68, 0x6f, 0x6d, 0x65, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x77, 0x61, 0x79, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x77, 0x61, 0x79, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x39, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65,
+	0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x42, 0x79, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x6f, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2d, 0x66, 0x6f,
+	0x6f, 0x74, 0x62, 0x61, 0x6c, 0x6c, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_result_tie_proto_rawDescOnce sync.Once
+	file_result_tie_proto_rawDescData = file_result_tie_proto_rawDesc
+)
+
+func file_result_tie_proto_rawDescGZIP() []byte {
+	file_result_tie_proto_rawDescOnce.Do(func() {
+		file_result_tie_proto_rawDescData = protoimpl.X.CompressGZIP(file_result_tie_proto_rawDescData)
+	})
+	return file_result_tie_proto_rawDescData
+}
+
+var file_result_tie_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_result_tie_proto_goTypes = []interface{}{
+	(*TieRequest)(nil),             // 0: statistico.data.TieRequest
+	(*StageTiesRequest)(nil),       // 1: statistico.data.StageTiesRequest
+	(*StageTiesResponse)(nil),      // 2: statistico.data.StageTiesResponse
+	(*Tie)(nil),                    // 3: statistico.data.Tie
+	(*wrapperspb.UInt64Value)(nil), // 4: google.protobuf.UInt64Value
+}
+var file_result_tie_proto_depIdxs = []int32{
+	3, // 0: statistico.data.StageTiesResponse.ties:type_name -> statistico.data.Tie
+	4, // 1: statistico.data.Tie.aggregate_id:type_name -> google.protobuf.UInt64Value
+	4, // 2: statistico.data.Tie.stage_id:type_name -> google.protobuf.UInt64Value
+	4, // 3: statistico.data.Tie.winner_id:type_name -> google.protobuf.UInt64Value
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_result_tie_proto_init() }
+func file_result_tie_proto_init() {
+	if File_result_tie_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_result_tie_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TieRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_result_tie_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StageTiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_result_tie_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StageTiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_result_tie_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tie); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_result_tie_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_result_tie_proto_goTypes,
+		DependencyIndexes: file_result_tie_proto_depIdxs,
+		MessageInfos:      file_result_tie_proto_msgTypes,
+	}.Build()
+	File_result_tie_proto = out.File
+	file_result_tie_proto_rawDesc = nil
+	file_result_tie_proto_goTypes = nil
+	file_result_tie_proto_depIdxs = nil
+}
@@ -0,0 +1,472 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: standings.proto
+
+package proto
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type StandingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SeasonId uint64 `protobuf:"varint,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	// Optional round the table is returned for. The snapshot persisted for the round is returned if one exists
+	RoundId *wrapperspb.UInt64Value `protobuf:"bytes,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	// Optional RFC3339 formatted string i.e "2006-01-02T15:04:05Z07:00", defaults to now. Ignored if round_id is provided
+	Date string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	// One of overall, home or away, defaults to overall
+	Table string `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
+}
+
+func (x *StandingsRequest) Reset() {
+	*x = StandingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_standings_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingsRequest) ProtoMessage() {}
+
+func (x *StandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_standings_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingsRequest.ProtoReflect.Descriptor instead.
+func (*StandingsRequest) Descriptor() ([]byte, []int) {
+	return file_standings_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StandingsRequest) GetSeasonId() uint64 {
+	if x != nil {
+		return x.SeasonId
+	}
+	return 0
+}
+
+func (x *StandingsRequest) GetRoundId() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.RoundId
+	}
+	return nil
+}
+
+func (x *StandingsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *StandingsRequest) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+type StandingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SeasonId  uint64                  `protobuf:"varint,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	RoundId   *wrapperspb.UInt64Value `protobuf:"bytes,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	Table     string                  `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	Standings []*Standing             `protobuf:"bytes,4,rep,name=standings,proto3" json:"standings,omitempty"`
+}
+
+func (x *StandingsResponse) Reset() {
+	*x = StandingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_standings_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StandingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingsResponse) ProtoMessage() {}
+
+func (x *StandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_standings_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingsResponse.ProtoReflect.Descriptor instead.
+func (*StandingsResponse) Descriptor() ([]byte, []int) {
+	return file_standings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StandingsResponse) GetSeasonId() uint64 {
+	if x != nil {
+		return x.SeasonId
+	}
+	return 0
+}
+
+func (x *StandingsResponse) GetRoundId() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.RoundId
+	}
+	return nil
+}
+
+func (x *StandingsResponse) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *StandingsResponse) GetStandings() []*Standing {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+type Standing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position       uint32 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	TeamId         uint64 `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Played         uint32 `protobuf:"varint,3,opt,name=played,proto3" json:"played,omitempty"`
+	Won            uint32 `protobuf:"varint,4,opt,name=won,proto3" json:"won,omitempty"`
+	Drawn          uint32 `protobuf:"varint,5,opt,name=drawn,proto3" json:"drawn,omitempty"`
+	Lost           uint32 `protobuf:"varint,6,opt,name=lost,proto3" json:"lost,omitempty"`
+	GoalsFor       uint32 `protobuf:"varint,7,opt,name=goals_for,json=goalsFor,proto3" json:"goals_for,omitempty"`
+	GoalsAgainst   uint32 `protobuf:"varint,8,opt,name=goals_against,json=goalsAgainst,proto3" json:"goals_against,omitempty"`
+	GoalDifference int32  `protobuf:"varint,9,opt,name=goal_difference,json=goalDifference,proto3" json:"goal_difference,omitempty"`
+	Points         uint32 `protobuf:"varint,10,opt,name=points,proto3" json:"points,omitempty"`
+	// The stage and group the table belongs to, null for fixtures without a stage or group
+	StageId *wrapperspb.UInt64Value `protobuf:"bytes,11,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	GroupId *wrapperspb.UInt64Value `protobuf:"bytes,12,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *Standing) Reset() {
+	*x = Standing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_standings_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Standing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Standing) ProtoMessage() {}
+
+func (x *Standing) ProtoReflect() protoreflect.Message {
+	mi := &file_standings_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Standing.ProtoReflect.Descriptor instead.
+func (*Standing) Descriptor() ([]byte, []int) {
+	return file_standings_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Standing) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Standing) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *Standing) GetPlayed() uint32 {
+	if x != nil {
+		return x.Played
+	}
+	return 0
+}
+
+func (x *Standing) GetWon() uint32 {
+	if x != nil {
+		return x.Won
+	}
+	return 0
+}
+
+func (x *Standing) GetDrawn() uint32 {
+	if x != nil {
+		return x.Drawn
+	}
+	return 0
+}
+
+func (x *Standing) GetLost() uint32 {
+	if x != nil {
+		return x.Lost
+	}
+	return 0
+}
+
+func (x *Standing) GetGoalsFor() uint32 {
+	if x != nil {
+		return x.GoalsFor
+	}
+	return 0
+}
+
+func (x *Standing) GetGoalsAgainst() uint32 {
+	if x != nil {
+		return x.GoalsAgainst
+	}
+	return 0
+}
+
+func (x *Standing) GetGoalDifference() int32 {
+	if x != nil {
+		return x.GoalDifference
+	}
+	return 0
+}
+
+func (x *Standing) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Standing) GetStageId() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.StageId
+	}
+	return nil
+}
+
+func (x *Standing) GetGroupId() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.GroupId
+	}
+	return nil
+}
+
+var File_standings_proto protoreflect.FileDescriptor
+
+var file_standings_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x88, 0x03, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65,
+	0x61, 0x6d, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x77, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x77, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64,
+	0x72, 0x61, 0x77, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x6c, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x6f, 0x61, 0x6c,
+	0x73, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x67, 0x6f, 0x61,
+	0x6c, 0x73, 0x46, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x5f, 0x61,
+	0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x67, 0x6f,
+	0x61, 0x6c, 0x73, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x6f,
+	0x61, 0x6c, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x67, 0x6f, 0x61, 0x6c, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x73,
+	0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x73, 0x74, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x32, 0x6b, 0x0a,
+	0x10, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x57, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x6f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x6f, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x6f, 0x2d, 0x66,
+	0x6f, 0x6f, 0x74, 0x62, 0x61, 0x6c, 0x6c, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_standings_proto_rawDescOnce sync.Once
+	file_standings_proto_rawDescData = file_standings_proto_rawDesc
+)
+
+func file_standings_proto_rawDescGZIP() []byte {
+	file_standings_proto_rawDescOnce.Do(func() {
+		file_standings_proto_rawDescData = protoimpl.X.CompressGZIP(file_standings_proto_rawDescData)
+	})
+	return file_standings_proto_rawDescData
+}
+
+var file_standings_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_standings_proto_goTypes = []interface{}{
+	(*StandingsRequest)(nil),       // 0: statistico.data.StandingsRequest
+	(*StandingsResponse)(nil),      // 1: statistico.data.StandingsResponse
+	(*Standing)(nil),               // 2: statistico.data.Standing
+	(*wrapperspb.UInt64Value)(nil), // 3: google.protobuf.UInt64Value
+}
+var file_standings_proto_depIdxs = []int32{
+	3, // 0: statistico.data.StandingsRequest.round_id:type_name -> google.protobuf.UInt64Value
+	3, // 1: statistico.data.StandingsResponse.round_id:type_name -> google.protobuf.UInt64Value
+	2, // 2: statistico.data.StandingsResponse.standings:type_name -> statistico.data.Standing
+	3, // 3: statistico.data.Standing.stage_id:type_name -> google.protobuf.UInt64Value
+	3, // 4: statistico.data.Standing.group_id:type_name -> google.protobuf.UInt64Value
+	0, // 5: statistico.data.StandingsService.GetStandings:input_type -> statistico.data.StandingsRequest
+	1, // 6: statistico.data.StandingsService.GetStandings:output_type -> statistico.data.StandingsResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_standings_proto_init() }
+func file_standings_proto_init() {
+	if File_standings_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_standings_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StandingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_standings_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StandingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_standings_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Standing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_standings_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_standings_proto_goTypes,
+		DependencyIndexes: file_standings_proto_depIdxs,
+		MessageInfos:      file_standings_proto_msgTypes,
+	}.Build()
+	File_standings_proto = out.File
+	file_standings_proto_rawDesc = nil
+	file_standings_proto_goTypes = nil
+	file_standings_proto_depIdxs = nil
+}
@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.14.0
+// source: standings.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// StandingsServiceClient is the client API for StandingsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StandingsServiceClient interface {
+	// Returns the league table for a season as it stood at the end of a round or on a given date
+	GetStandings(ctx context.Context, in *StandingsRequest, opts ...grpc.CallOption) (*StandingsResponse, error)
+}
+
+type standingsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStandingsServiceClient(cc grpc.ClientConnInterface) StandingsServiceClient {
+	return &standingsServiceClient{cc}
+}
+
+func (c *standingsServiceClient) GetStandings(ctx context.Context, in *StandingsRequest, opts ...grpc.CallOption) (*StandingsResponse, error) {
+	out := new(StandingsResponse)
+	err := c.cc.Invoke(ctx, "/statistico.data.StandingsService/GetStandings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StandingsServiceServer is the server API for StandingsService service.
+// All implementations must embed UnimplementedStandingsServiceServer
+// for forward compatibility
+type StandingsServiceServer interface {
+	// Returns the league table for a season as it stood at the end of a round or on a given date
+	GetStandings(context.Context, *StandingsRequest) (*StandingsResponse, error)
+	mustEmbedUnimplementedStandingsServiceServer()
+}
+
+// UnimplementedStandingsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedStandingsServiceServer struct {
+}
+
+func (UnimplementedStandingsServiceServer) GetStandings(context.Context, *StandingsRequest) (*StandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStandings not implemented")
+}
+func (UnimplementedStandingsServiceServer) mustEmbedUnimplementedStandingsServiceServer() {}
+
+// UnsafeStandingsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StandingsServiceServer will
+// result in compilation errors.
+type UnsafeStandingsServiceServer interface {
+	mustEmbedUnimplementedStandingsServiceServer()
+}
+
+func RegisterStandingsServiceServer(s grpc.ServiceRegistrar, srv StandingsServiceServer) {
+	s.RegisterService(&StandingsService_ServiceDesc, srv)
+}
+
+func _StandingsService_GetStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingsServiceServer).GetStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/statistico.data.StandingsService/GetStandings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingsServiceServer).GetStandings(ctx, req.(*StandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StandingsService_ServiceDesc is the grpc.ServiceDesc for StandingsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StandingsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "statistico.data.StandingsService",
+	HandlerType: (*StandingsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStandings",
+			Handler:    _StandingsService_GetStandings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "standings.proto",
+}
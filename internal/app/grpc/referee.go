@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/factory"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RefereeService struct {
+	refereeRepo app.RefereeRepository
+	logger      *logrus.Logger
+	proto.UnimplementedRefereeServiceServer
+}
+
+func (s *RefereeService) GetRefereeStats(c context.Context, r *proto.RefereeStatsRequest) (*proto.RefereeStatsResponse, error) {
+	ref, err := s.refereeRepo.ByID(r.GetRefereeId())
+
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("referee with ID %d does not exist", r.GetRefereeId()))
+	}
+
+	query := app.RefereeStatsQuery{
+		SeasonIDs:      r.GetSeasonIds(),
+		CompetitionIDs: r.GetCompetitionIds(),
+	}
+
+	stats, err := s.refereeRepo.Stats(ref.ID, query)
+
+	if err != nil {
+		s.logger.Errorf("Error retrieving referee stats in referee service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	res := proto.RefereeStatsResponse{
+		Referee: factory.RefereeToProto(ref),
+		Stats:   factory.RefereeStatsToProto(stats),
+	}
+
+	return &res, nil
+}
+
+func NewRefereeService(r app.RefereeRepository, log *logrus.Logger) *RefereeService {
+	return &RefereeService{refereeRepo: r, logger: log}
+}
@@ -0,0 +1,88 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRefereeService_GetRefereeStats(t *testing.T) {
+	t.Run("returns referee and stats filtered by seasons and competitions", func(t *testing.T) {
+		t.Helper()
+
+		refereeRepo := new(mock.RefereeRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewRefereeService(refereeRepo, logger)
+
+		query := app.RefereeStatsQuery{SeasonIDs: []uint64{16036}, CompetitionIDs: []uint64{8}}
+		stats := app.RefereeStats{RefereeID: 14532, Fixtures: 4, YellowCards: 14, RedCards: 1, Fouls: 86, Penalties: 2}
+
+		refereeRepo.On("ByID", uint64(14532)).Return(&app.Referee{ID: 14532, CommonName: "M. Oliver"}, nil)
+		refereeRepo.On("Stats", uint64(14532), query).Return(&stats, nil)
+
+		res, err := service.GetRefereeStats(context.Background(), &proto.RefereeStatsRequest{
+			RefereeId:      14532,
+			SeasonIds:      []uint64{16036},
+			CompetitionIds: []uint64{8},
+		})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(14532), res.Referee.Id)
+		a.Equal("M. Oliver", res.Referee.CommonName)
+		a.Equal(uint32(4), res.Stats.Fixtures)
+		a.Equal(uint32(14), res.Stats.YellowCards)
+		a.Equal(float32(3.5), res.Stats.YellowCardsPerGame)
+		a.Equal(float32(0.25), res.Stats.RedCardsPerGame)
+		a.Equal(float32(21.5), res.Stats.FoulsPerGame)
+		a.Equal(float32(0.5), res.Stats.PenaltiesPerGame)
+	})
+
+	t.Run("returns not found error if referee does not exist", func(t *testing.T) {
+		t.Helper()
+
+		refereeRepo := new(mock.RefereeRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewRefereeService(refereeRepo, logger)
+
+		refereeRepo.On("ByID", uint64(14532)).Return(&app.Referee{}, errors.New("not found"))
+
+		_, err := service.GetRefereeStats(context.Background(), &proto.RefereeStatsRequest{RefereeId: 14532})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = NotFound desc = referee with ID 14532 does not exist", err.Error())
+	})
+
+	t.Run("logs error and returns internal server error if error returned from referee repository", func(t *testing.T) {
+		t.Helper()
+
+		refereeRepo := new(mock.RefereeRepository)
+		logger, hook := test.NewNullLogger()
+		service := grpc.NewRefereeService(refereeRepo, logger)
+
+		refereeRepo.On("ByID", uint64(14532)).Return(&app.Referee{ID: 14532}, nil)
+		refereeRepo.On("Stats", uint64(14532), app.RefereeStatsQuery{}).Return(&app.RefereeStats{}, errors.New("oh no"))
+
+		_, err := service.GetRefereeStats(context.Background(), &proto.RefereeStatsRequest{RefereeId: 14532})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = Internal desc = Internal server error", err.Error())
+		assert.Equal(t, "Error retrieving referee stats in referee service. Error: oh no", hook.LastEntry().Message)
+	})
+}
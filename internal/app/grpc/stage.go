@@ -0,0 +1,255 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/factory"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	statistico "github.com/statistico/statistico-proto/go"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FixtureStageService implements the GetFixtureStage and ListSeasonStages RPCs added to the statistico.FixtureService.
+// Register the service alongside the FixtureService using RegisterFixtureService.
+type FixtureStageService struct {
+	fixtureRepo app.FixtureRepository
+	stageRepo   app.StageRepository
+	groupRepo   app.GroupRepository
+	logger      *logrus.Logger
+}
+
+func (s *FixtureStageService) GetFixtureStage(c context.Context, r *proto.FixtureStageRequest) (*proto.FixtureStage, error) {
+	fix, err := s.fixtureRepo.ByID(r.GetFixtureId())
+
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("fixture with ID %d does not exist", r.GetFixtureId()))
+	}
+
+	if fix.StageID == nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("fixture with ID %d is not assigned to a stage", fix.ID))
+	}
+
+	stage, err := s.stageRepo.ByID(*fix.StageID)
+
+	if err != nil {
+		if err == errors.ErrorNotFound {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("stage with ID %d does not exist", *fix.StageID))
+		}
+
+		s.logger.Errorf("Error retrieving stage in fixture stage service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	res := proto.FixtureStage{
+		FixtureId: fix.ID,
+		Stage:     factory.StageToProto(stage, []app.Group{}),
+	}
+
+	if fix.GroupID == nil {
+		return &res, nil
+	}
+
+	group, err := s.groupRepo.ByID(*fix.GroupID)
+
+	if err != nil && err != errors.ErrorNotFound {
+		s.logger.Errorf("Error retrieving group in fixture stage service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	if err == nil {
+		res.Group = factory.GroupToProto(group)
+	}
+
+	return &res, nil
+}
+
+func (s *FixtureStageService) ListSeasonStages(c context.Context, r *proto.SeasonStagesRequest) (*proto.SeasonStagesResponse, error) {
+	stages, err := s.stageRepo.BySeasonID(r.GetSeasonId())
+
+	if err != nil {
+		s.logger.Errorf("Error retrieving stages in fixture stage service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	res := proto.SeasonStagesResponse{Stages: []*proto.Stage{}}
+
+	for _, st := range stages {
+		groups, err := s.groupRepo.ByStageID(st.ID)
+
+		if err != nil {
+			s.logger.Errorf("Error retrieving groups in fixture stage service. Error: %s", err.Error())
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+
+		res.Stages = append(res.Stages, factory.StageToProto(&st, groups))
+	}
+
+	return &res, nil
+}
+
+func NewFixtureStageService(
+	f app.FixtureRepository,
+	s app.StageRepository,
+	g app.GroupRepository,
+	log *logrus.Logger,
+) *FixtureStageService {
+	return &FixtureStageService{fixtureRepo: f, stageRepo: s, groupRepo: g, logger: log}
+}
+
+// RegisterFixtureService registers the FixtureService and FixtureStageService as the statistico.FixtureService. The
+// stage RPCs are added to the existing service rather than served by a second service so clients built against
+// statistico-proto keep working.
+func RegisterFixtureService(s grpclib.ServiceRegistrar, f *FixtureService, st *FixtureStageService) {
+	s.RegisterService(&fixtureServiceDesc, &fixtureServer{FixtureService: f, FixtureStageService: st})
+}
+
+type fixtureServiceServer interface {
+	statistico.FixtureServiceServer
+	GetFixtureStage(c context.Context, r *proto.FixtureStageRequest) (*proto.FixtureStage, error)
+	ListSeasonStages(c context.Context, r *proto.SeasonStagesRequest) (*proto.SeasonStagesResponse, error)
+}
+
+type fixtureServer struct {
+	*FixtureService
+	*FixtureStageService
+}
+
+type fixtureStream struct {
+	grpclib.ServerStream
+}
+
+func (x *fixtureStream) Send(f *statistico.Fixture) error {
+	return x.ServerStream.SendMsg(f)
+}
+
+var fixtureServiceDesc = grpclib.ServiceDesc{
+	ServiceName: "statistico.FixtureService",
+	HandlerType: (*fixtureServiceServer)(nil),
+	Methods: []grpclib.MethodDesc{
+		{
+			MethodName: "FixtureByID",
+			Handler:    fixtureByIDHandler,
+		},
+		{
+			MethodName: "GetFixtureStage",
+			Handler:    getFixtureStageHandler,
+		},
+		{
+			MethodName: "ListSeasonStages",
+			Handler:    listSeasonStagesHandler,
+		},
+	},
+	Streams: []grpclib.StreamDesc{
+		{
+			StreamName:    "ListSeasonFixtures",
+			Handler:       listSeasonFixturesHandler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Search",
+			Handler:       searchFixturesHandler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "fixture.proto",
+}
+
+func fixtureByIDHandler(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpclib.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(statistico.FixtureRequest)
+
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(fixtureServiceServer).FixtureByID(ctx, in)
+	}
+
+	info := &grpclib.UnaryServerInfo{Server: srv, FullMethod: "/statistico.FixtureService/FixtureByID"}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(fixtureServiceServer).FixtureByID(ctx, req.(*statistico.FixtureRequest))
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
+
+func getFixtureStageHandler(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpclib.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(proto.FixtureStageRequest)
+
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(fixtureServiceServer).GetFixtureStage(ctx, in)
+	}
+
+	info := &grpclib.UnaryServerInfo{Server: srv, FullMethod: "/statistico.FixtureService/GetFixtureStage"}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(fixtureServiceServer).GetFixtureStage(ctx, req.(*proto.FixtureStageRequest))
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
+
+func listSeasonStagesHandler(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpclib.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(proto.SeasonStagesRequest)
+
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(fixtureServiceServer).ListSeasonStages(ctx, in)
+	}
+
+	info := &grpclib.UnaryServerInfo{Server: srv, FullMethod: "/statistico.FixtureService/ListSeasonStages"}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(fixtureServiceServer).ListSeasonStages(ctx, req.(*proto.SeasonStagesRequest))
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
+
+func listSeasonFixturesHandler(srv interface{}, stream grpclib.ServerStream) error {
+	in := new(statistico.SeasonFixtureRequest)
+
+	if err := stream.RecvMsg(in); err != nil {
+		return err
+	}
+
+	return srv.(fixtureServiceServer).ListSeasonFixtures(in, &fixtureStream{stream})
+}
+
+func searchFixturesHandler(srv interface{}, stream grpclib.ServerStream) error {
+	in := new(statistico.FixtureSearchRequest)
+
+	if err := stream.RecvMsg(in); err != nil {
+		return err
+	}
+
+	return srv.(fixtureServiceServer).Search(in, &fixtureStream{stream})
+}
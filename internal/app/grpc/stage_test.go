@@ -0,0 +1,183 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	e "github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/grpc"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFixtureStageService_GetFixtureStage(t *testing.T) {
+	t.Run("returns the stage and group a fixture belongs to", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		stageRepo := new(mock.StageRepository)
+		groupRepo := new(mock.GroupRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewFixtureStageService(fixtureRepo, stageRepo, groupRepo, logger)
+
+		stageID := uint64(77443861)
+		groupID := uint64(240)
+		stageType := "Group Stage"
+
+		fixtureRepo.On("ByID", uint64(11867285)).Return(&app.Fixture{ID: 11867285, StageID: &stageID, GroupID: &groupID}, nil)
+		stageRepo.On("ByID", stageID).Return(&app.Stage{ID: stageID, Name: "Group Stage", Type: &stageType, HasStandings: true}, nil)
+		groupRepo.On("ByID", groupID).Return(&app.Group{ID: groupID, Name: "Group A", StageID: stageID}, nil)
+
+		res, err := service.GetFixtureStage(context.Background(), &proto.FixtureStageRequest{FixtureId: 11867285})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(11867285), res.FixtureId)
+		a.Equal(stageID, res.Stage.Id)
+		a.Equal("Group Stage", res.Stage.Type.GetValue())
+		a.True(res.Stage.HasStandings)
+		a.Nil(res.Stage.SortOrder)
+		a.Equal(groupID, res.Group.Id)
+		a.Equal("Group A", res.Group.Name)
+	})
+
+	t.Run("returns not found error if fixture is not assigned to a stage", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		stageRepo := new(mock.StageRepository)
+		groupRepo := new(mock.GroupRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewFixtureStageService(fixtureRepo, stageRepo, groupRepo, logger)
+
+		fixtureRepo.On("ByID", uint64(11867285)).Return(&app.Fixture{ID: 11867285}, nil)
+
+		_, err := service.GetFixtureStage(context.Background(), &proto.FixtureStageRequest{FixtureId: 11867285})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = NotFound desc = fixture with ID 11867285 is not assigned to a stage", err.Error())
+		stageRepo.AssertNotCalled(t, "ByID", uint64(11867285))
+	})
+
+	t.Run("logs error and returns internal server error if error returned from stage repository", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		stageRepo := new(mock.StageRepository)
+		groupRepo := new(mock.GroupRepository)
+		logger, hook := test.NewNullLogger()
+		service := grpc.NewFixtureStageService(fixtureRepo, stageRepo, groupRepo, logger)
+
+		stageID := uint64(77443861)
+
+		fixtureRepo.On("ByID", uint64(11867285)).Return(&app.Fixture{ID: 11867285, StageID: &stageID}, nil)
+		stageRepo.On("ByID", stageID).Return(&app.Stage{}, errors.New("oh no"))
+
+		_, err := service.GetFixtureStage(context.Background(), &proto.FixtureStageRequest{FixtureId: 11867285})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = Internal desc = Internal server error", err.Error())
+		assert.Equal(t, "Error retrieving stage in fixture stage service. Error: oh no", hook.LastEntry().Message)
+	})
+
+	t.Run("returns not found error if stage does not exist", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		stageRepo := new(mock.StageRepository)
+		groupRepo := new(mock.GroupRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewFixtureStageService(fixtureRepo, stageRepo, groupRepo, logger)
+
+		stageID := uint64(77443861)
+
+		fixtureRepo.On("ByID", uint64(11867285)).Return(&app.Fixture{ID: 11867285, StageID: &stageID}, nil)
+		stageRepo.On("ByID", stageID).Return(&app.Stage{}, e.ErrorNotFound)
+
+		_, err := service.GetFixtureStage(context.Background(), &proto.FixtureStageRequest{FixtureId: 11867285})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = NotFound desc = stage with ID 77443861 does not exist", err.Error())
+	})
+}
+
+func TestFixtureStageService_ListSeasonStages(t *testing.T) {
+	t.Run("returns stages for season with groups", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		stageRepo := new(mock.StageRepository)
+		groupRepo := new(mock.GroupRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewFixtureStageService(fixtureRepo, stageRepo, groupRepo, logger)
+
+		one, two := 1, 2
+
+		stages := []app.Stage{
+			{ID: 77443861, Name: "Group Stage", SeasonID: 16036, SortOrder: &one},
+			{ID: 77443862, Name: "Round of 16", SeasonID: 16036, SortOrder: &two},
+		}
+
+		groups := []app.Group{
+			{ID: 240, Name: "Group A", StageID: 77443861},
+			{ID: 241, Name: "Group B", StageID: 77443861},
+		}
+
+		stageRepo.On("BySeasonID", uint64(16036)).Return(stages, nil)
+		groupRepo.On("ByStageID", uint64(77443861)).Return(groups, nil)
+		groupRepo.On("ByStageID", uint64(77443862)).Return([]app.Group{}, nil)
+
+		res, err := service.ListSeasonStages(context.Background(), &proto.SeasonStagesRequest{SeasonId: 16036})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(res.Stages))
+		a.Equal(uint64(77443861), res.Stages[0].Id)
+		a.Equal(int32(1), res.Stages[0].SortOrder.GetValue())
+		a.Equal(2, len(res.Stages[0].Groups))
+		a.Equal("Group B", res.Stages[0].Groups[1].Name)
+		a.Equal(uint64(77443862), res.Stages[1].Id)
+		a.Equal(0, len(res.Stages[1].Groups))
+	})
+
+	t.Run("logs error and returns internal server error if error returned from stage repository", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		stageRepo := new(mock.StageRepository)
+		groupRepo := new(mock.GroupRepository)
+		logger, hook := test.NewNullLogger()
+		service := grpc.NewFixtureStageService(fixtureRepo, stageRepo, groupRepo, logger)
+
+		stageRepo.On("BySeasonID", uint64(16036)).Return([]app.Stage{}, errors.New("oh no"))
+
+		_, err := service.ListSeasonStages(context.Background(), &proto.SeasonStagesRequest{SeasonId: 16036})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = Internal desc = Internal server error", err.Error())
+		assert.Equal(t, "Error retrieving stages in fixture stage service. Error: oh no", hook.LastEntry().Message)
+	})
+}
@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/factory"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"github.com/statistico/statistico-football-data/internal/app/standings"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+type StandingsService struct {
+	engine       *standings.Engine
+	roundRepo    app.RoundRepository
+	snapshotRepo app.StandingsSnapshotRepository
+	clock        clockwork.Clock
+	logger       *logrus.Logger
+	proto.UnimplementedStandingsServiceServer
+}
+
+func (s *StandingsService) GetStandings(c context.Context, r *proto.StandingsRequest) (*proto.StandingsResponse, error) {
+	table := r.GetTable()
+
+	if table == "" {
+		table = app.StandingsTableOverall
+	}
+
+	if table != app.StandingsTableOverall && table != app.StandingsTableHome && table != app.StandingsTableAway {
+		return nil, status.Error(codes.InvalidArgument, "table must be one of overall, home or away")
+	}
+
+	res := proto.StandingsResponse{
+		SeasonId:  r.GetSeasonId(),
+		Table:     table,
+		Standings: []*proto.Standing{},
+	}
+
+	rows, err := s.standings(r, table)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if r.GetRoundId() != nil {
+		res.RoundId = &wrappers.UInt64Value{Value: r.GetRoundId().GetValue()}
+	}
+
+	for _, x := range rows {
+		res.Standings = append(res.Standings, factory.StandingToProto(&x))
+	}
+
+	return &res, nil
+}
+
+func (s *StandingsService) standings(r *proto.StandingsRequest, table string) ([]app.Standing, error) {
+	if r.GetRoundId() != nil {
+		return s.roundStandings(r.GetSeasonId(), r.GetRoundId().GetValue(), table)
+	}
+
+	date := s.clock.Now()
+
+	if r.GetDate() != "" {
+		d, err := time.Parse(time.RFC3339, r.GetDate())
+
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "date provided is not a valid RFC3339 date")
+		}
+
+		date = d
+	}
+
+	rows, err := s.engine.AtDate(r.GetSeasonId(), date, table)
+
+	if err != nil {
+		s.logger.Errorf("Error calculating standings in standings service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	return rows, nil
+}
+
+// roundStandings returns the snapshot persisted for a round falling back to calculating the table if the round
+// has not been snapshot.
+func (s *StandingsService) roundStandings(seasonID, roundID uint64, table string) ([]app.Standing, error) {
+	snapshot, err := s.snapshotRepo.ByRound(seasonID, roundID, table)
+
+	if err == nil {
+		return snapshot.Standings, nil
+	}
+
+	if err != errors.ErrorNotFound {
+		s.logger.Errorf("Error retrieving standings snapshot in standings service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	round, err := s.roundRepo.ByID(roundID)
+
+	if err != nil || round.SeasonID != seasonID {
+		return nil, status.Error(
+			codes.NotFound,
+			fmt.Sprintf("round with ID %d does not exist for season %d", roundID, seasonID),
+		)
+	}
+
+	rows, err := s.engine.AtRound(round, table)
+
+	if err != nil {
+		s.logger.Errorf("Error calculating standings in standings service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	return rows, nil
+}
+
+func NewStandingsService(
+	e *standings.Engine,
+	r app.RoundRepository,
+	s app.StandingsSnapshotRepository,
+	c clockwork.Clock,
+	log *logrus.Logger,
+) *StandingsService {
+	return &StandingsService{engine: e, roundRepo: r, snapshotRepo: s, clock: c, logger: log}
+}
@@ -0,0 +1,164 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	e "github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/grpc"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/standings"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestStandingsService_GetStandings(t *testing.T) {
+	now := time.Date(2020, 3, 5, 12, 0, 0, 0, time.UTC)
+
+	t.Run("returns standings calculated at the current date by default", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		rulesRepo := new(mock.StandingsRulesRepository)
+		roundRepo := new(mock.RoundRepository)
+		snapshotRepo := new(mock.StandingsSnapshotRepository)
+		logger, _ := test.NewNullLogger()
+
+		engine := standings.NewEngine(fixtureRepo, resultRepo, seasonRepo, rulesRepo)
+		service := grpc.NewStandingsService(engine, roundRepo, snapshotRepo, clockwork.NewFakeClockAt(now), logger)
+
+		home, away := 1, 3
+
+		seasonRepo.On("ByID", uint64(16036)).Return(&app.Season{ID: 16036, CompetitionID: 8}, nil)
+		rulesRepo.On("ByCompetitionID", uint64(8)).Return(&app.StandingsRules{}, e.ErrorNotFound)
+		fixtureRepo.On("Get", app.FixtureRepositoryQuery{SeasonIDs: []uint64{16036}}).
+			Return([]app.Fixture{{ID: 1, HomeTeamID: 10, AwayTeamID: 20}}, nil)
+		resultRepo.On("BySeasonID", uint64(16036)).Return([]app.Result{{FixtureID: 1, HomeScore: &home, AwayScore: &away}}, nil)
+
+		res, err := service.GetStandings(context.Background(), &proto.StandingsRequest{SeasonId: 16036})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(16036), res.SeasonId)
+		a.Nil(res.RoundId)
+		a.Equal("overall", res.Table)
+		a.Equal(2, len(res.Standings))
+		a.Equal(uint64(20), res.Standings[0].TeamId)
+		a.Equal(uint32(3), res.Standings[0].Points)
+		a.Equal(int32(2), res.Standings[0].GoalDifference)
+		a.Equal(uint64(10), res.Standings[1].TeamId)
+		a.Equal(int32(-2), res.Standings[1].GoalDifference)
+		fixtureRepo.AssertExpectations(t)
+	})
+
+	t.Run("returns persisted snapshot for round", func(t *testing.T) {
+		t.Helper()
+
+		roundRepo := new(mock.RoundRepository)
+		snapshotRepo := new(mock.StandingsSnapshotRepository)
+		logger, _ := test.NewNullLogger()
+
+		service := grpc.NewStandingsService(nil, roundRepo, snapshotRepo, clockwork.NewFakeClockAt(now), logger)
+
+		snapshot := app.StandingsSnapshot{
+			SeasonID:  16036,
+			RoundID:   194967,
+			Table:     app.StandingsTableHome,
+			Standings: []app.Standing{{Position: 1, TeamID: 10, Played: 1, Won: 1, GoalsFor: 2, Points: 3}},
+		}
+
+		snapshotRepo.On("ByRound", uint64(16036), uint64(194967), "home").Return(&snapshot, nil)
+
+		res, err := service.GetStandings(context.Background(), &proto.StandingsRequest{
+			SeasonId: 16036,
+			RoundId:  &wrappers.UInt64Value{Value: 194967},
+			Table:    "home",
+		})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(194967), res.RoundId.GetValue())
+		a.Equal("home", res.Table)
+		a.Equal(1, len(res.Standings))
+		a.Equal(uint32(1), res.Standings[0].Position)
+		a.Equal(uint64(10), res.Standings[0].TeamId)
+	})
+
+	t.Run("returns not found error if round has no snapshot and does not belong to season", func(t *testing.T) {
+		t.Helper()
+
+		roundRepo := new(mock.RoundRepository)
+		snapshotRepo := new(mock.StandingsSnapshotRepository)
+		logger, _ := test.NewNullLogger()
+
+		service := grpc.NewStandingsService(nil, roundRepo, snapshotRepo, clockwork.NewFakeClockAt(now), logger)
+
+		snapshotRepo.On("ByRound", uint64(16036), uint64(194967), "overall").Return(&app.StandingsSnapshot{}, e.ErrorNotFound)
+		roundRepo.On("ByID", uint64(194967)).Return(&app.Round{ID: 194967, SeasonID: 17420}, nil)
+
+		_, err := service.GetStandings(context.Background(), &proto.StandingsRequest{
+			SeasonId: 16036,
+			RoundId:  &wrappers.UInt64Value{Value: 194967},
+		})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = NotFound desc = round with ID 194967 does not exist for season 16036", err.Error())
+	})
+
+	t.Run("returns invalid argument error if table is not supported", func(t *testing.T) {
+		t.Helper()
+
+		logger, _ := test.NewNullLogger()
+
+		service := grpc.NewStandingsService(nil, nil, nil, clockwork.NewFakeClockAt(now), logger)
+
+		_, err := service.GetStandings(context.Background(), &proto.StandingsRequest{SeasonId: 16036, Table: "form"})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = InvalidArgument desc = table must be one of overall, home or away", err.Error())
+	})
+
+	t.Run("logs error and returns internal server error if error returned from snapshot repository", func(t *testing.T) {
+		t.Helper()
+
+		snapshotRepo := new(mock.StandingsSnapshotRepository)
+		logger, hook := test.NewNullLogger()
+
+		service := grpc.NewStandingsService(nil, nil, snapshotRepo, clockwork.NewFakeClockAt(now), logger)
+
+		snapshotRepo.On("ByRound", uint64(16036), uint64(194967), "overall").Return(&app.StandingsSnapshot{}, errors.New("oh no"))
+
+		_, err := service.GetStandings(context.Background(), &proto.StandingsRequest{
+			SeasonId: 16036,
+			RoundId:  &wrappers.UInt64Value{Value: 194967},
+		})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = Internal desc = Internal server error", err.Error())
+		assert.Equal(t, "Error retrieving standings snapshot in standings service. Error: oh no", hook.LastEntry().Message)
+	})
+}
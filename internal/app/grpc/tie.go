@@ -0,0 +1,251 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/factory"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	statistico "github.com/statistico/statistico-proto/go"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResultTieService implements the GetTie and ListStageTies RPCs added to the statistico.ResultService. Register the
+// service alongside the ResultService using RegisterResultService.
+type ResultTieService struct {
+	fixtureRepo app.FixtureRepository
+	resultRepo  app.ResultRepository
+	logger      *logrus.Logger
+}
+
+func (s *ResultTieService) GetTie(c context.Context, r *proto.TieRequest) (*proto.Tie, error) {
+	fix, err := s.fixtureRepo.ByID(r.GetFixtureId())
+
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("fixture with ID %d does not exist", r.GetFixtureId()))
+	}
+
+	legs := []app.Fixture{*fix}
+
+	if fix.AggregateID != nil {
+		legs, err = s.fixtureRepo.Get(app.FixtureRepositoryQuery{AggregateID: fix.AggregateID})
+
+		if err != nil {
+			s.logger.Errorf("Error retrieving tie fixtures in result tie service. Error: %s", err.Error())
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	tie := app.CalculateTie(legs, s.results(legs))
+
+	return factory.TieToProto(&tie), nil
+}
+
+func (s *ResultTieService) ListStageTies(c context.Context, r *proto.StageTiesRequest) (*proto.StageTiesResponse, error) {
+	fixtures, err := s.fixtureRepo.Get(app.FixtureRepositoryQuery{StageIDs: []uint64{r.GetStageId()}})
+
+	if err != nil {
+		s.logger.Errorf("Error retrieving stage fixtures in result tie service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	results := s.results(fixtures)
+
+	res := proto.StageTiesResponse{Ties: []*proto.Tie{}}
+
+	for _, legs := range app.GroupTies(fixtures) {
+		tie := app.CalculateTie(legs, results)
+		res.Ties = append(res.Ties, factory.TieToProto(&tie))
+	}
+
+	return &res, nil
+}
+
+// results returns the results of the fixtures provided keyed by fixture ID. Fixtures without a result are yet to
+// be played and are omitted.
+func (s *ResultTieService) results(fixtures []app.Fixture) map[uint64]*app.Result {
+	results := map[uint64]*app.Result{}
+
+	for _, f := range fixtures {
+		if res, err := s.resultRepo.ByFixtureID(f.ID); err == nil {
+			results[f.ID] = res
+		}
+	}
+
+	return results
+}
+
+func NewResultTieService(f app.FixtureRepository, r app.ResultRepository, log *logrus.Logger) *ResultTieService {
+	return &ResultTieService{fixtureRepo: f, resultRepo: r, logger: log}
+}
+
+// RegisterResultService registers the ResultService and ResultTieService as the statistico.ResultService. The tie
+// RPCs are added to the existing service rather than served by a second service so clients built against
+// statistico-proto keep working.
+func RegisterResultService(s grpclib.ServiceRegistrar, r *ResultService, t *ResultTieService) {
+	s.RegisterService(&resultServiceDesc, &resultServer{ResultService: r, ResultTieService: t})
+}
+
+type resultServiceServer interface {
+	statistico.ResultServiceServer
+	GetTie(c context.Context, r *proto.TieRequest) (*proto.Tie, error)
+	ListStageTies(c context.Context, r *proto.StageTiesRequest) (*proto.StageTiesResponse, error)
+}
+
+type resultServer struct {
+	*ResultService
+	*ResultTieService
+}
+
+type resultStream struct {
+	grpclib.ServerStream
+}
+
+func (x *resultStream) Send(r *statistico.Result) error {
+	return x.ServerStream.SendMsg(r)
+}
+
+var resultServiceDesc = grpclib.ServiceDesc{
+	ServiceName: "statistico.ResultService",
+	HandlerType: (*resultServiceServer)(nil),
+	Methods: []grpclib.MethodDesc{
+		{
+			MethodName: "GetById",
+			Handler:    getResultByIDHandler,
+		},
+		{
+			MethodName: "GetTie",
+			Handler:    getTieHandler,
+		},
+		{
+			MethodName: "ListStageTies",
+			Handler:    listStageTiesHandler,
+		},
+	},
+	Streams: []grpclib.StreamDesc{
+		{
+			StreamName:    "GetHistoricalResultsForFixture",
+			Handler:       historicalResultsHandler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetResultsForSeason",
+			Handler:       seasonResultsHandler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetResultsForTeam",
+			Handler:       teamResultsHandler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "result.proto",
+}
+
+func getResultByIDHandler(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpclib.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(statistico.ResultRequest)
+
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(resultServiceServer).GetById(ctx, in)
+	}
+
+	info := &grpclib.UnaryServerInfo{Server: srv, FullMethod: "/statistico.ResultService/GetById"}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(resultServiceServer).GetById(ctx, req.(*statistico.ResultRequest))
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
+
+func getTieHandler(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpclib.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(proto.TieRequest)
+
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(resultServiceServer).GetTie(ctx, in)
+	}
+
+	info := &grpclib.UnaryServerInfo{Server: srv, FullMethod: "/statistico.ResultService/GetTie"}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(resultServiceServer).GetTie(ctx, req.(*proto.TieRequest))
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
+
+func listStageTiesHandler(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpclib.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(proto.StageTiesRequest)
+
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(resultServiceServer).ListStageTies(ctx, in)
+	}
+
+	info := &grpclib.UnaryServerInfo{Server: srv, FullMethod: "/statistico.ResultService/ListStageTies"}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(resultServiceServer).ListStageTies(ctx, req.(*proto.StageTiesRequest))
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
+
+func historicalResultsHandler(srv interface{}, stream grpclib.ServerStream) error {
+	in := new(statistico.HistoricalResultRequest)
+
+	if err := stream.RecvMsg(in); err != nil {
+		return err
+	}
+
+	return srv.(resultServiceServer).GetHistoricalResultsForFixture(in, &resultStream{stream})
+}
+
+func seasonResultsHandler(srv interface{}, stream grpclib.ServerStream) error {
+	in := new(statistico.SeasonRequest)
+
+	if err := stream.RecvMsg(in); err != nil {
+		return err
+	}
+
+	return srv.(resultServiceServer).GetResultsForSeason(in, &resultStream{stream})
+}
+
+func teamResultsHandler(srv interface{}, stream grpclib.ServerStream) error {
+	in := new(statistico.TeamResultRequest)
+
+	if err := stream.RecvMsg(in); err != nil {
+		return err
+	}
+
+	return srv.(resultServiceServer).GetResultsForTeam(in, &resultStream{stream})
+}
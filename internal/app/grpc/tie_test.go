@@ -0,0 +1,250 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/grpc"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestResultTieService_GetTie(t *testing.T) {
+	aggregateID := uint64(3401)
+	stageID := uint64(77443862)
+
+	first := app.Fixture{
+		ID:          1,
+		HomeTeamID:  10,
+		AwayTeamID:  20,
+		StageID:     &stageID,
+		AggregateID: &aggregateID,
+		Date:        time.Unix(1581447600, 0),
+	}
+
+	second := app.Fixture{
+		ID:          2,
+		HomeTeamID:  20,
+		AwayTeamID:  10,
+		StageID:     &stageID,
+		AggregateID: &aggregateID,
+		Date:        time.Unix(1583953200, 0),
+	}
+
+	t.Run("returns tie won on aggregate", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewResultTieService(fixtureRepo, resultRepo, logger)
+
+		fixtureRepo.On("ByID", uint64(2)).Return(&second, nil)
+		fixtureRepo.On("Get", app.FixtureRepositoryQuery{AggregateID: &aggregateID}).Return([]app.Fixture{second, first}, nil)
+		resultRepo.On("ByFixtureID", uint64(1)).Return(newTieResult(1, 2, 1, nil, nil), nil)
+		resultRepo.On("ByFixtureID", uint64(2)).Return(newTieResult(2, 0, 0, nil, nil), nil)
+
+		res, err := service.GetTie(context.Background(), &proto.TieRequest{FixtureId: 2})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(aggregateID, res.AggregateId.GetValue())
+		a.Equal(stageID, res.StageId.GetValue())
+		a.Equal([]uint64{1, 2}, res.FixtureIds)
+		a.Equal(uint64(10), res.HomeTeamId)
+		a.Equal(uint64(20), res.AwayTeamId)
+		a.Equal(uint32(2), res.HomeScore)
+		a.Equal(uint32(1), res.AwayScore)
+		a.True(res.Complete)
+		a.Equal(uint64(10), res.WinnerId.GetValue())
+		a.Equal("aggregate", res.DecidedBy)
+	})
+
+	t.Run("returns tie won on away goals", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewResultTieService(fixtureRepo, resultRepo, logger)
+
+		fixtureRepo.On("ByID", uint64(1)).Return(&first, nil)
+		fixtureRepo.On("Get", app.FixtureRepositoryQuery{AggregateID: &aggregateID}).Return([]app.Fixture{first, second}, nil)
+		resultRepo.On("ByFixtureID", uint64(1)).Return(newTieResult(1, 1, 2, nil, nil), nil)
+		resultRepo.On("ByFixtureID", uint64(2)).Return(newTieResult(2, 0, 1, nil, nil), nil)
+
+		res, err := service.GetTie(context.Background(), &proto.TieRequest{FixtureId: 1})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint32(2), res.HomeScore)
+		a.Equal(uint32(2), res.AwayScore)
+		a.True(res.Complete)
+		a.Equal(uint64(20), res.WinnerId.GetValue())
+		a.Equal("away_goals", res.DecidedBy)
+	})
+
+	t.Run("returns tie won on penalties", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewResultTieService(fixtureRepo, resultRepo, logger)
+
+		homePens, awayPens := 5, 4
+
+		fixtureRepo.On("ByID", uint64(1)).Return(&first, nil)
+		fixtureRepo.On("Get", app.FixtureRepositoryQuery{AggregateID: &aggregateID}).Return([]app.Fixture{first, second}, nil)
+		resultRepo.On("ByFixtureID", uint64(1)).Return(newTieResult(1, 1, 1, nil, nil), nil)
+		resultRepo.On("ByFixtureID", uint64(2)).Return(newTieResult(2, 1, 1, &homePens, &awayPens), nil)
+
+		res, err := service.GetTie(context.Background(), &proto.TieRequest{FixtureId: 1})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.True(res.Complete)
+		a.Equal(uint64(20), res.WinnerId.GetValue())
+		a.Equal("penalties", res.DecidedBy)
+	})
+
+	t.Run("returns incomplete tie if second leg has not been played", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewResultTieService(fixtureRepo, resultRepo, logger)
+
+		fixtureRepo.On("ByID", uint64(1)).Return(&first, nil)
+		fixtureRepo.On("Get", app.FixtureRepositoryQuery{AggregateID: &aggregateID}).Return([]app.Fixture{first, second}, nil)
+		resultRepo.On("ByFixtureID", uint64(1)).Return(newTieResult(1, 3, 0, nil, nil), nil)
+		resultRepo.On("ByFixtureID", uint64(2)).Return(&app.Result{}, errors.New("result with ID 2 does not exist"))
+
+		res, err := service.GetTie(context.Background(), &proto.TieRequest{FixtureId: 1})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint32(3), res.HomeScore)
+		a.Equal(uint32(0), res.AwayScore)
+		a.False(res.Complete)
+		a.Nil(res.WinnerId)
+		a.Equal("", res.DecidedBy)
+	})
+
+	t.Run("returns not found error if fixture does not exist", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewResultTieService(fixtureRepo, resultRepo, logger)
+
+		fixtureRepo.On("ByID", uint64(1)).Return(&app.Fixture{}, errors.New("not found"))
+
+		_, err := service.GetTie(context.Background(), &proto.TieRequest{FixtureId: 1})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = NotFound desc = fixture with ID 1 does not exist", err.Error())
+	})
+}
+
+func TestResultTieService_ListStageTies(t *testing.T) {
+	t.Run("returns ties for a stage ordered by first leg date", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewResultTieService(fixtureRepo, resultRepo, logger)
+
+		aggOne, aggTwo := uint64(1), uint64(2)
+
+		fixtures := []app.Fixture{
+			{ID: 11, HomeTeamID: 10, AwayTeamID: 20, AggregateID: &aggOne, Date: time.Unix(1581447600, 0)},
+			{ID: 21, HomeTeamID: 30, AwayTeamID: 40, AggregateID: &aggTwo, Date: time.Unix(1581361200, 0)},
+			{ID: 12, HomeTeamID: 20, AwayTeamID: 10, AggregateID: &aggOne, Date: time.Unix(1583953200, 0)},
+			{ID: 31, HomeTeamID: 50, AwayTeamID: 60, Date: time.Unix(1590000000, 0)},
+		}
+
+		fixtureRepo.On("Get", app.FixtureRepositoryQuery{StageIDs: []uint64{77443862}}).Return(fixtures, nil)
+		resultRepo.On("ByFixtureID", uint64(11)).Return(newTieResult(11, 0, 0, nil, nil), nil)
+		resultRepo.On("ByFixtureID", uint64(12)).Return(newTieResult(12, 1, 1, nil, nil), nil)
+		resultRepo.On("ByFixtureID", uint64(21)).Return(newTieResult(21, 2, 2, nil, nil), nil)
+		resultRepo.On("ByFixtureID", uint64(31)).Return(newTieResult(31, 1, 0, nil, nil), nil)
+
+		res, err := service.ListStageTies(context.Background(), &proto.StageTiesRequest{StageId: 77443862})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(3, len(res.Ties))
+		a.Equal([]uint64{21}, res.Ties[0].FixtureIds)
+		a.False(res.Ties[0].Complete)
+		a.Equal([]uint64{11, 12}, res.Ties[1].FixtureIds)
+		a.Equal(uint32(1), res.Ties[1].HomeScore)
+		a.Equal(uint32(1), res.Ties[1].AwayScore)
+		a.Equal(uint64(10), res.Ties[1].WinnerId.GetValue())
+		a.Equal("away_goals", res.Ties[1].DecidedBy)
+		a.Equal([]uint64{31}, res.Ties[2].FixtureIds)
+		a.Nil(res.Ties[2].AggregateId)
+		a.True(res.Ties[2].Complete)
+		a.Equal(uint64(50), res.Ties[2].WinnerId.GetValue())
+	})
+
+	t.Run("logs error and returns internal server error if error returned from fixture repository", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		logger, hook := test.NewNullLogger()
+		service := grpc.NewResultTieService(fixtureRepo, resultRepo, logger)
+
+		fixtureRepo.On("Get", app.FixtureRepositoryQuery{StageIDs: []uint64{77443862}}).Return([]app.Fixture{}, errors.New("oh no"))
+
+		_, err := service.ListStageTies(context.Background(), &proto.StageTiesRequest{StageId: 77443862})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = Internal desc = Internal server error", err.Error())
+		assert.Equal(t, "Error retrieving stage fixtures in result tie service. Error: oh no", hook.LastEntry().Message)
+	})
+}
+
+func newTieResult(fixtureID uint64, home, away int, homePens, awayPens *int) *app.Result {
+	return &app.Result{
+		FixtureID:    fixtureID,
+		HomeScore:    &home,
+		AwayScore:    &away,
+		HomePenScore: homePens,
+		AwayPenScore: awayPens,
+	}
+}
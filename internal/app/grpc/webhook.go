@@ -0,0 +1,96 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/factory"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"github.com/statistico/statistico-football-data/internal/app/webhook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WebhookService manages webhook subscriptions. Subscriptions receive domain events so every request must provide
+// the admin token configured for the application, all requests are rejected if no token is configured.
+type WebhookService struct {
+	subscriptionRepo app.WebhookSubscriptionRepository
+	token            string
+	logger           *logrus.Logger
+	proto.UnimplementedWebhookServiceServer
+}
+
+func (s *WebhookService) CreateSubscription(c context.Context, r *proto.CreateWebhookSubscriptionRequest) (*proto.CreateWebhookSubscriptionResponse, error) {
+	if err := authorizeAdmin(c, s.token); err != nil {
+		return nil, err
+	}
+
+	sub := app.WebhookSubscription{
+		URL:            r.GetUrl(),
+		EventTypes:     r.GetEventTypes(),
+		CompetitionIDs: r.GetCompetitionIds(),
+		Secret:         r.GetSecret(),
+	}
+
+	if err := webhook.Prepare(&sub); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.subscriptionRepo.Insert(&sub); err != nil {
+		s.logger.Errorf("Error inserting subscription in webhook service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	res := proto.CreateWebhookSubscriptionResponse{
+		Subscription: factory.WebhookSubscriptionToProto(&sub),
+		Secret:       sub.Secret,
+	}
+
+	return &res, nil
+}
+
+func (s *WebhookService) ListSubscriptions(c context.Context, r *proto.ListWebhookSubscriptionsRequest) (*proto.ListWebhookSubscriptionsResponse, error) {
+	if err := authorizeAdmin(c, s.token); err != nil {
+		return nil, err
+	}
+
+	subs, err := s.subscriptionRepo.All()
+
+	if err != nil {
+		s.logger.Errorf("Error retrieving subscriptions in webhook service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	res := proto.ListWebhookSubscriptionsResponse{}
+
+	for i := range subs {
+		res.Subscriptions = append(res.Subscriptions, factory.WebhookSubscriptionToProto(&subs[i]))
+	}
+
+	return &res, nil
+}
+
+func (s *WebhookService) DeleteSubscription(c context.Context, r *proto.DeleteWebhookSubscriptionRequest) (*proto.DeleteWebhookSubscriptionResponse, error) {
+	if err := authorizeAdmin(c, s.token); err != nil {
+		return nil, err
+	}
+
+	err := s.subscriptionRepo.Delete(r.GetId())
+
+	if err == errors.ErrorNotFound {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("webhook subscription with ID %d does not exist", r.GetId()))
+	}
+
+	if err != nil {
+		s.logger.Errorf("Error deleting subscription in webhook service. Error: %s", err.Error())
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	return &proto.DeleteWebhookSubscriptionResponse{}, nil
+}
+
+func NewWebhookService(s app.WebhookSubscriptionRepository, token string, log *logrus.Logger) *WebhookService {
+	return &WebhookService{subscriptionRepo: s, token: token, logger: log}
+}
@@ -0,0 +1,158 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	e "github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/grpc"
+	"github.com/statistico/statistico-football-data/internal/app/grpc/proto"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestWebhookService_CreateSubscription(t *testing.T) {
+	t.Run("creates subscription and returns generated secret", func(t *testing.T) {
+		t.Helper()
+
+		subRepo := new(mock.WebhookSubscriptionRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewWebhookService(subRepo, "secret", logger)
+
+		subRepo.On("Insert", m.MatchedBy(func(s *app.WebhookSubscription) bool {
+			return s.URL == "https://example.com/hooks" && s.EventTypes[0] == "fixture-xg.updated" &&
+				s.CompetitionIDs[0] == 8 && len(s.Secret) == 64
+		})).Run(func(args m.Arguments) {
+			s := args.Get(0).(*app.WebhookSubscription)
+			s.ID = 3
+			s.CreatedAt = time.Date(2021, 2, 10, 15, 0, 0, 0, time.UTC)
+		}).Return(nil)
+
+		res, err := service.CreateSubscription(adminContext("Bearer secret"), &proto.CreateWebhookSubscriptionRequest{
+			Url:            "https://example.com/hooks",
+			EventTypes:     []string{"fixture-xg.updated"},
+			CompetitionIds: []uint64{8},
+		})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(3), res.GetSubscription().GetId())
+		a.Equal("https://example.com/hooks", res.GetSubscription().GetUrl())
+		a.Equal([]string{"fixture-xg.updated"}, res.GetSubscription().GetEventTypes())
+		a.Equal([]uint64{8}, res.GetSubscription().GetCompetitionIds())
+		a.Equal("2021-02-10T15:00:00Z", res.GetSubscription().GetCreatedAt())
+		a.Equal(64, len(res.GetSecret()))
+	})
+
+	t.Run("returns invalid argument error if subscription is invalid", func(t *testing.T) {
+		t.Helper()
+
+		subRepo := new(mock.WebhookSubscriptionRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewWebhookService(subRepo, "secret", logger)
+
+		_, err := service.CreateSubscription(adminContext("Bearer secret"), &proto.CreateWebhookSubscriptionRequest{Url: "https://example.com"})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = InvalidArgument desc = at least one event type must be provided", err.Error())
+		subRepo.AssertNotCalled(t, "Insert", m.Anything)
+	})
+
+	t.Run("returns unauthenticated error if the admin token provided is invalid", func(t *testing.T) {
+		t.Helper()
+
+		subRepo := new(mock.WebhookSubscriptionRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewWebhookService(subRepo, "secret", logger)
+
+		for _, c := range []context.Context{context.Background(), adminContext("Bearer wrong")} {
+			_, err := service.CreateSubscription(c, &proto.CreateWebhookSubscriptionRequest{
+				Url:        "https://example.com/hooks",
+				EventTypes: []string{"fixture-xg.updated"},
+			})
+
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+
+			assert.Equal(t, "rpc error: code = Unauthenticated desc = A valid admin token must be provided", err.Error())
+		}
+
+		subRepo.AssertNotCalled(t, "Insert", m.Anything)
+	})
+}
+
+func TestWebhookService_ListSubscriptions(t *testing.T) {
+	t.Run("returns subscriptions without their secrets", func(t *testing.T) {
+		t.Helper()
+
+		subRepo := new(mock.WebhookSubscriptionRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewWebhookService(subRepo, "secret", logger)
+
+		subRepo.On("All").Return([]app.WebhookSubscription{
+			{ID: 3, URL: "https://example.com/hooks", EventTypes: []string{"result.finalised"}, Secret: "s3cr3t"},
+			{ID: 4, URL: "https://example.com/xg", EventTypes: []string{"fixture-xg.updated"}, Secret: "s3cr3t"},
+		}, nil)
+
+		res, err := service.ListSubscriptions(adminContext("Bearer secret"), &proto.ListWebhookSubscriptionsRequest{})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(res.GetSubscriptions()))
+		assert.Equal(t, uint64(4), res.GetSubscriptions()[1].GetId())
+		assert.NotContains(t, res.String(), "s3cr3t")
+	})
+
+	t.Run("returns internal server error if subscriptions cannot be retrieved", func(t *testing.T) {
+		t.Helper()
+
+		subRepo := new(mock.WebhookSubscriptionRepository)
+		logger, hook := test.NewNullLogger()
+		service := grpc.NewWebhookService(subRepo, "secret", logger)
+
+		subRepo.On("All").Return([]app.WebhookSubscription{}, errors.New("oh no"))
+
+		_, err := service.ListSubscriptions(adminContext("Bearer secret"), &proto.ListWebhookSubscriptionsRequest{})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = Internal desc = Internal server error", err.Error())
+		assert.Equal(t, "Error retrieving subscriptions in webhook service. Error: oh no", hook.LastEntry().Message)
+	})
+}
+
+func TestWebhookService_DeleteSubscription(t *testing.T) {
+	t.Run("returns not found error if subscription does not exist", func(t *testing.T) {
+		t.Helper()
+
+		subRepo := new(mock.WebhookSubscriptionRepository)
+		logger, _ := test.NewNullLogger()
+		service := grpc.NewWebhookService(subRepo, "secret", logger)
+
+		subRepo.On("Delete", uint64(99)).Return(e.ErrorNotFound)
+
+		_, err := service.DeleteSubscription(adminContext("Bearer secret"), &proto.DeleteWebhookSubscriptionRequest{Id: 99})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = NotFound desc = webhook subscription with ID 99 does not exist", err.Error())
+	})
+}
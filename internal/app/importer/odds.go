@@ -0,0 +1,152 @@
+package importer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Columns an odds CSV file must contain. The line column is optional and captured_at values must be RFC3339
+// formatted i.e. "2020-09-12T10:15:00Z".
+const (
+	OddsFixtureID   = "fixture_id"
+	OddsBookmakerID = "bookmaker_id"
+	OddsBookmaker   = "bookmaker"
+	OddsMarket      = "market"
+	OddsSelection   = "selection"
+	OddsLine        = "line"
+	OddsPrice       = "price"
+	OddsCapturedAt  = "captured_at"
+)
+
+var requiredOddsColumns = []string{
+	OddsFixtureID,
+	OddsBookmakerID,
+	OddsBookmaker,
+	OddsMarket,
+	OddsSelection,
+	OddsPrice,
+	OddsCapturedAt,
+}
+
+// OddsReader parses rows from an odds CSV file into Odds structs.
+type OddsReader struct {
+	csv   *csv.Reader
+	index map[string]int
+	line  int
+}
+
+// Next returns the next Odds in the CSV file or io.EOF once all rows have been read. Errors returned for
+// rows that cannot be parsed contain the line number of the row so the caller is able to log and continue.
+func (r *OddsReader) Next() (*app.Odds, error) {
+	values, err := r.csv.Read()
+
+	if err == io.EOF {
+		return nil, err
+	}
+
+	r.line++
+
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %s", r.line, err.Error())
+	}
+
+	o, err := r.parse(values)
+
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %s", r.line, err.Error())
+	}
+
+	return o, nil
+}
+
+func (r *OddsReader) parse(values []string) (*app.Odds, error) {
+	fixtureID, err := strconv.ParseUint(r.value(values, OddsFixtureID), 10, 64)
+
+	if err != nil {
+		return nil, fmt.Errorf("value '%s' for column %s is not a valid ID", r.value(values, OddsFixtureID), OddsFixtureID)
+	}
+
+	bookmakerID, err := strconv.ParseUint(r.value(values, OddsBookmakerID), 10, 64)
+
+	if err != nil {
+		return nil, fmt.Errorf("value '%s' for column %s is not a valid ID", r.value(values, OddsBookmakerID), OddsBookmakerID)
+	}
+
+	price, err := strconv.ParseFloat(r.value(values, OddsPrice), 32)
+
+	if err != nil {
+		return nil, fmt.Errorf("value '%s' for column %s is not a valid price", r.value(values, OddsPrice), OddsPrice)
+	}
+
+	captured, err := time.Parse(time.RFC3339, r.value(values, OddsCapturedAt))
+
+	if err != nil {
+		return nil, fmt.Errorf("value '%s' for column %s is not a valid RFC3339 date", r.value(values, OddsCapturedAt), OddsCapturedAt)
+	}
+
+	o := app.Odds{
+		FixtureID:   fixtureID,
+		BookmakerID: bookmakerID,
+		Bookmaker:   r.value(values, OddsBookmaker),
+		Market:      r.value(values, OddsMarket),
+		Selection:   r.value(values, OddsSelection),
+		Price:       float32(price),
+		CapturedAt:  captured.UTC(),
+	}
+
+	if o.Market == "" || o.Selection == "" {
+		return nil, fmt.Errorf("market and selection are required")
+	}
+
+	if line := r.value(values, OddsLine); line != "" {
+		o.Line = &line
+	}
+
+	return &o, nil
+}
+
+func (r *OddsReader) value(values []string, column string) string {
+	i, ok := r.index[column]
+
+	if !ok || i >= len(values) {
+		return ""
+	}
+
+	return strings.TrimSpace(values[i])
+}
+
+// NewOddsReader reads the header row from the CSV provided and returns an OddsReader for the remaining rows. An
+// error is returned if any required column is missing from the header.
+func NewOddsReader(in io.Reader) (*OddsReader, error) {
+	c := csv.NewReader(in)
+	c.FieldsPerRecord = -1
+
+	header, err := c.Read()
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading csv header: %s", err.Error())
+	}
+
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	index := map[string]int{}
+
+	for i, h := range header {
+		index[strings.TrimSpace(h)] = i
+	}
+
+	for _, column := range requiredOddsColumns {
+		if _, ok := index[column]; !ok {
+			return nil, fmt.Errorf("csv header does not contain the %s column", column)
+		}
+	}
+
+	return &OddsReader{csv: c, index: index, line: 1}, nil
+}
@@ -0,0 +1,73 @@
+package importer_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app/importer"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strings"
+	"testing"
+)
+
+const oddsCSV = `fixture_id,bookmaker_id,bookmaker,market,selection,line,price,captured_at
+16475287,2,bet365,3Way Result,1,,2.10,2020-09-12T10:15:00Z
+16475287,2,bet365,Over/Under,Over,2.5,1.91,2020-09-12T10:15:00+01:00
+16475287,2,bet365,Over/Under,Under,2.5,evens,2020-09-12T10:15:00Z
+`
+
+func TestOddsReader_Next(t *testing.T) {
+	t.Run("parses csv rows into odds structs", func(t *testing.T) {
+		t.Helper()
+
+		reader, err := importer.NewOddsReader(strings.NewReader(oddsCSV))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		home, err := reader.Next()
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(16475287), home.FixtureID)
+		a.Equal(uint64(2), home.BookmakerID)
+		a.Equal("bet365", home.Bookmaker)
+		a.Equal("3Way Result", home.Market)
+		a.Equal("1", home.Selection)
+		a.Nil(home.Line)
+		a.Equal(float32(2.10), home.Price)
+		a.Equal("2020-09-12 10:15:00 +0000 UTC", home.CapturedAt.String())
+
+		over, err := reader.Next()
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a.Equal("2.5", *over.Line)
+		a.Equal("2020-09-12 09:15:00 +0000 UTC", over.CapturedAt.String())
+
+		_, err = reader.Next()
+
+		a.Equal("line 4: value 'evens' for column price is not a valid price", err.Error())
+
+		_, err = reader.Next()
+
+		a.Equal(io.EOF, err)
+	})
+
+	t.Run("returns error if required columns are missing from header", func(t *testing.T) {
+		t.Helper()
+
+		_, err := importer.NewOddsReader(strings.NewReader("fixture_id,bookmaker_id,market\n"))
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "csv header does not contain the bookmaker column", err.Error())
+	})
+}
@@ -0,0 +1,246 @@
+package importer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Record is a single CSV row parsed into domain values. Result and team stats values only contain the
+// fields present in the CSV file, the FixtureID and TeamID properties are populated by the importing processor
+// once the fixture and teams have been resolved.
+type Record struct {
+	Line      int
+	Values    []string
+	Date      time.Time
+	HasTime   bool
+	HomeTeam  string
+	AwayTeam  string
+	Result    app.Result
+	HomeStats app.TeamStats
+	AwayStats app.TeamStats
+	HasStats  bool
+}
+
+// Reader parses rows from a CSV file into Record structs using the column mapping provided by a Spec.
+type Reader struct {
+	csv    *csv.Reader
+	spec   *Spec
+	header []string
+	index  map[string]int
+	line   int
+}
+
+// Header returns the header row of the CSV file.
+func (r *Reader) Header() []string {
+	return r.header
+}
+
+// Next returns the next Record in the CSV file or io.EOF once all rows have been read. An error is returned
+// alongside the Record if the row cannot be parsed so the caller is able to reject the row and continue.
+func (r *Reader) Next() (*Record, error) {
+	values, err := r.csv.Read()
+
+	if err == io.EOF {
+		return nil, err
+	}
+
+	r.line++
+
+	rec := Record{Line: r.line, Values: values}
+
+	if err != nil {
+		return &rec, err
+	}
+
+	rec.HomeTeam = strings.TrimSpace(r.value(values, FieldHomeTeam))
+	rec.AwayTeam = strings.TrimSpace(r.value(values, FieldAwayTeam))
+
+	if rec.HomeTeam == "" || rec.AwayTeam == "" {
+		return &rec, fmt.Errorf("home and away team are required")
+	}
+
+	if rec.Date, rec.HasTime, err = r.parseDate(values); err != nil {
+		return &rec, err
+	}
+
+	if err := r.parseResult(values, &rec); err != nil {
+		return &rec, err
+	}
+
+	if err := r.parseStats(values, &rec); err != nil {
+		return &rec, err
+	}
+
+	return &rec, nil
+}
+
+func (r *Reader) parseDate(values []string) (time.Time, bool, error) {
+	date := strings.TrimSpace(r.value(values, FieldDate))
+	clock := strings.TrimSpace(r.value(values, FieldTime))
+
+	for _, format := range r.spec.DateFormats {
+		if clock != "" {
+			if t, err := time.ParseInLocation(format+" 15:04", date+" "+clock, r.spec.Location()); err == nil {
+				return t, true, nil
+			}
+		}
+
+		if t, err := time.ParseInLocation(format, date, r.spec.Location()); err == nil {
+			return t, false, nil
+		}
+	}
+
+	return time.Time{}, false, fmt.Errorf("date '%s' does not match any configured date format", date)
+}
+
+func (r *Reader) parseResult(values []string, rec *Record) error {
+	var err error
+
+	if rec.Result.HomeScore, err = r.int(values, FieldHomeScore); err != nil {
+		return err
+	}
+
+	if rec.Result.AwayScore, err = r.int(values, FieldAwayScore); err != nil {
+		return err
+	}
+
+	ht, err := r.int(values, FieldHomeHalfTimeScore)
+
+	if err != nil {
+		return err
+	}
+
+	at, err := r.int(values, FieldAwayHalfTimeScore)
+
+	if err != nil {
+		return err
+	}
+
+	if ht != nil && at != nil {
+		score := fmt.Sprintf("%d-%d", *ht, *at)
+		rec.Result.HalfTimeScore = &score
+	}
+
+	if rec.Result.HomeScore != nil && rec.Result.AwayScore != nil {
+		score := fmt.Sprintf("%d-%d", *rec.Result.HomeScore, *rec.Result.AwayScore)
+		rec.Result.FullTimeScore = &score
+	}
+
+	rec.HomeStats.Goals = rec.Result.HomeScore
+	rec.AwayStats.Goals = rec.Result.AwayScore
+
+	return nil
+}
+
+func (r *Reader) parseStats(values []string, rec *Record) error {
+	for _, side := range []string{"home_", "away_"} {
+		s := &rec.HomeStats
+
+		if side == "away_" {
+			s = &rec.AwayStats
+		}
+
+		for _, stat := range stats {
+			val, err := r.int(values, side+stat)
+
+			if err != nil {
+				return err
+			}
+
+			if val == nil {
+				continue
+			}
+
+			rec.HasStats = true
+
+			switch stat {
+			case StatShotsTotal:
+				s.TeamShots.Total = val
+			case StatShotsOnGoal:
+				s.TeamShots.OnGoal = val
+			case StatFouls:
+				s.Fouls = val
+			case StatCorners:
+				s.Corners = val
+			case StatOffsides:
+				s.Offsides = val
+			case StatYellowCards:
+				s.YellowCards = val
+			case StatRedCards:
+				s.RedCards = val
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *Reader) int(values []string, field string) (*int, error) {
+	v := strings.TrimSpace(r.value(values, field))
+
+	if v == "" {
+		return nil, nil
+	}
+
+	i, err := strconv.Atoi(v)
+
+	if err != nil {
+		return nil, fmt.Errorf("value '%s' for column %s is not a valid integer", v, r.spec.Columns[field])
+	}
+
+	return &i, nil
+}
+
+func (r *Reader) value(values []string, field string) string {
+	i, ok := r.index[field]
+
+	if !ok || i >= len(values) {
+		return ""
+	}
+
+	return values[i]
+}
+
+// NewReader reads the header row from the CSV provided and returns a Reader for the remaining rows. An error
+// is returned if the date, home team or away team columns are missing from the header.
+func NewReader(in io.Reader, s *Spec) (*Reader, error) {
+	c := csv.NewReader(in)
+	c.FieldsPerRecord = -1
+
+	header, err := c.Read()
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading csv header: %s", err.Error())
+	}
+
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	columns := map[string]int{}
+
+	for i, h := range header {
+		columns[strings.TrimSpace(h)] = i
+	}
+
+	index := map[string]int{}
+
+	for field, column := range s.Columns {
+		if i, ok := columns[column]; ok {
+			index[field] = i
+		}
+	}
+
+	for _, field := range []string{FieldDate, FieldHomeTeam, FieldAwayTeam} {
+		if _, ok := index[field]; !ok {
+			return nil, fmt.Errorf("csv header does not contain the column mapped to %s", field)
+		}
+	}
+
+	return &Reader{csv: c, spec: s, header: header, index: index, line: 1}, nil
+}
@@ -0,0 +1,171 @@
+package importer_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app/importer"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const footballDataCSV = `Div,Date,Time,HomeTeam,AwayTeam,FTHG,FTAG,FTR,HTHG,HTAG,HS,AS,HST,AST,HF,AF,HC,AC,HY,AY,HR,AR
+E0,12/09/2020,12:30,Fulham,Arsenal,0,3,A,0,1,5,13,2,6,12,12,2,3,2,2,0,0
+E0,12/09/2020,,Crystal Palace,Southampton,1,0,H,1,0,9,9,5,3,14,11,7,3,2,1,0,0
+E0,13/09/20,16:30,Tottenham,,0,1,A,0,0,9,15,3,5,15,15,5,3,1,1,0,0
+E0,14/09/2020,20:15,Sheffield United,Wolves,zero,2,A,0,2,9,7,2,3,15,11,5,7,2,3,0,0
+`
+
+func TestReader_Next(t *testing.T) {
+	t.Run("parses csv rows into records using the default football-data column mapping", func(t *testing.T) {
+		t.Helper()
+
+		spec := loadSpec(t, `{"file": "E0.csv", "time_zone": "UTC"}`)
+
+		reader, err := importer.NewReader(strings.NewReader(footballDataCSV), spec)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		rec, err := reader.Next()
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, rec.Line)
+		a.Equal("Fulham", rec.HomeTeam)
+		a.Equal("Arsenal", rec.AwayTeam)
+		a.Equal("2020-09-12 12:30:00 +0000 UTC", rec.Date.String())
+		a.True(rec.HasTime)
+		a.Equal(0, *rec.Result.HomeScore)
+		a.Equal(3, *rec.Result.AwayScore)
+		a.Equal("0-1", *rec.Result.HalfTimeScore)
+		a.Equal("0-3", *rec.Result.FullTimeScore)
+		a.True(rec.HasStats)
+		a.Equal(0, *rec.HomeStats.Goals)
+		a.Equal(5, *rec.HomeStats.TeamShots.Total)
+		a.Equal(2, *rec.HomeStats.TeamShots.OnGoal)
+		a.Equal(12, *rec.HomeStats.Fouls)
+		a.Equal(2, *rec.HomeStats.Corners)
+		a.Equal(2, *rec.HomeStats.YellowCards)
+		a.Equal(0, *rec.HomeStats.RedCards)
+		a.Nil(rec.HomeStats.Offsides)
+		a.Equal(3, *rec.AwayStats.Goals)
+		a.Equal(13, *rec.AwayStats.TeamShots.Total)
+		a.Equal(6, *rec.AwayStats.TeamShots.OnGoal)
+		a.Equal(3, *rec.AwayStats.Corners)
+
+		rec, err = reader.Next()
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a.Equal(3, rec.Line)
+		a.Equal("2020-09-12 00:00:00 +0000 UTC", rec.Date.String())
+		a.False(rec.HasTime)
+	})
+
+	t.Run("returns record alongside error for rows that cannot be parsed", func(t *testing.T) {
+		t.Helper()
+
+		spec := loadSpec(t, `{"file": "E0.csv"}`)
+
+		reader, err := importer.NewReader(strings.NewReader(footballDataCSV), spec)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		_, _ = reader.Next()
+		_, _ = reader.Next()
+
+		rec, err := reader.Next()
+
+		assert.Equal(t, 4, rec.Line)
+		assert.Equal(t, "home and away team are required", err.Error())
+
+		rec, err = reader.Next()
+
+		assert.Equal(t, 5, rec.Line)
+		assert.Equal(t, "value 'zero' for column FTHG is not a valid integer", err.Error())
+
+		_, err = reader.Next()
+
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("uses custom column mapping provided by spec", func(t *testing.T) {
+		t.Helper()
+
+		spec := loadSpec(t, `{
+			"file": "custom.csv",
+			"date_formats": ["2006-01-02"],
+			"columns": {"date": "match_date", "home_team": "home", "away_team": "away", "home_score": "hg", "away_score": "ag"}
+		}`)
+
+		csv := "match_date,home,away,hg,ag\n2012-08-18,Arsenal,Sunderland,0,0\n"
+
+		reader, err := importer.NewReader(strings.NewReader(csv), spec)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		rec, err := reader.Next()
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "Arsenal", rec.HomeTeam)
+		assert.Equal(t, "Sunderland", rec.AwayTeam)
+		assert.Equal(t, "0-0", *rec.Result.FullTimeScore)
+		assert.Nil(t, rec.Result.HalfTimeScore)
+		assert.False(t, rec.HasStats)
+	})
+
+	t.Run("returns error if required columns are missing from header", func(t *testing.T) {
+		t.Helper()
+
+		spec := loadSpec(t, `{"file": "E0.csv"}`)
+
+		_, err := importer.NewReader(strings.NewReader("Div,Date,HomeTeam\n"), spec)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "csv header does not contain the column mapped to away_team", err.Error())
+	})
+}
+
+func loadSpec(t *testing.T, json string) *importer.Spec {
+	dir, err := ioutil.TempDir("", "import")
+
+	if err != nil {
+		t.Fatalf("Error creating temp dir: %s", err.Error())
+	}
+
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "spec.json")
+
+	if err := ioutil.WriteFile(path, []byte(json), 0644); err != nil {
+		t.Fatalf("Error writing spec: %s", err.Error())
+	}
+
+	spec, err := importer.LoadSpec(path)
+
+	if err != nil {
+		t.Fatalf("Error loading spec: %s", err.Error())
+	}
+
+	return spec
+}
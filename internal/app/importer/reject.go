@@ -0,0 +1,48 @@
+package importer
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// RejectWriter writes CSV rows that could not be imported to a reject file alongside the line number of the
+// row in the original file and the reason it was rejected.
+type RejectWriter struct {
+	csv   *csv.Writer
+	count int
+}
+
+// Reject writes the rejected row to the reject file.
+func (w *RejectWriter) Reject(line int, values []string, reason string) error {
+	w.count++
+
+	row := append([]string{strconv.Itoa(line), reason}, values...)
+
+	if err := w.csv.Write(row); err != nil {
+		return err
+	}
+
+	w.csv.Flush()
+
+	return w.csv.Error()
+}
+
+// Count returns the number of rows rejected.
+func (w *RejectWriter) Count() int {
+	return w.count
+}
+
+// NewRejectWriter writes the reject file header row, built from the original CSV header, and returns a
+// RejectWriter for the rows that follow.
+func NewRejectWriter(out io.Writer, header []string) (*RejectWriter, error) {
+	w := csv.NewWriter(out)
+
+	if err := w.Write(append([]string{"line", "reason"}, header...)); err != nil {
+		return nil, err
+	}
+
+	w.Flush()
+
+	return &RejectWriter{csv: w}, w.Error()
+}
@@ -0,0 +1,145 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"io/ioutil"
+	"time"
+)
+
+// Fields a CSV column can be mapped to. Team stat fields are prefixed with the side of the fixture
+// they belong to i.e. "home_corners" and "away_corners".
+const (
+	FieldDate              = "date"
+	FieldTime              = "time"
+	FieldHomeTeam          = "home_team"
+	FieldAwayTeam          = "away_team"
+	FieldHomeScore         = "home_score"
+	FieldAwayScore         = "away_score"
+	FieldHomeHalfTimeScore = "home_half_time_score"
+	FieldAwayHalfTimeScore = "away_half_time_score"
+
+	StatShotsTotal  = "shots_total"
+	StatShotsOnGoal = "shots_on_goal"
+	StatFouls       = "fouls"
+	StatCorners     = "corners"
+	StatOffsides    = "offsides"
+	StatYellowCards = "yellow_cards"
+	StatRedCards    = "red_cards"
+)
+
+var stats = []string{
+	StatShotsTotal,
+	StatShotsOnGoal,
+	StatFouls,
+	StatCorners,
+	StatOffsides,
+	StatYellowCards,
+	StatRedCards,
+}
+
+// footballDataColumns maps fields to the column headers used by football-data.co.uk results files.
+var footballDataColumns = map[string]string{
+	FieldDate:                 "Date",
+	FieldTime:                 "Time",
+	FieldHomeTeam:             "HomeTeam",
+	FieldAwayTeam:             "AwayTeam",
+	FieldHomeScore:            "FTHG",
+	FieldAwayScore:            "FTAG",
+	FieldHomeHalfTimeScore:    "HTHG",
+	FieldAwayHalfTimeScore:    "HTAG",
+	"home_" + StatShotsTotal:  "HS",
+	"away_" + StatShotsTotal:  "AS",
+	"home_" + StatShotsOnGoal: "HST",
+	"away_" + StatShotsOnGoal: "AST",
+	"home_" + StatFouls:       "HF",
+	"away_" + StatFouls:       "AF",
+	"home_" + StatCorners:     "HC",
+	"away_" + StatCorners:     "AC",
+	"home_" + StatOffsides:    "HO",
+	"away_" + StatOffsides:    "AO",
+	"home_" + StatYellowCards: "HY",
+	"away_" + StatYellowCards: "AY",
+	"home_" + StatRedCards:    "HR",
+	"away_" + StatRedCards:    "AR",
+}
+
+// Spec describes a single CSV import. The CSV file, the file unresolvable rows are written to, how columns
+// map to fields and the season new fixtures are created in are all provided by the Spec.
+type Spec struct {
+	File        string            `json:"file"`
+	RejectFile  string            `json:"reject_file"`
+	Provider    string            `json:"provider"`
+	SeasonID    uint64            `json:"season_id"`
+	DateFormats []string          `json:"date_formats"`
+	TimeZone    string            `json:"time_zone"`
+	Columns     map[string]string `json:"columns"`
+	TeamAliases map[string]uint64 `json:"team_aliases"`
+	location    *time.Location
+}
+
+// Location returns the time zone dates and times in the CSV file are recorded in.
+func (s *Spec) Location() *time.Location {
+	if s.location == nil {
+		return time.UTC
+	}
+
+	return s.location
+}
+
+// LoadSpec reads a JSON encoded Spec from the path provided, applying football-data.co.uk defaults for any
+// optional values that have not been provided.
+func LoadSpec(path string) (*Spec, error) {
+	b, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading import spec %s: %s", path, err.Error())
+	}
+
+	var s Spec
+
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("error parsing import spec %s: %s", path, err.Error())
+	}
+
+	if err := s.applyDefaults(); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (s *Spec) applyDefaults() error {
+	if s.File == "" {
+		return fmt.Errorf("import spec must provide a file to import")
+	}
+
+	if s.RejectFile == "" {
+		s.RejectFile = s.File + ".rejects.csv"
+	}
+
+	if s.Provider == "" {
+		s.Provider = app.ProviderFootballData
+	}
+
+	if len(s.DateFormats) == 0 {
+		s.DateFormats = []string{"02/01/2006", "02/01/06"}
+	}
+
+	if len(s.Columns) == 0 {
+		s.Columns = footballDataColumns
+	}
+
+	if s.TimeZone != "" {
+		loc, err := time.LoadLocation(s.TimeZone)
+
+		if err != nil {
+			return fmt.Errorf("import spec time zone %s is invalid: %s", s.TimeZone, err.Error())
+		}
+
+		s.location = loc
+	}
+
+	return nil
+}
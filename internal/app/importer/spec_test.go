@@ -0,0 +1,35 @@
+package importer_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/importer"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLoadSpec(t *testing.T) {
+	t.Run("applies defaults for optional values", func(t *testing.T) {
+		t.Helper()
+
+		spec := loadSpec(t, `{"file": "/data/E0.csv", "season_id": 16036, "team_aliases": {"Man United": 14}}`)
+
+		a := assert.New(t)
+
+		a.Equal("/data/E0.csv", spec.File)
+		a.Equal("/data/E0.csv.rejects.csv", spec.RejectFile)
+		a.Equal(app.ProviderFootballData, spec.Provider)
+		a.Equal(uint64(16036), spec.SeasonID)
+		a.Equal([]string{"02/01/2006", "02/01/06"}, spec.DateFormats)
+		a.Equal("HomeTeam", spec.Columns[importer.FieldHomeTeam])
+		a.Equal(uint64(14), spec.TeamAliases["Man United"])
+		a.Equal("UTC", spec.Location().String())
+	})
+
+	t.Run("returns error if spec file does not exist", func(t *testing.T) {
+		t.Helper()
+
+		_, err := importer.LoadSpec("./does-not-exist.json")
+
+		assert.NotNil(t, err)
+	})
+}
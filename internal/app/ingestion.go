@@ -0,0 +1,120 @@
+package app
+
+import (
+	"fmt"
+	"time"
+)
+
+// IngestionTier determines how frequently the data of a competition is refreshed. Scheduled runs of the console
+// select the tier of competitions they process so high tier competitions can be refreshed more often than low tier
+// competitions, limiting the requests made to the data provider for minor competitions.
+type IngestionTier string
+
+const (
+	IngestionTierHigh     IngestionTier = "high"
+	IngestionTierStandard IngestionTier = "standard"
+	IngestionTierLow      IngestionTier = "low"
+)
+
+// ParseIngestionTier returns the IngestionTier matching the value provided.
+func ParseIngestionTier(value string) (IngestionTier, error) {
+	switch t := IngestionTier(value); t {
+	case IngestionTierHigh, IngestionTierStandard, IngestionTierLow:
+		return t, nil
+	default:
+		return "", fmt.Errorf("ingestion tier '%s' is not supported, use high, standard or low", value)
+	}
+}
+
+// Datasets ingested by processors, named after the console commands ingesting them.
+const (
+	DatasetEvents      = "events"
+	DatasetFixtureXG   = "fixture-xg"
+	DatasetFixtures    = "fixtures"
+	DatasetLineups     = "lineup"
+	DatasetLive        = "live"
+	DatasetManagers    = "manager"
+	DatasetOdds        = "odds"
+	DatasetPlayerStats = "player-stats"
+	DatasetReferees    = "referee"
+	DatasetResults     = "results"
+	DatasetRounds      = "round"
+	DatasetSidelined   = "sidelined"
+	DatasetSquads      = "squad"
+	DatasetStages      = "stage"
+	DatasetStandings   = "standings"
+	DatasetSuspensions = "suspension"
+	DatasetTeams       = "team"
+	DatasetTeamStats   = "team-stats"
+	DatasetTransfers   = "transfer"
+	DatasetVenues      = "venue"
+)
+
+// IngestionDatasets contains every dataset an IngestionPolicy can enable.
+var IngestionDatasets = []string{
+	DatasetEvents,
+	DatasetFixtureXG,
+	DatasetFixtures,
+	DatasetLineups,
+	DatasetLive,
+	DatasetManagers,
+	DatasetOdds,
+	DatasetPlayerStats,
+	DatasetReferees,
+	DatasetResults,
+	DatasetRounds,
+	DatasetSidelined,
+	DatasetSquads,
+	DatasetStages,
+	DatasetStandings,
+	DatasetSuspensions,
+	DatasetTeams,
+	DatasetTeamStats,
+	DatasetTransfers,
+	DatasetVenues,
+}
+
+// IngestionPolicy determines the data ingested for a competition. Processors only request the datasets enabled for
+// the competition, a policy without datasets disables ingestion for the competition. HistoryDepth limits commands
+// processing every season to the most recent seasons of the competition, every season is processed if zero.
+// Competitions without a policy ingest every dataset for every season at the standard tier.
+type IngestionPolicy struct {
+	CompetitionID uint64        `json:"competition_id"`
+	Datasets      []string      `json:"datasets"`
+	Tier          IngestionTier `json:"tier"`
+	HistoryDepth  int           `json:"history_depth"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+}
+
+// Enables returns true if the policy includes the dataset provided.
+func (i IngestionPolicy) Enables(dataset string) bool {
+	for _, d := range i.Datasets {
+		if d == dataset {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DefaultIngestionPolicy returns the policy applied to a competition without a stored policy.
+func DefaultIngestionPolicy(competitionID uint64) IngestionPolicy {
+	datasets := make([]string, len(IngestionDatasets))
+	copy(datasets, IngestionDatasets)
+
+	return IngestionPolicy{
+		CompetitionID: competitionID,
+		Datasets:      datasets,
+		Tier:          IngestionTierStandard,
+	}
+}
+
+// IngestionPolicyRepository provides an interface to persist IngestionPolicy domain struct objects to a storage
+// engine.
+type IngestionPolicyRepository interface {
+	// Save inserts the policy or replaces the existing policy for the competition.
+	Save(p *IngestionPolicy) error
+	ByCompetitionID(id uint64) (*IngestionPolicy, error)
+	All() ([]IngestionPolicy, error)
+	Delete(competitionID uint64) error
+}
@@ -0,0 +1,53 @@
+package ingestion
+
+import (
+	"fmt"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"strings"
+)
+
+// Prepare validates the policy provided, enabling every dataset if no datasets are provided and defaulting the
+// tier to standard. An empty list of datasets is kept, disabling ingestion for the competition.
+func Prepare(p *app.IngestionPolicy) error {
+	if p.CompetitionID == 0 {
+		return fmt.Errorf("competition ID must be provided")
+	}
+
+	if p.Datasets == nil {
+		p.Datasets = app.DefaultIngestionPolicy(p.CompetitionID).Datasets
+	}
+
+	for _, d := range p.Datasets {
+		if !isDataset(d) {
+			return fmt.Errorf(
+				"dataset '%s' is not supported, datasets available are %s",
+				d,
+				strings.Join(app.IngestionDatasets, ", "),
+			)
+		}
+	}
+
+	if p.Tier == "" {
+		p.Tier = app.IngestionTierStandard
+	}
+
+	if _, err := app.ParseIngestionTier(string(p.Tier)); err != nil {
+		return err
+	}
+
+	if p.HistoryDepth < 0 {
+		return fmt.Errorf("history depth must not be negative")
+	}
+
+	return nil
+}
+
+func isDataset(name string) bool {
+	for _, d := range app.IngestionDatasets {
+		if d == name {
+			return true
+		}
+	}
+
+	return false
+}
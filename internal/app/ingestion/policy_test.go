@@ -0,0 +1,68 @@
+package ingestion_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/ingestion"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPrepare(t *testing.T) {
+	t.Run("enables every dataset at the standard tier if not provided", func(t *testing.T) {
+		t.Helper()
+
+		p := app.IngestionPolicy{CompetitionID: 8}
+
+		if err := ingestion.Prepare(&p); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, app.IngestionDatasets, p.Datasets)
+		assert.Equal(t, app.IngestionTierStandard, p.Tier)
+	})
+
+	t.Run("keeps an empty list of datasets disabling the competition", func(t *testing.T) {
+		t.Helper()
+
+		p := app.IngestionPolicy{CompetitionID: 8, Datasets: []string{}, Tier: app.IngestionTierLow}
+
+		if err := ingestion.Prepare(&p); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 0, len(p.Datasets))
+		assert.False(t, p.Enables(app.DatasetResults))
+	})
+
+	t.Run("returns error if the policy is invalid", func(t *testing.T) {
+		t.Helper()
+
+		policies := []struct {
+			policy app.IngestionPolicy
+			err    string
+		}{
+			{app.IngestionPolicy{}, "competition ID must be provided"},
+			{
+				app.IngestionPolicy{CompetitionID: 8, Datasets: []string{"results", "goals"}},
+				"dataset 'goals' is not supported, datasets available are events, fixture-xg, fixtures, lineup, " +
+					"live, manager, odds, player-stats, referee, results, round, sidelined, squad, stage, standings, " +
+					"suspension, team, team-stats, transfer, venue",
+			},
+			{
+				app.IngestionPolicy{CompetitionID: 8, Tier: "weekly"},
+				"ingestion tier 'weekly' is not supported, use high, standard or low",
+			},
+			{app.IngestionPolicy{CompetitionID: 8, HistoryDepth: -1}, "history depth must not be negative"},
+		}
+
+		for _, p := range policies {
+			err := ingestion.Prepare(&p.policy)
+
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+
+			assert.Equal(t, p.err, err.Error())
+		}
+	})
+}
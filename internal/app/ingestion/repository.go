@@ -0,0 +1,377 @@
+package ingestion
+
+import (
+	"fmt"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"sort"
+	"sync"
+)
+
+// filter selects the competitions a dataset is ingested for using the IngestionPolicy of each competition and the
+// tier processed by the console. Competitions are only filtered once a policy has been stored or a tier selected,
+// until then every competition is ingested as before. Policies are loaded once, repositories are built for a single
+// processor run so policies changed during a run apply from the next run.
+type filter struct {
+	policyRepo app.IngestionPolicyRepository
+	dataset    string
+	tier       app.IngestionTier
+	once       sync.Once
+	loaded     *policies
+	err        error
+}
+
+func (f *filter) load() (*policies, error) {
+	f.once.Do(func() {
+		all, err := f.policyRepo.All()
+
+		if err != nil {
+			f.err = fmt.Errorf("error fetching ingestion policies: %s", err.Error())
+			return
+		}
+
+		p := policies{byID: make(map[uint64]app.IngestionPolicy, len(all)), dataset: f.dataset, tier: f.tier}
+
+		for _, x := range all {
+			p.byID[x.CompetitionID] = x
+		}
+
+		f.loaded = &p
+	})
+
+	return f.loaded, f.err
+}
+
+type policies struct {
+	byID    map[uint64]app.IngestionPolicy
+	dataset string
+	tier    app.IngestionTier
+}
+
+func (p policies) active() bool {
+	return len(p.byID) > 0 || p.tier != ""
+}
+
+func (p policies) policy(competitionID uint64) app.IngestionPolicy {
+	if x, ok := p.byID[competitionID]; ok {
+		return x
+	}
+
+	return app.DefaultIngestionPolicy(competitionID)
+}
+
+func (p policies) ingests(competitionID uint64) bool {
+	x := p.policy(competitionID)
+
+	return x.Enables(p.dataset) && (p.tier == "" || x.Tier == p.tier)
+}
+
+// recent returns the seasons within the history depth of the competition policy, keeping the order provided.
+// Seasons are named after the years they are played in so the most recent seasons have the greatest names.
+func (p policies) recent(competitionID uint64, seasons []app.Season) []app.Season {
+	depth := p.policy(competitionID).HistoryDepth
+
+	if depth == 0 || len(seasons) <= depth {
+		return seasons
+	}
+
+	names := make([]string, len(seasons))
+
+	for i, s := range seasons {
+		names[i] = s.Name
+	}
+
+	sort.Sort(sort.Reverse(sort.StringSlice(names)))
+
+	oldest := names[depth-1]
+	kept := make([]app.Season, 0, depth)
+
+	for _, s := range seasons {
+		if s.Name >= oldest && len(kept) < depth {
+			kept = append(kept, s)
+		}
+	}
+
+	return kept
+}
+
+// SeasonRepository limits the seasons returned by the wrapped SeasonRepository to the seasons of competitions the
+// dataset is ingested for, limiting commands processing every season to the history depth of each competition.
+type SeasonRepository struct {
+	app.SeasonRepository
+	competitionRepo app.CompetitionRepository
+	filter
+}
+
+func (s *SeasonRepository) IDs() ([]uint64, error) {
+	p, err := s.load()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.active() {
+		return s.SeasonRepository.IDs()
+	}
+
+	seasons, err := s.ingested(p)
+
+	if err != nil {
+		return nil, err
+	}
+
+	byCompetition := map[uint64][]app.Season{}
+
+	for _, x := range seasons {
+		byCompetition[x.CompetitionID] = append(byCompetition[x.CompetitionID], x)
+	}
+
+	var ids []uint64
+
+	for id, x := range byCompetition {
+		for _, season := range p.recent(id, x) {
+			ids = append(ids, season.ID)
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	return ids, nil
+}
+
+func (s *SeasonRepository) CurrentSeasonIDs() ([]uint64, error) {
+	p, err := s.load()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.active() {
+		return s.SeasonRepository.CurrentSeasonIDs()
+	}
+
+	seasons, err := s.ingested(p)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []uint64
+
+	for _, x := range seasons {
+		if x.IsCurrent {
+			ids = append(ids, x.ID)
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	return ids, nil
+}
+
+// ingested returns the seasons of every competition the dataset is ingested for in a single query.
+func (s *SeasonRepository) ingested(p *policies) ([]app.Season, error) {
+	competitions, err := s.competitionRepo.IDs()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []uint64
+
+	for _, c := range competitions {
+		if p.ingests(c) {
+			ids = append(ids, c)
+		}
+	}
+
+	if len(ids) == 0 {
+		return []app.Season{}, nil
+	}
+
+	return s.SeasonRepository.ByCompetitionIds(ids, "name_desc")
+}
+
+func (s *SeasonRepository) ByCompetitionId(id uint64, sort string) ([]app.Season, error) {
+	p, err := s.load()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.active() {
+		return s.SeasonRepository.ByCompetitionId(id, sort)
+	}
+
+	return s.byCompetitionID(p, id, sort)
+}
+
+func (s *SeasonRepository) byCompetitionID(p *policies, id uint64, sort string) ([]app.Season, error) {
+	if !p.ingests(id) {
+		return []app.Season{}, nil
+	}
+
+	seasons, err := s.SeasonRepository.ByCompetitionId(id, sort)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return p.recent(id, seasons), nil
+}
+
+// CompetitionRepository limits the competition IDs returned by the wrapped CompetitionRepository to the
+// competitions the dataset is ingested for.
+type CompetitionRepository struct {
+	app.CompetitionRepository
+	filter
+}
+
+func (c *CompetitionRepository) IDs() ([]uint64, error) {
+	p, err := c.load()
+
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := c.CompetitionRepository.IDs()
+
+	if err != nil || !p.active() {
+		return ids, err
+	}
+
+	var filtered []uint64
+
+	for _, id := range ids {
+		if p.ingests(id) {
+			filtered = append(filtered, id)
+		}
+	}
+
+	return filtered, nil
+}
+
+// FixtureRepository limits the fixtures returned by queries of the wrapped FixtureRepository to fixtures in
+// competitions the dataset is ingested for.
+type FixtureRepository struct {
+	app.FixtureRepository
+	seasonRepo app.SeasonRepository
+	filter
+}
+
+func (f *FixtureRepository) Get(q app.FixtureRepositoryQuery) ([]app.Fixture, error) {
+	p, err := f.load()
+
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures, err := f.FixtureRepository.Get(q)
+
+	if err != nil || !p.active() {
+		return fixtures, err
+	}
+
+	return f.ingested(p, fixtures)
+}
+
+func (f *FixtureRepository) GetIDs(q app.FixtureRepositoryQuery) ([]uint64, error) {
+	p, err := f.load()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.active() {
+		return f.FixtureRepository.GetIDs(q)
+	}
+
+	fixtures, err := f.FixtureRepository.Get(q)
+
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures, err = f.ingested(p, fixtures)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, len(fixtures))
+
+	for i, x := range fixtures {
+		ids[i] = x.ID
+	}
+
+	return ids, nil
+}
+
+func (f *FixtureRepository) ingested(p *policies, fixtures []app.Fixture) ([]app.Fixture, error) {
+	competitions := map[uint64]uint64{}
+	var filtered []app.Fixture
+
+	for _, x := range fixtures {
+		c, ok := competitions[x.SeasonID]
+
+		if !ok {
+			season, err := f.seasonRepo.ByID(x.SeasonID)
+
+			if err != nil {
+				return nil, fmt.Errorf("error fetching season %d of fixture %d: %s", x.SeasonID, x.ID, err.Error())
+			}
+
+			c = season.CompetitionID
+			competitions[x.SeasonID] = c
+		}
+
+		if p.ingests(c) {
+			filtered = append(filtered, x)
+		}
+	}
+
+	return filtered, nil
+}
+
+func NewSeasonRepository(
+	s app.SeasonRepository,
+	c app.CompetitionRepository,
+	p app.IngestionPolicyRepository,
+	dataset string,
+	tier app.IngestionTier,
+) *SeasonRepository {
+	return &SeasonRepository{
+		SeasonRepository: s,
+		competitionRepo:  c,
+		filter:           filter{policyRepo: p, dataset: dataset, tier: tier},
+	}
+}
+
+func NewCompetitionRepository(
+	c app.CompetitionRepository,
+	p app.IngestionPolicyRepository,
+	dataset string,
+	tier app.IngestionTier,
+) *CompetitionRepository {
+	return &CompetitionRepository{
+		CompetitionRepository: c,
+		filter:                filter{policyRepo: p, dataset: dataset, tier: tier},
+	}
+}
+
+func NewFixtureRepository(
+	f app.FixtureRepository,
+	s app.SeasonRepository,
+	p app.IngestionPolicyRepository,
+	dataset string,
+	tier app.IngestionTier,
+) *FixtureRepository {
+	return &FixtureRepository{
+		FixtureRepository: f,
+		seasonRepo:        s,
+		filter:            filter{policyRepo: p, dataset: dataset, tier: tier},
+	}
+}
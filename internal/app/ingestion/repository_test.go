@@ -0,0 +1,253 @@
+package ingestion_test
+
+import (
+	"errors"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/ingestion"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestSeasonRepository_IDs(t *testing.T) {
+	t.Run("returns every season if no policies are stored and no tier is selected", func(t *testing.T) {
+		t.Helper()
+
+		seasons := new(mock.SeasonRepository)
+		competitions := new(mock.CompetitionRepository)
+		policies := new(mock.IngestionPolicyRepository)
+
+		repo := ingestion.NewSeasonRepository(seasons, competitions, policies, app.DatasetResults, "")
+
+		policies.On("All").Return([]app.IngestionPolicy{}, nil)
+		seasons.On("IDs").Return([]uint64{1, 2, 3}, nil)
+
+		ids, err := repo.IDs()
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []uint64{1, 2, 3}, ids)
+		competitions.AssertNotCalled(t, "IDs")
+	})
+
+	t.Run("returns the recent seasons of competitions the dataset is ingested for", func(t *testing.T) {
+		t.Helper()
+
+		seasons := new(mock.SeasonRepository)
+		competitions := new(mock.CompetitionRepository)
+		policies := new(mock.IngestionPolicyRepository)
+
+		repo := ingestion.NewSeasonRepository(seasons, competitions, policies, app.DatasetResults, "")
+
+		policies.On("All").Return([]app.IngestionPolicy{
+			{CompetitionID: 8, Datasets: []string{app.DatasetResults}, Tier: app.IngestionTierHigh, HistoryDepth: 2},
+			{CompetitionID: 9, Datasets: []string{app.DatasetFixtures}, Tier: app.IngestionTierLow},
+		}, nil)
+		competitions.On("IDs").Return([]uint64{8, 9, 82}, nil)
+		seasons.On("ByCompetitionIds", []uint64{8, 82}, "name_desc").Return([]app.Season{
+			{ID: 17420, Name: "2020/2021", CompetitionID: 8},
+			{ID: 17361, Name: "2020/2021", CompetitionID: 82},
+			{ID: 16036, Name: "2019/2020", CompetitionID: 8},
+			{ID: 12962, Name: "2018/2019", CompetitionID: 8},
+		}, nil)
+
+		ids, err := repo.IDs()
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []uint64{16036, 17361, 17420}, ids)
+		policies.AssertNumberOfCalls(t, "All", 1)
+	})
+
+	t.Run("returns error if policies cannot be fetched", func(t *testing.T) {
+		t.Helper()
+
+		seasons := new(mock.SeasonRepository)
+		competitions := new(mock.CompetitionRepository)
+		policies := new(mock.IngestionPolicyRepository)
+
+		repo := ingestion.NewSeasonRepository(seasons, competitions, policies, app.DatasetResults, "")
+
+		policies.On("All").Return([]app.IngestionPolicy{}, errors.New("oh no"))
+
+		_, err := repo.IDs()
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "error fetching ingestion policies: oh no", err.Error())
+		seasons.AssertNotCalled(t, "IDs")
+	})
+}
+
+func TestSeasonRepository_CurrentSeasonIDs(t *testing.T) {
+	t.Run("returns current seasons of competitions in the tier selected", func(t *testing.T) {
+		t.Helper()
+
+		seasons := new(mock.SeasonRepository)
+		competitions := new(mock.CompetitionRepository)
+		policies := new(mock.IngestionPolicyRepository)
+
+		repo := ingestion.NewSeasonRepository(seasons, competitions, policies, app.DatasetFixtures, app.IngestionTierHigh)
+
+		policies.On("All").Return([]app.IngestionPolicy{
+			{CompetitionID: 8, Datasets: app.IngestionDatasets, Tier: app.IngestionTierHigh},
+		}, nil)
+		competitions.On("IDs").Return([]uint64{8, 82}, nil)
+		seasons.On("ByCompetitionIds", []uint64{8}, "name_desc").Return([]app.Season{
+			{ID: 17420, Name: "2020/2021", CompetitionID: 8, IsCurrent: true},
+			{ID: 16036, Name: "2019/2020", CompetitionID: 8},
+		}, nil)
+
+		ids, err := repo.CurrentSeasonIDs()
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []uint64{17420}, ids)
+		seasons.AssertNotCalled(t, "CurrentSeasonIDs")
+		seasons.AssertNotCalled(t, "ByID", m.Anything)
+	})
+
+	t.Run("loads policies once for every call", func(t *testing.T) {
+		t.Helper()
+
+		seasons := new(mock.SeasonRepository)
+		competitions := new(mock.CompetitionRepository)
+		policies := new(mock.IngestionPolicyRepository)
+
+		repo := ingestion.NewSeasonRepository(seasons, competitions, policies, app.DatasetFixtures, "")
+
+		policies.On("All").Return([]app.IngestionPolicy{}, nil)
+		seasons.On("CurrentSeasonIDs").Return([]uint64{17420}, nil)
+
+		for i := 0; i < 3; i++ {
+			if _, err := repo.CurrentSeasonIDs(); err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+		}
+
+		policies.AssertNumberOfCalls(t, "All", 1)
+		seasons.AssertNumberOfCalls(t, "CurrentSeasonIDs", 3)
+	})
+}
+
+func TestSeasonRepository_ByCompetitionId(t *testing.T) {
+	t.Run("limits seasons to the history depth keeping the order requested", func(t *testing.T) {
+		t.Helper()
+
+		seasons := new(mock.SeasonRepository)
+		competitions := new(mock.CompetitionRepository)
+		policies := new(mock.IngestionPolicyRepository)
+
+		repo := ingestion.NewSeasonRepository(seasons, competitions, policies, app.DatasetTeamStats, "")
+
+		policies.On("All").Return([]app.IngestionPolicy{
+			{CompetitionID: 8, Datasets: []string{app.DatasetTeamStats}, Tier: app.IngestionTierHigh, HistoryDepth: 2},
+		}, nil)
+		seasons.On("ByCompetitionId", uint64(8), "name_asc").Return([]app.Season{
+			{ID: 12962, Name: "2018/2019"},
+			{ID: 16036, Name: "2019/2020"},
+			{ID: 17420, Name: "2020/2021"},
+		}, nil)
+
+		fetched, err := repo.ByCompetitionId(8, "name_asc")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(fetched))
+		assert.Equal(t, uint64(16036), fetched[0].ID)
+		assert.Equal(t, uint64(17420), fetched[1].ID)
+	})
+
+	t.Run("returns no seasons if the dataset is not ingested for the competition", func(t *testing.T) {
+		t.Helper()
+
+		seasons := new(mock.SeasonRepository)
+		competitions := new(mock.CompetitionRepository)
+		policies := new(mock.IngestionPolicyRepository)
+
+		repo := ingestion.NewSeasonRepository(seasons, competitions, policies, app.DatasetPlayerStats, "")
+
+		policies.On("All").Return([]app.IngestionPolicy{
+			{CompetitionID: 8, Datasets: []string{app.DatasetTeamStats}, Tier: app.IngestionTierHigh},
+		}, nil)
+
+		fetched, err := repo.ByCompetitionId(8, "name_asc")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 0, len(fetched))
+		seasons.AssertNotCalled(t, "ByCompetitionId", uint64(8), "name_asc")
+	})
+}
+
+func TestCompetitionRepository_IDs(t *testing.T) {
+	t.Run("returns competitions the dataset is ingested for with a default policy for competitions without one", func(t *testing.T) {
+		t.Helper()
+
+		competitions := new(mock.CompetitionRepository)
+		policies := new(mock.IngestionPolicyRepository)
+
+		repo := ingestion.NewCompetitionRepository(competitions, policies, app.DatasetTeamStats, "")
+
+		policies.On("All").Return([]app.IngestionPolicy{
+			{CompetitionID: 9, Datasets: []string{}, Tier: app.IngestionTierLow},
+		}, nil)
+		competitions.On("IDs").Return([]uint64{8, 9, 82}, nil)
+
+		ids, err := repo.IDs()
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []uint64{8, 82}, ids)
+	})
+}
+
+func TestFixtureRepository_GetIDs(t *testing.T) {
+	t.Run("returns fixtures in competitions the dataset is ingested for", func(t *testing.T) {
+		t.Helper()
+
+		fixtures := new(mock.FixtureRepository)
+		seasons := new(mock.SeasonRepository)
+		policies := new(mock.IngestionPolicyRepository)
+
+		repo := ingestion.NewFixtureRepository(fixtures, seasons, policies, app.DatasetOdds, "")
+
+		query := app.FixtureRepositoryQuery{SeasonIDs: []uint64{17420, 17361}}
+
+		policies.On("All").Return([]app.IngestionPolicy{
+			{CompetitionID: 82, Datasets: []string{app.DatasetFixtures}, Tier: app.IngestionTierLow},
+		}, nil)
+		fixtures.On("Get", query).Return([]app.Fixture{
+			{ID: 45, SeasonID: 17420},
+			{ID: 46, SeasonID: 17361},
+			{ID: 47, SeasonID: 17420},
+		}, nil)
+		seasons.On("ByID", uint64(17420)).Return(&app.Season{ID: 17420, CompetitionID: 8}, nil).Once()
+		seasons.On("ByID", uint64(17361)).Return(&app.Season{ID: 17361, CompetitionID: 82}, nil).Once()
+
+		ids, err := repo.GetIDs(query)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []uint64{45, 47}, ids)
+		seasons.AssertExpectations(t)
+		fixtures.AssertNotCalled(t, "GetIDs", query)
+	})
+}
@@ -0,0 +1,49 @@
+package app
+
+import "time"
+
+const (
+	JobPending   = "pending"
+	JobRunning   = "running"
+	JobSucceeded = "succeeded"
+	JobFailed    = "failed"
+)
+
+// Job is a console command queued for a worker process to run. Pending jobs are claimed in order of priority,
+// highest first, once their NotBefore time has passed. Failed runs are retried until MaxAttempts is reached.
+type Job struct {
+	ID          uint64     `json:"id"`
+	Command     string     `json:"command"`
+	Option      string     `json:"option"`
+	Priority    int        `json:"priority"`
+	NotBefore   time.Time  `json:"not_before"`
+	Status      string     `json:"status"`
+	Attempts    int        `json:"attempts"`
+	MaxAttempts int        `json:"max_attempts"`
+	LastError   *string    `json:"last_error"`
+	WorkerID    *string    `json:"worker_id"`
+	HeartbeatAt *time.Time `json:"heartbeat_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// JobQueue provides an interface to enqueue Job structs and for worker processes to claim and record the outcome
+// of them. A job is claimed by a single worker, the worker sends heartbeats whilst running the job and jobs whose
+// worker stops sending heartbeats can be claimed by another worker.
+type JobQueue interface {
+	Enqueue(j *Job) error
+	// Claim marks the next job due to run as running on the worker provided, returning errors.ErrorNotFound if no
+	// job is due. Running jobs without a heartbeat since the stale time provided are claimed again.
+	Claim(workerID string, staleBefore time.Time) (*Job, error)
+	// Heartbeat records that the worker is still running the job, returning errors.ErrorNotFound if the job is no
+	// longer running on the worker provided.
+	Heartbeat(id uint64, workerID string) error
+	// Complete, Retry and Fail record the outcome of a job running on the worker provided, returning
+	// errors.ErrorNotFound if the job is no longer running on the worker.
+	Complete(id uint64, workerID string) error
+	// Retry returns the job to the queue to be claimed again after the time provided.
+	Retry(id uint64, workerID, reason string, at time.Time) error
+	Fail(id uint64, workerID, reason string) error
+	// Recent returns the most recently created jobs ordered by ID descending.
+	Recent(limit uint64) ([]Job, error)
+}
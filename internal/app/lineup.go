@@ -0,0 +1,69 @@
+package app
+
+import (
+	"time"
+)
+
+// Lineup domain entity. The starting eleven and bench named by a team for a fixture. Lineups are announced by the
+// data provider ahead of kick-off so a Lineup can exist for a fixture without PlayerStats. Formation is the team's
+// formation i.e. "4-2-3-1" and is nil until provided.
+type Lineup struct {
+	FixtureID uint64         `json:"fixture_id"`
+	TeamID    uint64         `json:"team_id"`
+	Formation *string        `json:"formation"`
+	Players   []LineupPlayer `json:"players"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// LineupPlayer domain sub entity. FormationPosition is the player's slot in the team's formation, numbered from the
+// goalkeeper, and GridX and GridY the player's coordinates on the formation grid as provided by the data provider.
+type LineupPlayer struct {
+	PlayerID          uint64  `json:"player_id"`
+	ShirtNumber       int     `json:"shirt_number"`
+	Position          *string `json:"position"`
+	FormationPosition *int    `json:"formation_position"`
+	GridX             *int    `json:"grid_x"`
+	GridY             *int    `json:"grid_y"`
+	Captain           bool    `json:"captain"`
+	IsSubstitute      bool    `json:"is_substitute"`
+}
+
+// Starting returns the players named in the starting eleven.
+func (l Lineup) Starting() []LineupPlayer {
+	return l.filter(false)
+}
+
+// Bench returns the players named as substitutes.
+func (l Lineup) Bench() []LineupPlayer {
+	return l.filter(true)
+}
+
+func (l Lineup) filter(sub bool) []LineupPlayer {
+	players := []LineupPlayer{}
+
+	for _, p := range l.Players {
+		if p.IsSubstitute == sub {
+			players = append(players, p)
+		}
+	}
+
+	return players
+}
+
+// LineupRepository provides an interface to persist Lineup domain struct objects to a storage engine.
+type LineupRepository interface {
+	// Save inserts the lineup provided or replaces the existing lineup for the fixture and team.
+	Save(l *Lineup) error
+	ByFixtureAndTeam(fixtureID, teamID uint64) (*Lineup, error)
+	ByFixtureID(id uint64) ([]Lineup, error)
+}
+
+// LineupRequester provides an interface allowing this application to request data from an external
+// data provider. The requester implementation is responsible for creating the channel, filtering struct data into
+// the channel before closing the channel once successful execution is complete.
+type LineupRequester interface {
+	LineupsByFixtureIDs(ids []uint64) <-chan Lineup
+	LineupsBySeasonIDs(ids []uint64) <-chan Lineup
+	LineupsByDate(date time.Time, competitionIDs []uint64) <-chan Lineup
+}
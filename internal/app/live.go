@@ -0,0 +1,45 @@
+package app
+
+// LiveFixture is a snapshot of a fixture in play as reported by an external data provider. Events contain every
+// event recorded in the fixture so far rather than those recorded since the previous snapshot.
+type LiveFixture struct {
+	FixtureID       uint64
+	Status          FixtureStatus
+	Result          Result
+	TeamStats       []TeamStats
+	Goals           []GoalEvent
+	Cards           []CardEvent
+	Substitutions   []SubstitutionEvent
+	MissedPenalties []MissedPenaltyEvent
+}
+
+// LiveRequester provides an interface allowing this application to request live fixture data from an external
+// data provider. The requester implementation is responsible for creating the channel, filtering struct data into
+// the channel before closing the channel once successful execution is complete.
+type LiveRequester interface {
+	LiveFixtures(ids []uint64) <-chan LiveFixture
+}
+
+const (
+	FixtureUpdateCard          = "card"
+	FixtureUpdateGoal          = "goal"
+	FixtureUpdateMissedPenalty = "missed-penalty"
+	FixtureUpdateResult        = "result"
+	FixtureUpdateStatus        = "status"
+	FixtureUpdateSubstitution  = "substitution"
+)
+
+// FixtureUpdate is published when the status, score or events of a fixture change. EventID is populated for
+// updates caused by a new card, goal, missed penalty or substitution event.
+type FixtureUpdate struct {
+	FixtureID uint64  `json:"fixture_id"`
+	Type      string  `json:"type"`
+	EventID   *uint64 `json:"event_id"`
+}
+
+// FixtureUpdateListener provides an interface allowing this application to receive FixtureUpdate structs
+// published by any instance of this application. Subscribe returns a channel receiving updates until the returned
+// function is called.
+type FixtureUpdateListener interface {
+	Subscribe() (<-chan FixtureUpdate, func())
+}
@@ -0,0 +1,53 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// LockStrategy determines how a process behaves when the lock it requires is already held by another process.
+type LockStrategy string
+
+const (
+	// LockStrategySkip gives up immediately if the lock is held.
+	LockStrategySkip LockStrategy = "skip"
+	// LockStrategyWait blocks until the lock is released by the process holding it.
+	LockStrategyWait LockStrategy = "wait"
+	// LockStrategySteal disconnects the process holding the lock and takes the lock from it.
+	LockStrategySteal LockStrategy = "steal"
+)
+
+// ErrLockHeld is returned by a Locker when the lock requested is held by another process and the LockStrategy does
+// not allow the lock to be waited for or stolen.
+var ErrLockHeld = errors.New("lock is held by another process")
+
+// Locker acquires named locks shared by every process connected to the same storage engine, preventing two
+// processes from running the same work concurrently.
+type Locker interface {
+	Acquire(name string, s LockStrategy) (Lock, error)
+}
+
+// Lock is a lock acquired by a Locker, held until released or lost.
+type Lock interface {
+	// Lost returns a channel that is closed if the lock is lost before being released, i.e. stolen by another
+	// process.
+	Lost() <-chan struct{}
+	Release() error
+}
+
+// CommandLockName returns the name of the lock held whilst a command runs, runs of the same command with a different
+// option do not conflict.
+func CommandLockName(command, option string) string {
+	return strings.TrimSpace("console:" + command + " " + option)
+}
+
+// ParseLockStrategy returns the LockStrategy matching the value provided.
+func ParseLockStrategy(value string) (LockStrategy, error) {
+	switch s := LockStrategy(value); s {
+	case LockStrategySkip, LockStrategyWait, LockStrategySteal:
+		return s, nil
+	default:
+		return "", fmt.Errorf("lock strategy '%s' is not supported, use skip, wait or steal", value)
+	}
+}
@@ -0,0 +1,118 @@
+package app
+
+import (
+	"sort"
+	"time"
+)
+
+// Manager domain entity.
+type Manager struct {
+	ID          uint64    `json:"id"`
+	TeamID      *uint64   `json:"team_id"`
+	CountryID   uint64    `json:"country_id"`
+	FirstName   string    `json:"first_name"`
+	LastName    string    `json:"last_name"`
+	Nationality string    `json:"nationality"`
+	Image       *string   `json:"image"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ManagerTenure is a period a manager was in charge of a team. From and To are the dates of the first and last
+// fixtures the manager was in charge of, the manager of a team on any date is the manager of the latest tenure
+// starting on or before that date.
+type ManagerTenure struct {
+	TeamID    uint64    `json:"team_id"`
+	ManagerID uint64    `json:"manager_id"`
+	From      time.Time `json:"from"`
+	To        time.Time `json:"to"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ManagerSighting records a manager being in charge of a team for a fixture played on Date. Sightings are stored
+// as the evidence each team's tenure history is built from.
+type ManagerSighting struct {
+	Manager   Manager
+	TeamID    uint64
+	FixtureID uint64
+	Date      time.Time
+}
+
+// ManagerRepository provides an interface to persist Manager domain struct objects to a storage engine.
+type ManagerRepository interface {
+	Insert(m *Manager) error
+	Update(m *Manager) error
+	ByID(id uint64) (*Manager, error)
+}
+
+// ManagerSightingRepository provides an interface to persist ManagerSighting domain struct objects to a storage
+// engine. A team has a single sighting per fixture, saving a sighting replaces the existing sighting for the fixture.
+// Sightings returned only hold the ID of the manager.
+type ManagerSightingRepository interface {
+	Save(s *ManagerSighting) error
+	ByTeamID(teamID uint64) ([]ManagerSighting, error)
+}
+
+// ManagerTenureRepository provides an interface to persist ManagerTenure domain struct objects to a storage engine.
+type ManagerTenureRepository interface {
+	ReplaceForTeam(teamID uint64, tenures []ManagerTenure) error
+	ByTeamID(teamID uint64) ([]ManagerTenure, error)
+	ByManagerID(managerID uint64) ([]ManagerTenure, error)
+	AtDate(teamID uint64, date time.Time) (*ManagerTenure, error)
+}
+
+// ManagerRequester provides an interface allowing this application to request data from an external
+// data provider. The requester implementation is responsible for creating the channel, filtering struct data into
+// the channel before closing the channel once successful execution is complete.
+type ManagerRequester interface {
+	ManagerSightingsBySeasonIDs(ids []uint64) <-chan ManagerSighting
+}
+
+// BuildManagerTenures builds the chronological tenure history of a single team from every sighting stored for the
+// team. Sightings are ordered by date so seasons can be processed in any order and consecutive sightings of the same
+// manager are merged into a single tenure. Tenures matching an existing tenure for the same manager and start date
+// keep the existing creation date.
+func BuildManagerTenures(teamID uint64, sightings []ManagerSighting, existing []ManagerTenure) []ManagerTenure {
+	sorted := make([]ManagerSighting, len(sightings))
+	copy(sorted, sightings)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Date.Equal(sorted[j].Date) {
+			return sorted[i].FixtureID < sorted[j].FixtureID
+		}
+
+		return sorted[i].Date.Before(sorted[j].Date)
+	})
+
+	created := map[uint64]map[int64]time.Time{}
+
+	for _, t := range existing {
+		if created[t.ManagerID] == nil {
+			created[t.ManagerID] = map[int64]time.Time{}
+		}
+
+		created[t.ManagerID][t.From.Unix()] = t.CreatedAt
+	}
+
+	var tenures []ManagerTenure
+
+	for _, s := range sorted {
+		last := len(tenures) - 1
+
+		if last >= 0 && tenures[last].ManagerID == s.Manager.ID {
+			tenures[last].To = s.Date
+			continue
+		}
+
+		tenures = append(tenures, ManagerTenure{
+			TeamID:    teamID,
+			ManagerID: s.Manager.ID,
+			From:      s.Date,
+			To:        s.Date,
+			CreatedAt: created[s.Manager.ID][s.Date.Unix()],
+		})
+	}
+
+	return tenures
+}
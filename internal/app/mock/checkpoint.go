@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/stretchr/testify/mock"
+)
+
+type CheckpointRepository struct {
+	mock.Mock
+}
+
+func (m *CheckpointRepository) Completed(run string, unit string) ([]uint64, error) {
+	args := m.Called(run, unit)
+	return args.Get(0).([]uint64), args.Error(1)
+}
+
+func (m *CheckpointRepository) Complete(run string, unit string, id uint64) error {
+	args := m.Called(run, unit, id)
+	return args.Error(0)
+}
+
+func (m *CheckpointRepository) Clear(run string) error {
+	args := m.Called(run)
+	return args.Error(0)
+}
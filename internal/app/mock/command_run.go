@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+)
+
+type CommandRunRepository struct {
+	mock.Mock
+}
+
+func (m *CommandRunRepository) Insert(r *app.CommandRun) error {
+	args := m.Called(r)
+	return args.Error(0)
+}
+
+func (m *CommandRunRepository) Update(r *app.CommandRun) error {
+	args := m.Called(r)
+	return args.Error(0)
+}
+
+func (m *CommandRunRepository) ByID(id uint64) (*app.CommandRun, error) {
+	args := m.Called(id)
+	return args.Get(0).(*app.CommandRun), args.Error(1)
+}
+
+func (m *CommandRunRepository) Recent(limit uint64) ([]app.CommandRun, error) {
+	args := m.Called(limit)
+	return args.Get(0).([]app.CommandRun), args.Error(1)
+}
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+)
+
+type DataOverrideRepository struct {
+	mock.Mock
+}
+
+func (m *DataOverrideRepository) Insert(o *app.DataOverride) error {
+	args := m.Called(o)
+	return args.Error(0)
+}
+
+func (m *DataOverrideRepository) Expire(id uint64) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *DataOverrideRepository) Active(entity string, keys ...string) ([]app.DataOverride, error) {
+	args := m.Called(entity, keys)
+	return args.Get(0).([]app.DataOverride), args.Error(1)
+}
+
+func (m *DataOverrideRepository) All(includeExpired bool) ([]app.DataOverride, error) {
+	args := m.Called(includeExpired)
+	return args.Get(0).([]app.DataOverride), args.Error(1)
+}
@@ -14,16 +14,46 @@ func (m *EventRepository) InsertCardEvent(e *app.CardEvent) error {
 	return args.Error(0)
 }
 
+func (m *EventRepository) UpdateCardEvent(e *app.CardEvent) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
 func (m *EventRepository) InsertGoalEvent(e *app.GoalEvent) error {
 	args := m.Called(e)
 	return args.Error(0)
 }
 
+func (m *EventRepository) UpdateGoalEvent(e *app.GoalEvent) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
 func (m *EventRepository) InsertSubstitutionEvent(e *app.SubstitutionEvent) error {
 	args := m.Called(e)
 	return args.Error(0)
 }
 
+func (m *EventRepository) UpdateSubstitutionEvent(e *app.SubstitutionEvent) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *EventRepository) InsertMissedPenaltyEvent(e *app.MissedPenaltyEvent) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *EventRepository) UpdateMissedPenaltyEvent(e *app.MissedPenaltyEvent) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *EventRepository) DeleteFixtureEventsExcept(fixtureID uint64, keep app.FixtureEventIDs) error {
+	args := m.Called(fixtureID, keep)
+	return args.Error(0)
+}
+
 func (m *EventRepository) CardEventsForFixture(fixtureID uint64) ([]*app.CardEvent, error) {
 	args := m.Called(fixtureID)
 	return args.Get(0).([]*app.CardEvent), args.Error(1)
@@ -34,6 +64,26 @@ func (m *EventRepository) GoalEventsForFixture(fixtureID uint64) ([]*app.GoalEve
 	return args.Get(0).([]*app.GoalEvent), args.Error(1)
 }
 
+func (m *EventRepository) MissedPenaltyEventsForFixture(fixtureID uint64) ([]*app.MissedPenaltyEvent, error) {
+	args := m.Called(fixtureID)
+	return args.Get(0).([]*app.MissedPenaltyEvent), args.Error(1)
+}
+
+func (m *EventRepository) SubstitutionEventsForFixture(fixtureID uint64) ([]*app.SubstitutionEvent, error) {
+	args := m.Called(fixtureID)
+	return args.Get(0).([]*app.SubstitutionEvent), args.Error(1)
+}
+
+func (m *EventRepository) SubstitutionEventsForTeam(teamID uint64) ([]*app.SubstitutionEvent, error) {
+	args := m.Called(teamID)
+	return args.Get(0).([]*app.SubstitutionEvent), args.Error(1)
+}
+
+func (m *EventRepository) SubstitutionEventsForPlayer(playerID uint64) ([]*app.SubstitutionEvent, error) {
+	args := m.Called(playerID)
+	return args.Get(0).([]*app.SubstitutionEvent), args.Error(1)
+}
+
 func (m *EventRepository) CardEventByID(id uint64) (*app.CardEvent, error) {
 	args := m.Called(id)
 	c := args.Get(0).(*app.CardEvent)
@@ -52,16 +102,28 @@ func (m *EventRepository) SubstitutionEventByID(id uint64) (*app.SubstitutionEve
 	return c, args.Error(1)
 }
 
+func (m *EventRepository) MissedPenaltyEventByID(id uint64) (*app.MissedPenaltyEvent, error) {
+	args := m.Called(id)
+	c := args.Get(0).(*app.MissedPenaltyEvent)
+	return c, args.Error(1)
+}
+
 type EventRequester struct {
 	mock.Mock
 }
 
-func (m *EventRequester) EventsByFixtureIDs(ids []uint64) (<-chan app.GoalEvent, <-chan app.SubstitutionEvent, <-chan app.CardEvent) {
+func (m *EventRequester) EventsByFixtureIDs(ids []uint64) (<-chan app.GoalEvent, <-chan app.SubstitutionEvent, <-chan app.CardEvent, <-chan app.MissedPenaltyEvent) {
 	args := m.Called(ids)
-	return args.Get(0).(chan app.GoalEvent), args.Get(1).(chan app.SubstitutionEvent), args.Get(2).(chan app.CardEvent)
+	return args.Get(0).(chan app.GoalEvent),
+		args.Get(1).(chan app.SubstitutionEvent),
+		args.Get(2).(chan app.CardEvent),
+		args.Get(3).(chan app.MissedPenaltyEvent)
 }
 
-func (m *EventRequester) EventsBySeasonIDs(ids []uint64) (<-chan app.GoalEvent, <-chan app.SubstitutionEvent, <-chan app.CardEvent) {
+func (m *EventRequester) EventsBySeasonIDs(ids []uint64) (<-chan app.GoalEvent, <-chan app.SubstitutionEvent, <-chan app.CardEvent, <-chan app.MissedPenaltyEvent) {
 	args := m.Called(ids)
-	return args.Get(0).(chan app.GoalEvent), args.Get(1).(chan app.SubstitutionEvent), args.Get(2).(chan app.CardEvent)
+	return args.Get(0).(chan app.GoalEvent),
+		args.Get(1).(chan app.SubstitutionEvent),
+		args.Get(2).(chan app.CardEvent),
+		args.Get(3).(chan app.MissedPenaltyEvent)
 }
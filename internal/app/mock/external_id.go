@@ -0,0 +1,44 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+)
+
+type ExternalIDRepository struct {
+	mock.Mock
+}
+
+func (m *ExternalIDRepository) Insert(e *app.ExternalID) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *ExternalIDRepository) Update(e *app.ExternalID) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *ExternalIDRepository) ByExternalID(entityType, provider, externalID string) (*app.ExternalID, error) {
+	args := m.Called(entityType, provider, externalID)
+	return args.Get(0).(*app.ExternalID), args.Error(1)
+}
+
+func (m *ExternalIDRepository) ByEntityID(entityType string, entityID uint64, provider string) ([]app.ExternalID, error) {
+	args := m.Called(entityType, entityID, provider)
+	return args.Get(0).([]app.ExternalID), args.Error(1)
+}
+
+type ExternalIDResolver struct {
+	mock.Mock
+}
+
+func (m *ExternalIDResolver) Resolve(entityType, provider, externalID string) (uint64, error) {
+	args := m.Called(entityType, provider, externalID)
+	return args.Get(0).(uint64), args.Error(1)
+}
+
+func (m *ExternalIDResolver) Link(entityType string, entityID uint64, provider, externalID string) error {
+	args := m.Called(entityType, entityID, provider, externalID)
+	return args.Error(0)
+}
@@ -9,13 +9,13 @@ type FixtureRepository struct {
 	mock.Mock
 }
 
-func (m *FixtureRepository) Insert(c *app.Fixture) error {
-	args := m.Called(c)
+func (m *FixtureRepository) Insert(c *app.Fixture, e ...app.DomainEvent) error {
+	args := m.Called(withEvents(c, e)...)
 	return args.Error(0)
 }
 
-func (m *FixtureRepository) Update(c *app.Fixture) error {
-	args := m.Called(c)
+func (m *FixtureRepository) Update(c *app.Fixture, e ...app.DomainEvent) error {
+	args := m.Called(withEvents(c, e)...)
 	return args.Error(0)
 }
 
@@ -53,3 +53,31 @@ func (m *FixtureRequester) FixturesBySeasonIDs(ids []uint64) <-chan app.Fixture
 	args := m.Called(ids)
 	return args.Get(0).(chan app.Fixture)
 }
+
+func (m *FixtureRequester) FixturesBySeasonID(id uint64) ([]app.Fixture, error) {
+	args := m.Called(id)
+	return args.Get(0).([]app.Fixture), args.Error(1)
+}
+
+type FixtureIDGenerator struct {
+	mock.Mock
+}
+
+func (m *FixtureIDGenerator) NextID() (uint64, error) {
+	args := m.Called()
+	return args.Get(0).(uint64), args.Error(1)
+}
+
+type FixtureStatusAnomalyRepository struct {
+	mock.Mock
+}
+
+func (m *FixtureStatusAnomalyRepository) Insert(a *app.FixtureStatusAnomaly) error {
+	args := m.Called(a)
+	return args.Error(0)
+}
+
+func (m *FixtureStatusAnomalyRepository) ByFixtureID(id uint64) ([]app.FixtureStatusAnomaly, error) {
+	args := m.Called(id)
+	return args.Get(0).([]app.FixtureStatusAnomaly), args.Error(1)
+}
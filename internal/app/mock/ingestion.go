@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+)
+
+type IngestionPolicyRepository struct {
+	mock.Mock
+}
+
+func (m *IngestionPolicyRepository) Save(p *app.IngestionPolicy) error {
+	args := m.Called(p)
+	return args.Error(0)
+}
+
+func (m *IngestionPolicyRepository) ByCompetitionID(id uint64) (*app.IngestionPolicy, error) {
+	args := m.Called(id)
+	return args.Get(0).(*app.IngestionPolicy), args.Error(1)
+}
+
+func (m *IngestionPolicyRepository) All() ([]app.IngestionPolicy, error) {
+	args := m.Called()
+	return args.Get(0).([]app.IngestionPolicy), args.Error(1)
+}
+
+func (m *IngestionPolicyRepository) Delete(competitionID uint64) error {
+	args := m.Called(competitionID)
+	return args.Error(0)
+}
@@ -0,0 +1,46 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type JobQueue struct {
+	mock.Mock
+}
+
+func (m *JobQueue) Enqueue(j *app.Job) error {
+	args := m.Called(j)
+	return args.Error(0)
+}
+
+func (m *JobQueue) Claim(workerID string, staleBefore time.Time) (*app.Job, error) {
+	args := m.Called(workerID, staleBefore)
+	return args.Get(0).(*app.Job), args.Error(1)
+}
+
+func (m *JobQueue) Heartbeat(id uint64, workerID string) error {
+	args := m.Called(id, workerID)
+	return args.Error(0)
+}
+
+func (m *JobQueue) Complete(id uint64, workerID string) error {
+	args := m.Called(id, workerID)
+	return args.Error(0)
+}
+
+func (m *JobQueue) Retry(id uint64, workerID, reason string, at time.Time) error {
+	args := m.Called(id, workerID, reason, at)
+	return args.Error(0)
+}
+
+func (m *JobQueue) Fail(id uint64, workerID, reason string) error {
+	args := m.Called(id, workerID, reason)
+	return args.Error(0)
+}
+
+func (m *JobQueue) Recent(limit uint64) ([]app.Job, error) {
+	args := m.Called(limit)
+	return args.Get(0).([]app.Job), args.Error(1)
+}
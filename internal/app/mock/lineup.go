@@ -0,0 +1,45 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type LineupRepository struct {
+	mock.Mock
+}
+
+func (m *LineupRepository) Save(l *app.Lineup) error {
+	args := m.Called(l)
+	return args.Error(0)
+}
+
+func (m *LineupRepository) ByFixtureAndTeam(fixtureID, teamID uint64) (*app.Lineup, error) {
+	args := m.Called(fixtureID, teamID)
+	return args.Get(0).(*app.Lineup), args.Error(1)
+}
+
+func (m *LineupRepository) ByFixtureID(id uint64) ([]app.Lineup, error) {
+	args := m.Called(id)
+	return args.Get(0).([]app.Lineup), args.Error(1)
+}
+
+type LineupRequester struct {
+	mock.Mock
+}
+
+func (m *LineupRequester) LineupsByFixtureIDs(ids []uint64) <-chan app.Lineup {
+	args := m.Called(ids)
+	return args.Get(0).(chan app.Lineup)
+}
+
+func (m *LineupRequester) LineupsBySeasonIDs(ids []uint64) <-chan app.Lineup {
+	args := m.Called(ids)
+	return args.Get(0).(chan app.Lineup)
+}
+
+func (m *LineupRequester) LineupsByDate(date time.Time, competitionIDs []uint64) <-chan app.Lineup {
+	args := m.Called(date, competitionIDs)
+	return args.Get(0).(chan app.Lineup)
+}
@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+)
+
+type LiveRequester struct {
+	mock.Mock
+}
+
+func (m *LiveRequester) LiveFixtures(ids []uint64) <-chan app.LiveFixture {
+	args := m.Called(ids)
+	return args.Get(0).(chan app.LiveFixture)
+}
+
+type FixtureUpdateListener struct {
+	mock.Mock
+}
+
+func (m *FixtureUpdateListener) Subscribe() (<-chan app.FixtureUpdate, func()) {
+	args := m.Called()
+	return args.Get(0).(chan app.FixtureUpdate), args.Get(1).(func())
+}
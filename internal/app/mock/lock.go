@@ -0,0 +1,29 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+)
+
+type Locker struct {
+	mock.Mock
+}
+
+func (m *Locker) Acquire(name string, s app.LockStrategy) (app.Lock, error) {
+	args := m.Called(name, s)
+	return args.Get(0).(app.Lock), args.Error(1)
+}
+
+type Lock struct {
+	mock.Mock
+}
+
+func (m *Lock) Lost() <-chan struct{} {
+	args := m.Called()
+	return args.Get(0).(chan struct{})
+}
+
+func (m *Lock) Release() error {
+	args := m.Called()
+	return args.Error(0)
+}
@@ -0,0 +1,73 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type ManagerRepository struct {
+	mock.Mock
+}
+
+func (m *ManagerRepository) Insert(x *app.Manager) error {
+	args := m.Called(x)
+	return args.Error(0)
+}
+
+func (m *ManagerRepository) Update(x *app.Manager) error {
+	args := m.Called(x)
+	return args.Error(0)
+}
+
+func (m *ManagerRepository) ByID(id uint64) (*app.Manager, error) {
+	args := m.Called(id)
+	return args.Get(0).(*app.Manager), args.Error(1)
+}
+
+type ManagerSightingRepository struct {
+	mock.Mock
+}
+
+func (m *ManagerSightingRepository) Save(s *app.ManagerSighting) error {
+	args := m.Called(s)
+	return args.Error(0)
+}
+
+func (m *ManagerSightingRepository) ByTeamID(teamID uint64) ([]app.ManagerSighting, error) {
+	args := m.Called(teamID)
+	return args.Get(0).([]app.ManagerSighting), args.Error(1)
+}
+
+type ManagerTenureRepository struct {
+	mock.Mock
+}
+
+func (m *ManagerTenureRepository) ReplaceForTeam(teamID uint64, tenures []app.ManagerTenure) error {
+	args := m.Called(teamID, tenures)
+	return args.Error(0)
+}
+
+func (m *ManagerTenureRepository) ByTeamID(teamID uint64) ([]app.ManagerTenure, error) {
+	args := m.Called(teamID)
+	return args.Get(0).([]app.ManagerTenure), args.Error(1)
+}
+
+func (m *ManagerTenureRepository) ByManagerID(managerID uint64) ([]app.ManagerTenure, error) {
+	args := m.Called(managerID)
+	return args.Get(0).([]app.ManagerTenure), args.Error(1)
+}
+
+func (m *ManagerTenureRepository) AtDate(teamID uint64, date time.Time) (*app.ManagerTenure, error) {
+	args := m.Called(teamID, date)
+	return args.Get(0).(*app.ManagerTenure), args.Error(1)
+}
+
+type ManagerRequester struct {
+	mock.Mock
+}
+
+func (m *ManagerRequester) ManagerSightingsBySeasonIDs(ids []uint64) <-chan app.ManagerSighting {
+	args := m.Called(ids)
+	return args.Get(0).(chan app.ManagerSighting)
+}
@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+)
+
+type OddsRepository struct {
+	mock.Mock
+}
+
+func (m *OddsRepository) Insert(o *app.Odds) error {
+	args := m.Called(o)
+	return args.Error(0)
+}
+
+func (m *OddsRepository) Exists(o *app.Odds) (bool, error) {
+	args := m.Called(o)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *OddsRepository) ByFixtureAndMarket(fixtureID uint64, market string) ([]app.Odds, error) {
+	args := m.Called(fixtureID, market)
+	return args.Get(0).([]app.Odds), args.Error(1)
+}
+
+type OddsRequester struct {
+	mock.Mock
+}
+
+func (m *OddsRequester) OddsByFixtureIDs(ids []uint64) <-chan app.Odds {
+	args := m.Called(ids)
+	return args.Get(0).(chan app.Odds)
+}
@@ -46,6 +46,11 @@ func (m PlayerStatsRequester) PlayerStatsBySeasonIDs(ids []uint64) <-chan *app.P
 	return args.Get(0).(chan *app.PlayerStats)
 }
 
+func (m PlayerStatsRequester) PlayerStatsBySeasonID(id uint64) ([]*app.PlayerStats, error) {
+	args := m.Called(id)
+	return args.Get(0).([]*app.PlayerStats), args.Error(1)
+}
+
 func (m PlayerStatsRequester) PlayerStatsByDate(date time.Time, competitionIDS []uint64) <-chan *app.PlayerStats {
 	args := m.Called(date, competitionIDS)
 	return args.Get(0).(chan *app.PlayerStats)
@@ -0,0 +1,51 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+)
+
+type EventPublisher struct {
+	mock.Mock
+}
+
+func (m *EventPublisher) Publish(e app.DomainEvent) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+type OutboxRepository struct {
+	mock.Mock
+}
+
+func (m *OutboxRepository) Pending(afterID uint64, limit uint64) ([]app.OutboxMessage, error) {
+	args := m.Called(afterID, limit)
+	return args.Get(0).([]app.OutboxMessage), args.Error(1)
+}
+
+func (m *OutboxRepository) MarkRelayed(id uint64) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *OutboxRepository) MarkFailed(id uint64, reason string) error {
+	args := m.Called(id, reason)
+	return args.Error(0)
+}
+
+func (m *OutboxRepository) MarkDeadLettered(id uint64, reason string) error {
+	args := m.Called(id, reason)
+	return args.Error(0)
+}
+
+// withEvents returns the arguments recorded for a repository write, the entity written followed by each DomainEvent
+// published with the write, so expectations list the events expected after the entity.
+func withEvents(entity interface{}, events []app.DomainEvent) []interface{} {
+	args := []interface{}{entity}
+
+	for _, e := range events {
+		args = append(args, e)
+	}
+
+	return args
+}
@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+)
+
+type RawPayloadStore struct {
+	mock.Mock
+}
+
+func (m *RawPayloadStore) Save(p *app.RawPayload) error {
+	args := m.Called(p)
+	return args.Error(0)
+}
+
+func (m *RawPayloadStore) Latest(provider, endpoint string) (*app.RawPayload, error) {
+	args := m.Called(provider, endpoint)
+	return args.Get(0).(*app.RawPayload), args.Error(1)
+}
@@ -0,0 +1,39 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+)
+
+type RefereeRepository struct {
+	mock.Mock
+}
+
+func (m *RefereeRepository) Insert(r *app.Referee) error {
+	args := m.Called(r)
+	return args.Error(0)
+}
+
+func (m *RefereeRepository) Update(r *app.Referee) error {
+	args := m.Called(r)
+	return args.Error(0)
+}
+
+func (m *RefereeRepository) ByID(id uint64) (*app.Referee, error) {
+	args := m.Called(id)
+	return args.Get(0).(*app.Referee), args.Error(1)
+}
+
+func (m *RefereeRepository) Stats(id uint64, q app.RefereeStatsQuery) (*app.RefereeStats, error) {
+	args := m.Called(id, q)
+	return args.Get(0).(*app.RefereeStats), args.Error(1)
+}
+
+type RefereeRequester struct {
+	mock.Mock
+}
+
+func (m *RefereeRequester) RefereesBySeasonIDs(ids []uint64) <-chan app.Referee {
+	args := m.Called(ids)
+	return args.Get(0).(chan app.Referee)
+}
@@ -9,13 +9,13 @@ type ResultRepository struct {
 	mock.Mock
 }
 
-func (m *ResultRepository) Insert(r *app.Result) error {
-	args := m.Called(r)
+func (m *ResultRepository) Insert(r *app.Result, e ...app.DomainEvent) error {
+	args := m.Called(withEvents(r, e)...)
 	return args.Error(0)
 }
 
-func (m *ResultRepository) Update(r *app.Result) error {
-	args := m.Called(r)
+func (m *ResultRepository) Update(r *app.Result, e ...app.DomainEvent) error {
+	args := m.Called(withEvents(r, e)...)
 	return args.Error(0)
 }
 
@@ -25,6 +25,11 @@ func (m *ResultRepository) ByFixtureID(id uint64) (*app.Result, error) {
 	return c, args.Error(1)
 }
 
+func (m *ResultRepository) BySeasonID(id uint64) ([]app.Result, error) {
+	args := m.Called(id)
+	return args.Get(0).([]app.Result), args.Error(1)
+}
+
 type ResultRequester struct {
 	mock.Mock
 }
@@ -33,3 +38,8 @@ func (m *ResultRequester) ResultsBySeasonIDs(id []uint64) <-chan app.Result {
 	args := m.Called(id)
 	return args.Get(0).(chan app.Result)
 }
+
+func (m *ResultRequester) ResultsBySeasonID(id uint64) ([]app.Result, error) {
+	args := m.Called(id)
+	return args.Get(0).([]app.Result), args.Error(1)
+}
@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type SidelinedRepository struct {
+	mock.Mock
+}
+
+func (m *SidelinedRepository) ReplaceForTeam(t *app.TeamSidelined) error {
+	args := m.Called(t)
+	return args.Error(0)
+}
+
+func (m *SidelinedRepository) ByPlayerID(id uint64) ([]app.Sidelined, error) {
+	args := m.Called(id)
+	return args.Get(0).([]app.Sidelined), args.Error(1)
+}
+
+func (m *SidelinedRepository) ByTeamAtDate(teamID, seasonID uint64, date time.Time) ([]app.Sidelined, error) {
+	args := m.Called(teamID, seasonID, date)
+	return args.Get(0).([]app.Sidelined), args.Error(1)
+}
+
+type SidelinedRequester struct {
+	mock.Mock
+}
+
+func (m *SidelinedRequester) SidelinedBySeasonIDs(ids []uint64) <-chan app.TeamSidelined {
+	args := m.Called(ids)
+	return args.Get(0).(chan app.TeamSidelined)
+}
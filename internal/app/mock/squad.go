@@ -14,9 +14,14 @@ func (m SquadRepository) Insert(c *app.Squad) error {
 	return args.Error(0)
 }
 
-func (m SquadRepository) Update(c *app.Squad) error {
-	args := m.Called(&c)
-	return args.Error(0)
+func (m SquadRepository) Update(c *app.Squad, movements ...app.SquadMovement) error {
+	args := []interface{}{&c}
+
+	for _, mv := range movements {
+		args = append(args, mv)
+	}
+
+	return m.Called(args...).Error(0)
 }
 
 func (m SquadRepository) BySeasonAndTeam(seasonId, teamId uint64) (*app.Squad, error) {
@@ -43,3 +48,22 @@ func (m SquadRequester) SquadsBySeasonIDs(seasonIDs []uint64) <-chan *app.Squad
 	args := m.Called(seasonIDs)
 	return args.Get(0).(chan *app.Squad)
 }
+
+type SquadMovementRepository struct {
+	mock.Mock
+}
+
+func (m *SquadMovementRepository) Insert(s *app.SquadMovement) error {
+	args := m.Called(s)
+	return args.Error(0)
+}
+
+func (m *SquadMovementRepository) BySeasonAndTeam(seasonId, teamId uint64) ([]app.SquadMovement, error) {
+	args := m.Called(seasonId, teamId)
+	return args.Get(0).([]app.SquadMovement), args.Error(1)
+}
+
+func (m *SquadMovementRepository) ByPlayerID(id uint64) ([]app.SquadMovement, error) {
+	args := m.Called(id)
+	return args.Get(0).([]app.SquadMovement), args.Error(1)
+}
@@ -0,0 +1,63 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+)
+
+type StageRepository struct {
+	mock.Mock
+}
+
+func (m *StageRepository) Insert(s *app.Stage) error {
+	args := m.Called(s)
+	return args.Error(0)
+}
+
+func (m *StageRepository) Update(s *app.Stage) error {
+	args := m.Called(s)
+	return args.Error(0)
+}
+
+func (m *StageRepository) ByID(id uint64) (*app.Stage, error) {
+	args := m.Called(id)
+	return args.Get(0).(*app.Stage), args.Error(1)
+}
+
+func (m *StageRepository) BySeasonID(id uint64) ([]app.Stage, error) {
+	args := m.Called(id)
+	return args.Get(0).([]app.Stage), args.Error(1)
+}
+
+type GroupRepository struct {
+	mock.Mock
+}
+
+func (m *GroupRepository) Insert(g *app.Group) error {
+	args := m.Called(g)
+	return args.Error(0)
+}
+
+func (m *GroupRepository) Update(g *app.Group) error {
+	args := m.Called(g)
+	return args.Error(0)
+}
+
+func (m *GroupRepository) ByID(id uint64) (*app.Group, error) {
+	args := m.Called(id)
+	return args.Get(0).(*app.Group), args.Error(1)
+}
+
+func (m *GroupRepository) ByStageID(id uint64) ([]app.Group, error) {
+	args := m.Called(id)
+	return args.Get(0).([]app.Group), args.Error(1)
+}
+
+type StageRequester struct {
+	mock.Mock
+}
+
+func (m *StageRequester) StagesBySeasonIDs(ids []uint64) (<-chan app.Stage, <-chan app.Group) {
+	args := m.Called(ids)
+	return args.Get(0).(chan app.Stage), args.Get(1).(chan app.Group)
+}
@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+)
+
+type StandingsRulesRepository struct {
+	mock.Mock
+}
+
+func (m *StandingsRulesRepository) Save(r *app.StandingsRules) error {
+	args := m.Called(r)
+	return args.Error(0)
+}
+
+func (m *StandingsRulesRepository) ByCompetitionID(id uint64) (*app.StandingsRules, error) {
+	args := m.Called(id)
+	return args.Get(0).(*app.StandingsRules), args.Error(1)
+}
+
+type StandingsSnapshotRepository struct {
+	mock.Mock
+}
+
+func (m *StandingsSnapshotRepository) Save(s *app.StandingsSnapshot) error {
+	args := m.Called(s)
+	return args.Error(0)
+}
+
+func (m *StandingsSnapshotRepository) ByRound(seasonID, roundID uint64, table string) (*app.StandingsSnapshot, error) {
+	args := m.Called(seasonID, roundID, table)
+	return args.Get(0).(*app.StandingsSnapshot), args.Error(1)
+}
@@ -0,0 +1,39 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+)
+
+type SuspensionRulesRepository struct {
+	mock.Mock
+}
+
+func (m *SuspensionRulesRepository) Save(r *app.SuspensionRules) error {
+	args := m.Called(r)
+	return args.Error(0)
+}
+
+func (m *SuspensionRulesRepository) ByCompetitionID(id uint64) (*app.SuspensionRules, error) {
+	args := m.Called(id)
+	return args.Get(0).(*app.SuspensionRules), args.Error(1)
+}
+
+type SuspensionRepository struct {
+	mock.Mock
+}
+
+func (m *SuspensionRepository) ReplaceForTeam(t *app.TeamSuspensions) error {
+	args := m.Called(t)
+	return args.Error(0)
+}
+
+func (m *SuspensionRepository) ByFixtureID(id uint64) ([]app.Suspension, error) {
+	args := m.Called(id)
+	return args.Get(0).([]app.Suspension), args.Error(1)
+}
+
+func (m *SuspensionRepository) ByPlayerID(id uint64) ([]app.Suspension, error) {
+	args := m.Called(id)
+	return args.Get(0).([]app.Suspension), args.Error(1)
+}
@@ -10,13 +10,13 @@ type TeamStatsRepository struct {
 	mock.Mock
 }
 
-func (m *TeamStatsRepository) InsertTeamStats(t *app.TeamStats) error {
-	args := m.Called(t)
+func (m *TeamStatsRepository) InsertTeamStats(t *app.TeamStats, e ...app.DomainEvent) error {
+	args := m.Called(withEvents(t, e)...)
 	return args.Error(0)
 }
 
-func (m *TeamStatsRepository) UpdateTeamStats(t *app.TeamStats) error {
-	args := m.Called(t)
+func (m *TeamStatsRepository) UpdateTeamStats(t *app.TeamStats, e ...app.DomainEvent) error {
+	args := m.Called(withEvents(t, e)...)
 	return args.Error(0)
 }
 
@@ -50,6 +50,11 @@ func (t *TeamStatsRequester) TeamStatsBySeasonIDs(ids []uint64) <-chan app.TeamS
 	return args.Get(0).(chan app.TeamStats)
 }
 
+func (t *TeamStatsRequester) TeamStatsBySeasonID(id uint64) ([]app.TeamStats, error) {
+	args := t.Called(id)
+	return args.Get(0).([]app.TeamStats), args.Error(1)
+}
+
 func (t *TeamStatsRequester) TeamStatsByDate(date time.Time, competitionIDs []uint64) <-chan app.TeamStats {
 	args := t.Called(date, competitionIDs)
 	return args.Get(0).(chan app.TeamStats)
@@ -9,13 +9,13 @@ type FixtureTeamXGRepository struct {
 	mock.Mock
 }
 
-func (m *FixtureTeamXGRepository) Insert(f *app.FixtureTeamXG) error {
-	args := m.Called(f)
+func (m *FixtureTeamXGRepository) Insert(f *app.FixtureTeamXG, e ...app.DomainEvent) error {
+	args := m.Called(withEvents(f, e)...)
 	return args.Error(0)
 }
 
-func (m *FixtureTeamXGRepository) Update(f *app.FixtureTeamXG) error {
-	args := m.Called(f)
+func (m *FixtureTeamXGRepository) Update(f *app.FixtureTeamXG, e ...app.DomainEvent) error {
+	args := m.Called(withEvents(f, e)...)
 	return args.Error(0)
 }
 
@@ -0,0 +1,29 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+)
+
+type TransferRepository struct {
+	mock.Mock
+}
+
+func (m *TransferRepository) Save(t *app.Transfer) error {
+	args := m.Called(t)
+	return args.Error(0)
+}
+
+func (m *TransferRepository) ByPlayerID(id uint64) ([]app.Transfer, error) {
+	args := m.Called(id)
+	return args.Get(0).([]app.Transfer), args.Error(1)
+}
+
+type TransferRequester struct {
+	mock.Mock
+}
+
+func (m *TransferRequester) TransfersBySeasonIDs(ids []uint64) <-chan app.Transfer {
+	args := m.Called(ids)
+	return args.Get(0).(chan app.Transfer)
+}
@@ -0,0 +1,58 @@
+package mock
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/stretchr/testify/mock"
+)
+
+type WebhookSubscriptionRepository struct {
+	mock.Mock
+}
+
+func (m *WebhookSubscriptionRepository) Insert(s *app.WebhookSubscription) error {
+	args := m.Called(s)
+	return args.Error(0)
+}
+
+func (m *WebhookSubscriptionRepository) Delete(id uint64) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *WebhookSubscriptionRepository) All() ([]app.WebhookSubscription, error) {
+	args := m.Called()
+	return args.Get(0).([]app.WebhookSubscription), args.Error(1)
+}
+
+type WebhookDeliveryRepository struct {
+	mock.Mock
+}
+
+func (m *WebhookDeliveryRepository) Insert(d *app.WebhookDelivery) error {
+	args := m.Called(d)
+	return args.Error(0)
+}
+
+func (m *WebhookDeliveryRepository) Update(d *app.WebhookDelivery) error {
+	args := m.Called(d)
+	return args.Error(0)
+}
+
+func (m *WebhookDeliveryRepository) Due(afterID uint64, limit uint64) ([]app.WebhookDelivery, error) {
+	args := m.Called(afterID, limit)
+	return args.Get(0).([]app.WebhookDelivery), args.Error(1)
+}
+
+func (m *WebhookDeliveryRepository) InsertAttempt(a *app.WebhookDeliveryAttempt) error {
+	args := m.Called(a)
+	return args.Error(0)
+}
+
+type WebhookSender struct {
+	mock.Mock
+}
+
+func (m *WebhookSender) Send(s app.WebhookSubscription, d app.WebhookDelivery) (int, error) {
+	args := m.Called(s, d)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,90 @@
+package app
+
+import (
+	"fmt"
+	"time"
+)
+
+// Odds is a single price captured for a selection within a bookmaker market. Prices are never updated,
+// every change in price is stored as a new Odds record so the full price history of a market is retained.
+type Odds struct {
+	FixtureID   uint64    `json:"fixture_id"`
+	BookmakerID uint64    `json:"bookmaker_id"`
+	Bookmaker   string    `json:"bookmaker"`
+	Market      string    `json:"market"`
+	Selection   string    `json:"selection"`
+	Line        *string   `json:"line"`
+	Price       float32   `json:"price"`
+	CapturedAt  time.Time `json:"captured_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// OddsRepository provides an interface to persist Odds domain struct objects to a storage engine.
+type OddsRepository interface {
+	Insert(o *Odds) error
+	// Exists returns true if a price captured at the same time as the Odds provided is stored for the selection.
+	Exists(o *Odds) (bool, error)
+	ByFixtureAndMarket(fixtureID uint64, market string) ([]Odds, error)
+}
+
+// OddsRequester provides an interface allowing this application to request data from an external
+// data provider. The requester implementation is responsible for creating the channel, filtering struct data into
+// the channel before closing the channel once successful execution is complete.
+type OddsRequester interface {
+	OddsByFixtureIDs(ids []uint64) <-chan Odds
+}
+
+// OpeningOdds returns the first price captured for each bookmaker and selection contained in the price history
+// provided. History is expected to be ordered by captured at ascending.
+func OpeningOdds(history []Odds) []Odds {
+	var opening []Odds
+	seen := map[string]bool{}
+
+	for _, o := range history {
+		if seen[o.key()] {
+			continue
+		}
+
+		seen[o.key()] = true
+		opening = append(opening, o)
+	}
+
+	return opening
+}
+
+// ClosingOdds returns the last price captured at or before kick off for each bookmaker and selection contained in
+// the price history provided. History is expected to be ordered by captured at ascending.
+func ClosingOdds(history []Odds, kickOff time.Time) []Odds {
+	var keys []string
+	closing := map[string]Odds{}
+
+	for _, o := range history {
+		if o.CapturedAt.After(kickOff) {
+			continue
+		}
+
+		if _, ok := closing[o.key()]; !ok {
+			keys = append(keys, o.key())
+		}
+
+		closing[o.key()] = o
+	}
+
+	var odds []Odds
+
+	for _, k := range keys {
+		odds = append(odds, closing[k])
+	}
+
+	return odds
+}
+
+func (o Odds) key() string {
+	line := ""
+
+	if o.Line != nil {
+		line = *o.Line
+	}
+
+	return fmt.Sprintf("%d|%s|%s", o.BookmakerID, o.Selection, line)
+}
@@ -0,0 +1,48 @@
+package app
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	EntityResult    = "result"
+	EntityTeamStats = "team_stats"
+)
+
+// DataOverride is a manual correction to a single field of an entity ingested from an external data provider.
+// Key identifies the entity, see OverrideKey, and Field is the name of the column being corrected i.e.
+// "home_score" or "shots_total". Active overrides are applied whenever an entity is written or read so
+// corrections are not lost when the entity is ingested again.
+type DataOverride struct {
+	ID        uint64     `json:"id"`
+	Entity    string     `json:"entity"`
+	Key       string     `json:"key"`
+	Field     string     `json:"field"`
+	Value     string     `json:"value"`
+	Reason    string     `json:"reason"`
+	Author    string     `json:"author"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// DataOverrideRepository provides an interface to persist DataOverride domain struct objects to a storage engine.
+type DataOverrideRepository interface {
+	Insert(o *DataOverride) error
+	Expire(id uint64) error
+	Active(entity string, keys ...string) ([]DataOverride, error)
+	All(includeExpired bool) ([]DataOverride, error)
+}
+
+// OverrideKey returns the key identifying an entity from the IDs provided. Fixtures and results are keyed by
+// fixture ID and team stats by fixture ID and team ID i.e. "16475287:19".
+func OverrideKey(ids ...uint64) string {
+	keys := make([]string, len(ids))
+
+	for i, id := range ids {
+		keys[i] = strconv.FormatUint(id, 10)
+	}
+
+	return strings.Join(keys, ":")
+}
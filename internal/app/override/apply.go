@@ -0,0 +1,158 @@
+package override
+
+import (
+	"fmt"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// null is the override value used to clear a nullable field.
+const null = "null"
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// protected fields identify an entity or are managed by the storage engine and cannot be overridden.
+var protected = map[string]bool{
+	"id":         true,
+	"fixture_id": true,
+	"team_id":    true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// Apply sets the fields of the struct pointed to by target to the values of the overrides provided. Fields are
+// named using their JSON tags with nested struct fields prefixed by the parent tag i.e. "shots_total", matching
+// the column names used to persist each entity. Overrides are applied in the order provided so later overrides
+// for the same field take precedence.
+func Apply(target interface{}, overrides []app.DataOverride) error {
+	fields := fields(reflect.ValueOf(target).Elem(), "")
+
+	for _, o := range overrides {
+		f, ok := fields[o.Field]
+
+		if !ok {
+			return fmt.Errorf("field %s does not exist for entity %s", o.Field, o.Entity)
+		}
+
+		if err := set(f, o.Value); err != nil {
+			return fmt.Errorf("value '%s' is not valid for field %s: %s", o.Value, o.Field, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// Fields returns the names of the fields that can be overridden for the struct pointed to by target.
+func Fields(target interface{}) []string {
+	var names []string
+
+	for name := range fields(reflect.ValueOf(target).Elem(), "") {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func fields(v reflect.Value, prefix string) map[string]reflect.Value {
+	found := map[string]reflect.Value{}
+
+	for i := 0; i < v.NumField(); i++ {
+		tag := strings.Split(v.Type().Field(i).Tag.Get("json"), ",")[0]
+
+		if tag == "" || tag == "-" || protected[prefix+tag] {
+			continue
+		}
+
+		f := v.Field(i)
+
+		if f.Kind() == reflect.Struct && f.Type() != timeType {
+			for name, nested := range fields(f, prefix+tag+"_") {
+				found[name] = nested
+			}
+
+			continue
+		}
+
+		found[prefix+tag] = f
+	}
+
+	return found
+}
+
+func set(f reflect.Value, value string) error {
+	if f.Kind() == reflect.Ptr {
+		if value == null {
+			f.Set(reflect.Zero(f.Type()))
+			return nil
+		}
+
+		p := reflect.New(f.Type().Elem())
+
+		if err := set(p.Elem(), value); err != nil {
+			return err
+		}
+
+		f.Set(p)
+
+		return nil
+	}
+
+	if f.Type() == timeType {
+		t, err := time.Parse(time.RFC3339, value)
+
+		if err != nil {
+			return err
+		}
+
+		f.Set(reflect.ValueOf(t))
+
+		return nil
+	}
+
+	switch f.Kind() {
+	case reflect.String:
+		f.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+
+		if err != nil {
+			return err
+		}
+
+		f.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 10, f.Type().Bits())
+
+		if err != nil {
+			return err
+		}
+
+		f.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, err := strconv.ParseUint(value, 10, f.Type().Bits())
+
+		if err != nil {
+			return err
+		}
+
+		f.SetUint(i)
+	case reflect.Float32, reflect.Float64:
+		x, err := strconv.ParseFloat(value, f.Type().Bits())
+
+		if err != nil {
+			return err
+		}
+
+		f.SetFloat(x)
+	default:
+		return fmt.Errorf("fields of type %s cannot be overridden", f.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package override_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/override"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestApply(t *testing.T) {
+	t.Run("sets fields of struct to override values", func(t *testing.T) {
+		t.Helper()
+
+		home := 1
+		formation := "4-4-2"
+
+		result := app.Result{FixtureID: 16475287, HomeScore: &home, HomeFormation: &formation}
+
+		overrides := []app.DataOverride{
+			{Entity: app.EntityResult, Field: "home_score", Value: "2"},
+			{Entity: app.EntityResult, Field: "full_time_score", Value: "2-1"},
+			{Entity: app.EntityResult, Field: "home_formation", Value: "null"},
+		}
+
+		if err := override.Apply(&result, overrides); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+		a.Equal(2, *result.HomeScore)
+		a.Equal("2-1", *result.FullTimeScore)
+		a.Nil(result.HomeFormation)
+		a.Equal(1, home)
+	})
+
+	t.Run("sets nested fields using parent field prefix", func(t *testing.T) {
+		t.Helper()
+
+		stats := app.TeamStats{FixtureID: 16475287, TeamID: 1}
+
+		overrides := []app.DataOverride{
+			{Entity: app.EntityTeamStats, Field: "shots_total", Value: "12"},
+			{Entity: app.EntityTeamStats, Field: "passes_percentage", Value: "81.5"},
+			{Entity: app.EntityTeamStats, Field: "corners", Value: "7"},
+		}
+
+		if err := override.Apply(&stats, overrides); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 12, *stats.TeamShots.Total)
+		assert.Equal(t, float32(81.5), *stats.TeamPasses.Percentage)
+		assert.Equal(t, 7, *stats.Corners)
+	})
+
+	t.Run("returns error if field does not exist or value is invalid", func(t *testing.T) {
+		t.Helper()
+
+		fixture := app.Fixture{ID: 16475287}
+
+		err := override.Apply(&fixture, []app.DataOverride{{Entity: app.EntityFixture, Field: "id", Value: "1"}})
+
+		assert.Equal(t, "field id does not exist for entity fixture", err.Error())
+
+		err = override.Apply(&fixture, []app.DataOverride{{Entity: app.EntityFixture, Field: "date", Value: "today"}})
+
+		assert.Equal(
+			t,
+			"value 'today' is not valid for field date: parsing time \"today\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"today\" as \"2006\"",
+			err.Error(),
+		)
+	})
+}
+
+func TestFields(t *testing.T) {
+	t.Run("returns fields that can be overridden excluding identifiers", func(t *testing.T) {
+		t.Helper()
+
+		fields := override.Fields(&app.Fixture{})
+
+		assert.Equal(
+			t,
+			[]string{
+				"aggregate_id",
+				"away_team_id",
+				"date",
+				"group_id",
+				"home_team_id",
+				"referee_id",
+				"round_id",
+				"season_id",
+				"stage_id",
+				"status",
+				"venue_id",
+			},
+			fields,
+		)
+	})
+}
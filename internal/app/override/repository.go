@@ -0,0 +1,327 @@
+package override
+
+import (
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"math"
+	"strconv"
+)
+
+// applier fetches the active overrides for entities and applies them. Invalid overrides are logged and skipped
+// so a bad correction never prevents an entity being ingested or served. Overrides are applied to a copy of each
+// entity written so the entity provided by the caller is left unchanged.
+type applier struct {
+	overrides app.DataOverrideRepository
+	logger    *logrus.Logger
+}
+
+func (a applier) apply(entity, key string, target interface{}) error {
+	overrides, err := a.overrides.Active(entity, key)
+
+	if err != nil {
+		return fmt.Errorf("error fetching data overrides for %s %s: %s", entity, key, err.Error())
+	}
+
+	a.applyEach(target, overrides)
+
+	return nil
+}
+
+func (a applier) byKey(entity string, keys []string) (map[string][]app.DataOverride, error) {
+	grouped := map[string][]app.DataOverride{}
+
+	if len(keys) == 0 {
+		return grouped, nil
+	}
+
+	overrides, err := a.overrides.Active(entity, keys...)
+
+	if err != nil {
+		return nil, fmt.Errorf("error fetching data overrides for %s: %s", entity, err.Error())
+	}
+
+	for _, o := range overrides {
+		grouped[o.Key] = append(grouped[o.Key], o)
+	}
+
+	return grouped, nil
+}
+
+func (a applier) applyEach(target interface{}, overrides []app.DataOverride) {
+	for _, o := range overrides {
+		if err := Apply(target, []app.DataOverride{o}); err != nil {
+			a.logger.Warnf("Error applying data override %d: %s", o.ID, err.Error())
+		}
+	}
+}
+
+// ResultRepository applies data overrides to results written to and read from the wrapped ResultRepository.
+type ResultRepository struct {
+	app.ResultRepository
+	applier
+}
+
+func (r *ResultRepository) Insert(x *app.Result, events ...app.DomainEvent) error {
+	y := *x
+
+	if err := r.apply(app.EntityResult, app.OverrideKey(x.FixtureID), &y); err != nil {
+		return err
+	}
+
+	return r.ResultRepository.Insert(&y, events...)
+}
+
+func (r *ResultRepository) Update(x *app.Result, events ...app.DomainEvent) error {
+	y := *x
+
+	if err := r.apply(app.EntityResult, app.OverrideKey(x.FixtureID), &y); err != nil {
+		return err
+	}
+
+	return r.ResultRepository.Update(&y, events...)
+}
+
+func (r *ResultRepository) ByFixtureID(id uint64) (*app.Result, error) {
+	x, err := r.ResultRepository.ByFixtureID(id)
+
+	if err != nil {
+		return x, err
+	}
+
+	if err := r.apply(app.EntityResult, app.OverrideKey(id), x); err != nil {
+		return nil, err
+	}
+
+	return x, nil
+}
+
+func (r *ResultRepository) BySeasonID(seasonID uint64) ([]app.Result, error) {
+	results, err := r.ResultRepository.BySeasonID(seasonID)
+
+	if err != nil {
+		return results, err
+	}
+
+	var keys []string
+
+	for _, x := range results {
+		keys = append(keys, app.OverrideKey(x.FixtureID))
+	}
+
+	overrides, err := r.byKey(app.EntityResult, keys)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		r.applyEach(&results[i], overrides[app.OverrideKey(results[i].FixtureID)])
+	}
+
+	return results, nil
+}
+
+// TeamStatsRepository applies data overrides to team stats written to and read from the wrapped
+// TeamStatsRepository. Queries aggregating or filtering team stats in the database, such as the performance
+// StatReader and RefereeRepository.Stats, do not pass through this repository and read the stored columns, which
+// include the overrides active when each record was last written.
+type TeamStatsRepository struct {
+	app.TeamStatsRepository
+	applier
+}
+
+func (t *TeamStatsRepository) InsertTeamStats(x *app.TeamStats, events ...app.DomainEvent) error {
+	y := *x
+
+	if err := t.apply(app.EntityTeamStats, app.OverrideKey(x.FixtureID, x.TeamID), &y); err != nil {
+		return err
+	}
+
+	return t.TeamStatsRepository.InsertTeamStats(&y, events...)
+}
+
+func (t *TeamStatsRepository) UpdateTeamStats(x *app.TeamStats, events ...app.DomainEvent) error {
+	y := *x
+
+	if err := t.apply(app.EntityTeamStats, app.OverrideKey(x.FixtureID, x.TeamID), &y); err != nil {
+		return err
+	}
+
+	return t.TeamStatsRepository.UpdateTeamStats(&y, events...)
+}
+
+func (t *TeamStatsRepository) ByFixtureAndTeam(fixtureID, teamID uint64) (*app.TeamStats, error) {
+	x, err := t.TeamStatsRepository.ByFixtureAndTeam(fixtureID, teamID)
+
+	if err != nil {
+		return x, err
+	}
+
+	if err := t.apply(app.EntityTeamStats, app.OverrideKey(fixtureID, teamID), x); err != nil {
+		return nil, err
+	}
+
+	return x, nil
+}
+
+func (t *TeamStatsRepository) StatByFixtureAndTeam(stat string, fixtureID, teamID uint64) (*app.TeamStat, error) {
+	x, err := t.TeamStatsRepository.StatByFixtureAndTeam(stat, fixtureID, teamID)
+
+	if err != nil {
+		return x, err
+	}
+
+	overrides, err := t.overrides.Active(app.EntityTeamStats, app.OverrideKey(fixtureID, teamID))
+
+	if err != nil {
+		return nil, fmt.Errorf("error fetching data overrides for %s: %s", app.EntityTeamStats, err.Error())
+	}
+
+	for _, o := range overrides {
+		if o.Field != stat {
+			continue
+		}
+
+		if o.Value == null {
+			x.Value = nil
+			continue
+		}
+
+		v, err := strconv.ParseFloat(o.Value, 64)
+
+		if err != nil {
+			t.logger.Warnf("Error applying data override %d: %s", o.ID, err.Error())
+			continue
+		}
+
+		value := uint32(math.Ceil(v))
+		x.Value = &value
+	}
+
+	return x, nil
+}
+
+func (t *TeamStatsRepository) Get() ([]*app.TeamStats, error) {
+	stats, err := t.TeamStatsRepository.Get()
+
+	if err != nil {
+		return stats, err
+	}
+
+	var keys []string
+
+	for _, s := range stats {
+		keys = append(keys, app.OverrideKey(s.FixtureID, s.TeamID))
+	}
+
+	overrides, err := t.byKey(app.EntityTeamStats, keys)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range stats {
+		t.applyEach(s, overrides[app.OverrideKey(s.FixtureID, s.TeamID)])
+	}
+
+	return stats, nil
+}
+
+// FixtureRepository applies data overrides to fixtures written to and read from the wrapped FixtureRepository.
+// GetIDs returns IDs only and filters on the stored columns, which include the overrides active when each fixture
+// was last written, so overrides created or expired since then are not reflected by it.
+type FixtureRepository struct {
+	app.FixtureRepository
+	applier
+}
+
+func (f *FixtureRepository) Insert(x *app.Fixture, events ...app.DomainEvent) error {
+	y := *x
+
+	if err := f.apply(app.EntityFixture, app.OverrideKey(x.ID), &y); err != nil {
+		return err
+	}
+
+	return f.FixtureRepository.Insert(&y, events...)
+}
+
+func (f *FixtureRepository) Update(x *app.Fixture, events ...app.DomainEvent) error {
+	y := *x
+
+	if err := f.apply(app.EntityFixture, app.OverrideKey(x.ID), &y); err != nil {
+		return err
+	}
+
+	return f.FixtureRepository.Update(&y, events...)
+}
+
+func (f *FixtureRepository) ByID(id uint64) (*app.Fixture, error) {
+	x, err := f.FixtureRepository.ByID(id)
+
+	if err != nil {
+		return x, err
+	}
+
+	if err := f.apply(app.EntityFixture, app.OverrideKey(id), x); err != nil {
+		return nil, err
+	}
+
+	return x, nil
+}
+
+func (f *FixtureRepository) ByTeamID(id uint64, query app.FixtureFilterQuery) ([]app.Fixture, error) {
+	fixtures, err := f.FixtureRepository.ByTeamID(id, query)
+
+	if err != nil {
+		return fixtures, err
+	}
+
+	return f.applyFixtures(fixtures)
+}
+
+func (f *FixtureRepository) Get(q app.FixtureRepositoryQuery) ([]app.Fixture, error) {
+	fixtures, err := f.FixtureRepository.Get(q)
+
+	if err != nil {
+		return fixtures, err
+	}
+
+	return f.applyFixtures(fixtures)
+}
+
+func (f *FixtureRepository) applyFixtures(fixtures []app.Fixture) ([]app.Fixture, error) {
+	var keys []string
+
+	for _, x := range fixtures {
+		keys = append(keys, app.OverrideKey(x.ID))
+	}
+
+	overrides, err := f.byKey(app.EntityFixture, keys)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range fixtures {
+		f.applyEach(&fixtures[i], overrides[app.OverrideKey(fixtures[i].ID)])
+	}
+
+	return fixtures, nil
+}
+
+func NewResultRepository(r app.ResultRepository, o app.DataOverrideRepository, log *logrus.Logger) *ResultRepository {
+	return &ResultRepository{ResultRepository: r, applier: applier{overrides: o, logger: log}}
+}
+
+func NewTeamStatsRepository(
+	t app.TeamStatsRepository,
+	o app.DataOverrideRepository,
+	log *logrus.Logger,
+) *TeamStatsRepository {
+	return &TeamStatsRepository{TeamStatsRepository: t, applier: applier{overrides: o, logger: log}}
+}
+
+func NewFixtureRepository(f app.FixtureRepository, o app.DataOverrideRepository, log *logrus.Logger) *FixtureRepository {
+	return &FixtureRepository{FixtureRepository: f, applier: applier{overrides: o, logger: log}}
+}
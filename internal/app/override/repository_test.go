@@ -0,0 +1,147 @@
+package override_test
+
+import (
+	"errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/override"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestResultRepository_Update(t *testing.T) {
+	t.Run("applies overrides before writing result", func(t *testing.T) {
+		t.Helper()
+
+		results := new(mock.ResultRepository)
+		overrides := new(mock.DataOverrideRepository)
+		logger, _ := test.NewNullLogger()
+
+		repo := override.NewResultRepository(results, overrides, logger)
+
+		home := 1
+		result := app.Result{FixtureID: 16475287, HomeScore: &home}
+
+		overrides.On("Active", app.EntityResult, []string{"16475287"}).
+			Return([]app.DataOverride{{ID: 1, Entity: app.EntityResult, Field: "home_score", Value: "2"}}, nil)
+		results.On("Update", m.MatchedBy(func(r *app.Result) bool {
+			return *r.HomeScore == 2
+		})).Return(nil)
+
+		if err := repo.Update(&result); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		results.AssertExpectations(t)
+		assert.Equal(t, 1, *result.HomeScore)
+	})
+
+	t.Run("returns error and does not write result if overrides cannot be fetched", func(t *testing.T) {
+		t.Helper()
+
+		results := new(mock.ResultRepository)
+		overrides := new(mock.DataOverrideRepository)
+		logger, _ := test.NewNullLogger()
+
+		repo := override.NewResultRepository(results, overrides, logger)
+
+		overrides.On("Active", app.EntityResult, []string{"16475287"}).Return([]app.DataOverride{}, errors.New("oh no"))
+
+		err := repo.Update(&app.Result{FixtureID: 16475287})
+
+		assert.Equal(t, "error fetching data overrides for result 16475287: oh no", err.Error())
+		results.AssertNotCalled(t, "Update", m.Anything)
+	})
+}
+
+func TestResultRepository_ByFixtureID(t *testing.T) {
+	t.Run("applies overrides to result read and logs invalid overrides", func(t *testing.T) {
+		t.Helper()
+
+		results := new(mock.ResultRepository)
+		overrides := new(mock.DataOverrideRepository)
+		logger, hook := test.NewNullLogger()
+
+		repo := override.NewResultRepository(results, overrides, logger)
+
+		results.On("ByFixtureID", uint64(16475287)).Return(&app.Result{FixtureID: 16475287}, nil)
+		overrides.On("Active", app.EntityResult, []string{"16475287"}).Return([]app.DataOverride{
+			{ID: 1, Entity: app.EntityResult, Field: "home_score", Value: "two"},
+			{ID: 2, Entity: app.EntityResult, Field: "away_score", Value: "3"},
+		}, nil)
+
+		result, err := repo.ByFixtureID(16475287)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Nil(t, result.HomeScore)
+		assert.Equal(t, 3, *result.AwayScore)
+		assert.Equal(
+			t,
+			"Error applying data override 1: value 'two' is not valid for field home_score: strconv.ParseInt: parsing \"two\": invalid syntax",
+			hook.LastEntry().Message,
+		)
+	})
+}
+
+func TestTeamStatsRepository_StatByFixtureAndTeam(t *testing.T) {
+	t.Run("applies override for stat requested", func(t *testing.T) {
+		t.Helper()
+
+		stats := new(mock.TeamStatsRepository)
+		overrides := new(mock.DataOverrideRepository)
+		logger, _ := test.NewNullLogger()
+
+		repo := override.NewTeamStatsRepository(stats, overrides, logger)
+
+		value := uint32(4)
+
+		stats.On("StatByFixtureAndTeam", "shots_total", uint64(16475287), uint64(1)).
+			Return(&app.TeamStat{FixtureID: 16475287, Stat: "shots_total", Value: &value}, nil)
+		overrides.On("Active", app.EntityTeamStats, []string{"16475287:1"}).Return([]app.DataOverride{
+			{ID: 1, Entity: app.EntityTeamStats, Field: "corners", Value: "9"},
+			{ID: 2, Entity: app.EntityTeamStats, Field: "shots_total", Value: "11"},
+		}, nil)
+
+		stat, err := repo.StatByFixtureAndTeam("shots_total", 16475287, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, uint32(11), *stat.Value)
+	})
+}
+
+func TestFixtureRepository_Get(t *testing.T) {
+	t.Run("applies overrides to each fixture using a single overrides query", func(t *testing.T) {
+		t.Helper()
+
+		fixtures := new(mock.FixtureRepository)
+		overrides := new(mock.DataOverrideRepository)
+		logger, _ := test.NewNullLogger()
+
+		repo := override.NewFixtureRepository(fixtures, overrides, logger)
+
+		query := app.FixtureRepositoryQuery{}
+
+		fixtures.On("Get", query).Return([]app.Fixture{{ID: 1, SeasonID: 5}, {ID: 2, SeasonID: 5}}, nil)
+		overrides.On("Active", app.EntityFixture, []string{"1", "2"}).Return([]app.DataOverride{
+			{ID: 1, Entity: app.EntityFixture, Key: "2", Field: "season_id", Value: "6"},
+		}, nil)
+
+		fetched, err := repo.Get(query)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, uint64(5), fetched[0].SeasonID)
+		assert.Equal(t, uint64(6), fetched[1].SeasonID)
+		overrides.AssertNumberOfCalls(t, "Active", 1)
+	})
+}
@@ -86,6 +86,8 @@ type PlayerStatsRepository interface {
 type PlayerStatRequester interface {
 	PlayerStatsByFixtureIDs(ids []uint64) <-chan *PlayerStats
 	PlayerStatsBySeasonIDs(seasonIDs []uint64) <-chan *PlayerStats
+	// PlayerStatsBySeasonID requests the player stats of a single season, returning an error if the request fails.
+	PlayerStatsBySeasonID(seasonID uint64) ([]*PlayerStats, error)
 	// Fetch as parse player stats for fixture on a given date. Provide a struct of uint64 season IDs to limit fetched
 	// data to specific competitions
 	PlayerStatsByDate(date time.Time, competitionIDs []uint64) <-chan *PlayerStats
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jonboulle/clockwork"
+)
+
+type CheckpointRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+func (r *CheckpointRepository) Completed(run string, unit string) ([]uint64, error) {
+	rows, err := r.queryBuilder().
+		Select("unit_id").
+		From("checkpoint").
+		Where(sq.Eq{"run": run}).
+		Where(sq.Eq{"unit": unit}).
+		OrderBy("unit_id ASC").
+		Query()
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var ids []uint64
+
+	for rows.Next() {
+		var id uint64
+
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// Complete records the unit as completed by the run, recording a unit already completed is not an error.
+func (r *CheckpointRepository) Complete(run string, unit string, id uint64) error {
+	_, err := r.queryBuilder().
+		Insert("checkpoint").
+		Columns("run", "unit", "unit_id", "completed_at").
+		Values(run, unit, id, r.clock.Now().Unix()).
+		Suffix("ON CONFLICT (run, unit, unit_id) DO NOTHING").
+		Exec()
+
+	return err
+}
+
+func (r *CheckpointRepository) Clear(run string) error {
+	_, err := r.queryBuilder().
+		Delete("checkpoint").
+		Where(sq.Eq{"run": run}).
+		Exec()
+
+	return err
+}
+
+func (r *CheckpointRepository) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(r.connection)
+}
+
+func NewCheckpointRepository(connection *sql.DB, clock clockwork.Clock) *CheckpointRepository {
+	return &CheckpointRepository{connection: connection, clock: clock}
+}
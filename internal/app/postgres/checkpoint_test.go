@@ -0,0 +1,82 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCheckpointRepository_Completed(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "checkpoint")
+	repo := postgres.NewCheckpointRepository(conn, test.Clock)
+
+	t.Run("returns the units of the type provided completed by the run", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		checkpoints := []struct {
+			run  string
+			unit string
+			id   uint64
+		}{
+			{"player-stats:by-competition-id 8", app.CheckpointSeason, 16036},
+			{"player-stats:by-competition-id 8", app.CheckpointSeason, 12962},
+			{"player-stats:by-competition-id 8", app.CheckpointSeason, 12962},
+			{"player-stats:by-competition-id 8", app.CheckpointFixture, 45},
+			{"player-stats:by-competition-id 9", app.CheckpointSeason, 17141},
+		}
+
+		for _, c := range checkpoints {
+			if err := repo.Complete(c.run, c.unit, c.id); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		ids, err := repo.Completed("player-stats:by-competition-id 8", app.CheckpointSeason)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []uint64{12962, 16036}, ids)
+	})
+}
+
+func TestCheckpointRepository_Clear(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "checkpoint")
+	repo := postgres.NewCheckpointRepository(conn, test.Clock)
+
+	t.Run("removes every checkpoint of the run", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		if err := repo.Complete("results:by-season-id 16036", app.CheckpointFixture, 45); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		if err := repo.Complete("results:by-season-id 17141", app.CheckpointFixture, 46); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		if err := repo.Clear("results:by-season-id 16036"); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		cleared, err := repo.Completed("results:by-season-id 16036", app.CheckpointFixture)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		remaining, err := repo.Completed("results:by-season-id 17141", app.CheckpointFixture)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Nil(t, cleared)
+		assert.Equal(t, []uint64{46}, remaining)
+	})
+}
@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"time"
+)
+
+type CommandRunRepository struct {
+	connection *sql.DB
+}
+
+func (r *CommandRunRepository) Insert(c *app.CommandRun) error {
+	return r.queryBuilder().
+		Insert("command_run").
+		Columns("command", "option", "status", "error", "started_at", "finished_at").
+		Values(c.Command, c.Option, c.Status, c.Error, c.StartedAt.Unix(), unixOrNil(c.FinishedAt)).
+		Suffix("RETURNING id").
+		QueryRow().
+		Scan(&c.ID)
+}
+
+func (r *CommandRunRepository) Update(c *app.CommandRun) error {
+	_, err := r.queryBuilder().
+		Update("command_run").
+		Set("status", c.Status).
+		Set("error", c.Error).
+		Set("finished_at", unixOrNil(c.FinishedAt)).
+		Where(sq.Eq{"id": c.ID}).
+		Exec()
+
+	return err
+}
+
+func (r *CommandRunRepository) ByID(id uint64) (*app.CommandRun, error) {
+	row := r.queryBuilder().
+		Select("id", "command", "option", "status", "error", "started_at", "finished_at").
+		From("command_run").
+		Where(sq.Eq{"id": id}).
+		QueryRow()
+
+	c, err := scanCommandRun(row)
+
+	if err == sql.ErrNoRows {
+		return nil, errors.ErrorNotFound
+	}
+
+	return c, err
+}
+
+func (r *CommandRunRepository) Recent(limit uint64) ([]app.CommandRun, error) {
+	rows, err := r.queryBuilder().
+		Select("id", "command", "option", "status", "error", "started_at", "finished_at").
+		From("command_run").
+		OrderBy("id DESC").
+		Limit(limit).
+		Query()
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var runs []app.CommandRun
+
+	for rows.Next() {
+		c, err := scanCommandRun(rows)
+
+		if err != nil {
+			return nil, err
+		}
+
+		runs = append(runs, *c)
+	}
+
+	return runs, rows.Err()
+}
+
+func (r *CommandRunRepository) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(r.connection)
+}
+
+func NewCommandRunRepository(connection *sql.DB) *CommandRunRepository {
+	return &CommandRunRepository{connection: connection}
+}
+
+func scanCommandRun(r sq.RowScanner) (*app.CommandRun, error) {
+	var c app.CommandRun
+	var started int64
+	var finished *int64
+
+	if err := r.Scan(&c.ID, &c.Command, &c.Option, &c.Status, &c.Error, &started, &finished); err != nil {
+		return nil, err
+	}
+
+	c.StartedAt = time.Unix(started, 0)
+
+	if finished != nil {
+		t := time.Unix(*finished, 0)
+		c.FinishedAt = &t
+	}
+
+	return &c, nil
+}
+
+func unixOrNil(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+
+	u := t.Unix()
+
+	return &u
+}
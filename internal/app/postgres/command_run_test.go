@@ -0,0 +1,97 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCommandRunRepository_Update(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "command_run")
+	repo := postgres.NewCommandRunRepository(conn)
+
+	t.Run("updates the status of a run", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		run := app.CommandRun{
+			Command:   "results:by-season-id",
+			Option:    "16036",
+			Status:    app.CommandRunRunning,
+			StartedAt: test.Clock.Now(),
+		}
+
+		if err := repo.Insert(&run); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		reason := "oh no"
+		finished := test.Clock.Now().Add(time.Minute)
+
+		run.Status = app.CommandRunFailed
+		run.Error = &reason
+		run.FinishedAt = &finished
+
+		if err := repo.Update(&run); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		fetched, err := repo.ByID(run.ID)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal("results:by-season-id", fetched.Command)
+		a.Equal("16036", fetched.Option)
+		a.Equal(app.CommandRunFailed, fetched.Status)
+		a.Equal("oh no", *fetched.Error)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", fetched.StartedAt.UTC().String())
+		a.Equal("2019-01-14 11:26:00 +0000 UTC", fetched.FinishedAt.UTC().String())
+	})
+
+	t.Run("returns not found error if run does not exist", func(t *testing.T) {
+		t.Helper()
+
+		_, err := repo.ByID(99)
+
+		assert.Equal(t, errors.ErrorNotFound, err)
+	})
+}
+
+func TestCommandRunRepository_Recent(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "command_run")
+	repo := postgres.NewCommandRunRepository(conn)
+
+	t.Run("returns most recent runs first", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		for _, c := range []string{"season", "team", "round"} {
+			run := app.CommandRun{Command: c, Status: app.CommandRunRunning, StartedAt: test.Clock.Now()}
+
+			if err := repo.Insert(&run); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		runs, err := repo.Recent(2)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(runs))
+		a.Equal("round", runs[0].Command)
+		a.Equal("team", runs[1].Command)
+		a.Nil(runs[1].FinishedAt)
+	})
+}
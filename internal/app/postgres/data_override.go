@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"time"
+)
+
+type DataOverrideRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+func (r *DataOverrideRepository) Insert(o *app.DataOverride) error {
+	builder := r.queryBuilder()
+
+	var expires *int64
+
+	if o.ExpiresAt != nil {
+		e := o.ExpiresAt.Unix()
+		expires = &e
+	}
+
+	o.CreatedAt = r.clock.Now()
+
+	return builder.
+		Insert("data_override").
+		Columns("entity", "key", "field", "value", "reason", "author", "created_at", "expires_at").
+		Values(o.Entity, o.Key, o.Field, o.Value, o.Reason, o.Author, o.CreatedAt.Unix(), expires).
+		Suffix("RETURNING id").
+		QueryRow().
+		Scan(&o.ID)
+}
+
+func (r *DataOverrideRepository) Expire(id uint64) error {
+	builder := r.queryBuilder()
+
+	res, err := builder.
+		Update("data_override").
+		Set("expires_at", r.clock.Now().Unix()).
+		Where(sq.Eq{"id": id}).
+		Where(r.active()).
+		Exec()
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return errors.ErrorNotFound
+	}
+
+	return nil
+}
+
+func (r *DataOverrideRepository) Active(entity string, keys ...string) ([]app.DataOverride, error) {
+	builder := r.queryBuilder()
+
+	rows, err := builder.
+		Select(dataOverrideColumns()...).
+		From("data_override").
+		Where(sq.Eq{"entity": entity}).
+		Where(sq.Eq{"key": keys}).
+		Where(r.active()).
+		OrderBy("id ASC").
+		Query()
+
+	if err != nil {
+		return []app.DataOverride{}, err
+	}
+
+	return rowsToDataOverrides(rows)
+}
+
+func (r *DataOverrideRepository) All(includeExpired bool) ([]app.DataOverride, error) {
+	builder := r.queryBuilder()
+
+	query := builder.
+		Select(dataOverrideColumns()...).
+		From("data_override").
+		OrderBy("id ASC")
+
+	if !includeExpired {
+		query = query.Where(r.active())
+	}
+
+	rows, err := query.Query()
+
+	if err != nil {
+		return []app.DataOverride{}, err
+	}
+
+	return rowsToDataOverrides(rows)
+}
+
+func (r *DataOverrideRepository) active() sq.Or {
+	return sq.Or{sq.Eq{"expires_at": nil}, sq.Gt{"expires_at": r.clock.Now().Unix()}}
+}
+
+func (r *DataOverrideRepository) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(r.connection)
+}
+
+func dataOverrideColumns() []string {
+	return []string{"id", "entity", "key", "field", "value", "reason", "author", "created_at", "expires_at"}
+}
+
+func rowsToDataOverrides(rows *sql.Rows) ([]app.DataOverride, error) {
+	defer rows.Close()
+
+	var overrides []app.DataOverride
+
+	for rows.Next() {
+		var o app.DataOverride
+		var created int64
+		var expires *int64
+
+		err := rows.Scan(&o.ID, &o.Entity, &o.Key, &o.Field, &o.Value, &o.Reason, &o.Author, &created, &expires)
+
+		if err != nil {
+			return overrides, err
+		}
+
+		o.CreatedAt = time.Unix(created, 0)
+
+		if expires != nil {
+			e := time.Unix(*expires, 0)
+			o.ExpiresAt = &e
+		}
+
+		overrides = append(overrides, o)
+	}
+
+	return overrides, nil
+}
+
+func NewDataOverrideRepository(connection *sql.DB, clock clockwork.Clock) *DataOverrideRepository {
+	return &DataOverrideRepository{connection: connection, clock: clock}
+}
@@ -0,0 +1,136 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDataOverrideRepository_Insert(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "data_override")
+	repo := postgres.NewDataOverrideRepository(conn, test.Clock)
+
+	t.Run("increases table count and populates ID", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		for i := 1; i < 4; i++ {
+			o := newDataOverride(app.EntityResult, "16475287", "home_score")
+
+			if err := repo.Insert(o); err != nil {
+				t.Errorf("Test failed, expected nil, got %s", err)
+			}
+
+			row := conn.QueryRow("select count(*) from data_override")
+
+			var count int
+
+			if err := row.Scan(&count); err != nil {
+				t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+			}
+
+			assert.Equal(t, i, count)
+			assert.NotEqual(t, uint64(0), o.ID)
+		}
+	})
+}
+
+func TestDataOverrideRepository_Active(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "data_override")
+	repo := postgres.NewDataOverrideRepository(conn, test.Clock)
+
+	t.Run("returns overrides for entity keys that have not expired", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		overrides := []*app.DataOverride{
+			newDataOverride(app.EntityResult, "16475287", "home_score"),
+			newDataOverride(app.EntityResult, "16475287", "away_score"),
+			newDataOverride(app.EntityResult, "16475288", "home_score"),
+			newDataOverride(app.EntityTeamStats, "16475287:1", "corners"),
+		}
+
+		for _, o := range overrides {
+			if err := repo.Insert(o); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		if err := repo.Expire(overrides[1].ID); err != nil {
+			t.Fatalf("Error expiring override: %s", err.Error())
+		}
+
+		fetched, err := repo.Active(app.EntityResult, "16475287", "16475288")
+
+		if err != nil {
+			t.Fatalf("Error retrieving records from the database: %s", err.Error())
+		}
+
+		a := assert.New(t)
+		a.Equal(2, len(fetched))
+		a.Equal("16475287", fetched[0].Key)
+		a.Equal("home_score", fetched[0].Field)
+		a.Equal("2", fetched[0].Value)
+		a.Equal("Score corrected from match report", fetched[0].Reason)
+		a.Equal("joe", fetched[0].Author)
+		a.Nil(fetched[0].ExpiresAt)
+		a.Equal("16475288", fetched[1].Key)
+	})
+}
+
+func TestDataOverrideRepository_Expire(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "data_override")
+	repo := postgres.NewDataOverrideRepository(conn, test.Clock)
+
+	t.Run("expires override so it is only returned when including expired overrides", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		o := newDataOverride(app.EntityResult, "16475287", "home_score")
+
+		if err := repo.Insert(o); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		if err := repo.Expire(o.ID); err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		active, err := repo.All(false)
+
+		if err != nil {
+			t.Fatalf("Error retrieving records from the database: %s", err.Error())
+		}
+
+		all, err := repo.All(true)
+
+		if err != nil {
+			t.Fatalf("Error retrieving records from the database: %s", err.Error())
+		}
+
+		assert.Equal(t, 0, len(active))
+		assert.Equal(t, 1, len(all))
+		assert.Equal(t, int64(1547465100), all[0].ExpiresAt.Unix())
+	})
+
+	t.Run("returns not found error if override does not exist or has already expired", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		assert.Equal(t, errors.ErrorNotFound, repo.Expire(99))
+	})
+}
+
+func newDataOverride(entity, key, field string) *app.DataOverride {
+	return &app.DataOverride{
+		Entity: entity,
+		Key:    key,
+		Field:  field,
+		Value:  "2",
+		Reason: "Score corrected from match report",
+		Author: "joe",
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
 	"github.com/statistico/statistico-football-data/internal/app"
 	"time"
 )
@@ -26,10 +27,26 @@ func (e *EventRepository) InsertCardEvent(c *app.CardEvent) error {
 	return err
 }
 
+func (e *EventRepository) UpdateCardEvent(c *app.CardEvent) error {
+	builder := e.queryBuilder()
+
+	_, err := builder.
+		Update("sportmonks_card_event").
+		Set("team_id", c.TeamID).
+		Set("type", c.Type).
+		Set("player_id", c.PlayerID).
+		Set("minute", c.Minute).
+		Set("reason", c.Reason).
+		Where(sq.Eq{"id": c.ID}).
+		Exec()
+
+	return err
+}
+
 func (e *EventRepository) InsertGoalEvent(g *app.GoalEvent) error {
 	query := `
-	INSERT INTO sportmonks_goal_event (id, team_id, player_id, player_assist_id, minute, score, created_at, fixture_id) 
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	INSERT INTO sportmonks_goal_event (id, team_id, player_id, player_assist_id, minute, score, created_at, fixture_id,
+	type, extra_minute) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
 
 	_, err := e.connection.Exec(
 		query,
@@ -41,6 +58,28 @@ func (e *EventRepository) InsertGoalEvent(g *app.GoalEvent) error {
 		g.Score,
 		e.clock.Now().Unix(),
 		g.FixtureID,
+		g.Type,
+		g.ExtraMinute,
+	)
+
+	return err
+}
+
+func (e *EventRepository) UpdateGoalEvent(g *app.GoalEvent) error {
+	query := `
+	UPDATE sportmonks_goal_event SET team_id = $2, player_id = $3, player_assist_id = $4, minute = $5, score = $6,
+	type = $7, extra_minute = $8 WHERE id = $1`
+
+	_, err := e.connection.Exec(
+		query,
+		g.ID,
+		g.TeamID,
+		g.PlayerID,
+		g.PlayerAssistID,
+		g.Minute,
+		g.Score,
+		g.Type,
+		g.ExtraMinute,
 	)
 
 	return err
@@ -66,6 +105,87 @@ func (e *EventRepository) InsertSubstitutionEvent(s *app.SubstitutionEvent) erro
 	return err
 }
 
+func (e *EventRepository) UpdateSubstitutionEvent(s *app.SubstitutionEvent) error {
+	query := `
+	UPDATE sportmonks_substitution_event SET team_id = $2, player_in_id = $3, player_out_id = $4, minute = $5,
+	injured = $6 WHERE id = $1`
+
+	_, err := e.connection.Exec(
+		query,
+		s.ID,
+		s.TeamID,
+		s.PlayerInID,
+		s.PlayerOutID,
+		s.Minute,
+		s.Injured,
+	)
+
+	return err
+}
+
+func (e *EventRepository) InsertMissedPenaltyEvent(m *app.MissedPenaltyEvent) error {
+	builder := e.queryBuilder()
+
+	_, err := builder.
+		Insert("sportmonks_missed_penalty_event").
+		Columns("id", "fixture_id", "team_id", "player_id", "minute", "extra_minute", "created_at").
+		Values(m.ID, m.FixtureID, m.TeamID, m.PlayerID, m.Minute, m.ExtraMinute, e.clock.Now().Unix()).
+		Exec()
+
+	return err
+}
+
+func (e *EventRepository) UpdateMissedPenaltyEvent(m *app.MissedPenaltyEvent) error {
+	builder := e.queryBuilder()
+
+	_, err := builder.
+		Update("sportmonks_missed_penalty_event").
+		Set("team_id", m.TeamID).
+		Set("player_id", m.PlayerID).
+		Set("minute", m.Minute).
+		Set("extra_minute", m.ExtraMinute).
+		Where(sq.Eq{"id": m.ID}).
+		Exec()
+
+	return err
+}
+
+func (e *EventRepository) DeleteFixtureEventsExcept(fixtureID uint64, keep app.FixtureEventIDs) error {
+	tx, err := e.connection.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	tables := []struct {
+		name string
+		ids  []uint64
+	}{
+		{"sportmonks_goal_event", keep.Goals},
+		{"sportmonks_card_event", keep.Cards},
+		{"sportmonks_substitution_event", keep.Substitutions},
+		{"sportmonks_missed_penalty_event", keep.MissedPenalties},
+	}
+
+	for _, t := range tables {
+		if t.ids == nil {
+			continue
+		}
+
+		// The list is copied as a nil array would be NULL in SQL and match nothing, an empty list removes every
+		// event in the table.
+		ids := append([]uint64{}, t.ids...)
+		query := fmt.Sprintf("DELETE FROM %s WHERE fixture_id = $1 AND NOT (id = ANY($2))", t.name)
+
+		if _, err := tx.Exec(query, fixtureID, pq.Array(ids)); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
 func (e *EventRepository) CardEventsForFixture(fixtureID uint64) ([]*app.CardEvent, error) {
 	builder := e.queryBuilder()
 
@@ -73,6 +193,7 @@ func (e *EventRepository) CardEventsForFixture(fixtureID uint64) ([]*app.CardEve
 		Select("sportmonks_card_event.*").
 		From("sportmonks_card_event").
 		Where(sq.Eq{"fixture_id": fixtureID}).
+		OrderBy("minute ASC", "id ASC").
 		Query()
 
 	if err != nil {
@@ -83,9 +204,10 @@ func (e *EventRepository) CardEventsForFixture(fixtureID uint64) ([]*app.CardEve
 
 	var created int64
 	var events []*app.CardEvent
-	var event app.CardEvent
 
 	for rows.Next() {
+		var event app.CardEvent
+
 		err := rows.Scan(
 			&event.ID,
 			&event.TeamID,
@@ -113,9 +235,10 @@ func (e *EventRepository) GoalEventsForFixture(fixtureID uint64) ([]*app.GoalEve
 	builder := e.queryBuilder()
 
 	rows, err := builder.
-		Select("sportmonks_goal_event.*").
+		Select(goalEventColumns()...).
 		From("sportmonks_goal_event").
 		Where(sq.Eq{"fixture_id": fixtureID}).
+		OrderBy("minute ASC", "id ASC").
 		Query()
 
 	if err != nil {
@@ -126,18 +249,65 @@ func (e *EventRepository) GoalEventsForFixture(fixtureID uint64) ([]*app.GoalEve
 
 	var created int64
 	var events []*app.GoalEvent
-	var event app.GoalEvent
 
 	for rows.Next() {
+		var event app.GoalEvent
+
 		err := rows.Scan(
 			&event.ID,
+			&event.FixtureID,
 			&event.TeamID,
 			&event.PlayerID,
 			&event.PlayerAssistID,
+			&event.Type,
 			&event.Minute,
+			&event.ExtraMinute,
 			&event.Score,
 			&created,
+		)
+
+		if err != nil {
+			return events, err
+		}
+
+		event.CreatedAt = time.Unix(created, 0)
+
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+func (e *EventRepository) MissedPenaltyEventsForFixture(fixtureID uint64) ([]*app.MissedPenaltyEvent, error) {
+	builder := e.queryBuilder()
+
+	rows, err := builder.
+		Select(missedPenaltyEventColumns()...).
+		From("sportmonks_missed_penalty_event").
+		Where(sq.Eq{"fixture_id": fixtureID}).
+		OrderBy("minute ASC", "id ASC").
+		Query()
+
+	if err != nil {
+		return []*app.MissedPenaltyEvent{}, err
+	}
+
+	defer rows.Close()
+
+	var created int64
+	var events []*app.MissedPenaltyEvent
+
+	for rows.Next() {
+		var event app.MissedPenaltyEvent
+
+		err := rows.Scan(
+			&event.ID,
 			&event.FixtureID,
+			&event.TeamID,
+			&event.PlayerID,
+			&event.Minute,
+			&event.ExtraMinute,
+			&created,
 		)
 
 		if err != nil {
@@ -152,6 +322,51 @@ func (e *EventRepository) GoalEventsForFixture(fixtureID uint64) ([]*app.GoalEve
 	return events, nil
 }
 
+func (e *EventRepository) SubstitutionEventsForFixture(fixtureID uint64) ([]*app.SubstitutionEvent, error) {
+	rows, err := e.queryBuilder().
+		Select(substitutionEventColumns()...).
+		From("sportmonks_substitution_event").
+		Where(sq.Eq{"fixture_id": fixtureID}).
+		OrderBy("minute ASC", "id ASC").
+		Query()
+
+	if err != nil {
+		return []*app.SubstitutionEvent{}, err
+	}
+
+	return rowsToSubstitutionEvents(rows)
+}
+
+func (e *EventRepository) SubstitutionEventsForTeam(teamID uint64) ([]*app.SubstitutionEvent, error) {
+	rows, err := e.queryBuilder().
+		Select(substitutionEventColumns()...).
+		From("sportmonks_substitution_event").
+		Where(sq.Eq{"team_id": teamID}).
+		OrderBy("fixture_id ASC", "minute ASC", "id ASC").
+		Query()
+
+	if err != nil {
+		return []*app.SubstitutionEvent{}, err
+	}
+
+	return rowsToSubstitutionEvents(rows)
+}
+
+func (e *EventRepository) SubstitutionEventsForPlayer(playerID uint64) ([]*app.SubstitutionEvent, error) {
+	rows, err := e.queryBuilder().
+		Select(substitutionEventColumns()...).
+		From("sportmonks_substitution_event").
+		Where(sq.Or{sq.Eq{"player_in_id": playerID}, sq.Eq{"player_out_id": playerID}}).
+		OrderBy("fixture_id ASC", "minute ASC", "id ASC").
+		Query()
+
+	if err != nil {
+		return []*app.SubstitutionEvent{}, err
+	}
+
+	return rowsToSubstitutionEvents(rows)
+}
+
 func (e *EventRepository) CardEventByID(id uint64) (*app.CardEvent, error) {
 	query := `SELECT * FROM sportmonks_card_event WHERE id = $1`
 
@@ -181,14 +396,27 @@ func (e *EventRepository) CardEventByID(id uint64) (*app.CardEvent, error) {
 }
 
 func (e *EventRepository) GoalEventByID(id uint64) (*app.GoalEvent, error) {
-	query := `SELECT * FROM sportmonks_goal_event WHERE id = $1`
-
 	var g = app.GoalEvent{}
 	var created int64
 
-	row := e.connection.QueryRow(query, id)
+	row := e.queryBuilder().
+		Select(goalEventColumns()...).
+		From("sportmonks_goal_event").
+		Where(sq.Eq{"id": id}).
+		QueryRow()
 
-	err := row.Scan(&g.ID, &g.TeamID, &g.PlayerID, &g.PlayerAssistID, &g.Minute, &g.Score, &created, &g.FixtureID)
+	err := row.Scan(
+		&g.ID,
+		&g.FixtureID,
+		&g.TeamID,
+		&g.PlayerID,
+		&g.PlayerAssistID,
+		&g.Type,
+		&g.Minute,
+		&g.ExtraMinute,
+		&g.Score,
+		&created,
+	)
 
 	if err != nil {
 		return &g, fmt.Errorf("goal event with ID %d does not exist", id)
@@ -218,6 +446,27 @@ func (e *EventRepository) SubstitutionEventByID(id uint64) (*app.SubstitutionEve
 	return &s, nil
 }
 
+func (e *EventRepository) MissedPenaltyEventByID(id uint64) (*app.MissedPenaltyEvent, error) {
+	var m = app.MissedPenaltyEvent{}
+	var created int64
+
+	row := e.queryBuilder().
+		Select(missedPenaltyEventColumns()...).
+		From("sportmonks_missed_penalty_event").
+		Where(sq.Eq{"id": id}).
+		QueryRow()
+
+	err := row.Scan(&m.ID, &m.FixtureID, &m.TeamID, &m.PlayerID, &m.Minute, &m.ExtraMinute, &created)
+
+	if err != nil {
+		return &m, fmt.Errorf("missed penalty event with ID %d does not exist", id)
+	}
+
+	m.CreatedAt = time.Unix(created, 0)
+
+	return &m, nil
+}
+
 func NewEventRepository(connection *sql.DB, clock clockwork.Clock) *EventRepository {
 	return &EventRepository{connection: connection, clock: clock}
 }
@@ -225,3 +474,75 @@ func NewEventRepository(connection *sql.DB, clock clockwork.Clock) *EventReposit
 func (e *EventRepository) queryBuilder() sq.StatementBuilderType {
 	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(e.connection)
 }
+
+func goalEventColumns() []string {
+	return []string{
+		"id",
+		"fixture_id",
+		"team_id",
+		"player_id",
+		"player_assist_id",
+		"type",
+		"minute",
+		"extra_minute",
+		"score",
+		"created_at",
+	}
+}
+
+func missedPenaltyEventColumns() []string {
+	return []string{
+		"id",
+		"fixture_id",
+		"team_id",
+		"player_id",
+		"minute",
+		"extra_minute",
+		"created_at",
+	}
+}
+
+func substitutionEventColumns() []string {
+	return []string{
+		"id",
+		"fixture_id",
+		"team_id",
+		"player_in_id",
+		"player_out_id",
+		"minute",
+		"injured",
+		"created_at",
+	}
+}
+
+func rowsToSubstitutionEvents(rows *sql.Rows) ([]*app.SubstitutionEvent, error) {
+	defer rows.Close()
+
+	var created int64
+	var events []*app.SubstitutionEvent
+
+	for rows.Next() {
+		var event app.SubstitutionEvent
+
+		err := rows.Scan(
+			&event.ID,
+			&event.FixtureID,
+			&event.TeamID,
+			&event.PlayerInID,
+			&event.PlayerOutID,
+			&event.Minute,
+			&event.Injured,
+			&created,
+		)
+
+		if err != nil {
+			return events, err
+		}
+
+		event.CreatedAt = time.Unix(created, 0)
+
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
@@ -51,6 +51,40 @@ func TestEventRepository_InsertGoalEvent(t *testing.T) {
 	})
 }
 
+func TestEventRepository_UpdateGoalEvent(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_goal_event")
+	repo := postgres.NewEventRepository(conn, test.Clock)
+
+	t.Run("updates the type and added time of an existing goal", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		m := newGoalEvent(33, 45)
+
+		if err := repo.InsertGoalEvent(m); err != nil {
+			t.Errorf("Test failed, expected nil, got %s", err)
+		}
+
+		m.Type = app.GoalTypeOwnGoal
+		m.ExtraMinute = nil
+
+		if err := repo.UpdateGoalEvent(m); err != nil {
+			t.Errorf("Test failed, expected nil, got %s", err)
+		}
+
+		r, err := repo.GoalEventByID(33)
+
+		if err != nil {
+			t.Errorf("Error when retrieving a record from the database: %s", err.Error())
+		}
+
+		a := assert.New(t)
+		a.Equal(app.GoalTypeOwnGoal, r.Type)
+		a.Nil(r.ExtraMinute)
+		a.Equal(82, r.Minute)
+	})
+}
+
 func TestEventRepository_InsertSubstitutionEvent(t *testing.T) {
 	conn, cleanUp := test.GetConnection(t, "sportmonks_substitution_event")
 	repo := postgres.NewEventRepository(conn, test.Clock)
@@ -162,7 +196,9 @@ func TestEventRepository_GoalEventByID(t *testing.T) {
 		a.Equal(uint64(4509), r.TeamID)
 		a.Equal(uint64(3401), r.PlayerID)
 		a.Nil(r.PlayerAssistID)
+		a.Equal(app.GoalTypePenalty, r.Type)
 		a.Equal(82, r.Minute)
+		a.Equal(3, *r.ExtraMinute)
 		a.Equal("0-1", r.Score)
 		a.Equal("2019-01-14 11:25:00 +0000 UTC", r.CreatedAt.String())
 	})
@@ -264,6 +300,124 @@ func TestEventRepository_InsertCardEvent(t *testing.T) {
 	})
 }
 
+func TestEventRepository_UpdateCardEvent(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_card_event")
+	repo := postgres.NewEventRepository(conn, test.Clock)
+
+	t.Run("updates an existing card", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		c := newCardEvent(3, 45)
+
+		if err := repo.InsertCardEvent(c); err != nil {
+			t.Errorf("Test failed, expected nil, got %s", err)
+		}
+
+		c.Type = "yellowcard"
+		c.Minute = 87
+
+		if err := repo.UpdateCardEvent(c); err != nil {
+			t.Errorf("Test failed, expected nil, got %s", err)
+		}
+
+		r, err := repo.CardEventByID(3)
+
+		if err != nil {
+			t.Errorf("Error when retrieving a record from the database: %s", err.Error())
+		}
+
+		a := assert.New(t)
+		a.Equal("yellowcard", r.Type)
+		a.Equal(87, r.Minute)
+	})
+}
+
+func TestEventRepository_DeleteFixtureEventsExcept(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_card_event")
+	repo := postgres.NewEventRepository(conn, test.Clock)
+
+	t.Run("deletes events of the fixture not in the IDs provided", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		events := []*app.CardEvent{
+			newCardEvent(1, 45),
+			newCardEvent(2, 45),
+			newCardEvent(3, 102),
+		}
+
+		for _, e := range events {
+			if err := repo.InsertCardEvent(e); err != nil {
+				t.Errorf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		if err := repo.DeleteFixtureEventsExcept(45, app.FixtureEventIDs{Cards: []uint64{1}}); err != nil {
+			t.Fatalf("Expected nil, got %s", err)
+		}
+
+		fetched, err := repo.CardEventsForFixture(45)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err)
+		}
+
+		assert.Equal(t, 1, len(fetched))
+		assert.Equal(t, uint64(1), fetched[0].ID)
+
+		other, err := repo.CardEventsForFixture(102)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err)
+		}
+
+		assert.Equal(t, 1, len(other))
+	})
+
+	t.Run("deletes every event of the fixture when an empty list of IDs is provided", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		if err := repo.InsertCardEvent(newCardEvent(1, 45)); err != nil {
+			t.Errorf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		if err := repo.DeleteFixtureEventsExcept(45, app.FixtureEventIDs{Cards: []uint64{}}); err != nil {
+			t.Fatalf("Expected nil, got %s", err)
+		}
+
+		fetched, err := repo.CardEventsForFixture(45)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err)
+		}
+
+		assert.Equal(t, 0, len(fetched))
+	})
+
+	t.Run("leaves events untouched when a nil list of IDs is provided", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		if err := repo.InsertCardEvent(newCardEvent(1, 45)); err != nil {
+			t.Errorf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		if err := repo.DeleteFixtureEventsExcept(45, app.FixtureEventIDs{}); err != nil {
+			t.Fatalf("Expected nil, got %s", err)
+		}
+
+		fetched, err := repo.CardEventsForFixture(45)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err)
+		}
+
+		assert.Equal(t, 1, len(fetched))
+	})
+}
+
 func TestEventRepository_CardEventsForFixture(t *testing.T) {
 	conn, cleanUp := test.GetConnection(t, "sportmonks_card_event")
 	repo := postgres.NewEventRepository(conn, test.Clock)
@@ -292,6 +446,9 @@ func TestEventRepository_CardEventsForFixture(t *testing.T) {
 		}
 
 		assert.Equal(t, 3, len(fetched))
+		assert.Equal(t, uint64(1), fetched[0].ID)
+		assert.Equal(t, uint64(3), fetched[1].ID)
+		assert.Equal(t, uint64(4), fetched[2].ID)
 
 		for _, e := range fetched {
 			assert.Equal(t, uint64(45), e.FixtureID)
@@ -327,6 +484,9 @@ func TestEventRepository_GoalEventsForFixture(t *testing.T) {
 		}
 
 		assert.Equal(t, 3, len(fetched))
+		assert.Equal(t, uint64(1), fetched[0].ID)
+		assert.Equal(t, uint64(3), fetched[1].ID)
+		assert.Equal(t, uint64(4), fetched[2].ID)
 
 		for _, e := range fetched {
 			assert.Equal(t, uint64(45), e.FixtureID)
@@ -334,6 +494,191 @@ func TestEventRepository_GoalEventsForFixture(t *testing.T) {
 	})
 }
 
+func TestEventRepository_SubstitutionEventsForFixture(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_substitution_event")
+	repo := postgres.NewEventRepository(conn, test.Clock)
+
+	t.Run("returns a slice of substitution event struct ordered by minute", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		late := newSubstitutionEvent(1)
+		late.Minute = 88
+		other := newSubstitutionEvent(2)
+		other.FixtureID = 102
+		early := newSubstitutionEvent(3)
+		early.Minute = 46
+		early.Injured = nil
+
+		insertSubstitutionEvents(t, repo, late, other, early)
+
+		fetched, err := repo.SubstitutionEventsForFixture(45)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err)
+		}
+
+		a := assert.New(t)
+		a.Equal(2, len(fetched))
+		a.Equal(uint64(3), fetched[0].ID)
+		a.Equal(uint64(45), fetched[0].FixtureID)
+		a.Equal(uint64(4509), fetched[0].TeamID)
+		a.Equal(uint64(3401), fetched[0].PlayerInID)
+		a.Equal(uint64(901), fetched[0].PlayerOutID)
+		a.Equal(46, fetched[0].Minute)
+		a.Nil(fetched[0].Injured)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", fetched[0].CreatedAt.String())
+		a.Equal(uint64(1), fetched[1].ID)
+		a.True(*fetched[1].Injured)
+	})
+}
+
+func TestEventRepository_SubstitutionEventsForTeam(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_substitution_event")
+	repo := postgres.NewEventRepository(conn, test.Clock)
+
+	t.Run("returns a slice of substitution event struct for team ordered by fixture and minute", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		one := newSubstitutionEvent(1)
+		one.FixtureID = 102
+		two := newSubstitutionEvent(2)
+		two.TeamID = 1
+		three := newSubstitutionEvent(3)
+
+		insertSubstitutionEvents(t, repo, one, two, three)
+
+		fetched, err := repo.SubstitutionEventsForTeam(4509)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err)
+		}
+
+		a := assert.New(t)
+		a.Equal(2, len(fetched))
+		a.Equal(uint64(3), fetched[0].ID)
+		a.Equal(uint64(1), fetched[1].ID)
+	})
+}
+
+func TestEventRepository_SubstitutionEventsForPlayer(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_substitution_event")
+	repo := postgres.NewEventRepository(conn, test.Clock)
+
+	t.Run("returns substitutions the player was brought on or taken off in", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		on := newSubstitutionEvent(1)
+		off := newSubstitutionEvent(2)
+		off.FixtureID = 102
+		off.PlayerInID = 55
+		off.PlayerOutID = 3401
+		other := newSubstitutionEvent(3)
+		other.PlayerInID = 55
+
+		insertSubstitutionEvents(t, repo, on, off, other)
+
+		fetched, err := repo.SubstitutionEventsForPlayer(3401)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err)
+		}
+
+		a := assert.New(t)
+		a.Equal(2, len(fetched))
+		a.Equal(uint64(1), fetched[0].ID)
+		a.Equal(uint64(2), fetched[1].ID)
+	})
+}
+
+func TestEventRepository_MissedPenaltyEventByID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_missed_penalty_event")
+	repo := postgres.NewEventRepository(conn, test.Clock)
+
+	t.Run("missed penalty event can be retrieved by ID", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		m := newMissedPenaltyEvent(33, 45)
+
+		if err := repo.InsertMissedPenaltyEvent(m); err != nil {
+			t.Errorf("Test failed, expected nil, got %s", err)
+		}
+
+		r, err := repo.MissedPenaltyEventByID(33)
+
+		if err != nil {
+			t.Errorf("Error when retrieving a record from the database: %s", err.Error())
+		}
+
+		a := assert.New(t)
+		a.Equal(uint64(33), r.ID)
+		a.Equal(uint64(45), r.FixtureID)
+		a.Equal(uint64(4509), r.TeamID)
+		a.Equal(uint64(3401), r.PlayerID)
+		a.Equal(64, r.Minute)
+		a.Nil(r.ExtraMinute)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", r.CreatedAt.String())
+	})
+
+	t.Run("returns error if missed penalty event does not exist", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		_, err := repo.MissedPenaltyEventByID(99)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "missed penalty event with ID 99 does not exist", err.Error())
+	})
+}
+
+func TestEventRepository_MissedPenaltyEventsForFixture(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_missed_penalty_event")
+	repo := postgres.NewEventRepository(conn, test.Clock)
+
+	t.Run("returns a slice of missed penalty event struct", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		events := []*app.MissedPenaltyEvent{
+			newMissedPenaltyEvent(1, 45),
+			newMissedPenaltyEvent(2, 102),
+			newMissedPenaltyEvent(3, 45),
+		}
+
+		for _, e := range events {
+			if err := repo.InsertMissedPenaltyEvent(e); err != nil {
+				t.Errorf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		fetched, err := repo.MissedPenaltyEventsForFixture(45)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err)
+		}
+
+		assert.Equal(t, 2, len(fetched))
+		assert.Equal(t, uint64(1), fetched[0].ID)
+		assert.Equal(t, uint64(3), fetched[1].ID)
+	})
+}
+
+func insertSubstitutionEvents(t *testing.T, repo *postgres.EventRepository, events ...*app.SubstitutionEvent) {
+	t.Helper()
+
+	for _, e := range events {
+		if err := repo.InsertSubstitutionEvent(e); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+	}
+}
+
 func newCardEvent(id, fixtureID uint64) *app.CardEvent {
 	return &app.CardEvent{
 		ID:          id,
@@ -348,13 +693,28 @@ func newCardEvent(id, fixtureID uint64) *app.CardEvent {
 }
 
 func newGoalEvent(id, fixtureID uint64) *app.GoalEvent {
+	extra := 3
+
 	return &app.GoalEvent{
+		ID:          id,
+		FixtureID:   fixtureID,
+		TeamID:      uint64(4509),
+		PlayerID:    uint64(3401),
+		Type:        app.GoalTypePenalty,
+		Minute:      82,
+		ExtraMinute: &extra,
+		Score:       "0-1",
+		CreatedAt:   time.Unix(1546965200, 0),
+	}
+}
+
+func newMissedPenaltyEvent(id, fixtureID uint64) *app.MissedPenaltyEvent {
+	return &app.MissedPenaltyEvent{
 		ID:        id,
 		FixtureID: fixtureID,
 		TeamID:    uint64(4509),
 		PlayerID:  uint64(3401),
-		Minute:    82,
-		Score:     "0-1",
+		Minute:    64,
 		CreatedAt: time.Unix(1546965200, 0),
 	}
 }
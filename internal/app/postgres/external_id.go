@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"time"
+)
+
+type ExternalIDRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+func (r *ExternalIDRepository) Insert(e *app.ExternalID) error {
+	builder := r.queryBuilder()
+
+	_, err := builder.
+		Insert("external_id").
+		Columns("entity_type", "entity_id", "provider", "external_id", "created_at", "updated_at").
+		Values(e.EntityType, e.EntityID, e.Provider, e.ExternalID, r.clock.Now().Unix(), r.clock.Now().Unix()).
+		Exec()
+
+	return err
+}
+
+func (r *ExternalIDRepository) Update(e *app.ExternalID) error {
+	if _, err := r.ByExternalID(e.EntityType, e.Provider, e.ExternalID); err != nil {
+		return err
+	}
+
+	builder := r.queryBuilder()
+
+	_, err := builder.
+		Update("external_id").
+		Set("entity_id", e.EntityID).
+		Set("updated_at", r.clock.Now().Unix()).
+		Where(sq.Eq{"entity_type": e.EntityType}).
+		Where(sq.Eq{"provider": e.Provider}).
+		Where(sq.Eq{"external_id": e.ExternalID}).
+		Exec()
+
+	return err
+}
+
+func (r *ExternalIDRepository) ByExternalID(entityType, provider, externalID string) (*app.ExternalID, error) {
+	builder := r.queryBuilder()
+
+	row := builder.
+		Select("entity_type", "entity_id", "provider", "external_id", "created_at", "updated_at").
+		From("external_id").
+		Where(sq.Eq{"entity_type": entityType}).
+		Where(sq.Eq{"provider": provider}).
+		Where(sq.Eq{"external_id": externalID}).
+		QueryRow()
+
+	var e app.ExternalID
+	var created int64
+	var updated int64
+
+	if err := row.Scan(&e.EntityType, &e.EntityID, &e.Provider, &e.ExternalID, &created, &updated); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.ErrorNotFound
+		}
+
+		return nil, err
+	}
+
+	e.CreatedAt = time.Unix(created, 0)
+	e.UpdatedAt = time.Unix(updated, 0)
+
+	return &e, nil
+}
+
+func (r *ExternalIDRepository) ByEntityID(entityType string, entityID uint64, provider string) ([]app.ExternalID, error) {
+	builder := r.queryBuilder()
+
+	rows, err := builder.
+		Select("entity_type", "entity_id", "provider", "external_id", "created_at", "updated_at").
+		From("external_id").
+		Where(sq.Eq{"entity_type": entityType}).
+		Where(sq.Eq{"entity_id": entityID}).
+		Where(sq.Eq{"provider": provider}).
+		OrderBy("external_id ASC").
+		Query()
+
+	if err != nil {
+		return []app.ExternalID{}, err
+	}
+
+	defer rows.Close()
+
+	var ids []app.ExternalID
+
+	for rows.Next() {
+		var e app.ExternalID
+		var created int64
+		var updated int64
+
+		if err := rows.Scan(&e.EntityType, &e.EntityID, &e.Provider, &e.ExternalID, &created, &updated); err != nil {
+			return ids, err
+		}
+
+		e.CreatedAt = time.Unix(created, 0)
+		e.UpdatedAt = time.Unix(updated, 0)
+
+		ids = append(ids, e)
+	}
+
+	return ids, nil
+}
+
+func (r *ExternalIDRepository) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(r.connection)
+}
+
+func NewExternalIDRepository(connection *sql.DB, clock clockwork.Clock) *ExternalIDRepository {
+	return &ExternalIDRepository{connection: connection, clock: clock}
+}
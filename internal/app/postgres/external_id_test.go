@@ -0,0 +1,200 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestExternalIDRepository_Insert(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "external_id")
+	repo := postgres.NewExternalIDRepository(conn, test.Clock)
+
+	t.Run("increases table count", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		for i := 1; i < 4; i++ {
+			e := newExternalID(app.EntityTeam, uint64(i), strconv.Itoa(i+100))
+
+			if err := repo.Insert(e); err != nil {
+				t.Errorf("Test failed, expected nil, got %s", err)
+			}
+
+			row := conn.QueryRow("select count(*) from external_id")
+
+			var count int
+
+			if err := row.Scan(&count); err != nil {
+				t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+			}
+
+			assert.Equal(t, i, count)
+		}
+	})
+
+	t.Run("returns error when external ID violates unique constraint", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		e := newExternalID(app.EntityFixture, 5601, "11430")
+
+		_ = repo.Insert(e)
+
+		if err := repo.Insert(e); err == nil {
+			t.Errorf("Test failed, expected error, got nil")
+		}
+	})
+
+	t.Run("the same external ID can be used for different entity types", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		if err := repo.Insert(newExternalID(app.EntityFixture, 5601, "88")); err != nil {
+			t.Errorf("Test failed, expected nil, got %s", err)
+		}
+
+		if err := repo.Insert(newExternalID(app.EntityTeam, 1, "88")); err != nil {
+			t.Errorf("Test failed, expected nil, got %s", err)
+		}
+	})
+}
+
+func TestExternalIDRepository_Update(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "external_id")
+	repo := postgres.NewExternalIDRepository(conn, test.Clock)
+
+	t.Run("modifies existing resource", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		e := newExternalID(app.EntityTeam, 1, "88")
+
+		if err := repo.Insert(e); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		e.EntityID = 14
+
+		if err := repo.Update(e); err != nil {
+			t.Fatalf("Error updating record to the database: %s", err.Error())
+		}
+
+		fetched, err := repo.ByExternalID(app.EntityTeam, app.ProviderUnderstat, "88")
+
+		if err != nil {
+			t.Fatalf("Error retrieving record from the database: %s", err.Error())
+		}
+
+		assert.Equal(t, uint64(14), fetched.EntityID)
+	})
+
+	t.Run("returns error if updating a resource that does not exist", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		e := newExternalID(app.EntityTeam, 1, "88")
+
+		if err := repo.Update(e); err == nil {
+			t.Errorf("Test failed, expected error got nil")
+		}
+	})
+}
+
+func TestExternalIDRepository_ByExternalID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "external_id")
+	repo := postgres.NewExternalIDRepository(conn, test.Clock)
+
+	t.Run("external ID can be retrieved by entity type, provider and external ID", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		e := newExternalID(app.EntityFixture, 3401, "2371")
+
+		if err := repo.Insert(e); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		fetched, err := repo.ByExternalID(app.EntityFixture, app.ProviderUnderstat, "2371")
+
+		if err != nil {
+			t.Fatalf("Error retrieving record from the database: %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(app.EntityFixture, fetched.EntityType)
+		a.Equal(uint64(3401), fetched.EntityID)
+		a.Equal(app.ProviderUnderstat, fetched.Provider)
+		a.Equal("2371", fetched.ExternalID)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", fetched.CreatedAt.String())
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", fetched.UpdatedAt.String())
+	})
+
+	t.Run("returns ErrorNotFound if external ID does not exist", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		_, err := repo.ByExternalID(app.EntityFixture, app.ProviderUnderstat, "2371")
+
+		assert.Equal(t, errors.ErrorNotFound, err)
+	})
+}
+
+func TestExternalIDRepository_ByEntityID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "external_id")
+	repo := postgres.NewExternalIDRepository(conn, test.Clock)
+
+	t.Run("returns all external IDs linked to an entity for a provider", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		ids := []*app.ExternalID{
+			newExternalID(app.EntityTeam, 1, "88"),
+			newExternalID(app.EntityTeam, 1, "89"),
+			newExternalID(app.EntityTeam, 14, "90"),
+		}
+
+		for _, e := range ids {
+			if err := repo.Insert(e); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		fetched, err := repo.ByEntityID(app.EntityTeam, 1, app.ProviderUnderstat)
+
+		if err != nil {
+			t.Fatalf("Error retrieving records from the database: %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(fetched))
+		assert.Equal(t, "88", fetched[0].ExternalID)
+		assert.Equal(t, "89", fetched[1].ExternalID)
+	})
+
+	t.Run("returns empty slice if no external IDs exist for entity", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		fetched, err := repo.ByEntityID(app.EntityTeam, 1, app.ProviderUnderstat)
+
+		if err != nil {
+			t.Fatalf("Error retrieving records from the database: %s", err.Error())
+		}
+
+		assert.Equal(t, 0, len(fetched))
+	})
+}
+
+func newExternalID(entity string, id uint64, externalID string) *app.ExternalID {
+	return &app.ExternalID{
+		EntityType: entity,
+		EntityID:   id,
+		Provider:   app.ProviderUnderstat,
+		ExternalID: externalID,
+	}
+}
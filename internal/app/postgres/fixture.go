@@ -14,12 +14,16 @@ type FixtureRepository struct {
 	clock      clockwork.Clock
 }
 
-func (r *FixtureRepository) Insert(f *app.Fixture) error {
+func (r *FixtureRepository) Insert(f *app.Fixture, events ...app.DomainEvent) error {
 	query := `
 	INSERT INTO sportmonks_fixture (id, season_id, round_id, venue_id, home_team_id, away_team_id, referee_id,
-	date, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	date, created_at, updated_at, stage_id, group_id, aggregate_id, status) VALUES ($1, $2, $3, $4, $5, $6, $7, $8,
+	$9, $10, $11, $12, $13, $14)`
 
-	_, err := r.connection.Exec(
+	err := writeWithEvents(
+		r.connection,
+		r.clock,
+		events,
 		query,
 		f.ID,
 		f.SeasonID,
@@ -31,12 +35,16 @@ func (r *FixtureRepository) Insert(f *app.Fixture) error {
 		f.Date.Unix(),
 		r.clock.Now().Unix(),
 		r.clock.Now().Unix(),
+		f.StageID,
+		f.GroupID,
+		f.AggregateID,
+		f.Status,
 	)
 
 	return err
 }
 
-func (r *FixtureRepository) Update(f *app.Fixture) error {
+func (r *FixtureRepository) Update(f *app.Fixture, events ...app.DomainEvent) error {
 	_, err := r.ByID(f.ID)
 
 	if err != nil {
@@ -44,9 +52,12 @@ func (r *FixtureRepository) Update(f *app.Fixture) error {
 	}
 
 	query := `UPDATE sportmonks_fixture set season_id = $2, round_id = $3, venue_id = $4, home_team_id = $5, away_team_id = $6,
-	referee_id = $7, date = $8, updated_at = $9 where id = $1`
+	referee_id = $7, date = $8, updated_at = $9, stage_id = $10, group_id = $11, aggregate_id = $12, status = $13 where id = $1`
 
-	_, err = r.connection.Exec(
+	err = writeWithEvents(
+		r.connection,
+		r.clock,
+		events,
 		query,
 		f.ID,
 		f.SeasonID,
@@ -57,6 +68,10 @@ func (r *FixtureRepository) Update(f *app.Fixture) error {
 		f.RefereeID,
 		f.Date.Unix(),
 		r.clock.Now().Unix(),
+		f.StageID,
+		f.GroupID,
+		f.AggregateID,
+		f.Status,
 	)
 
 	return err
@@ -167,6 +182,18 @@ func buildQuery(b sq.SelectBuilder, q app.FixtureRepositoryQuery) sq.SelectBuild
 		b = b.Where(sq.Eq{"season_id": q.SeasonIDs})
 	}
 
+	if len(q.StageIDs) > 0 {
+		b = b.Where(sq.Eq{"stage_id": q.StageIDs})
+	}
+
+	if len(q.GroupIDs) > 0 {
+		b = b.Where(sq.Eq{"group_id": q.GroupIDs})
+	}
+
+	if q.AggregateID != nil {
+		b = b.Where(sq.Eq{"aggregate_id": q.AggregateID})
+	}
+
 	if q.HomeTeamID != nil {
 		b = b.Where(sq.Eq{"home_team_id": q.HomeTeamID})
 	}
@@ -187,6 +214,10 @@ func buildQuery(b sq.SelectBuilder, q app.FixtureRepositoryQuery) sq.SelectBuild
 		b = b.JoinClause(nested.Prefix("JOIN (").Suffix(") t2 ON sportmonks_fixture.away_team_id = t2.id"))
 	}
 
+	if len(q.StatusGroups) > 0 {
+		b = b.Where(statusGroupsClause(q.StatusGroups))
+	}
+
 	if q.DateFrom != nil {
 		b = b.Where(sq.GtOrEq{"date": q.DateFrom.Unix()})
 	}
@@ -214,6 +245,20 @@ func buildQuery(b sq.SelectBuilder, q app.FixtureRepositoryQuery) sq.SelectBuild
 	return b
 }
 
+// statusGroupsClause matches fixtures with a status in the groups provided, matching fixtures without a status if
+// the unknown group is provided.
+func statusGroupsClause(groups []app.FixtureStatusGroup) sq.Sqlizer {
+	clause := sq.Or{sq.Eq{"status": app.FixtureStatusesInGroups(groups...)}}
+
+	for _, g := range groups {
+		if g == app.FixtureStatusGroupUnknown {
+			clause = append(clause, sq.Eq{"status": nil})
+		}
+	}
+
+	return clause
+}
+
 func rowsToIntSlice(rows *sql.Rows) ([]uint64, error) {
 	defer rows.Close()
 
@@ -252,6 +297,10 @@ func rowsToFixtureSlice(rows *sql.Rows) ([]app.Fixture, error) {
 			&date,
 			&created,
 			&updated,
+			&f.StageID,
+			&f.GroupID,
+			&f.AggregateID,
+			&f.Status,
 		)
 
 		if err != nil {
@@ -286,6 +335,10 @@ func rowToFixture(r *sql.Row, id uint64) (*app.Fixture, error) {
 		&date,
 		&created,
 		&updated,
+		&f.StageID,
+		&f.GroupID,
+		&f.AggregateID,
+		&f.Status,
 	)
 
 	if err != nil {
@@ -0,0 +1,23 @@
+package postgres
+
+import (
+	"database/sql"
+)
+
+type FixtureIDGenerator struct {
+	connection *sql.DB
+}
+
+func (g *FixtureIDGenerator) NextID() (uint64, error) {
+	var id uint64
+
+	if err := g.connection.QueryRow(`SELECT nextval('imported_fixture_id_seq')`).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func NewFixtureIDGenerator(connection *sql.DB) *FixtureIDGenerator {
+	return &FixtureIDGenerator{connection: connection}
+}
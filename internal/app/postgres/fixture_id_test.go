@@ -0,0 +1,32 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFixtureIDGenerator_NextID(t *testing.T) {
+	conn, _ := test.GetConnection(t, "sportmonks_fixture")
+	generator := postgres.NewFixtureIDGenerator(conn)
+
+	t.Run("returns increasing IDs above the SportMonks ID range", func(t *testing.T) {
+		t.Helper()
+
+		first, err := generator.NextID()
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		second, err := generator.NextID()
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.True(t, first >= 1000000000)
+		assert.Equal(t, first+1, second)
+	})
+}
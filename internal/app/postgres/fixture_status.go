@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"time"
+)
+
+type FixtureStatusAnomalyRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+func (r *FixtureStatusAnomalyRepository) Insert(a *app.FixtureStatusAnomaly) error {
+	a.CreatedAt = r.clock.Now()
+
+	return r.queryBuilder().
+		Insert("fixture_status_anomaly").
+		Columns("fixture_id", "from_status", "to_status", "created_at").
+		Values(a.FixtureID, string(a.From), string(a.To), a.CreatedAt.Unix()).
+		Suffix("RETURNING id").
+		QueryRow().
+		Scan(&a.ID)
+}
+
+func (r *FixtureStatusAnomalyRepository) ByFixtureID(id uint64) ([]app.FixtureStatusAnomaly, error) {
+	rows, err := r.queryBuilder().
+		Select("id", "fixture_id", "from_status", "to_status", "created_at").
+		From("fixture_status_anomaly").
+		Where(sq.Eq{"fixture_id": id}).
+		OrderBy("id ASC").
+		Query()
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var anomalies []app.FixtureStatusAnomaly
+
+	for rows.Next() {
+		var a app.FixtureStatusAnomaly
+		var created int64
+
+		if err := rows.Scan(&a.ID, &a.FixtureID, &a.From, &a.To, &created); err != nil {
+			return nil, err
+		}
+
+		a.CreatedAt = time.Unix(created, 0)
+
+		anomalies = append(anomalies, a)
+	}
+
+	return anomalies, rows.Err()
+}
+
+func (r *FixtureStatusAnomalyRepository) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(r.connection)
+}
+
+func NewFixtureStatusAnomalyRepository(connection *sql.DB, clock clockwork.Clock) *FixtureStatusAnomalyRepository {
+	return &FixtureStatusAnomalyRepository{connection: connection, clock: clock}
+}
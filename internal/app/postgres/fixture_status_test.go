@@ -0,0 +1,46 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFixtureStatusAnomalyRepository_Insert(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "fixture_status_anomaly")
+	repo := postgres.NewFixtureStatusAnomalyRepository(conn, test.Clock)
+
+	t.Run("increases table count and anomalies can be retrieved by fixture ID", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		anomalies := []app.FixtureStatusAnomaly{
+			{FixtureID: 45, From: app.FixtureStatusFullTime, To: app.FixtureStatusLive},
+			{FixtureID: 46, From: app.FixtureStatusLive, To: app.FixtureStatusNotStarted},
+			{FixtureID: 45, From: app.FixtureStatusLive, To: "UNKNOWN"},
+		}
+
+		for i := range anomalies {
+			if err := repo.Insert(&anomalies[i]); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		fetched, err := repo.ByFixtureID(45)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(fetched))
+		a.Equal(anomalies[0].ID, fetched[0].ID)
+		a.Equal(app.FixtureStatusFullTime, fetched[0].From)
+		a.Equal(app.FixtureStatusLive, fetched[0].To)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", fetched[0].CreatedAt.UTC().String())
+		a.Equal(app.FixtureStatus("UNKNOWN"), fetched[1].To)
+	})
+}
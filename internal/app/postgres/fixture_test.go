@@ -81,6 +81,7 @@ func TestFixtureRepository_ByID(t *testing.T) {
 		a.Equal(uint64(924), r.AwayTeamID)
 		a.Nil(r.RefereeID)
 		a.Equal("2019-01-21 16:08:49 +0000 UTC", r.Date.String())
+		a.Equal(app.FixtureStatusNotStarted, *r.Status)
 		a.Equal("2019-01-14 11:25:00 +0000 UTC", r.CreatedAt.String())
 		a.Equal("2019-01-14 11:25:00 +0000 UTC", r.UpdatedAt.String())
 	})
@@ -114,11 +115,13 @@ func TestFixtureRepository_Update(t *testing.T) {
 		var venueId = uint64(574)
 		var roundId *uint64
 		var d = time.Date(2019, 01, 14, 11, 25, 00, 00, time.UTC)
+		var status = app.FixtureStatusFullTime
 
 		f.VenueID = &venueId
 		f.AwayTeamID = uint64(4390)
 		f.RoundID = roundId
 		f.Date = d
+		f.Status = &status
 
 		if err := repo.Update(f); err != nil {
 			t.Errorf("Error when updating a record in the database: %s", err.Error())
@@ -140,6 +143,7 @@ func TestFixtureRepository_Update(t *testing.T) {
 		a.Equal(uint64(4390), f.AwayTeamID)
 		a.Nil(f.RefereeID)
 		a.Equal("2019-01-14 11:25:00 +0000 UTC", r.Date.String())
+		a.Equal(app.FixtureStatusFullTime, *r.Status)
 		a.Equal("2019-01-14 11:25:00 +0000 UTC", r.CreatedAt.String())
 		a.Equal("2019-01-14 11:25:00 +0000 UTC", r.UpdatedAt.String())
 	})
@@ -856,6 +860,134 @@ func TestFixtureRepository_Get(t *testing.T) {
 		a := assert.New(t)
 		a.Equal(8, len(fix))
 	})
+
+	t.Run("returns fixtures filtered by stage, group and aggregate ID", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		stage := uint64(77443828)
+		group := uint64(245)
+		aggregate := uint64(33112)
+
+		fixtures := []*app.Fixture{
+			newFixture(1, 14567, 451, 924),
+			newFixture(2, 14567, 924, 451),
+			newFixture(3, 14567, 1, 2),
+		}
+
+		fixtures[0].StageID, fixtures[0].AggregateID = &stage, &aggregate
+		fixtures[1].StageID, fixtures[1].AggregateID = &stage, &aggregate
+		fixtures[2].StageID, fixtures[2].GroupID = &stage, &group
+
+		for _, f := range fixtures {
+			if err := repo.Insert(f); err != nil {
+				t.Errorf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		fix, err := repo.Get(app.FixtureRepositoryQuery{StageIDs: []uint64{stage}})
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+		a.Equal(3, len(fix))
+		a.Equal(stage, *fix[0].StageID)
+		a.Equal(aggregate, *fix[0].AggregateID)
+		a.Nil(fix[0].GroupID)
+
+		fix, err = repo.Get(app.FixtureRepositoryQuery{AggregateID: &aggregate})
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		a.Equal(2, len(fix))
+
+		fix, err = repo.Get(app.FixtureRepositoryQuery{GroupIDs: []uint64{group}})
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		a.Equal(1, len(fix))
+		a.Equal(uint64(3), fix[0].ID)
+	})
+
+	t.Run("returns fixtures filtered by status group", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		statuses := []app.FixtureStatus{
+			app.FixtureStatusNotStarted,
+			app.FixtureStatusHalfTime,
+			app.FixtureStatusFullTimePenalties,
+			app.FixtureStatusAbandoned,
+			app.FixtureStatusAwarded,
+		}
+
+		for i := range statuses {
+			f := newFixture(uint64(i+1), 14567, 451, 924)
+			f.Status = &statuses[i]
+
+			if err := repo.Insert(f); err != nil {
+				t.Errorf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		query := app.FixtureRepositoryQuery{
+			StatusGroups: []app.FixtureStatusGroup{app.FixtureStatusGroupLive, app.FixtureStatusGroupFinished},
+		}
+
+		fix, err := repo.Get(query)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+		a.Equal(3, len(fix))
+		a.Equal(app.FixtureStatusHalfTime, *fix[0].Status)
+		a.Equal(app.FixtureStatusFullTimePenalties, *fix[1].Status)
+		a.Equal(app.FixtureStatusAwarded, *fix[2].Status)
+
+		ids, err := repo.GetIDs(app.FixtureRepositoryQuery{StatusGroups: []app.FixtureStatusGroup{"cancelled"}})
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		a.Equal([]uint64{4}, ids)
+	})
+
+	t.Run("returns fixtures without a status for the unknown status group", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		finished := app.FixtureStatusFullTime
+
+		for i := 1; i <= 3; i++ {
+			f := newFixture(uint64(i), 14567, 451, 924)
+			f.Status = nil
+
+			if i == 2 {
+				f.Status = &finished
+			}
+
+			if err := repo.Insert(f); err != nil {
+				t.Errorf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		ids, err := repo.GetIDs(app.FixtureRepositoryQuery{StatusGroups: []app.FixtureStatusGroup{"unknown"}})
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []uint64{1, 3}, ids)
+	})
 }
 
 func TestFixtureRepository_Delete(t *testing.T) {
@@ -909,6 +1041,7 @@ func TestFixtureRepository_Delete(t *testing.T) {
 
 func newFixture(id, seasonId, homeId, awayId uint64) *app.Fixture {
 	var roundId = uint64(165789)
+	var status = app.FixtureStatusNotStarted
 
 	return &app.Fixture{
 		ID:         id,
@@ -917,6 +1050,7 @@ func newFixture(id, seasonId, homeId, awayId uint64) *app.Fixture {
 		HomeTeamID: homeId,
 		AwayTeamID: awayId,
 		Date:       time.Unix(1548086929, 0),
+		Status:     &status,
 	}
 }
 
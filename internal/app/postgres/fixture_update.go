@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"encoding/json"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"sync"
+	"time"
+)
+
+// fixtureUpdateChannel is the channel FixtureUpdate notifications are published to by the notify_fixture_update
+// database trigger, so every FixtureUpdateListener receives them once the write is committed regardless of which
+// application instance persisted the change.
+const fixtureUpdateChannel = "fixture_update"
+
+const fixtureUpdateListenRetry = 5 * time.Second
+
+const fixtureUpdateListenMaxRetry = time.Minute
+
+// FixtureUpdateListener listens for FixtureUpdate notifications published by the database when fixtures, results
+// and events are written and fans them out to each subscriber. A single database connection is held open for all
+// subscribers.
+type FixtureUpdateListener struct {
+	listener    *pq.Listener
+	logger      *logrus.Logger
+	once        sync.Once
+	mutex       sync.Mutex
+	subscribers map[chan app.FixtureUpdate]bool
+}
+
+func (f *FixtureUpdateListener) Subscribe() (<-chan app.FixtureUpdate, func()) {
+	f.once.Do(func() {
+		go f.listen()
+	})
+
+	ch := make(chan app.FixtureUpdate, 100)
+
+	f.mutex.Lock()
+	f.subscribers[ch] = true
+	f.mutex.Unlock()
+
+	cancel := func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+
+		if f.subscribers[ch] {
+			delete(f.subscribers, ch)
+			close(ch)
+		}
+	}
+
+	return ch, cancel
+}
+
+func (f *FixtureUpdateListener) listen() {
+	retry := fixtureUpdateListenRetry
+
+	// Listen blocks until a connection is established, an error is only returned when the LISTEN command fails so
+	// it is retried with a backoff until it succeeds.
+	for {
+		err := f.listener.Listen(fixtureUpdateChannel)
+
+		if err == nil || err == pq.ErrChannelAlreadyOpen {
+			break
+		}
+
+		f.logger.Errorf("Error '%s' occurred when listening for fixture updates, retrying in %s", err.Error(), retry)
+
+		time.Sleep(retry)
+
+		if retry *= 2; retry > fixtureUpdateListenMaxRetry {
+			retry = fixtureUpdateListenMaxRetry
+		}
+	}
+
+	for n := range f.listener.Notify {
+		// A nil notification is sent when the connection is re-established, updates published while
+		// disconnected are lost.
+		if n == nil {
+			continue
+		}
+
+		var u app.FixtureUpdate
+
+		if err := json.Unmarshal([]byte(n.Extra), &u); err != nil {
+			f.logger.Warningf("Error '%s' occurred when parsing fixture update payload: %s", err.Error(), n.Extra)
+			continue
+		}
+
+		f.publish(u)
+	}
+}
+
+// publish sends the update to each subscriber without blocking, updates are dropped for subscribers that are not
+// keeping up.
+func (f *FixtureUpdateListener) publish(u app.FixtureUpdate) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for ch := range f.subscribers {
+		select {
+		case ch <- u:
+		default:
+			f.logger.Warningf("Dropping fixture update for fixture %d as subscriber is not keeping up", u.FixtureID)
+		}
+	}
+}
+
+func NewFixtureUpdateListener(dsn string, log *logrus.Logger) *FixtureUpdateListener {
+	callback := func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Errorf("Error '%s' occurred on fixture update listener connection", err.Error())
+		}
+	}
+
+	return &FixtureUpdateListener{
+		listener:    pq.NewListener(dsn, 10*time.Second, time.Minute, callback),
+		logger:      log,
+		subscribers: make(map[chan app.FixtureUpdate]bool),
+	}
+}
@@ -0,0 +1,45 @@
+package postgres_test
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestFixtureUpdateListener_Subscribe(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_goal_event")
+	repo := postgres.NewEventRepository(conn, test.Clock)
+
+	t.Run("subscribers receive a fixture update when a goal event is inserted", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		listener := postgres.NewFixtureUpdateListener(test.DSN(), logrus.New())
+
+		updates, cancel := listener.Subscribe()
+		defer cancel()
+
+		// Allow the listener to start listening before the notification is published.
+		time.Sleep(500 * time.Millisecond)
+
+		goal := newGoalEvent(4501, 34)
+
+		if err := repo.InsertGoalEvent(goal); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		select {
+		case u := <-updates:
+			a := assert.New(t)
+			a.Equal(goal.FixtureID, u.FixtureID)
+			a.Equal(app.FixtureUpdateGoal, u.Type)
+			a.Equal(uint64(4501), *u.EventID)
+		case <-time.After(5 * time.Second):
+			t.Fatal("Expected fixture update, none received")
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"time"
+)
+
+type GroupRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+func (r *GroupRepository) Insert(g *app.Group) error {
+	query := `
+	INSERT INTO sportmonks_group (id, name, stage_id, season_id, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6)`
+
+	_, err := r.connection.Exec(
+		query,
+		g.ID,
+		g.Name,
+		g.StageID,
+		g.SeasonID,
+		r.clock.Now().Unix(),
+		r.clock.Now().Unix(),
+	)
+
+	return err
+}
+
+func (r *GroupRepository) Update(g *app.Group) error {
+	_, err := r.ByID(g.ID)
+
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE sportmonks_group set name = $2, stage_id = $3, season_id = $4, updated_at = $5 where id = $1`
+
+	_, err = r.connection.Exec(query, g.ID, g.Name, g.StageID, g.SeasonID, r.clock.Now().Unix())
+
+	return err
+}
+
+func (r *GroupRepository) ByID(id uint64) (*app.Group, error) {
+	query := `SELECT * FROM sportmonks_group where id = $1`
+
+	rows, err := r.connection.Query(query, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := rowsToGroups(rows)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groups) == 0 {
+		return nil, errors.ErrorNotFound
+	}
+
+	return &groups[0], nil
+}
+
+func (r *GroupRepository) ByStageID(id uint64) ([]app.Group, error) {
+	query := `SELECT * FROM sportmonks_group where stage_id = $1 ORDER BY name ASC`
+
+	rows, err := r.connection.Query(query, id)
+
+	if err != nil {
+		return []app.Group{}, err
+	}
+
+	return rowsToGroups(rows)
+}
+
+func rowsToGroups(rows *sql.Rows) ([]app.Group, error) {
+	defer rows.Close()
+
+	var groups []app.Group
+
+	for rows.Next() {
+		var g app.Group
+		var created, updated int64
+
+		if err := rows.Scan(&g.ID, &g.Name, &g.StageID, &g.SeasonID, &created, &updated); err != nil {
+			return groups, err
+		}
+
+		g.CreatedAt = time.Unix(created, 0)
+		g.UpdatedAt = time.Unix(updated, 0)
+
+		groups = append(groups, g)
+	}
+
+	return groups, nil
+}
+
+func NewGroupRepository(connection *sql.DB, clock clockwork.Clock) *GroupRepository {
+	return &GroupRepository{connection: connection, clock: clock}
+}
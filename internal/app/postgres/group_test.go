@@ -0,0 +1,115 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestGroupRepository_Insert(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_group")
+	repo := postgres.NewGroupRepository(conn, test.Clock)
+
+	t.Run("increases table count", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		for i := 1; i < 4; i++ {
+			if err := repo.Insert(newGroup(uint64(i), 10, "Group A")); err != nil {
+				t.Errorf("Error when inserting record into the database: %s", err.Error())
+			}
+
+			row := conn.QueryRow("select count(*) from sportmonks_group")
+
+			var count int
+
+			if err := row.Scan(&count); err != nil {
+				t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+			}
+
+			assert.Equal(t, i, count)
+		}
+	})
+}
+
+func TestGroupRepository_ByID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_group")
+	repo := postgres.NewGroupRepository(conn, test.Clock)
+
+	t.Run("group can be retrieved by ID", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		if err := repo.Insert(newGroup(240, 10, "Group H")); err != nil {
+			t.Errorf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		g, err := repo.ByID(240)
+
+		if err != nil {
+			t.Fatalf("Error when retrieving a record from the database: %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(240), g.ID)
+		a.Equal("Group H", g.Name)
+		a.Equal(uint64(10), g.StageID)
+		a.Equal(uint64(16036), g.SeasonID)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", g.CreatedAt.UTC().String())
+	})
+
+	t.Run("returns error if group does not exist", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		_, err := repo.ByID(99)
+
+		assert.Equal(t, errors.ErrorNotFound, err)
+	})
+}
+
+func TestGroupRepository_ByStageID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_group")
+	repo := postgres.NewGroupRepository(conn, test.Clock)
+
+	t.Run("returns groups for stage ordered by name", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		for _, g := range []*app.Group{
+			newGroup(2, 10, "Group B"),
+			newGroup(1, 10, "Group A"),
+			newGroup(3, 11, "Group C"),
+		} {
+			if err := repo.Insert(g); err != nil {
+				t.Errorf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		groups, err := repo.ByStageID(10)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(groups))
+		assert.Equal(t, "Group A", groups[0].Name)
+		assert.Equal(t, "Group B", groups[1].Name)
+	})
+}
+
+func newGroup(id, stageID uint64, name string) *app.Group {
+	return &app.Group{
+		ID:        id,
+		Name:      name,
+		StageID:   stageID,
+		SeasonID:  16036,
+		CreatedAt: time.Unix(1546965200, 0),
+		UpdatedAt: time.Unix(1546965200, 0),
+	}
+}
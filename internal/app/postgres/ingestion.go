@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"time"
+)
+
+type IngestionPolicyRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+func (r *IngestionPolicyRepository) Save(p *app.IngestionPolicy) error {
+	datasets := p.Datasets
+
+	if datasets == nil {
+		datasets = []string{}
+	}
+
+	p.UpdatedAt = r.clock.Now()
+
+	_, err := r.queryBuilder().
+		Insert("ingestion_policy").
+		Columns("competition_id", "datasets", "tier", "history_depth", "updated_at").
+		Values(p.CompetitionID, pq.Array(datasets), p.Tier, p.HistoryDepth, p.UpdatedAt.Unix()).
+		Suffix(`ON CONFLICT (competition_id) DO UPDATE SET datasets = EXCLUDED.datasets, tier = EXCLUDED.tier,
+			history_depth = EXCLUDED.history_depth, updated_at = EXCLUDED.updated_at`).
+		Exec()
+
+	return err
+}
+
+func (r *IngestionPolicyRepository) ByCompetitionID(id uint64) (*app.IngestionPolicy, error) {
+	row := r.queryBuilder().
+		Select("competition_id", "datasets", "tier", "history_depth", "updated_at").
+		From("ingestion_policy").
+		Where(sq.Eq{"competition_id": id}).
+		QueryRow()
+
+	p, err := scanIngestionPolicy(row)
+
+	if err == sql.ErrNoRows {
+		return nil, errors.ErrorNotFound
+	}
+
+	return p, err
+}
+
+func (r *IngestionPolicyRepository) All() ([]app.IngestionPolicy, error) {
+	rows, err := r.queryBuilder().
+		Select("competition_id", "datasets", "tier", "history_depth", "updated_at").
+		From("ingestion_policy").
+		OrderBy("competition_id ASC").
+		Query()
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var policies []app.IngestionPolicy
+
+	for rows.Next() {
+		p, err := scanIngestionPolicy(rows)
+
+		if err != nil {
+			return nil, err
+		}
+
+		policies = append(policies, *p)
+	}
+
+	return policies, rows.Err()
+}
+
+func (r *IngestionPolicyRepository) Delete(competitionID uint64) error {
+	res, err := r.queryBuilder().
+		Delete("ingestion_policy").
+		Where(sq.Eq{"competition_id": competitionID}).
+		Exec()
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return errors.ErrorNotFound
+	}
+
+	return nil
+}
+
+func (r *IngestionPolicyRepository) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(r.connection)
+}
+
+func scanIngestionPolicy(r sq.RowScanner) (*app.IngestionPolicy, error) {
+	var p app.IngestionPolicy
+	var updated int64
+
+	if err := r.Scan(&p.CompetitionID, pq.Array(&p.Datasets), &p.Tier, &p.HistoryDepth, &updated); err != nil {
+		return nil, err
+	}
+
+	p.UpdatedAt = time.Unix(updated, 0)
+
+	return &p, nil
+}
+
+func NewIngestionPolicyRepository(connection *sql.DB, clock clockwork.Clock) *IngestionPolicyRepository {
+	return &IngestionPolicyRepository{connection: connection, clock: clock}
+}
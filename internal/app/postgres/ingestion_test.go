@@ -0,0 +1,130 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIngestionPolicyRepository_Save(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "ingestion_policy")
+	repo := postgres.NewIngestionPolicyRepository(conn, test.Clock)
+
+	t.Run("inserts a policy and replaces the existing policy for the competition", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		policy := app.IngestionPolicy{
+			CompetitionID: 8,
+			Datasets:      []string{app.DatasetFixtures, app.DatasetResults},
+			Tier:          app.IngestionTierHigh,
+		}
+
+		if err := repo.Save(&policy); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		policy.Datasets = []string{app.DatasetResults}
+		policy.Tier = app.IngestionTierLow
+		policy.HistoryDepth = 3
+
+		if err := repo.Save(&policy); err != nil {
+			t.Fatalf("Error when updating record in the database: %s", err.Error())
+		}
+
+		fetched, err := repo.ByCompetitionID(8)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(8), fetched.CompetitionID)
+		a.Equal([]string{app.DatasetResults}, fetched.Datasets)
+		a.Equal(app.IngestionTierLow, fetched.Tier)
+		a.Equal(3, fetched.HistoryDepth)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", fetched.UpdatedAt.UTC().String())
+	})
+
+	t.Run("stores a policy without datasets", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		if err := repo.Save(&app.IngestionPolicy{CompetitionID: 9, Tier: app.IngestionTierLow}); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		policies, err := repo.All()
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(policies))
+		assert.Equal(t, 0, len(policies[0].Datasets))
+	})
+}
+
+func TestIngestionPolicyRepository_All(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "ingestion_policy")
+	repo := postgres.NewIngestionPolicyRepository(conn, test.Clock)
+
+	t.Run("returns every policy ordered by competition ID", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		for _, id := range []uint64{501, 8, 82} {
+			p := app.IngestionPolicy{CompetitionID: id, Datasets: []string{app.DatasetFixtures}, Tier: app.IngestionTierStandard}
+
+			if err := repo.Save(&p); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		policies, err := repo.All()
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(3, len(policies))
+		a.Equal(uint64(8), policies[0].CompetitionID)
+		a.Equal(uint64(82), policies[1].CompetitionID)
+		a.Equal(uint64(501), policies[2].CompetitionID)
+	})
+}
+
+func TestIngestionPolicyRepository_Delete(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "ingestion_policy")
+	repo := postgres.NewIngestionPolicyRepository(conn, test.Clock)
+
+	t.Run("removes the policy for the competition", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		if err := repo.Save(&app.IngestionPolicy{CompetitionID: 8, Tier: app.IngestionTierHigh}); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		if err := repo.Delete(8); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		_, err := repo.ByCompetitionID(8)
+
+		assert.Equal(t, errors.ErrorNotFound, err)
+	})
+
+	t.Run("returns not found error if the competition does not have a policy", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		assert.Equal(t, errors.ErrorNotFound, repo.Delete(99))
+	})
+}
@@ -0,0 +1,233 @@
+package postgres
+
+import (
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"strings"
+	"time"
+)
+
+var jobColumns = []string{
+	"id",
+	"command",
+	"option",
+	"priority",
+	"not_before",
+	"status",
+	"attempts",
+	"max_attempts",
+	"last_error",
+	"worker_id",
+	"heartbeat_at",
+	"created_at",
+	"updated_at",
+}
+
+// JobQueue stores jobs in the job table. Jobs are claimed using SELECT ... FOR UPDATE SKIP LOCKED so concurrent
+// workers never claim the same job.
+type JobQueue struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+func (q *JobQueue) Enqueue(j *app.Job) error {
+	now := q.clock.Now()
+
+	if j.NotBefore.IsZero() {
+		j.NotBefore = now
+	}
+
+	j.Status = app.JobPending
+	j.Attempts = 0
+	j.CreatedAt = now
+	j.UpdatedAt = now
+
+	return q.queryBuilder().
+		Insert("job").
+		Columns("command", "option", "priority", "not_before", "status", "max_attempts", "created_at", "updated_at").
+		Values(j.Command, j.Option, j.Priority, j.NotBefore.Unix(), j.Status, j.MaxAttempts, now.Unix(), now.Unix()).
+		Suffix("RETURNING id").
+		QueryRow().
+		Scan(&j.ID)
+}
+
+func (q *JobQueue) Claim(workerID string, staleBefore time.Time) (*app.Job, error) {
+	now := q.clock.Now().Unix()
+
+	next := sq.Select("id").
+		From("job").
+		Where(sq.Or{
+			sq.And{sq.Eq{"status": app.JobPending}, sq.LtOrEq{"not_before": now}},
+			sq.And{sq.Eq{"status": app.JobRunning}, sq.Lt{"heartbeat_at": staleBefore.Unix()}},
+		}).
+		OrderBy("priority DESC", "not_before ASC", "id ASC").
+		Limit(1).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	row := q.queryBuilder().
+		Update("job").
+		Set("status", app.JobRunning).
+		Set("worker_id", workerID).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("heartbeat_at", now).
+		Set("updated_at", now).
+		Where(next.Prefix("id = (").Suffix(")")).
+		Suffix("RETURNING " + strings.Join(jobColumns, ", ")).
+		QueryRow()
+
+	j, err := scanJob(row)
+
+	if err == sql.ErrNoRows {
+		return nil, errors.ErrorNotFound
+	}
+
+	return j, err
+}
+
+func (q *JobQueue) Heartbeat(id uint64, workerID string) error {
+	return q.updateClaimed(
+		q.queryBuilder().
+			Update("job").
+			Set("heartbeat_at", q.clock.Now().Unix()),
+		id,
+		workerID,
+	)
+}
+
+func (q *JobQueue) Complete(id uint64, workerID string) error {
+	return q.updateClaimed(
+		q.queryBuilder().
+			Update("job").
+			Set("status", app.JobSucceeded).
+			Set("last_error", nil).
+			Set("updated_at", q.clock.Now().Unix()),
+		id,
+		workerID,
+	)
+}
+
+func (q *JobQueue) Retry(id uint64, workerID, reason string, at time.Time) error {
+	return q.updateClaimed(
+		q.queryBuilder().
+			Update("job").
+			Set("status", app.JobPending).
+			Set("not_before", at.Unix()).
+			Set("last_error", reason).
+			Set("worker_id", nil).
+			Set("heartbeat_at", nil).
+			Set("updated_at", q.clock.Now().Unix()),
+		id,
+		workerID,
+	)
+}
+
+func (q *JobQueue) Fail(id uint64, workerID, reason string) error {
+	return q.updateClaimed(
+		q.queryBuilder().
+			Update("job").
+			Set("status", app.JobFailed).
+			Set("last_error", reason).
+			Set("updated_at", q.clock.Now().Unix()),
+		id,
+		workerID,
+	)
+}
+
+// updateClaimed executes the update for a job running on the worker provided, returning errors.ErrorNotFound if
+// the job is no longer running on the worker.
+func (q *JobQueue) updateClaimed(b sq.UpdateBuilder, id uint64, workerID string) error {
+	res, err := b.Where(sq.Eq{"id": id, "status": app.JobRunning, "worker_id": workerID}).Exec()
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return errors.ErrorNotFound
+	}
+
+	return nil
+}
+
+func (q *JobQueue) Recent(limit uint64) ([]app.Job, error) {
+	rows, err := q.queryBuilder().
+		Select(jobColumns...).
+		From("job").
+		OrderBy("id DESC").
+		Limit(limit).
+		Query()
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var jobs []app.Job
+
+	for rows.Next() {
+		j, err := scanJob(rows)
+
+		if err != nil {
+			return nil, err
+		}
+
+		jobs = append(jobs, *j)
+	}
+
+	return jobs, rows.Err()
+}
+
+func (q *JobQueue) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(q.connection)
+}
+
+func NewJobQueue(connection *sql.DB, clock clockwork.Clock) *JobQueue {
+	return &JobQueue{connection: connection, clock: clock}
+}
+
+func scanJob(r sq.RowScanner) (*app.Job, error) {
+	var j app.Job
+	var notBefore, created, updated int64
+	var heartbeat *int64
+
+	err := r.Scan(
+		&j.ID,
+		&j.Command,
+		&j.Option,
+		&j.Priority,
+		&notBefore,
+		&j.Status,
+		&j.Attempts,
+		&j.MaxAttempts,
+		&j.LastError,
+		&j.WorkerID,
+		&heartbeat,
+		&created,
+		&updated,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	j.NotBefore = time.Unix(notBefore, 0)
+	j.CreatedAt = time.Unix(created, 0)
+	j.UpdatedAt = time.Unix(updated, 0)
+
+	if heartbeat != nil {
+		t := time.Unix(*heartbeat, 0)
+		j.HeartbeatAt = &t
+	}
+
+	return &j, nil
+}
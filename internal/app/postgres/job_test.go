@@ -0,0 +1,228 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestJobQueue_Claim(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "job")
+	queue := postgres.NewJobQueue(conn, test.Clock)
+
+	stale := test.Clock.Now().Add(-2 * time.Minute)
+
+	t.Run("claims due jobs in order of priority", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		jobs := []app.Job{
+			{Command: "season", Priority: 0, MaxAttempts: 3},
+			{Command: "results:by-season-id", Option: "16036", Priority: 10, MaxAttempts: 3},
+			{Command: "team", Priority: 20, MaxAttempts: 3, NotBefore: test.Clock.Now().Add(time.Hour)},
+		}
+
+		for i := range jobs {
+			if err := queue.Enqueue(&jobs[i]); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		a := assert.New(t)
+
+		first, err := queue.Claim("worker-1", stale)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a.Equal(jobs[1].ID, first.ID)
+		a.Equal("results:by-season-id", first.Command)
+		a.Equal("16036", first.Option)
+		a.Equal(app.JobRunning, first.Status)
+		a.Equal(1, first.Attempts)
+		a.Equal("worker-1", *first.WorkerID)
+
+		second, err := queue.Claim("worker-2", stale)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a.Equal(jobs[0].ID, second.ID)
+
+		_, err = queue.Claim("worker-3", stale)
+
+		a.Equal(errors.ErrorNotFound, err)
+	})
+
+	t.Run("claims running jobs without a heartbeat since the stale time", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		job := app.Job{Command: "season", MaxAttempts: 3}
+
+		if err := queue.Enqueue(&job); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		if _, err := queue.Claim("worker-1", stale); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		claimed, err := queue.Claim("worker-2", test.Clock.Now().Add(time.Second))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(job.ID, claimed.ID)
+		a.Equal(2, claimed.Attempts)
+		a.Equal("worker-2", *claimed.WorkerID)
+		a.Equal(errors.ErrorNotFound, queue.Heartbeat(job.ID, "worker-1"))
+		a.Nil(queue.Heartbeat(job.ID, "worker-2"))
+	})
+}
+
+func TestJobQueue_Retry(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "job")
+	queue := postgres.NewJobQueue(conn, test.Clock)
+
+	stale := test.Clock.Now().Add(-2 * time.Minute)
+
+	t.Run("returns job to the queue to be claimed after the time provided", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		job := app.Job{Command: "season", MaxAttempts: 3}
+
+		if err := queue.Enqueue(&job); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		if _, err := queue.Claim("worker-1", stale); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		if err := queue.Retry(job.ID, "worker-1", "exit status 1", test.Clock.Now().Add(time.Minute)); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		_, err := queue.Claim("worker-1", stale)
+
+		a := assert.New(t)
+
+		a.Equal(errors.ErrorNotFound, err)
+
+		jobs, err := queue.Recent(10)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a.Equal(1, len(jobs))
+		a.Equal(app.JobPending, jobs[0].Status)
+		a.Equal(1, jobs[0].Attempts)
+		a.Equal("exit status 1", *jobs[0].LastError)
+		a.Nil(jobs[0].WorkerID)
+		a.Equal("2019-01-14 11:26:00 +0000 UTC", jobs[0].NotBefore.UTC().String())
+	})
+}
+
+func TestJobQueue_Complete(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "job")
+	queue := postgres.NewJobQueue(conn, test.Clock)
+
+	t.Run("returns not found error if the job is no longer running on the worker", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		job := app.Job{Command: "season", MaxAttempts: 3}
+
+		if err := queue.Enqueue(&job); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		if _, err := queue.Claim("worker-1", test.Clock.Now().Add(-2*time.Minute)); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		// The heartbeat of worker-1 is older than the stale time so the job is claimed by worker-2.
+		if _, err := queue.Claim("worker-2", test.Clock.Now().Add(time.Minute)); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(errors.ErrorNotFound, queue.Complete(job.ID, "worker-1"))
+		a.Equal(errors.ErrorNotFound, queue.Fail(job.ID, "worker-1", "exit status 1"))
+		a.Nil(queue.Complete(job.ID, "worker-2"))
+
+		jobs, err := queue.Recent(1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a.Equal(app.JobSucceeded, jobs[0].Status)
+		a.Equal("worker-2", *jobs[0].WorkerID)
+	})
+}
+
+func TestJobQueue_Recent(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "job")
+	queue := postgres.NewJobQueue(conn, test.Clock)
+
+	t.Run("returns most recent jobs with their outcome", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		jobs := []app.Job{
+			{Command: "season", MaxAttempts: 3},
+			{Command: "team", MaxAttempts: 3},
+			{Command: "round", MaxAttempts: 3},
+		}
+
+		for i := range jobs {
+			if err := queue.Enqueue(&jobs[i]); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		for i := 0; i < 2; i++ {
+			if _, err := queue.Claim("worker-1", test.Clock.Now().Add(-2*time.Minute)); err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+		}
+
+		if err := queue.Complete(jobs[0].ID, "worker-1"); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		if err := queue.Fail(jobs[1].ID, "worker-1", "exit status 1"); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		recent, err := queue.Recent(2)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(recent))
+		a.Equal("round", recent[0].Command)
+		a.Equal(app.JobPending, recent[0].Status)
+		a.Equal("team", recent[1].Command)
+		a.Equal(app.JobFailed, recent[1].Status)
+		a.Equal("exit status 1", *recent[1].LastError)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", recent[1].CreatedAt.UTC().String())
+	})
+}
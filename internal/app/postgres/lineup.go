@@ -0,0 +1,199 @@
+package postgres
+
+import (
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"time"
+)
+
+type LineupRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+// Save inserts the lineup provided or replaces the existing lineup for the fixture and team within a single
+// transaction.
+func (r *LineupRepository) Save(l *app.Lineup) error {
+	tx, err := r.connection.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	query := `
+	INSERT INTO sportmonks_lineup (fixture_id, team_id, formation, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (fixture_id, team_id) DO UPDATE SET formation = $3, updated_at = $5`
+
+	_, err = tx.Exec(query, l.FixtureID, l.TeamID, l.Formation, r.clock.Now().Unix(), r.clock.Now().Unix())
+
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec(
+		`DELETE FROM sportmonks_lineup_player where fixture_id = $1 AND team_id = $2`,
+		l.FixtureID,
+		l.TeamID,
+	)
+
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	query = `
+	INSERT INTO sportmonks_lineup_player (fixture_id, team_id, player_id, shirt_number, position, formation_position,
+	grid_x, grid_y, captain, is_substitute) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	for _, p := range l.Players {
+		_, err := tx.Exec(
+			query,
+			l.FixtureID,
+			l.TeamID,
+			p.PlayerID,
+			p.ShirtNumber,
+			p.Position,
+			p.FormationPosition,
+			p.GridX,
+			p.GridY,
+			p.Captain,
+			p.IsSubstitute,
+		)
+
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *LineupRepository) ByFixtureAndTeam(fixtureID, teamID uint64) (*app.Lineup, error) {
+	lineups, err := r.lineups(sq.Eq{"fixture_id": fixtureID, "team_id": teamID})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lineups) == 0 {
+		return nil, errors.ErrorNotFound
+	}
+
+	return &lineups[0], nil
+}
+
+// ByFixtureID returns the lineups announced for a fixture ordered by team ID, a fixture without announced lineups
+// returns an empty slice.
+func (r *LineupRepository) ByFixtureID(id uint64) ([]app.Lineup, error) {
+	return r.lineups(sq.Eq{"fixture_id": id})
+}
+
+func (r *LineupRepository) lineups(where sq.Eq) ([]app.Lineup, error) {
+	rows, err := r.queryBuilder().
+		Select("fixture_id", "team_id", "formation", "created_at", "updated_at").
+		From("sportmonks_lineup").
+		Where(where).
+		OrderBy("team_id ASC").
+		Query()
+
+	if err != nil {
+		return []app.Lineup{}, err
+	}
+
+	defer rows.Close()
+
+	lineups := []app.Lineup{}
+
+	for rows.Next() {
+		var l app.Lineup
+		var created, updated int64
+
+		if err := rows.Scan(&l.FixtureID, &l.TeamID, &l.Formation, &created, &updated); err != nil {
+			return lineups, err
+		}
+
+		l.CreatedAt = time.Unix(created, 0)
+		l.UpdatedAt = time.Unix(updated, 0)
+
+		lineups = append(lineups, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return lineups, err
+	}
+
+	for i := range lineups {
+		players, err := r.players(lineups[i].FixtureID, lineups[i].TeamID)
+
+		if err != nil {
+			return lineups, err
+		}
+
+		lineups[i].Players = players
+	}
+
+	return lineups, nil
+}
+
+// players returns the starting eleven ordered by formation position followed by the bench ordered by shirt number.
+func (r *LineupRepository) players(fixtureID, teamID uint64) ([]app.LineupPlayer, error) {
+	rows, err := r.queryBuilder().
+		Select(
+			"player_id",
+			"shirt_number",
+			"position",
+			"formation_position",
+			"grid_x",
+			"grid_y",
+			"captain",
+			"is_substitute",
+		).
+		From("sportmonks_lineup_player").
+		Where(sq.Eq{"fixture_id": fixtureID, "team_id": teamID}).
+		OrderBy("is_substitute ASC", "formation_position ASC NULLS LAST", "shirt_number ASC").
+		Query()
+
+	if err != nil {
+		return []app.LineupPlayer{}, err
+	}
+
+	defer rows.Close()
+
+	players := []app.LineupPlayer{}
+
+	for rows.Next() {
+		var p app.LineupPlayer
+
+		err := rows.Scan(
+			&p.PlayerID,
+			&p.ShirtNumber,
+			&p.Position,
+			&p.FormationPosition,
+			&p.GridX,
+			&p.GridY,
+			&p.Captain,
+			&p.IsSubstitute,
+		)
+
+		if err != nil {
+			return players, err
+		}
+
+		players = append(players, p)
+	}
+
+	return players, nil
+}
+
+func (r *LineupRepository) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(r.connection)
+}
+
+func NewLineupRepository(connection *sql.DB, clock clockwork.Clock) *LineupRepository {
+	return &LineupRepository{connection: connection, clock: clock}
+}
@@ -0,0 +1,161 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLineupRepository_Save(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_lineup")
+	_, cleanUpPlayers := test.GetConnection(t, "sportmonks_lineup_player")
+	repo := postgres.NewLineupRepository(conn, test.Clock)
+
+	t.Run("inserts new lineup and replaces existing lineup for fixture and team", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+		defer cleanUpPlayers()
+
+		l := newLineup(45, 1)
+
+		if err := repo.Save(&l); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		formation := "4-4-2"
+		l.Formation = &formation
+		l.Players = l.Players[:2]
+
+		if err := repo.Save(&l); err != nil {
+			t.Fatalf("Error when updating record in the database: %s", err.Error())
+		}
+
+		row := conn.QueryRow("select count(*) from sportmonks_lineup_player")
+
+		var count int
+
+		if err := row.Scan(&count); err != nil {
+			t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+		}
+
+		fetched, err := repo.ByFixtureAndTeam(45, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, count)
+		a.Equal("4-4-2", *fetched.Formation)
+		a.Equal(2, len(fetched.Players))
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", fetched.UpdatedAt.UTC().String())
+	})
+}
+
+func TestLineupRepository_ByFixtureAndTeam(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_lineup")
+	_, cleanUpPlayers := test.GetConnection(t, "sportmonks_lineup_player")
+	repo := postgres.NewLineupRepository(conn, test.Clock)
+
+	t.Run("returns lineup with starting players ordered by formation position followed by bench", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+		defer cleanUpPlayers()
+
+		l := newLineup(45, 1)
+
+		if err := repo.Save(&l); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		fetched, err := repo.ByFixtureAndTeam(45, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(45), fetched.FixtureID)
+		a.Equal(uint64(1), fetched.TeamID)
+		a.Equal("4-2-3-1", *fetched.Formation)
+		a.Equal(3, len(fetched.Players))
+		a.Equal(uint64(10), fetched.Players[0].PlayerID)
+		a.Equal(1, fetched.Players[0].ShirtNumber)
+		a.Equal("G", *fetched.Players[0].Position)
+		a.Equal(1, *fetched.Players[0].FormationPosition)
+		a.Equal(1, *fetched.Players[0].GridX)
+		a.Equal(1, *fetched.Players[0].GridY)
+		a.False(fetched.Players[0].Captain)
+		a.False(fetched.Players[0].IsSubstitute)
+		a.Equal(uint64(11), fetched.Players[1].PlayerID)
+		a.True(fetched.Players[1].Captain)
+		a.Equal(uint64(12), fetched.Players[2].PlayerID)
+		a.True(fetched.Players[2].IsSubstitute)
+		a.Nil(fetched.Players[2].FormationPosition)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", fetched.CreatedAt.UTC().String())
+	})
+
+	t.Run("returns not found error if lineup does not exist", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+		defer cleanUpPlayers()
+
+		_, err := repo.ByFixtureAndTeam(45, 1)
+
+		assert.Equal(t, errors.ErrorNotFound, err)
+	})
+}
+
+func TestLineupRepository_ByFixtureID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_lineup")
+	_, cleanUpPlayers := test.GetConnection(t, "sportmonks_lineup_player")
+	repo := postgres.NewLineupRepository(conn, test.Clock)
+
+	t.Run("returns lineups for fixture ordered by team ID", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+		defer cleanUpPlayers()
+
+		for _, l := range []app.Lineup{newLineup(45, 2), newLineup(45, 1), newLineup(46, 1)} {
+			if err := repo.Save(&l); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		lineups, err := repo.ByFixtureID(45)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(lineups))
+		a.Equal(uint64(1), lineups[0].TeamID)
+		a.Equal(uint64(2), lineups[1].TeamID)
+		a.Equal(3, len(lineups[1].Players))
+	})
+}
+
+func newLineup(fixtureID, teamID uint64) app.Lineup {
+	formation := "4-2-3-1"
+	goalkeeper := "G"
+	defender := "D"
+	one, two := 1, 2
+
+	return app.Lineup{
+		FixtureID: fixtureID,
+		TeamID:    teamID,
+		Formation: &formation,
+		Players: []app.LineupPlayer{
+			{PlayerID: 12, ShirtNumber: 13, Position: &goalkeeper, IsSubstitute: true},
+			{PlayerID: 11, ShirtNumber: 4, Position: &defender, FormationPosition: &two, GridX: &two, GridY: &one, Captain: true},
+			{PlayerID: 10, ShirtNumber: 1, Position: &goalkeeper, FormationPosition: &one, GridX: &one, GridY: &one},
+		},
+	}
+}
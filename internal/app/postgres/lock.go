@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"hash/fnv"
+	"sync"
+	"time"
+)
+
+// advisoryLockCheckInterval is how often the connection holding a lock is checked to detect the lock being lost.
+const advisoryLockCheckInterval = 10 * time.Second
+
+// AdvisoryLocker acquires session level Postgres advisory locks keyed by a hash of the lock name. Each lock holds a
+// dedicated connection open until released so the lock is released by Postgres if the process holding it exits.
+type AdvisoryLocker struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+	logger     *logrus.Logger
+}
+
+func (a *AdvisoryLocker) Acquire(name string, s app.LockStrategy) (app.Lock, error) {
+	ctx := context.Background()
+
+	conn, err := a.connection.Conn(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key := advisoryLockKey(name)
+
+	if err := a.acquire(ctx, conn, name, key, s); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	l := advisoryLock{
+		conn:     conn,
+		key:      key,
+		clock:    a.clock,
+		lost:     make(chan struct{}),
+		released: make(chan struct{}),
+	}
+
+	go l.monitor()
+
+	return &l, nil
+}
+
+func (a *AdvisoryLocker) acquire(ctx context.Context, conn *sql.Conn, name string, key int64, s app.LockStrategy) error {
+	var acquired bool
+
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, key).Scan(&acquired); err != nil {
+		return err
+	}
+
+	if acquired {
+		return nil
+	}
+
+	switch s {
+	case app.LockStrategyWait:
+		a.logger.Infof("Waiting for lock '%s' to be released by another process", name)
+	case app.LockStrategySteal:
+		if err := a.terminateHolder(ctx, conn, name, key); err != nil {
+			return err
+		}
+	default:
+		return app.ErrLockHeld
+	}
+
+	_, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, key)
+
+	return err
+}
+
+// terminateHolder disconnects the database session holding the lock, causing Postgres to release the lock. The
+// database user requires permission to signal the backend holding the lock, i.e. by being the same user.
+func (a *AdvisoryLocker) terminateHolder(ctx context.Context, conn *sql.Conn, name string, key int64) error {
+	var pid int
+
+	// Postgres stores the high and low 32 bits of a bigint advisory lock key in the classid and objid columns.
+	query := `SELECT pid FROM pg_locks WHERE locktype = 'advisory' AND granted AND objsubid = 1
+		AND ((classid::bigint << 32) | objid::bigint) = $1`
+
+	err := conn.QueryRowContext(ctx, query, key).Scan(&pid)
+
+	// The lock has been released since it was requested.
+	if err == sql.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_terminate_backend($1)`, pid); err != nil {
+		return err
+	}
+
+	a.logger.Warningf("Lock '%s' stolen from database session %d", name, pid)
+
+	return nil
+}
+
+// advisoryLockKey converts a lock name into the bigint key identifying the advisory lock.
+func advisoryLockKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+
+	return int64(h.Sum64())
+}
+
+func NewAdvisoryLocker(connection *sql.DB, clock clockwork.Clock, logger *logrus.Logger) *AdvisoryLocker {
+	return &AdvisoryLocker{connection: connection, clock: clock, logger: logger}
+}
+
+type advisoryLock struct {
+	conn     *sql.Conn
+	key      int64
+	clock    clockwork.Clock
+	once     sync.Once
+	lost     chan struct{}
+	released chan struct{}
+}
+
+func (l *advisoryLock) Lost() <-chan struct{} {
+	return l.lost
+}
+
+func (l *advisoryLock) Release() error {
+	var err error
+
+	l.once.Do(func() {
+		close(l.released)
+
+		_, err = l.conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, l.key)
+
+		l.conn.Close()
+	})
+
+	return err
+}
+
+// monitor periodically queries the connection holding the lock, closing the lost channel if the connection has
+// been terminated, i.e. by another process stealing the lock.
+func (l *advisoryLock) monitor() {
+	for {
+		select {
+		case <-l.released:
+			return
+		case <-l.clock.After(advisoryLockCheckInterval):
+		}
+
+		if _, err := l.conn.ExecContext(context.Background(), `SELECT 1`); err != nil {
+			select {
+			case <-l.released:
+			default:
+				close(l.lost)
+			}
+
+			return
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package postgres_test
+
+import (
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestAdvisoryLocker_Acquire(t *testing.T) {
+	conn, _ := test.GetConnection(t, "fixture")
+	logger := logrus.New()
+
+	t.Run("returns lock held error if lock is held and strategy is skip", func(t *testing.T) {
+		t.Helper()
+
+		locker := postgres.NewAdvisoryLocker(conn, clockwork.NewFakeClock(), logger)
+
+		held, err := locker.Acquire("team-stats:by-date 2021-02-06", app.LockStrategySkip)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		if _, err := locker.Acquire("team-stats:by-date 2021-02-06", app.LockStrategySkip); err != app.ErrLockHeld {
+			t.Fatalf("Expected lock held error, got %v", err)
+		}
+
+		other, err := locker.Acquire("team-stats:by-date 2021-02-07", app.LockStrategySkip)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Nil(t, held.Release())
+		assert.Nil(t, other.Release())
+	})
+
+	t.Run("waits for lock to be released if strategy is wait", func(t *testing.T) {
+		t.Helper()
+
+		locker := postgres.NewAdvisoryLocker(conn, clockwork.NewFakeClock(), logger)
+
+		held, err := locker.Acquire("team-stats:by-date 2021-02-06", app.LockStrategySkip)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			held.Release()
+		}()
+
+		l, err := locker.Acquire("team-stats:by-date 2021-02-06", app.LockStrategyWait)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Nil(t, l.Release())
+	})
+
+	t.Run("steals lock from the process holding it if strategy is steal", func(t *testing.T) {
+		t.Helper()
+
+		clock := clockwork.NewFakeClock()
+		locker := postgres.NewAdvisoryLocker(conn, clock, logger)
+
+		held, err := locker.Acquire("team-stats:by-date 2021-02-06", app.LockStrategySkip)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		l, err := locker.Acquire("team-stats:by-date 2021-02-06", app.LockStrategySteal)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		clock.BlockUntil(2)
+		clock.Advance(10 * time.Second)
+
+		select {
+		case <-held.Lost():
+		case <-time.After(time.Second):
+			t.Fatal("Expected stolen lock to be lost")
+		}
+
+		assert.Nil(t, l.Release())
+	})
+}
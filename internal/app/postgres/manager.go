@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"time"
+)
+
+type ManagerRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+func (r *ManagerRepository) Insert(m *app.Manager) error {
+	query := `
+	INSERT INTO sportmonks_manager (id, team_id, country_id, first_name, last_name, nationality, image, created_at,
+	updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := r.connection.Exec(
+		query,
+		m.ID,
+		m.TeamID,
+		m.CountryID,
+		m.FirstName,
+		m.LastName,
+		m.Nationality,
+		m.Image,
+		r.clock.Now().Unix(),
+		r.clock.Now().Unix(),
+	)
+
+	return err
+}
+
+func (r *ManagerRepository) Update(m *app.Manager) error {
+	_, err := r.ByID(m.ID)
+
+	if err != nil {
+		return err
+	}
+
+	query := `
+	UPDATE sportmonks_manager set team_id = $2, country_id = $3, first_name = $4, last_name = $5, nationality = $6,
+	image = $7, updated_at = $8 where id = $1`
+
+	_, err = r.connection.Exec(
+		query,
+		m.ID,
+		m.TeamID,
+		m.CountryID,
+		m.FirstName,
+		m.LastName,
+		m.Nationality,
+		m.Image,
+		r.clock.Now().Unix(),
+	)
+
+	return err
+}
+
+func (r *ManagerRepository) ByID(id uint64) (*app.Manager, error) {
+	query := `SELECT * FROM sportmonks_manager where id = $1`
+	row := r.connection.QueryRow(query, id)
+
+	var created int64
+	var updated int64
+
+	var m = app.Manager{}
+
+	err := row.Scan(
+		&m.ID,
+		&m.TeamID,
+		&m.CountryID,
+		&m.FirstName,
+		&m.LastName,
+		&m.Nationality,
+		&m.Image,
+		&created,
+		&updated,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.ErrorNotFound
+		}
+
+		return nil, err
+	}
+
+	m.CreatedAt = time.Unix(created, 0)
+	m.UpdatedAt = time.Unix(updated, 0)
+
+	return &m, nil
+}
+
+func NewManagerRepository(connection *sql.DB, clock clockwork.Clock) *ManagerRepository {
+	return &ManagerRepository{connection: connection, clock: clock}
+}
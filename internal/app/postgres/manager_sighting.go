@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"time"
+)
+
+type ManagerSightingRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+// Save inserts the sighting provided or replaces the existing sighting of the team for the same fixture.
+func (r *ManagerSightingRepository) Save(s *app.ManagerSighting) error {
+	query := `
+	INSERT INTO sportmonks_manager_sighting (team_id, fixture_id, manager_id, date, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	ON CONFLICT (team_id, fixture_id) DO UPDATE SET manager_id = $3, date = $4, updated_at = $6`
+
+	_, err := r.connection.Exec(
+		query,
+		s.TeamID,
+		s.FixtureID,
+		s.Manager.ID,
+		s.Date.Unix(),
+		r.clock.Now().Unix(),
+		r.clock.Now().Unix(),
+	)
+
+	return err
+}
+
+func (r *ManagerSightingRepository) ByTeamID(teamID uint64) ([]app.ManagerSighting, error) {
+	query := `
+	SELECT team_id, fixture_id, manager_id, date FROM sportmonks_manager_sighting where team_id = $1
+	ORDER BY date ASC, fixture_id ASC`
+
+	rows, err := r.connection.Query(query, teamID)
+
+	if err != nil {
+		return []app.ManagerSighting{}, err
+	}
+
+	defer rows.Close()
+
+	var sightings []app.ManagerSighting
+
+	for rows.Next() {
+		var s app.ManagerSighting
+		var date int64
+
+		if err := rows.Scan(&s.TeamID, &s.FixtureID, &s.Manager.ID, &date); err != nil {
+			return sightings, err
+		}
+
+		s.Date = time.Unix(date, 0)
+
+		sightings = append(sightings, s)
+	}
+
+	return sightings, nil
+}
+
+func NewManagerSightingRepository(connection *sql.DB, clock clockwork.Clock) *ManagerSightingRepository {
+	return &ManagerSightingRepository{connection: connection, clock: clock}
+}
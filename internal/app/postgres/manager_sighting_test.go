@@ -0,0 +1,81 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestManagerSightingRepository_Save(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_manager_sighting")
+	repo := postgres.NewManagerSightingRepository(conn, test.Clock)
+
+	t.Run("replaces existing sighting of team for fixture", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		if err := repo.Save(newManagerSighting(1, 100, 10, 1546300800)); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		if err := repo.Save(newManagerSighting(1, 100, 20, 1546300800)); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		sightings, err := repo.ByTeamID(1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(sightings))
+		assert.Equal(t, uint64(20), sightings[0].Manager.ID)
+	})
+}
+
+func TestManagerSightingRepository_ByTeamID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_manager_sighting")
+	repo := postgres.NewManagerSightingRepository(conn, test.Clock)
+
+	t.Run("returns sightings for team ordered by date", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		for _, s := range []*app.ManagerSighting{
+			newManagerSighting(1, 101, 20, 1575158400),
+			newManagerSighting(1, 100, 10, 1546300800),
+			newManagerSighting(2, 100, 30, 1546300800),
+		} {
+			if err := repo.Save(s); err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+		}
+
+		sightings, err := repo.ByTeamID(1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(sightings))
+		a.Equal(uint64(100), sightings[0].FixtureID)
+		a.Equal(uint64(10), sightings[0].Manager.ID)
+		a.Equal(int64(1546300800), sightings[0].Date.Unix())
+		a.Equal(uint64(101), sightings[1].FixtureID)
+		a.Equal(uint64(1), sightings[1].TeamID)
+	})
+}
+
+func newManagerSighting(teamID, fixtureID, managerID uint64, date int64) *app.ManagerSighting {
+	return &app.ManagerSighting{
+		Manager:   app.Manager{ID: managerID},
+		TeamID:    teamID,
+		FixtureID: fixtureID,
+		Date:      time.Unix(date, 0),
+	}
+}
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"time"
+)
+
+type ManagerTenureRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+// ReplaceForTeam replaces the tenure history for a team within a single transaction.
+func (r *ManagerTenureRepository) ReplaceForTeam(teamID uint64, tenures []app.ManagerTenure) error {
+	tx, err := r.connection.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`DELETE FROM sportmonks_manager_tenure where team_id = $1`, teamID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	query := `
+	INSERT INTO sportmonks_manager_tenure (team_id, manager_id, date_from, date_to, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6)`
+
+	for _, t := range tenures {
+		created := t.CreatedAt
+
+		if created.IsZero() {
+			created = r.clock.Now()
+		}
+
+		_, err := tx.Exec(query, teamID, t.ManagerID, t.From.Unix(), t.To.Unix(), created.Unix(), r.clock.Now().Unix())
+
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *ManagerTenureRepository) ByTeamID(teamID uint64) ([]app.ManagerTenure, error) {
+	rows, err := r.queryBuilder().
+		Select(managerTenureColumns()...).
+		From("sportmonks_manager_tenure").
+		Where(sq.Eq{"team_id": teamID}).
+		OrderBy("date_from ASC").
+		Query()
+
+	if err != nil {
+		return []app.ManagerTenure{}, err
+	}
+
+	return rowsToManagerTenures(rows)
+}
+
+func (r *ManagerTenureRepository) ByManagerID(managerID uint64) ([]app.ManagerTenure, error) {
+	rows, err := r.queryBuilder().
+		Select(managerTenureColumns()...).
+		From("sportmonks_manager_tenure").
+		Where(sq.Eq{"manager_id": managerID}).
+		OrderBy("date_from ASC").
+		Query()
+
+	if err != nil {
+		return []app.ManagerTenure{}, err
+	}
+
+	return rowsToManagerTenures(rows)
+}
+
+func (r *ManagerTenureRepository) AtDate(teamID uint64, date time.Time) (*app.ManagerTenure, error) {
+	rows, err := r.queryBuilder().
+		Select(managerTenureColumns()...).
+		From("sportmonks_manager_tenure").
+		Where(sq.Eq{"team_id": teamID}).
+		Where(sq.LtOrEq{"date_from": date.Unix()}).
+		OrderBy("date_from DESC").
+		Limit(1).
+		Query()
+
+	if err != nil {
+		return nil, err
+	}
+
+	tenures, err := rowsToManagerTenures(rows)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tenures) == 0 {
+		return nil, errors.ErrorNotFound
+	}
+
+	return &tenures[0], nil
+}
+
+func (r *ManagerTenureRepository) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(r.connection)
+}
+
+func managerTenureColumns() []string {
+	return []string{"team_id", "manager_id", "date_from", "date_to", "created_at", "updated_at"}
+}
+
+func rowsToManagerTenures(rows *sql.Rows) ([]app.ManagerTenure, error) {
+	defer rows.Close()
+
+	var tenures []app.ManagerTenure
+
+	for rows.Next() {
+		var t app.ManagerTenure
+		var from, to, created, updated int64
+
+		if err := rows.Scan(&t.TeamID, &t.ManagerID, &from, &to, &created, &updated); err != nil {
+			return tenures, err
+		}
+
+		t.From = time.Unix(from, 0)
+		t.To = time.Unix(to, 0)
+		t.CreatedAt = time.Unix(created, 0)
+		t.UpdatedAt = time.Unix(updated, 0)
+
+		tenures = append(tenures, t)
+	}
+
+	return tenures, nil
+}
+
+func NewManagerTenureRepository(connection *sql.DB, clock clockwork.Clock) *ManagerTenureRepository {
+	return &ManagerTenureRepository{connection: connection, clock: clock}
+}
@@ -0,0 +1,158 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestManagerTenureRepository_ReplaceForTeam(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_manager_tenure")
+	repo := postgres.NewManagerTenureRepository(conn, test.Clock)
+
+	t.Run("replaces existing tenures for team", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		if err := repo.ReplaceForTeam(1, newManagerTenures()); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		replaced := []app.ManagerTenure{newManagerTenure(1, 3, 1567296000, 1577836800)}
+
+		if err := repo.ReplaceForTeam(1, replaced); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		tenures, err := repo.ByTeamID(1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(tenures))
+		assert.Equal(t, uint64(3), tenures[0].ManagerID)
+	})
+}
+
+func TestManagerTenureRepository_ByTeamID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_manager_tenure")
+	repo := postgres.NewManagerTenureRepository(conn, test.Clock)
+
+	t.Run("returns tenures for team ordered by date from", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		if err := repo.ReplaceForTeam(1, newManagerTenures()); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		tenures, err := repo.ByTeamID(1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(tenures))
+		a.Equal(uint64(10), tenures[0].ManagerID)
+		a.Equal(int64(1546300800), tenures[0].From.Unix())
+		a.Equal(int64(1575158400), tenures[0].To.Unix())
+		a.Equal(uint64(20), tenures[1].ManagerID)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", tenures[1].CreatedAt.UTC().String())
+	})
+}
+
+func TestManagerTenureRepository_ByManagerID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_manager_tenure")
+	repo := postgres.NewManagerTenureRepository(conn, test.Clock)
+
+	t.Run("returns tenures for manager across teams", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		if err := repo.ReplaceForTeam(1, newManagerTenures()); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		other := []app.ManagerTenure{newManagerTenure(2, 10, 1420070400, 1514764800)}
+
+		if err := repo.ReplaceForTeam(2, other); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		tenures, err := repo.ByManagerID(10)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(tenures))
+		assert.Equal(t, uint64(2), tenures[0].TeamID)
+		assert.Equal(t, uint64(1), tenures[1].TeamID)
+	})
+}
+
+func TestManagerTenureRepository_AtDate(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_manager_tenure")
+	repo := postgres.NewManagerTenureRepository(conn, test.Clock)
+
+	t.Run("returns latest tenure starting on or before date", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		if err := repo.ReplaceForTeam(1, newManagerTenures()); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		tenure, err := repo.AtDate(1, time.Unix(1580515200, 0))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, uint64(20), tenure.ManagerID)
+
+		tenure, err = repo.AtDate(1, time.Unix(1560000000, 0))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, uint64(10), tenure.ManagerID)
+	})
+
+	t.Run("returns error if no tenure started before date", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		if err := repo.ReplaceForTeam(1, newManagerTenures()); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		_, err := repo.AtDate(1, time.Unix(1500000000, 0))
+
+		assert.Equal(t, errors.ErrorNotFound, err)
+	})
+}
+
+func newManagerTenures() []app.ManagerTenure {
+	return []app.ManagerTenure{
+		newManagerTenure(1, 10, 1546300800, 1575158400),
+		newManagerTenure(1, 20, 1577836800, 1590969600),
+	}
+}
+
+func newManagerTenure(teamID, managerID uint64, from, to int64) app.ManagerTenure {
+	return app.ManagerTenure{
+		TeamID:    teamID,
+		ManagerID: managerID,
+		From:      time.Unix(from, 0),
+		To:        time.Unix(to, 0),
+	}
+}
@@ -0,0 +1,145 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestManagerRepository_Insert(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_manager")
+	repo := postgres.NewManagerRepository(conn, test.Clock)
+
+	t.Run("increases table count", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		for i := 1; i < 4; i++ {
+			if err := repo.Insert(newManager(uint64(i))); err != nil {
+				t.Errorf("Error when inserting record into the database: %s", err.Error())
+			}
+
+			row := conn.QueryRow("select count(*) from sportmonks_manager")
+
+			var count int
+
+			if err := row.Scan(&count); err != nil {
+				t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+			}
+
+			assert.Equal(t, i, count)
+		}
+	})
+
+	t.Run("returns error when ID primary key violates unique constraint", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		m := newManager(50)
+
+		if err := repo.Insert(m); err != nil {
+			t.Errorf("Test failed, expected nil, got %s", err)
+		}
+
+		if e := repo.Insert(m); e == nil {
+			t.Fatalf("Test failed, expected %s, got nil", e)
+		}
+	})
+}
+
+func TestManagerRepository_ByID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_manager")
+	repo := postgres.NewManagerRepository(conn, test.Clock)
+
+	t.Run("manager can be retrieved by ID", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		if err := repo.Insert(newManager(455360)); err != nil {
+			t.Errorf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		m, err := repo.ByID(455360)
+
+		if err != nil {
+			t.Fatalf("Error when retrieving a record from the database: %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(455360), m.ID)
+		a.Equal(uint64(1), *m.TeamID)
+		a.Equal(uint64(462), m.CountryID)
+		a.Equal("David", m.FirstName)
+		a.Equal("Moyes", m.LastName)
+		a.Equal("Scotland", m.Nationality)
+		a.Nil(m.Image)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", m.CreatedAt.UTC().String())
+	})
+
+	t.Run("returns error if manager does not exist", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		_, err := repo.ByID(99)
+
+		assert.Equal(t, errors.ErrorNotFound, err)
+	})
+}
+
+func TestManagerRepository_Update(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_manager")
+	repo := postgres.NewManagerRepository(conn, test.Clock)
+
+	t.Run("modifies existing record", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		m := newManager(455360)
+
+		if err := repo.Insert(m); err != nil {
+			t.Errorf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		team := uint64(14)
+		m.TeamID = &team
+
+		if err := repo.Update(m); err != nil {
+			t.Fatalf("Error when updating a record in the database: %s", err.Error())
+		}
+
+		r, err := repo.ByID(455360)
+
+		if err != nil {
+			t.Fatalf("Error when retrieving a record from the database: %s", err.Error())
+		}
+
+		assert.Equal(t, uint64(14), *r.TeamID)
+	})
+
+	t.Run("returns error if manager does not exist", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		assert.Equal(t, errors.ErrorNotFound, repo.Update(newManager(99)))
+	})
+}
+
+func newManager(id uint64) *app.Manager {
+	team := uint64(1)
+
+	return &app.Manager{
+		ID:          id,
+		TeamID:      &team,
+		CountryID:   462,
+		FirstName:   "David",
+		LastName:    "Moyes",
+		Nationality: "Scotland",
+		CreatedAt:   time.Unix(1546965200, 0),
+		UpdatedAt:   time.Unix(1546965200, 0),
+	}
+}
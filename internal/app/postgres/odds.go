@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"time"
+)
+
+type OddsRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+func (r *OddsRepository) Insert(o *app.Odds) error {
+	builder := r.queryBuilder()
+
+	_, err := builder.
+		Insert("odds").
+		Columns(
+			"fixture_id",
+			"bookmaker_id",
+			"bookmaker",
+			"market",
+			"selection",
+			"line",
+			"price",
+			"captured_at",
+			"created_at",
+		).
+		Values(
+			o.FixtureID,
+			o.BookmakerID,
+			o.Bookmaker,
+			o.Market,
+			o.Selection,
+			o.Line,
+			o.Price,
+			o.CapturedAt.Unix(),
+			r.clock.Now().Unix(),
+		).
+		Exec()
+
+	return err
+}
+
+func (r *OddsRepository) Exists(o *app.Odds) (bool, error) {
+	query := r.queryBuilder().
+		Select("1").
+		From("odds").
+		Where(sq.Eq{"fixture_id": o.FixtureID}).
+		Where(sq.Eq{"bookmaker_id": o.BookmakerID}).
+		Where(sq.Eq{"market": o.Market}).
+		Where(sq.Eq{"selection": o.Selection}).
+		Where(sq.Eq{"captured_at": o.CapturedAt.Unix()}).
+		Limit(1)
+
+	if o.Line != nil {
+		query = query.Where(sq.Eq{"line": *o.Line})
+	} else {
+		query = query.Where(sq.Eq{"line": nil})
+	}
+
+	var exists int
+
+	err := query.QueryRow().Scan(&exists)
+
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (r *OddsRepository) ByFixtureAndMarket(fixtureID uint64, market string) ([]app.Odds, error) {
+	builder := r.queryBuilder()
+
+	rows, err := builder.
+		Select(oddsColumns()...).
+		From("odds").
+		Where(sq.Eq{"fixture_id": fixtureID}).
+		Where(sq.Eq{"market": market}).
+		OrderBy("captured_at ASC", "id ASC").
+		Query()
+
+	if err != nil {
+		return []app.Odds{}, err
+	}
+
+	defer rows.Close()
+
+	var odds []app.Odds
+
+	for rows.Next() {
+		o, err := rowToOdds(rows)
+
+		if err != nil {
+			return odds, err
+		}
+
+		odds = append(odds, *o)
+	}
+
+	return odds, nil
+}
+
+func (r *OddsRepository) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(r.connection)
+}
+
+func oddsColumns() []string {
+	return []string{
+		"fixture_id",
+		"bookmaker_id",
+		"bookmaker",
+		"market",
+		"selection",
+		"line",
+		"price",
+		"captured_at",
+		"created_at",
+	}
+}
+
+func rowToOdds(r sq.RowScanner) (*app.Odds, error) {
+	var o app.Odds
+	var captured int64
+	var created int64
+
+	err := r.Scan(
+		&o.FixtureID,
+		&o.BookmakerID,
+		&o.Bookmaker,
+		&o.Market,
+		&o.Selection,
+		&o.Line,
+		&o.Price,
+		&captured,
+		&created,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	o.CapturedAt = time.Unix(captured, 0)
+	o.CreatedAt = time.Unix(created, 0)
+
+	return &o, nil
+}
+
+func NewOddsRepository(connection *sql.DB, clock clockwork.Clock) *OddsRepository {
+	return &OddsRepository{connection: connection, clock: clock}
+}
@@ -0,0 +1,143 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestOddsRepository_Insert(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "odds")
+	repo := postgres.NewOddsRepository(conn, test.Clock)
+
+	t.Run("increases table count and retains price history", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		for i := 1; i < 4; i++ {
+			o := newOdds(5, "Home", nil, float32(i)+1.5, time.Unix(1548086929+int64(i), 0))
+
+			if err := repo.Insert(o); err != nil {
+				t.Errorf("Test failed, expected nil, got %s", err)
+			}
+
+			row := conn.QueryRow("select count(*) from odds")
+
+			var count int
+
+			if err := row.Scan(&count); err != nil {
+				t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+			}
+
+			assert.Equal(t, i, count)
+		}
+	})
+}
+
+func TestOddsRepository_Exists(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "odds")
+	repo := postgres.NewOddsRepository(conn, test.Clock)
+
+	t.Run("returns true only if the price capture for the selection is stored", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		line := "2.5"
+
+		stored := newOdds(5, "Over", &line, 1.95, time.Unix(1548086929, 0))
+
+		if err := repo.Insert(stored); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		exists, err := repo.Exists(newOdds(5, "Over", &line, 1.80, time.Unix(1548086929, 0)))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		a.True(exists)
+
+		exists, err = repo.Exists(newOdds(5, "Over", &line, 1.95, time.Unix(1548086000, 0)))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		a.False(exists)
+
+		exists, err = repo.Exists(newOdds(5, "Over", nil, 1.95, time.Unix(1548086929, 0)))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		a.False(exists)
+	})
+}
+
+func TestOddsRepository_ByFixtureAndMarket(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "odds")
+	repo := postgres.NewOddsRepository(conn, test.Clock)
+
+	t.Run("returns price history for market ordered by captured at", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		odds := []*app.Odds{
+			newOdds(5, "Home", nil, 1.95, time.Unix(1548086990, 0)),
+			newOdds(5, "Home", nil, 2.00, time.Unix(1548086929, 0)),
+			newOdds(2, "Away", nil, 3.10, time.Unix(1548087000, 0)),
+		}
+
+		for _, o := range odds {
+			if err := repo.Insert(o); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		fetched, err := repo.ByFixtureAndMarket(78102, "Over/Under")
+
+		if err != nil {
+			t.Fatalf("Error retrieving records from the database: %s", err.Error())
+		}
+
+		a := assert.New(t)
+		a.Equal(3, len(fetched))
+		a.Equal(float32(2.00), fetched[0].Price)
+		a.Equal(float32(1.95), fetched[1].Price)
+		a.Equal(float32(3.10), fetched[2].Price)
+		a.Equal(int64(1547465100), fetched[0].CreatedAt.Unix())
+	})
+
+	t.Run("returns empty slice if no prices exist for market", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		fetched, err := repo.ByFixtureAndMarket(78102, "3Way Result")
+
+		if err != nil {
+			t.Fatalf("Error retrieving records from the database: %s", err.Error())
+		}
+
+		assert.Equal(t, 0, len(fetched))
+	})
+}
+
+func newOdds(bookmakerID uint64, selection string, line *string, price float32, captured time.Time) *app.Odds {
+	return &app.Odds{
+		FixtureID:   78102,
+		BookmakerID: bookmakerID,
+		Bookmaker:   "Bet365",
+		Market:      "Over/Under",
+		Selection:   selection,
+		Line:        line,
+		Price:       price,
+		CapturedAt:  captured,
+	}
+}
@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"time"
+)
+
+// execer executes statements against a database connection or within a transaction.
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+// storeEvents stores the events in the outbox to be relayed to subscribers. Events only enter the outbox alongside
+// the write causing them, using writeWithEvents or within the transaction of the repository making the write.
+func storeEvents(e execer, clock clockwork.Clock, events []app.DomainEvent) error {
+	query := `INSERT INTO domain_event_outbox (name, aggregate_id, payload, created_at) VALUES ($1, $2, $3, $4)`
+
+	for _, ev := range events {
+		payload, err := json.Marshal(ev)
+
+		if err != nil {
+			return err
+		}
+
+		if _, err := e.Exec(query, ev.Name(), ev.AggregateID(), string(payload), clock.Now().Unix()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeWithEvents executes the write query and stores the events published with the write in the outbox within a
+// single transaction, so events are only relayed if the write is committed and a committed write never loses its
+// events.
+func writeWithEvents(
+	conn *sql.DB,
+	clock clockwork.Clock,
+	events []app.DomainEvent,
+	query string,
+	args ...interface{},
+) error {
+	tx, err := conn.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(query, args...); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := storeEvents(tx, clock, events); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+type OutboxRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+// Pending returns messages yet to be relayed or dead lettered with an ID greater than the ID provided ordered by
+// ID ascending.
+func (o *OutboxRepository) Pending(afterID uint64, limit uint64) ([]app.OutboxMessage, error) {
+	rows, err := o.queryBuilder().
+		Select("id", "name", "aggregate_id", "payload", "attempts", "last_error", "created_at", "relayed_at").
+		From("domain_event_outbox").
+		Where(sq.Eq{"relayed_at": nil, "dead_lettered_at": nil}).
+		Where(sq.Gt{"id": afterID}).
+		OrderBy("id ASC").
+		Limit(limit).
+		Query()
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var messages []app.OutboxMessage
+
+	for rows.Next() {
+		var m app.OutboxMessage
+		var created int64
+		var relayed *int64
+
+		err := rows.Scan(&m.ID, &m.Name, &m.AggregateID, &m.Payload, &m.Attempts, &m.LastError, &created, &relayed)
+
+		if err != nil {
+			return nil, err
+		}
+
+		m.CreatedAt = time.Unix(created, 0)
+
+		if relayed != nil {
+			t := time.Unix(*relayed, 0)
+			m.RelayedAt = &t
+		}
+
+		messages = append(messages, m)
+	}
+
+	return messages, rows.Err()
+}
+
+func (o *OutboxRepository) MarkRelayed(id uint64) error {
+	_, err := o.queryBuilder().
+		Update("domain_event_outbox").
+		Set("relayed_at", o.clock.Now().Unix()).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Where(sq.Eq{"id": id}).
+		Exec()
+
+	return err
+}
+
+func (o *OutboxRepository) MarkFailed(id uint64, reason string) error {
+	_, err := o.queryBuilder().
+		Update("domain_event_outbox").
+		Set("last_error", reason).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Where(sq.Eq{"id": id}).
+		Exec()
+
+	return err
+}
+
+// MarkDeadLettered records the final failed attempt of a message, removing it from the pending messages.
+func (o *OutboxRepository) MarkDeadLettered(id uint64, reason string) error {
+	_, err := o.queryBuilder().
+		Update("domain_event_outbox").
+		Set("last_error", reason).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("dead_lettered_at", o.clock.Now().Unix()).
+		Where(sq.Eq{"id": id}).
+		Exec()
+
+	return err
+}
+
+func (o *OutboxRepository) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(o.connection)
+}
+
+func NewOutboxRepository(connection *sql.DB, clock clockwork.Clock) *OutboxRepository {
+	return &OutboxRepository{connection: connection, clock: clock}
+}
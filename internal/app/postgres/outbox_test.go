@@ -0,0 +1,136 @@
+package postgres_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOutboxRepository_Pending(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "domain_event_outbox")
+	resultConn, resultCleanUp := test.GetConnection(t, "sportmonks_result")
+	resultRepo := postgres.NewResultRepository(resultConn, test.Clock)
+	repo := postgres.NewOutboxRepository(conn, test.Clock)
+
+	t.Run("returns domain event stored with a write as a pending message", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+		defer resultCleanUp()
+
+		home, away := 2, 1
+
+		if err := resultRepo.Insert(newResult(45), app.ResultFinalised{FixtureID: 45, HomeScore: &home, AwayScore: &away}); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		messages, err := repo.Pending(0, 10)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(1, len(messages))
+		a.Equal("result.finalised", messages[0].Name)
+		a.Equal(uint64(45), messages[0].AggregateID)
+		a.Equal(0, messages[0].Attempts)
+		a.Nil(messages[0].RelayedAt)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", messages[0].CreatedAt.UTC().String())
+
+		e, err := app.DecodeDomainEvent(messages[0].Name, messages[0].Payload)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a.Equal(2, *e.(app.ResultFinalised).HomeScore)
+		a.Equal(1, *e.(app.ResultFinalised).AwayScore)
+	})
+
+	t.Run("returns messages yet to be relayed after the ID provided", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		for i := 1; i <= 4; i++ {
+			storeOutboxEvent(t, conn, app.TeamStatsUpdated{FixtureID: uint64(i), TeamID: 1})
+		}
+
+		messages, err := repo.Pending(0, 10)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		if err := repo.MarkRelayed(messages[1].ID); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		if err := repo.MarkFailed(messages[2].ID, "oh no"); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		pending, err := repo.Pending(messages[0].ID, 10)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(pending))
+		a.Equal(uint64(3), pending[0].AggregateID)
+		a.Equal(1, pending[0].Attempts)
+		a.Equal("oh no", *pending[0].LastError)
+		a.Equal(uint64(4), pending[1].AggregateID)
+		a.Nil(pending[1].LastError)
+	})
+
+	t.Run("excludes dead lettered messages", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		for i := 1; i <= 2; i++ {
+			storeOutboxEvent(t, conn, app.TeamStatsUpdated{FixtureID: uint64(i), TeamID: 1})
+		}
+
+		messages, err := repo.Pending(0, 10)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		if err := repo.MarkDeadLettered(messages[0].ID, "oh no"); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		pending, err := repo.Pending(0, 10)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(1, len(pending))
+		a.Equal(uint64(2), pending[0].AggregateID)
+	})
+}
+
+func storeOutboxEvent(t *testing.T, conn *sql.DB, e app.DomainEvent) {
+	payload, err := json.Marshal(e)
+
+	if err != nil {
+		t.Fatalf("Expected nil, got %s", err.Error())
+	}
+
+	query := `INSERT INTO domain_event_outbox (name, aggregate_id, payload, created_at) VALUES ($1, $2, $3, $4)`
+
+	if _, err := conn.Exec(query, e.Name(), e.AggregateID(), string(payload), test.Clock.Now().Unix()); err != nil {
+		t.Fatalf("Error when inserting record into the database: %s", err.Error())
+	}
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/archive"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"time"
+)
+
+type RawPayloadStore struct {
+	connection *sql.DB
+}
+
+func (r *RawPayloadStore) Save(p *app.RawPayload) error {
+	body, err := archive.Compress(p.Body)
+
+	if err != nil {
+		return err
+	}
+
+	builder := r.queryBuilder()
+
+	_, err = builder.
+		Insert("raw_payload").
+		Columns("provider", "endpoint", "entity_id", "body", "fetched_at").
+		Values(p.Provider, p.Endpoint, p.EntityID, body, p.FetchedAt.Unix()).
+		Exec()
+
+	return err
+}
+
+func (r *RawPayloadStore) Latest(provider, endpoint string) (*app.RawPayload, error) {
+	builder := r.queryBuilder()
+
+	row := builder.
+		Select("provider", "endpoint", "entity_id", "body", "fetched_at").
+		From("raw_payload").
+		Where(sq.Eq{"provider": provider}).
+		Where(sq.Eq{"endpoint": endpoint}).
+		OrderBy("fetched_at DESC", "id DESC").
+		Limit(1).
+		QueryRow()
+
+	var p app.RawPayload
+	var body []byte
+	var fetched int64
+
+	if err := row.Scan(&p.Provider, &p.Endpoint, &p.EntityID, &body, &fetched); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.ErrorNotFound
+		}
+
+		return nil, err
+	}
+
+	decompressed, err := archive.Decompress(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.Body = decompressed
+	p.FetchedAt = time.Unix(fetched, 0)
+
+	return &p, nil
+}
+
+func (r *RawPayloadStore) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(r.connection)
+}
+
+func NewRawPayloadStore(connection *sql.DB) *RawPayloadStore {
+	return &RawPayloadStore{connection: connection}
+}
@@ -0,0 +1,94 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestRawPayloadStore_Save(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "raw_payload")
+	store := postgres.NewRawPayloadStore(conn)
+
+	t.Run("increases table count and stores body compressed", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		p := newRawPayload(`{"data": {"id": 11867285}}`, time.Unix(1548086929, 0))
+
+		if err := store.Save(p); err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err)
+		}
+
+		var count int
+		var body []byte
+
+		if err := conn.QueryRow("select count(*) from raw_payload").Scan(&count); err != nil {
+			t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+		}
+
+		if err := conn.QueryRow("select body from raw_payload").Scan(&body); err != nil {
+			t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+		}
+
+		assert.Equal(t, 1, count)
+		assert.NotEqual(t, p.Body, body)
+	})
+}
+
+func TestRawPayloadStore_Latest(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "raw_payload")
+	store := postgres.NewRawPayloadStore(conn)
+
+	t.Run("returns the most recently fetched payload for endpoint", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		payloads := []*app.RawPayload{
+			newRawPayload(`{"data": {"id": 1}}`, time.Unix(1548086929, 0)),
+			newRawPayload(`{"data": {"id": 2}}`, time.Unix(1548086990, 0)),
+		}
+
+		for _, p := range payloads {
+			if err := store.Save(p); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		p, err := store.Latest(app.ProviderSportMonks, "/fixtures/11867285?include=stats")
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+		a.Equal(`{"data": {"id": 2}}`, string(p.Body))
+		a.Equal(uint64(11867285), *p.EntityID)
+		a.Equal(int64(1548086990), p.FetchedAt.Unix())
+	})
+
+	t.Run("returns not found error if no payload exists for endpoint", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		_, err := store.Latest(app.ProviderSportMonks, "/fixtures/11867285?include=stats")
+
+		assert.Equal(t, errors.ErrorNotFound, err)
+	})
+}
+
+func newRawPayload(body string, fetched time.Time) *app.RawPayload {
+	id := uint64(11867285)
+
+	return &app.RawPayload{
+		Provider:  app.ProviderSportMonks,
+		Endpoint:  "/fixtures/11867285?include=stats",
+		EntityID:  &id,
+		Body:      []byte(body),
+		FetchedAt: fetched,
+	}
+}
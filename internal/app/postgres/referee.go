@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"time"
+)
+
+// refereeStatsQuery aggregates stats from the fixtures selected by the fixtures common table expression.
+const refereeStatsQuery = `
+	WITH fixtures AS (%s)
+	SELECT
+	(SELECT count(*) FROM fixtures),
+	(SELECT count(*) FROM sportmonks_card_event WHERE fixture_id IN (SELECT id FROM fixtures) AND type = 'yellowcard'),
+	(SELECT count(*) FROM sportmonks_card_event WHERE fixture_id IN (SELECT id FROM fixtures) AND type IN ('redcard', 'yellowred')),
+	(SELECT COALESCE(sum(fouls), 0) FROM sportmonks_team_stats WHERE fixture_id IN (SELECT id FROM fixtures)),
+	(SELECT COALESCE(sum(pen_won), 0) FROM sportmonks_player_stats WHERE fixture_id IN (SELECT id FROM fixtures))`
+
+type RefereeRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+func (r *RefereeRepository) Insert(x *app.Referee) error {
+	query := `
+	INSERT INTO sportmonks_referee (id, common_name, full_name, first_name, last_name, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.connection.Exec(
+		query,
+		x.ID,
+		x.CommonName,
+		x.FullName,
+		x.FirstName,
+		x.LastName,
+		r.clock.Now().Unix(),
+		r.clock.Now().Unix(),
+	)
+
+	return err
+}
+
+func (r *RefereeRepository) Update(x *app.Referee) error {
+	_, err := r.ByID(x.ID)
+
+	if err != nil {
+		return err
+	}
+
+	query := `
+	UPDATE sportmonks_referee set common_name = $2, full_name = $3, first_name = $4, last_name = $5,
+	updated_at = $6 where id = $1`
+
+	_, err = r.connection.Exec(
+		query,
+		x.ID,
+		x.CommonName,
+		x.FullName,
+		x.FirstName,
+		x.LastName,
+		r.clock.Now().Unix(),
+	)
+
+	return err
+}
+
+func (r *RefereeRepository) ByID(id uint64) (*app.Referee, error) {
+	query := `SELECT * FROM sportmonks_referee where id = $1`
+	row := r.connection.QueryRow(query, id)
+
+	var created int64
+	var updated int64
+
+	var x = app.Referee{}
+
+	err := row.Scan(&x.ID, &x.CommonName, &x.FullName, &x.FirstName, &x.LastName, &created, &updated)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.ErrorNotFound
+		}
+
+		return nil, err
+	}
+
+	x.CreatedAt = time.Unix(created, 0)
+	x.UpdatedAt = time.Unix(updated, 0)
+
+	return &x, nil
+}
+
+// Stats aggregates cards, fouls and penalties from fixtures officiated by the referee that have kicked off.
+func (r *RefereeRepository) Stats(id uint64, q app.RefereeStatsQuery) (*app.RefereeStats, error) {
+	fixtures := sq.Select("f.id").
+		From("sportmonks_fixture f").
+		Join("sportmonks_season s ON s.id = f.season_id").
+		Where(sq.Eq{"f.referee_id": id}).
+		Where(sq.LtOrEq{"f.date": r.clock.Now().Unix()})
+
+	if len(q.SeasonIDs) > 0 {
+		fixtures = fixtures.Where(sq.Eq{"f.season_id": q.SeasonIDs})
+	}
+
+	if len(q.CompetitionIDs) > 0 {
+		fixtures = fixtures.Where(sq.Eq{"s.league_id": q.CompetitionIDs})
+	}
+
+	sub, args, err := fixtures.ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := sq.Dollar.ReplacePlaceholders(fmt.Sprintf(refereeStatsQuery, sub))
+
+	if err != nil {
+		return nil, err
+	}
+
+	stats := app.RefereeStats{RefereeID: id}
+
+	err = r.connection.QueryRow(query, args...).Scan(
+		&stats.Fixtures,
+		&stats.YellowCards,
+		&stats.RedCards,
+		&stats.Fouls,
+		&stats.Penalties,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+func NewRefereeRepository(connection *sql.DB, clock clockwork.Clock) *RefereeRepository {
+	return &RefereeRepository{connection: connection, clock: clock}
+}
@@ -0,0 +1,260 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestRefereeRepository_Insert(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_referee")
+	repo := postgres.NewRefereeRepository(conn, test.Clock)
+
+	t.Run("increases table count", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		for i := 1; i < 4; i++ {
+			if err := repo.Insert(newReferee(uint64(i))); err != nil {
+				t.Errorf("Error when inserting record into the database: %s", err.Error())
+			}
+
+			row := conn.QueryRow("select count(*) from sportmonks_referee")
+
+			var count int
+
+			if err := row.Scan(&count); err != nil {
+				t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+			}
+
+			assert.Equal(t, i, count)
+		}
+	})
+
+	t.Run("returns error when ID primary key violates unique constraint", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		r := newReferee(50)
+
+		if err := repo.Insert(r); err != nil {
+			t.Errorf("Test failed, expected nil, got %s", err)
+		}
+
+		if e := repo.Insert(r); e == nil {
+			t.Fatalf("Test failed, expected %s, got nil", e)
+		}
+	})
+}
+
+func TestRefereeRepository_ByID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_referee")
+	repo := postgres.NewRefereeRepository(conn, test.Clock)
+
+	t.Run("referee can be retrieved by ID", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		if err := repo.Insert(newReferee(14532)); err != nil {
+			t.Errorf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		r, err := repo.ByID(14532)
+
+		if err != nil {
+			t.Fatalf("Error when retrieving a record from the database: %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(14532), r.ID)
+		a.Equal("M. Oliver", r.CommonName)
+		a.Equal("Michael Oliver", r.FullName)
+		a.Equal("Michael", r.FirstName)
+		a.Equal("Oliver", r.LastName)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", r.CreatedAt.UTC().String())
+	})
+
+	t.Run("returns error if referee does not exist", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		_, err := repo.ByID(99)
+
+		assert.Equal(t, errors.ErrorNotFound, err)
+	})
+}
+
+func TestRefereeRepository_Update(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_referee")
+	repo := postgres.NewRefereeRepository(conn, test.Clock)
+
+	t.Run("modifies existing record", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		r := newReferee(14532)
+
+		if err := repo.Insert(r); err != nil {
+			t.Errorf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		r.CommonName = "Mike Oliver"
+
+		if err := repo.Update(r); err != nil {
+			t.Fatalf("Error when updating a record in the database: %s", err.Error())
+		}
+
+		fetched, err := repo.ByID(14532)
+
+		if err != nil {
+			t.Fatalf("Error when retrieving a record from the database: %s", err.Error())
+		}
+
+		assert.Equal(t, "Mike Oliver", fetched.CommonName)
+	})
+
+	t.Run("returns error if referee does not exist", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		assert.Equal(t, errors.ErrorNotFound, repo.Update(newReferee(99)))
+	})
+}
+
+func TestRefereeRepository_Stats(t *testing.T) {
+	conn, _ := test.GetConnection(t, "sportmonks_referee")
+	repo := postgres.NewRefereeRepository(conn, test.Clock)
+	seasonConn, seasonCleanUp := test.GetConnection(t, "sportmonks_season")
+	seasonRepo := postgres.NewSeasonRepository(seasonConn, test.Clock)
+	fixtureConn, fixtureCleanUp := test.GetConnection(t, "sportmonks_fixture")
+	fixtureRepo := postgres.NewFixtureRepository(fixtureConn, test.Clock)
+	eventConn, eventCleanUp := test.GetConnection(t, "sportmonks_card_event")
+	eventRepo := postgres.NewEventRepository(eventConn, test.Clock)
+	statsConn, statsCleanUp := test.GetConnection(t, "sportmonks_team_stats")
+	statsRepo := postgres.NewTeamStatsRepository(statsConn, test.Clock)
+
+	insert := func(t *testing.T) {
+		for _, s := range []*app.Season{newSeason(1, 8, "2019/2020", false), newSeason(2, 24, "2019/2020", false)} {
+			if err := seasonRepo.Insert(s); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		referee := uint64(14532)
+		played := time.Unix(1546300800, 0)
+
+		fixtures := []*app.Fixture{
+			{ID: 1, SeasonID: 1, HomeTeamID: 1, AwayTeamID: 2, RefereeID: &referee, Date: played},
+			{ID: 2, SeasonID: 2, HomeTeamID: 3, AwayTeamID: 4, RefereeID: &referee, Date: played},
+			{ID: 3, SeasonID: 1, HomeTeamID: 1, AwayTeamID: 3, RefereeID: &referee, Date: time.Unix(1600000000, 0)},
+			{ID: 4, SeasonID: 1, HomeTeamID: 2, AwayTeamID: 4, Date: played},
+		}
+
+		for _, f := range fixtures {
+			if err := fixtureRepo.Insert(f); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		cards := []*app.CardEvent{
+			newRefereeCardEvent(1, 1, "yellowcard"),
+			newRefereeCardEvent(2, 1, "yellowcard"),
+			newRefereeCardEvent(3, 1, "yellowred"),
+			newRefereeCardEvent(4, 2, "redcard"),
+			newRefereeCardEvent(5, 4, "yellowcard"),
+		}
+
+		for _, c := range cards {
+			if err := eventRepo.InsertCardEvent(c); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		for i, id := range []uint64{1, 2, 4} {
+			fouls := 10 + i
+
+			s := newTeamStats(id, 1)
+			s.Fouls = &fouls
+
+			if err := statsRepo.InsertTeamStats(s); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+	}
+
+	t.Run("aggregates stats for fixtures officiated that have kicked off", func(t *testing.T) {
+		t.Helper()
+		defer seasonCleanUp()
+		defer fixtureCleanUp()
+		defer eventCleanUp()
+		defer statsCleanUp()
+
+		insert(t)
+
+		stats, err := repo.Stats(14532, app.RefereeStatsQuery{})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(14532), stats.RefereeID)
+		a.Equal(uint32(2), stats.Fixtures)
+		a.Equal(uint32(2), stats.YellowCards)
+		a.Equal(uint32(2), stats.RedCards)
+		a.Equal(uint32(21), stats.Fouls)
+		a.Equal(uint32(0), stats.Penalties)
+		a.Equal(float32(10.5), stats.PerGame(stats.Fouls))
+	})
+
+	t.Run("filters fixtures by competition and season", func(t *testing.T) {
+		t.Helper()
+		defer seasonCleanUp()
+		defer fixtureCleanUp()
+		defer eventCleanUp()
+		defer statsCleanUp()
+
+		insert(t)
+
+		stats, err := repo.Stats(14532, app.RefereeStatsQuery{CompetitionIDs: []uint64{24}})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, uint32(1), stats.Fixtures)
+		assert.Equal(t, uint32(0), stats.YellowCards)
+		assert.Equal(t, uint32(1), stats.RedCards)
+
+		stats, err = repo.Stats(14532, app.RefereeStatsQuery{SeasonIDs: []uint64{1}})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, uint32(1), stats.Fixtures)
+		assert.Equal(t, uint32(2), stats.YellowCards)
+	})
+}
+
+func newReferee(id uint64) *app.Referee {
+	return &app.Referee{
+		ID:         id,
+		CommonName: "M. Oliver",
+		FullName:   "Michael Oliver",
+		FirstName:  "Michael",
+		LastName:   "Oliver",
+	}
+}
+
+func newRefereeCardEvent(id, fixtureID uint64, cardType string) *app.CardEvent {
+	c := newCardEvent(id, fixtureID)
+	c.Type = cardType
+	return c
+}
@@ -13,14 +13,17 @@ type ResultRepository struct {
 	clock      clockwork.Clock
 }
 
-func (p *ResultRepository) Insert(r *app.Result) error {
+func (p *ResultRepository) Insert(r *app.Result, events ...app.DomainEvent) error {
 	query := `
 	INSERT INTO sportmonks_result (fixture_id, pitch_condition, home_formation, away_formation, home_score, away_score,
 	home_pen_score, away_pen_score, half_time_score, full_time_score, extra_time_score, home_league_position,
 	away_league_position, minutes, added_time, extra_time, injury_time, created_at, updated_at) VALUES ($1, $2, 
 	$3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)`
 
-	_, err := p.connection.Exec(
+	err := writeWithEvents(
+		p.connection,
+		p.clock,
+		events,
 		query,
 		r.FixtureID,
 		r.PitchCondition,
@@ -46,7 +49,7 @@ func (p *ResultRepository) Insert(r *app.Result) error {
 	return err
 }
 
-func (p *ResultRepository) Update(r *app.Result) error {
+func (p *ResultRepository) Update(r *app.Result, events ...app.DomainEvent) error {
 	_, err := p.ByFixtureID(r.FixtureID)
 
 	if err != nil {
@@ -59,7 +62,10 @@ func (p *ResultRepository) Update(r *app.Result) error {
 	extra_time_score = $11, home_league_position = $12, away_league_position = $13, minutes = $14, 
 	added_time = $15, extra_time = $16, injury_time = $17, updated_at = $18 WHERE fixture_id = $1`
 
-	_, err = p.connection.Exec(
+	err = writeWithEvents(
+		p.connection,
+		p.clock,
+		events,
 		query,
 		r.FixtureID,
 		r.PitchCondition,
@@ -91,7 +97,51 @@ func (p *ResultRepository) ByFixtureID(id uint64) (*app.Result, error) {
 	return rowToResult(row, id)
 }
 
+func (p *ResultRepository) BySeasonID(seasonID uint64) ([]app.Result, error) {
+	query := `
+	SELECT sportmonks_result.* FROM sportmonks_result
+	JOIN sportmonks_fixture ON sportmonks_fixture.id = sportmonks_result.fixture_id
+	WHERE sportmonks_fixture.season_id = $1 ORDER BY sportmonks_result.fixture_id ASC`
+
+	rows, err := p.connection.Query(query, seasonID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var results []app.Result
+
+	for rows.Next() {
+		m, err := scanResult(rows)
+
+		if err != nil {
+			return results, err
+		}
+
+		results = append(results, *m)
+	}
+
+	return results, rows.Err()
+}
+
 func rowToResult(r *sql.Row, id uint64) (*app.Result, error) {
+	m, err := scanResult(r)
+
+	if err != nil {
+		return m, fmt.Errorf("result with ID %d does not exist", id)
+	}
+
+	return m, nil
+}
+
+// scanner is satisfied by both *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanResult(r scanner) (*app.Result, error) {
 	var created int64
 	var updated int64
 
@@ -120,7 +170,7 @@ func rowToResult(r *sql.Row, id uint64) (*app.Result, error) {
 	)
 
 	if err != nil {
-		return &m, fmt.Errorf("result with ID %d does not exist", id)
+		return &m, err
 	}
 
 	m.CreatedAt = time.Unix(created, 0)
@@ -102,6 +102,61 @@ func TestResultRepository_ByFixtureID(t *testing.T) {
 	})
 }
 
+func TestResultRepository_BySeasonID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_result")
+	fixtureConn, fixtureCleanUp := test.GetConnection(t, "sportmonks_fixture")
+	repo := postgres.NewResultRepository(conn, test.Clock)
+	fixtureRepo := postgres.NewFixtureRepository(fixtureConn, test.Clock)
+
+	t.Run("returns the results of every fixture in the season", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+		defer fixtureCleanUp()
+
+		fixtures := []*app.Fixture{
+			newFixture(2, 16036, 1, 2),
+			newFixture(1, 16036, 3, 4),
+			newFixture(3, 17420, 1, 3),
+		}
+
+		for _, f := range fixtures {
+			if err := fixtureRepo.Insert(f); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+
+			if err := repo.Insert(newResult(f.ID)); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		results, err := repo.BySeasonID(16036)
+
+		if err != nil {
+			t.Fatalf("Error when retrieving records from the database: %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(results))
+		a.Equal(uint64(1), results[0].FixtureID)
+		a.Equal(uint64(2), results[1].FixtureID)
+	})
+
+	t.Run("returns an empty slice if the season has no results", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+		defer fixtureCleanUp()
+
+		results, err := repo.BySeasonID(16036)
+
+		if err != nil {
+			t.Fatalf("Error when retrieving records from the database: %s", err.Error())
+		}
+
+		assert.Equal(t, 0, len(results))
+	})
+}
+
 func TestUpdate(t *testing.T) {
 	conn, cleanUp := test.GetConnection(t, "sportmonks_result")
 	repo := postgres.NewResultRepository(conn, test.Clock)
@@ -64,6 +64,39 @@ func (p *RoundRepository) Update(r *app.Round) error {
 	return err
 }
 
+// BySeasonID returns the rounds of a season ordered by start date.
+func (p *RoundRepository) BySeasonID(id uint64) ([]app.Round, error) {
+	query := `SELECT * FROM sportmonks_round where season_id = $1 ORDER BY start_date ASC, id ASC`
+
+	rows, err := p.connection.Query(query, id)
+
+	if err != nil {
+		return []app.Round{}, err
+	}
+
+	defer rows.Close()
+
+	var rounds []app.Round
+
+	for rows.Next() {
+		var start, end, created, updated int64
+		var m = app.Round{}
+
+		if err := rows.Scan(&m.ID, &m.Name, &m.SeasonID, &start, &end, &created, &updated); err != nil {
+			return rounds, err
+		}
+
+		m.StartDate = time.Unix(start, 0)
+		m.EndDate = time.Unix(end, 0)
+		m.CreatedAt = time.Unix(created, 0)
+		m.UpdatedAt = time.Unix(updated, 0)
+
+		rounds = append(rounds, m)
+	}
+
+	return rounds, nil
+}
+
 func rowToRound(r *sql.Row) (*app.Round, error) {
 	var start int64
 	var end int64
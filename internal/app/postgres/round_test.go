@@ -92,6 +92,42 @@ func TestRoundRepository_GetByID(t *testing.T) {
 	})
 }
 
+func TestRoundRepository_BySeasonID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_round")
+	repo := postgres.NewRoundRepository(conn, test.Clock)
+
+	t.Run("returns rounds for season ordered by start date", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		one := newRound(1)
+		one.StartDate = time.Unix(1548691729, 0)
+
+		two := newRound(2)
+
+		three := newRound(3)
+		three.SeasonID = 16036
+
+		for _, r := range []*app.Round{one, two, three} {
+			if err := repo.Insert(r); err != nil {
+				t.Errorf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		rounds, err := repo.BySeasonID(4387)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(rounds))
+		a.Equal(uint64(2), rounds[0].ID)
+		a.Equal(uint64(1), rounds[1].ID)
+	})
+}
+
 func TestRoundRepository_Update(t *testing.T) {
 	conn, cleanUp := test.GetConnection(t, "sportmonks_round")
 	repo := postgres.NewRoundRepository(conn, test.Clock)
@@ -135,6 +135,30 @@ func (r *SeasonRepository) ByCompetitionId(id uint64, sort string) ([]app.Season
 	return rowsToSeasonSlice(rows)
 }
 
+func (r *SeasonRepository) ByCompetitionIds(ids []uint64, sort string) ([]app.Season, error) {
+	builder := r.queryBuilder()
+
+	query := builder.Select("*").
+		From("sportmonks_season").
+		Where(sq.Eq{"league_id": ids})
+
+	if sort == "name_asc" {
+		query = query.OrderBy("name ASC")
+	}
+
+	if sort == "name_desc" {
+		query = query.OrderBy("name DESC")
+	}
+
+	rows, err := query.Query()
+
+	if err != nil {
+		return []app.Season{}, err
+	}
+
+	return rowsToSeasonSlice(rows)
+}
+
 func (r *SeasonRepository) ByTeamId(id uint64, sort string) ([]app.Season, error) {
 	builder := r.queryBuilder()
 
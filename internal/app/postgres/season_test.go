@@ -276,6 +276,40 @@ func TestSeasonRepository_ByCompetitionId(t *testing.T) {
 	})
 }
 
+func TestSeasonRepository_ByCompetitionIds(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_season")
+	repo := postgres.NewSeasonRepository(conn, test.Clock)
+
+	t.Run("returns the seasons of every competition provided sorted by name descending", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		seasons := []*app.Season{
+			newSeason(1, 16036, "2019-2020", false),
+			newSeason(2, 12068, "2020-2021", true),
+			newSeason(3, 16036, "2018-2019", false),
+			newSeason(4, 501, "2020-2021", true),
+		}
+
+		for _, s := range seasons {
+			if err := repo.Insert(s); err != nil {
+				t.Errorf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		fetched, err := repo.ByCompetitionIds([]uint64{16036, 12068}, "name_desc")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 3, len(fetched))
+		assert.Equal(t, uint64(2), fetched[0].ID)
+		assert.Equal(t, uint64(1), fetched[1].ID)
+		assert.Equal(t, uint64(3), fetched[2].ID)
+	})
+}
+
 func TestSeasonRepository_ByTeamId(t *testing.T) {
 	seasonConn, seasonCleanUp := test.GetConnection(t, "sportmonks_season")
 	seasonRepo := postgres.NewSeasonRepository(seasonConn, test.Clock)
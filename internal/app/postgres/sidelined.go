@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"time"
+)
+
+type SidelinedRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+// ReplaceForTeam replaces the sidelined records for a team and season within a single transaction.
+func (r *SidelinedRepository) ReplaceForTeam(t *app.TeamSidelined) error {
+	tx, err := r.connection.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`DELETE FROM sportmonks_sidelined where team_id = $1 AND season_id = $2`, t.TeamID, t.SeasonID)
+
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	query := `
+	INSERT INTO sportmonks_sidelined (player_id, team_id, season_id, reason, type, start_date, expected_end_date,
+	created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	for _, s := range t.Sidelined {
+		var end *int64
+
+		if s.ExpectedEndDate != nil {
+			e := s.ExpectedEndDate.Unix()
+			end = &e
+		}
+
+		created := s.CreatedAt
+
+		if created.IsZero() {
+			created = r.clock.Now()
+		}
+
+		_, err := tx.Exec(
+			query,
+			s.PlayerID,
+			t.TeamID,
+			t.SeasonID,
+			s.Reason,
+			s.Type,
+			s.StartDate.Unix(),
+			end,
+			created.Unix(),
+			r.clock.Now().Unix(),
+		)
+
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *SidelinedRepository) ByPlayerID(id uint64) ([]app.Sidelined, error) {
+	rows, err := r.queryBuilder().
+		Select(sidelinedColumns()...).
+		From("sportmonks_sidelined").
+		Where(sq.Eq{"player_id": id}).
+		OrderBy("start_date ASC").
+		Query()
+
+	if err != nil {
+		return []app.Sidelined{}, err
+	}
+
+	return rowsToSidelined(rows)
+}
+
+func (r *SidelinedRepository) ByTeamAtDate(teamID, seasonID uint64, date time.Time) ([]app.Sidelined, error) {
+	rows, err := r.queryBuilder().
+		Select(sidelinedColumns()...).
+		From("sportmonks_sidelined").
+		Where(sq.Eq{"team_id": teamID}).
+		Where(sq.Eq{"season_id": seasonID}).
+		Where(sq.LtOrEq{"start_date": date.Unix()}).
+		Where(sq.Or{sq.Eq{"expected_end_date": nil}, sq.GtOrEq{"expected_end_date": date.Unix()}}).
+		OrderBy("start_date ASC", "player_id ASC").
+		Query()
+
+	if err != nil {
+		return []app.Sidelined{}, err
+	}
+
+	return rowsToSidelined(rows)
+}
+
+func (r *SidelinedRepository) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(r.connection)
+}
+
+func sidelinedColumns() []string {
+	return []string{
+		"player_id",
+		"team_id",
+		"season_id",
+		"reason",
+		"type",
+		"start_date",
+		"expected_end_date",
+		"created_at",
+		"updated_at",
+	}
+}
+
+func rowsToSidelined(rows *sql.Rows) ([]app.Sidelined, error) {
+	defer rows.Close()
+
+	var sidelined []app.Sidelined
+
+	for rows.Next() {
+		var s app.Sidelined
+		var start, created, updated int64
+		var end sql.NullInt64
+
+		err := rows.Scan(
+			&s.PlayerID,
+			&s.TeamID,
+			&s.SeasonID,
+			&s.Reason,
+			&s.Type,
+			&start,
+			&end,
+			&created,
+			&updated,
+		)
+
+		if err != nil {
+			return sidelined, err
+		}
+
+		s.StartDate = time.Unix(start, 0)
+		s.CreatedAt = time.Unix(created, 0)
+		s.UpdatedAt = time.Unix(updated, 0)
+
+		if end.Valid {
+			e := time.Unix(end.Int64, 0)
+			s.ExpectedEndDate = &e
+		}
+
+		sidelined = append(sidelined, s)
+	}
+
+	return sidelined, nil
+}
+
+func NewSidelinedRepository(connection *sql.DB, clock clockwork.Clock) *SidelinedRepository {
+	return &SidelinedRepository{connection: connection, clock: clock}
+}
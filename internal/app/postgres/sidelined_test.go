@@ -0,0 +1,152 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSidelinedRepository_ReplaceForTeam(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_sidelined")
+	repo := postgres.NewSidelinedRepository(conn, test.Clock)
+
+	t.Run("replaces existing records for team and season only", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		first := app.TeamSidelined{
+			TeamID:   1,
+			SeasonID: 16036,
+			Sidelined: []app.Sidelined{
+				newSidelined(100, time.Unix(1575158400, 0), nil),
+				newSidelined(101, time.Unix(1575158400, 0), nil),
+			},
+		}
+
+		other := app.TeamSidelined{
+			TeamID:    1,
+			SeasonID:  13133,
+			Sidelined: []app.Sidelined{newSidelined(100, time.Unix(1546300800, 0), nil)},
+		}
+
+		for _, s := range []*app.TeamSidelined{&first, &other} {
+			if err := repo.ReplaceForTeam(s); err != nil {
+				t.Fatalf("Error when inserting records into the database: %s", err.Error())
+			}
+		}
+
+		first.Sidelined = first.Sidelined[:1]
+
+		if err := repo.ReplaceForTeam(&first); err != nil {
+			t.Fatalf("Error when replacing records in the database: %s", err.Error())
+		}
+
+		row := conn.QueryRow("select count(*) from sportmonks_sidelined")
+
+		var count int
+
+		if err := row.Scan(&count); err != nil {
+			t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+		}
+
+		assert.Equal(t, 2, count)
+	})
+}
+
+func TestSidelinedRepository_ByPlayerID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_sidelined")
+	repo := postgres.NewSidelinedRepository(conn, test.Clock)
+
+	t.Run("returns records for player ordered by start date", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		end := time.Unix(1577836800, 0)
+
+		s := app.TeamSidelined{
+			TeamID:   1,
+			SeasonID: 16036,
+			Sidelined: []app.Sidelined{
+				newSidelined(100, time.Unix(1580515200, 0), nil),
+				newSidelined(100, time.Unix(1575158400, 0), &end),
+				newSidelined(101, time.Unix(1575158400, 0), nil),
+			},
+		}
+
+		if err := repo.ReplaceForTeam(&s); err != nil {
+			t.Fatalf("Error when inserting records into the database: %s", err.Error())
+		}
+
+		records, err := repo.ByPlayerID(100)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(records))
+		a.Equal(uint64(100), records[0].PlayerID)
+		a.Equal(uint64(1), records[0].TeamID)
+		a.Equal(uint64(16036), records[0].SeasonID)
+		a.Equal("Hamstring Injury", records[0].Reason)
+		a.Equal(app.SidelinedTypeInjury, records[0].Type)
+		a.Equal(int64(1575158400), records[0].StartDate.Unix())
+		a.Equal(int64(1577836800), records[0].ExpectedEndDate.Unix())
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", records[0].CreatedAt.UTC().String())
+		a.Nil(records[1].ExpectedEndDate)
+	})
+}
+
+func TestSidelinedRepository_ByTeamAtDate(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_sidelined")
+	repo := postgres.NewSidelinedRepository(conn, test.Clock)
+
+	t.Run("returns records where player is unavailable on date", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		returned := time.Unix(1577836800, 0)
+		expected := time.Unix(1583020800, 0)
+
+		s := app.TeamSidelined{
+			TeamID:   1,
+			SeasonID: 16036,
+			Sidelined: []app.Sidelined{
+				newSidelined(100, time.Unix(1575158400, 0), &returned),
+				newSidelined(101, time.Unix(1575158400, 0), nil),
+				newSidelined(102, time.Unix(1578787200, 0), &expected),
+				newSidelined(103, time.Unix(1582934400, 0), nil),
+			},
+		}
+
+		if err := repo.ReplaceForTeam(&s); err != nil {
+			t.Fatalf("Error when inserting records into the database: %s", err.Error())
+		}
+
+		records, err := repo.ByTeamAtDate(1, 16036, time.Unix(1580515200, 0))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(records))
+		a.Equal(uint64(101), records[0].PlayerID)
+		a.Equal(uint64(102), records[1].PlayerID)
+	})
+}
+
+func newSidelined(playerID uint64, start time.Time, end *time.Time) app.Sidelined {
+	return app.Sidelined{
+		PlayerID:        playerID,
+		Reason:          "Hamstring Injury",
+		Type:            app.SidelinedTypeInjury,
+		StartDate:       start,
+		ExpectedEndDate: end,
+	}
+}
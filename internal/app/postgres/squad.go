@@ -31,7 +31,9 @@ func (r *SquadRepository) Insert(s *app.Squad) error {
 	return err
 }
 
-func (r *SquadRepository) Update(s *app.Squad) error {
+// Update modifies the squad and records the movements provided within a single transaction, so movements are only
+// recorded if the squad they were detected against is updated.
+func (r *SquadRepository) Update(s *app.Squad, movements ...app.SquadMovement) error {
 	if _, err := r.BySeasonAndTeam(s.SeasonID, s.TeamID); err != nil {
 		return err
 	}
@@ -39,7 +41,13 @@ func (r *SquadRepository) Update(s *app.Squad) error {
 	query := `
 	UPDATE sportmonks_squad set player_ids = $3, updated_at = $4 where season_id = $1 and team_id = $2`
 
-	_, err := r.connection.Exec(
+	tx, err := r.connection.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
 		query,
 		s.SeasonID,
 		s.TeamID,
@@ -47,7 +55,19 @@ func (r *SquadRepository) Update(s *app.Squad) error {
 		r.clock.Now().Unix(),
 	)
 
-	return err
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for i := range movements {
+		if err := insertSquadMovement(tx, &movements[i]); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
 }
 
 func (r *SquadRepository) BySeasonAndTeam(seasonId, teamId uint64) (*app.Squad, error) {
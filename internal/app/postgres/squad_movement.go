@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"time"
+)
+
+type SquadMovementRepository struct {
+	connection *sql.DB
+}
+
+func (r *SquadMovementRepository) Insert(m *app.SquadMovement) error {
+	return insertSquadMovement(r.connection, m)
+}
+
+// insertSquadMovement records the movement provided unless the same movement has already been recorded for the
+// same detection time, so a squad processed again within the same run does not duplicate movements.
+func insertSquadMovement(e execer, m *app.SquadMovement) error {
+	query := `
+	INSERT INTO sportmonks_squad_movement (season_id, team_id, player_id, direction, detected_at)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (season_id, team_id, player_id, direction, detected_at) DO NOTHING`
+
+	_, err := e.Exec(query, m.SeasonID, m.TeamID, m.PlayerID, m.Direction, m.DetectedAt.Unix())
+
+	return err
+}
+
+func (r *SquadMovementRepository) BySeasonAndTeam(seasonId, teamId uint64) ([]app.SquadMovement, error) {
+	rows, err := r.queryBuilder().
+		Select("season_id", "team_id", "player_id", "direction", "detected_at").
+		From("sportmonks_squad_movement").
+		Where(sq.Eq{"season_id": seasonId}).
+		Where(sq.Eq{"team_id": teamId}).
+		OrderBy("detected_at ASC", "id ASC").
+		Query()
+
+	if err != nil {
+		return []app.SquadMovement{}, err
+	}
+
+	return rowsToSquadMovements(rows)
+}
+
+func (r *SquadMovementRepository) ByPlayerID(id uint64) ([]app.SquadMovement, error) {
+	rows, err := r.queryBuilder().
+		Select("season_id", "team_id", "player_id", "direction", "detected_at").
+		From("sportmonks_squad_movement").
+		Where(sq.Eq{"player_id": id}).
+		OrderBy("detected_at ASC", "id ASC").
+		Query()
+
+	if err != nil {
+		return []app.SquadMovement{}, err
+	}
+
+	return rowsToSquadMovements(rows)
+}
+
+func (r *SquadMovementRepository) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(r.connection)
+}
+
+func rowsToSquadMovements(rows *sql.Rows) ([]app.SquadMovement, error) {
+	defer rows.Close()
+
+	var movements []app.SquadMovement
+
+	for rows.Next() {
+		var m app.SquadMovement
+		var detected int64
+
+		if err := rows.Scan(&m.SeasonID, &m.TeamID, &m.PlayerID, &m.Direction, &detected); err != nil {
+			return movements, err
+		}
+
+		m.DetectedAt = time.Unix(detected, 0)
+
+		movements = append(movements, m)
+	}
+
+	return movements, nil
+}
+
+func NewSquadMovementRepository(connection *sql.DB) *SquadMovementRepository {
+	return &SquadMovementRepository{connection: connection}
+}
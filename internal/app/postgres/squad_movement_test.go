@@ -0,0 +1,144 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSquadMovementRepository_Insert(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_squad_movement")
+	repo := postgres.NewSquadMovementRepository(conn)
+
+	t.Run("increases table count", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		for i := 1; i < 4; i++ {
+			m := newSquadMovement(12962, 1, uint64(i), app.SquadMovementJoined, 1547465100)
+
+			if err := repo.Insert(&m); err != nil {
+				t.Errorf("Error when inserting record into the database: %s", err.Error())
+			}
+
+			row := conn.QueryRow("select count(*) from sportmonks_squad_movement")
+
+			var count int
+
+			if err := row.Scan(&count); err != nil {
+				t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+			}
+
+			assert.Equal(t, i, count)
+		}
+	})
+
+	t.Run("does not record the same movement twice", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		for i := 0; i < 2; i++ {
+			m := newSquadMovement(12962, 1, 57, app.SquadMovementLeft, 1547465100)
+
+			if err := repo.Insert(&m); err != nil {
+				t.Errorf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		row := conn.QueryRow("select count(*) from sportmonks_squad_movement")
+
+		var count int
+
+		if err := row.Scan(&count); err != nil {
+			t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+		}
+
+		assert.Equal(t, 1, count)
+	})
+}
+
+func TestSquadMovementRepository_BySeasonAndTeam(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_squad_movement")
+	repo := postgres.NewSquadMovementRepository(conn)
+
+	t.Run("returns movements for season and team ordered by detected date", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		movements := []app.SquadMovement{
+			newSquadMovement(12962, 1, 102, app.SquadMovementJoined, 1547551500),
+			newSquadMovement(12962, 1, 57, app.SquadMovementLeft, 1547465100),
+			newSquadMovement(12962, 14, 300, app.SquadMovementJoined, 1547465100),
+			newSquadMovement(13133, 1, 400, app.SquadMovementJoined, 1547465100),
+		}
+
+		for _, m := range movements {
+			if err := repo.Insert(&m); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		fetched, err := repo.BySeasonAndTeam(12962, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(fetched))
+		a.Equal(uint64(57), fetched[0].PlayerID)
+		a.Equal(app.SquadMovementLeft, fetched[0].Direction)
+		a.Equal(uint64(12962), fetched[0].SeasonID)
+		a.Equal(uint64(1), fetched[0].TeamID)
+		a.Equal(int64(1547465100), fetched[0].DetectedAt.Unix())
+		a.Equal(uint64(102), fetched[1].PlayerID)
+	})
+}
+
+func TestSquadMovementRepository_ByPlayerID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_squad_movement")
+	repo := postgres.NewSquadMovementRepository(conn)
+
+	t.Run("returns movements for player", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		movements := []app.SquadMovement{
+			newSquadMovement(12962, 1, 57, app.SquadMovementLeft, 1547551500),
+			newSquadMovement(12962, 14, 57, app.SquadMovementJoined, 1547465100),
+			newSquadMovement(12962, 14, 300, app.SquadMovementJoined, 1547465100),
+		}
+
+		for _, m := range movements {
+			if err := repo.Insert(&m); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		fetched, err := repo.ByPlayerID(57)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(fetched))
+		a.Equal(uint64(14), fetched[0].TeamID)
+		a.Equal(uint64(1), fetched[1].TeamID)
+	})
+}
+
+func newSquadMovement(seasonID, teamID, playerID uint64, direction string, detected int64) app.SquadMovement {
+	return app.SquadMovement{
+		SeasonID:   seasonID,
+		TeamID:     teamID,
+		PlayerID:   playerID,
+		Direction:  direction,
+		DetectedAt: time.Unix(detected, 0),
+	}
+}
@@ -110,6 +110,36 @@ func TestSquadRepository_Update(t *testing.T) {
 		a.Equal("2019-01-14 11:25:00 +0000 UTC", r.UpdatedAt.String())
 	})
 
+	t.Run("records movements provided with the update", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		movementConn, movementCleanUp := test.GetConnection(t, "sportmonks_squad_movement")
+		defer movementCleanUp()
+
+		m := newSquad(25, 62)
+
+		if err := repo.Insert(m); err != nil {
+			t.Errorf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		m.PlayerIDs = append(m.PlayerIDs, 87095)
+
+		movement := newSquadMovement(25, 62, 87095, app.SquadMovementJoined, 1547465100)
+
+		if err := repo.Update(m, movement); err != nil {
+			t.Errorf("Error when updating a record in the database: %s", err.Error())
+		}
+
+		movements, err := postgres.NewSquadMovementRepository(movementConn).BySeasonAndTeam(25, 62)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []app.SquadMovement{movement}, movements)
+	})
+
 	t.Run("returns an error if player does not exist", func(t *testing.T) {
 		t.Helper()
 		defer cleanUp()
@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"time"
+)
+
+type StageRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+func (r *StageRepository) Insert(s *app.Stage) error {
+	query := `
+	INSERT INTO sportmonks_stage (id, name, type, competition_id, season_id, sort_order, has_standings, created_at,
+	updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := r.connection.Exec(
+		query,
+		s.ID,
+		s.Name,
+		s.Type,
+		s.CompetitionID,
+		s.SeasonID,
+		s.SortOrder,
+		s.HasStandings,
+		r.clock.Now().Unix(),
+		r.clock.Now().Unix(),
+	)
+
+	return err
+}
+
+func (r *StageRepository) Update(s *app.Stage) error {
+	_, err := r.ByID(s.ID)
+
+	if err != nil {
+		return err
+	}
+
+	query := `
+	UPDATE sportmonks_stage set name = $2, type = $3, competition_id = $4, season_id = $5, sort_order = $6,
+	has_standings = $7, updated_at = $8 where id = $1`
+
+	_, err = r.connection.Exec(
+		query,
+		s.ID,
+		s.Name,
+		s.Type,
+		s.CompetitionID,
+		s.SeasonID,
+		s.SortOrder,
+		s.HasStandings,
+		r.clock.Now().Unix(),
+	)
+
+	return err
+}
+
+func (r *StageRepository) ByID(id uint64) (*app.Stage, error) {
+	query := `SELECT * FROM sportmonks_stage where id = $1`
+
+	rows, err := r.connection.Query(query, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stages, err := rowsToStages(rows)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stages) == 0 {
+		return nil, errors.ErrorNotFound
+	}
+
+	return &stages[0], nil
+}
+
+func (r *StageRepository) BySeasonID(id uint64) ([]app.Stage, error) {
+	query := `SELECT * FROM sportmonks_stage where season_id = $1 ORDER BY sort_order ASC, id ASC`
+
+	rows, err := r.connection.Query(query, id)
+
+	if err != nil {
+		return []app.Stage{}, err
+	}
+
+	return rowsToStages(rows)
+}
+
+func rowsToStages(rows *sql.Rows) ([]app.Stage, error) {
+	defer rows.Close()
+
+	var stages []app.Stage
+
+	for rows.Next() {
+		var s app.Stage
+		var created, updated int64
+
+		err := rows.Scan(
+			&s.ID,
+			&s.Name,
+			&s.Type,
+			&s.CompetitionID,
+			&s.SeasonID,
+			&s.SortOrder,
+			&s.HasStandings,
+			&created,
+			&updated,
+		)
+
+		if err != nil {
+			return stages, err
+		}
+
+		s.CreatedAt = time.Unix(created, 0)
+		s.UpdatedAt = time.Unix(updated, 0)
+
+		stages = append(stages, s)
+	}
+
+	return stages, nil
+}
+
+func NewStageRepository(connection *sql.DB, clock clockwork.Clock) *StageRepository {
+	return &StageRepository{connection: connection, clock: clock}
+}
@@ -0,0 +1,189 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestStageRepository_Insert(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_stage")
+	repo := postgres.NewStageRepository(conn, test.Clock)
+
+	t.Run("increases table count", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		for i := 1; i < 4; i++ {
+			if err := repo.Insert(newStage(uint64(i), 16036, i)); err != nil {
+				t.Errorf("Error when inserting record into the database: %s", err.Error())
+			}
+
+			row := conn.QueryRow("select count(*) from sportmonks_stage")
+
+			var count int
+
+			if err := row.Scan(&count); err != nil {
+				t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+			}
+
+			assert.Equal(t, i, count)
+		}
+	})
+
+	t.Run("returns error when ID primary key violates unique constraint", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		s := newStage(50, 16036, 1)
+
+		if err := repo.Insert(s); err != nil {
+			t.Errorf("Test failed, expected nil, got %s", err)
+		}
+
+		if e := repo.Insert(s); e == nil {
+			t.Fatalf("Test failed, expected %s, got nil", e)
+		}
+	})
+}
+
+func TestStageRepository_ByID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_stage")
+	repo := postgres.NewStageRepository(conn, test.Clock)
+
+	t.Run("stage can be retrieved by ID", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		if err := repo.Insert(newStage(77443862, 16036, 2)); err != nil {
+			t.Errorf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		s, err := repo.ByID(77443862)
+
+		if err != nil {
+			t.Fatalf("Error when retrieving a record from the database: %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(uint64(77443862), s.ID)
+		a.Equal("Round of 16", s.Name)
+		a.Equal("Knock Out", *s.Type)
+		a.Equal(uint64(2), s.CompetitionID)
+		a.Equal(uint64(16036), s.SeasonID)
+		a.Equal(2, *s.SortOrder)
+		a.False(s.HasStandings)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", s.CreatedAt.UTC().String())
+	})
+
+	t.Run("returns error if stage does not exist", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		_, err := repo.ByID(99)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, errors.ErrorNotFound, err)
+	})
+}
+
+func TestStageRepository_Update(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_stage")
+	repo := postgres.NewStageRepository(conn, test.Clock)
+
+	t.Run("modifies existing stage", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		s := newStage(77443862, 16036, 2)
+
+		if err := repo.Insert(s); err != nil {
+			t.Errorf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		s.Name = "Quarter-finals"
+		s.HasStandings = true
+
+		if err := repo.Update(s); err != nil {
+			t.Fatalf("Error when updating a record in the database: %s", err.Error())
+		}
+
+		r, err := repo.ByID(77443862)
+
+		if err != nil {
+			t.Fatalf("Error when retrieving a record from the database: %s", err.Error())
+		}
+
+		assert.Equal(t, "Quarter-finals", r.Name)
+		assert.True(t, r.HasStandings)
+	})
+
+	t.Run("returns an error if stage does not exist", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		err := repo.Update(newStage(146, 16036, 1))
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, errors.ErrorNotFound, err)
+	})
+}
+
+func TestStageRepository_BySeasonID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_stage")
+	repo := postgres.NewStageRepository(conn, test.Clock)
+
+	t.Run("returns stages for season ordered by sort order", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		for _, s := range []*app.Stage{
+			newStage(3, 16036, 3),
+			newStage(1, 16036, 1),
+			newStage(2, 16036, 2),
+			newStage(4, 17420, 1),
+		} {
+			if err := repo.Insert(s); err != nil {
+				t.Errorf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		stages, err := repo.BySeasonID(16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 3, len(stages))
+		assert.Equal(t, uint64(1), stages[0].ID)
+		assert.Equal(t, uint64(2), stages[1].ID)
+		assert.Equal(t, uint64(3), stages[2].ID)
+	})
+}
+
+func newStage(id, seasonID uint64, order int) *app.Stage {
+	t := "Knock Out"
+
+	return &app.Stage{
+		ID:            id,
+		Name:          "Round of 16",
+		Type:          &t,
+		CompetitionID: 2,
+		SeasonID:      seasonID,
+		SortOrder:     &order,
+		HasStandings:  false,
+		CreatedAt:     time.Unix(1546965200, 0),
+		UpdatedAt:     time.Unix(1546965200, 0),
+	}
+}
@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"time"
+)
+
+type StandingsRulesRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+// Save inserts the rules provided or replaces the existing rules for the competition.
+func (r *StandingsRulesRepository) Save(s *app.StandingsRules) error {
+	query := `
+	INSERT INTO standings_rules (competition_id, points_for_win, points_for_draw, points_for_loss, tie_breakers,
+	created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7)
+	ON CONFLICT (competition_id) DO UPDATE SET points_for_win = $2, points_for_draw = $3, points_for_loss = $4,
+	tie_breakers = $5, updated_at = $7`
+
+	_, err := r.connection.Exec(
+		query,
+		s.CompetitionID,
+		s.PointsForWin,
+		s.PointsForDraw,
+		s.PointsForLoss,
+		pq.Array(s.TieBreakers),
+		r.clock.Now().Unix(),
+		r.clock.Now().Unix(),
+	)
+
+	return err
+}
+
+func (r *StandingsRulesRepository) ByCompetitionID(id uint64) (*app.StandingsRules, error) {
+	query := `SELECT * FROM standings_rules where competition_id = $1`
+
+	var s app.StandingsRules
+	var created, updated int64
+
+	err := r.connection.QueryRow(query, id).Scan(
+		&s.CompetitionID,
+		&s.PointsForWin,
+		&s.PointsForDraw,
+		&s.PointsForLoss,
+		pq.Array(&s.TieBreakers),
+		&created,
+		&updated,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, errors.ErrorNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.CreatedAt = time.Unix(created, 0)
+	s.UpdatedAt = time.Unix(updated, 0)
+
+	return &s, nil
+}
+
+func NewStandingsRulesRepository(connection *sql.DB, clock clockwork.Clock) *StandingsRulesRepository {
+	return &StandingsRulesRepository{connection: connection, clock: clock}
+}
+
+type StandingsSnapshotRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+// Save replaces the snapshot for the season, round and table within a single transaction.
+func (r *StandingsSnapshotRepository) Save(s *app.StandingsSnapshot) error {
+	tx, err := r.connection.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		`DELETE FROM standings_snapshot where season_id = $1 AND round_id = $2 AND table_type = $3`,
+		s.SeasonID,
+		s.RoundID,
+		s.Table,
+	)
+
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	query := `
+	INSERT INTO standings_snapshot (season_id, round_id, table_type, stage_id, group_id, position, team_id, played,
+	won, drawn, lost, goals_for, goals_against, points, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`
+
+	for _, x := range s.Standings {
+		_, err := tx.Exec(
+			query,
+			s.SeasonID,
+			s.RoundID,
+			s.Table,
+			x.StageID,
+			x.GroupID,
+			x.Position,
+			x.TeamID,
+			x.Played,
+			x.Won,
+			x.Drawn,
+			x.Lost,
+			x.GoalsFor,
+			x.GoalsAgainst,
+			x.Points,
+			r.clock.Now().Unix(),
+		)
+
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *StandingsSnapshotRepository) ByRound(seasonID, roundID uint64, table string) (*app.StandingsSnapshot, error) {
+	query := `
+	SELECT stage_id, group_id, position, team_id, played, won, drawn, lost, goals_for, goals_against, points,
+	created_at FROM standings_snapshot where season_id = $1 AND round_id = $2 AND table_type = $3
+	ORDER BY stage_id ASC NULLS FIRST, group_id ASC NULLS FIRST, position ASC`
+
+	rows, err := r.connection.Query(query, seasonID, roundID, table)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	snapshot := app.StandingsSnapshot{SeasonID: seasonID, RoundID: roundID, Table: table}
+
+	for rows.Next() {
+		var s app.Standing
+		var created int64
+
+		err := rows.Scan(
+			&s.StageID,
+			&s.GroupID,
+			&s.Position,
+			&s.TeamID,
+			&s.Played,
+			&s.Won,
+			&s.Drawn,
+			&s.Lost,
+			&s.GoalsFor,
+			&s.GoalsAgainst,
+			&s.Points,
+			&created,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		snapshot.CreatedAt = time.Unix(created, 0)
+		snapshot.Standings = append(snapshot.Standings, s)
+	}
+
+	if len(snapshot.Standings) == 0 {
+		return nil, errors.ErrorNotFound
+	}
+
+	return &snapshot, nil
+}
+
+func NewStandingsSnapshotRepository(connection *sql.DB, clock clockwork.Clock) *StandingsSnapshotRepository {
+	return &StandingsSnapshotRepository{connection: connection, clock: clock}
+}
@@ -0,0 +1,171 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestStandingsRulesRepository_Save(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "standings_rules")
+	repo := postgres.NewStandingsRulesRepository(conn, test.Clock)
+
+	t.Run("inserts new rules and replaces existing rules for a competition", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		rules := app.DefaultStandingsRules(8)
+
+		if err := repo.Save(&rules); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		rules.PointsForWin = 2
+		rules.TieBreakers = []string{app.TieBreakHeadToHead, app.TieBreakGoalDifference}
+
+		if err := repo.Save(&rules); err != nil {
+			t.Fatalf("Error when updating record in the database: %s", err.Error())
+		}
+
+		row := conn.QueryRow("select count(*) from standings_rules")
+
+		var count int
+
+		if err := row.Scan(&count); err != nil {
+			t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+		}
+
+		r, err := repo.ByCompetitionID(8)
+
+		if err != nil {
+			t.Fatalf("Error when retrieving a record from the database: %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(1, count)
+		a.Equal(uint64(8), r.CompetitionID)
+		a.Equal(2, r.PointsForWin)
+		a.Equal(1, r.PointsForDraw)
+		a.Equal(0, r.PointsForLoss)
+		a.Equal([]string{"head_to_head", "goal_difference"}, r.TieBreakers)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", r.UpdatedAt.UTC().String())
+	})
+
+	t.Run("returns not found error if competition has no rules", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		_, err := repo.ByCompetitionID(8)
+
+		assert.Equal(t, errors.ErrorNotFound, err)
+	})
+}
+
+func TestStandingsSnapshotRepository_Save(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "standings_snapshot")
+	repo := postgres.NewStandingsSnapshotRepository(conn, test.Clock)
+
+	t.Run("replaces existing snapshot for season, round and table", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		snapshot := app.StandingsSnapshot{
+			SeasonID: 16036,
+			RoundID:  194967,
+			Table:    app.StandingsTableOverall,
+			Standings: []app.Standing{
+				{Position: 1, TeamID: 1, Played: 1, Won: 1, GoalsFor: 2, GoalsAgainst: 0, Points: 3},
+				{Position: 2, TeamID: 2, Played: 1, Lost: 1, GoalsFor: 0, GoalsAgainst: 2, Points: 0},
+			},
+		}
+
+		if err := repo.Save(&snapshot); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		snapshot.Standings[0].TeamID, snapshot.Standings[1].TeamID = 2, 1
+
+		if err := repo.Save(&snapshot); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		home := app.StandingsSnapshot{
+			SeasonID:  16036,
+			RoundID:   194967,
+			Table:     app.StandingsTableHome,
+			Standings: []app.Standing{{Position: 1, TeamID: 1, Played: 1, Won: 1, Points: 3}},
+		}
+
+		if err := repo.Save(&home); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		s, err := repo.ByRound(16036, 194967, app.StandingsTableOverall)
+
+		if err != nil {
+			t.Fatalf("Error when retrieving a record from the database: %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(s.Standings))
+		a.Equal(1, s.Standings[0].Position)
+		a.Equal(uint64(2), s.Standings[0].TeamID)
+		a.Equal(3, s.Standings[0].Points)
+		a.Equal(uint64(1), s.Standings[1].TeamID)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", s.CreatedAt.UTC().String())
+	})
+
+	t.Run("persists and orders standings by stage and group", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		stage := uint64(77443)
+		groupA := uint64(1)
+		groupB := uint64(2)
+
+		snapshot := app.StandingsSnapshot{
+			SeasonID: 16036,
+			RoundID:  194967,
+			Table:    app.StandingsTableOverall,
+			Standings: []app.Standing{
+				{StageID: &stage, GroupID: &groupB, Position: 1, TeamID: 1, Played: 1, Won: 1, Points: 3},
+				{StageID: &stage, GroupID: &groupA, Position: 1, TeamID: 2, Played: 1, Won: 1, Points: 3},
+				{StageID: &stage, GroupID: &groupA, Position: 2, TeamID: 3, Played: 1, Lost: 1},
+			},
+		}
+
+		if err := repo.Save(&snapshot); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		s, err := repo.ByRound(16036, 194967, app.StandingsTableOverall)
+
+		if err != nil {
+			t.Fatalf("Error when retrieving a record from the database: %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(3, len(s.Standings))
+		a.Equal(uint64(2), s.Standings[0].TeamID)
+		a.Equal(groupA, *s.Standings[0].GroupID)
+		a.Equal(uint64(3), s.Standings[1].TeamID)
+		a.Equal(uint64(1), s.Standings[2].TeamID)
+		a.Equal(groupB, *s.Standings[2].GroupID)
+		a.Equal(stage, *s.Standings[2].StageID)
+	})
+
+	t.Run("returns not found error if no snapshot exists", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		_, err := repo.ByRound(16036, 194967, app.StandingsTableOverall)
+
+		assert.Equal(t, errors.ErrorNotFound, err)
+	})
+}
@@ -0,0 +1,220 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"strconv"
+	"time"
+)
+
+type SuspensionRulesRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+// Save inserts the rules provided or replaces the existing rules for the competition.
+func (r *SuspensionRulesRepository) Save(s *app.SuspensionRules) error {
+	thresholds, err := json.Marshal(s.YellowThresholds)
+
+	if err != nil {
+		return err
+	}
+
+	query := `
+	INSERT INTO suspension_rules (competition_id, yellow_thresholds, straight_red_matches, second_yellow_matches,
+	created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)
+	ON CONFLICT (competition_id) DO UPDATE SET yellow_thresholds = $2, straight_red_matches = $3,
+	second_yellow_matches = $4, updated_at = $6`
+
+	_, err = r.connection.Exec(
+		query,
+		s.CompetitionID,
+		string(thresholds),
+		s.StraightRedMatches,
+		s.SecondYellowMatches,
+		r.clock.Now().Unix(),
+		r.clock.Now().Unix(),
+	)
+
+	return err
+}
+
+func (r *SuspensionRulesRepository) ByCompetitionID(id uint64) (*app.SuspensionRules, error) {
+	query := `SELECT * FROM suspension_rules where competition_id = $1`
+
+	var s app.SuspensionRules
+	var thresholds []byte
+	var created, updated int64
+
+	err := r.connection.QueryRow(query, id).Scan(
+		&s.CompetitionID,
+		&thresholds,
+		&s.StraightRedMatches,
+		&s.SecondYellowMatches,
+		&created,
+		&updated,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, errors.ErrorNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(thresholds, &s.YellowThresholds); err != nil {
+		return nil, err
+	}
+
+	s.CreatedAt = time.Unix(created, 0)
+	s.UpdatedAt = time.Unix(updated, 0)
+
+	return &s, nil
+}
+
+func NewSuspensionRulesRepository(connection *sql.DB, clock clockwork.Clock) *SuspensionRulesRepository {
+	return &SuspensionRulesRepository{connection: connection, clock: clock}
+}
+
+type SuspensionRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+// ReplaceForTeam replaces the suspensions for a team and season within a single transaction.
+func (r *SuspensionRepository) ReplaceForTeam(t *app.TeamSuspensions) error {
+	tx, err := r.connection.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`DELETE FROM suspension where team_id = $1 AND season_id = $2`, t.TeamID, t.SeasonID)
+
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	query := `
+	INSERT INTO suspension (player_id, team_id, season_id, reason, trigger_fixture_id, matches, fixture_ids,
+	created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	for _, s := range t.Suspensions {
+		_, err := tx.Exec(
+			query,
+			s.PlayerID,
+			t.TeamID,
+			t.SeasonID,
+			s.Reason,
+			s.TriggerFixtureID,
+			s.Matches,
+			pq.Array(s.FixtureIDs),
+			r.clock.Now().Unix(),
+		)
+
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *SuspensionRepository) ByFixtureID(id uint64) ([]app.Suspension, error) {
+	rows, err := r.queryBuilder().
+		Select(suspensionColumns()...).
+		From("suspension").
+		Where("fixture_ids @> ARRAY[?]::INTEGER[]", id).
+		OrderBy("team_id ASC", "player_id ASC").
+		Query()
+
+	if err != nil {
+		return []app.Suspension{}, err
+	}
+
+	return rowsToSuspensions(rows)
+}
+
+func (r *SuspensionRepository) ByPlayerID(id uint64) ([]app.Suspension, error) {
+	rows, err := r.queryBuilder().
+		Select(suspensionColumns()...).
+		From("suspension").
+		Where(sq.Eq{"player_id": id}).
+		OrderBy("season_id ASC", "trigger_fixture_id ASC").
+		Query()
+
+	if err != nil {
+		return []app.Suspension{}, err
+	}
+
+	return rowsToSuspensions(rows)
+}
+
+func (r *SuspensionRepository) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(r.connection)
+}
+
+func suspensionColumns() []string {
+	return []string{
+		"player_id",
+		"team_id",
+		"season_id",
+		"reason",
+		"trigger_fixture_id",
+		"matches",
+		"fixture_ids",
+		"created_at",
+	}
+}
+
+func rowsToSuspensions(rows *sql.Rows) ([]app.Suspension, error) {
+	defer rows.Close()
+
+	var suspensions []app.Suspension
+
+	for rows.Next() {
+		var s app.Suspension
+		var fixtures []string
+		var created int64
+
+		err := rows.Scan(
+			&s.PlayerID,
+			&s.TeamID,
+			&s.SeasonID,
+			&s.Reason,
+			&s.TriggerFixtureID,
+			&s.Matches,
+			pq.Array(&fixtures),
+			&created,
+		)
+
+		if err != nil {
+			return suspensions, err
+		}
+
+		s.FixtureIDs = []uint64{}
+
+		for _, f := range fixtures {
+			id, _ := strconv.Atoi(f)
+			s.FixtureIDs = append(s.FixtureIDs, uint64(id))
+		}
+
+		s.CreatedAt = time.Unix(created, 0)
+
+		suspensions = append(suspensions, s)
+	}
+
+	return suspensions, nil
+}
+
+func NewSuspensionRepository(connection *sql.DB, clock clockwork.Clock) *SuspensionRepository {
+	return &SuspensionRepository{connection: connection, clock: clock}
+}
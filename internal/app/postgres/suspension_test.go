@@ -0,0 +1,210 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSuspensionRulesRepository_Save(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "suspension_rules")
+	repo := postgres.NewSuspensionRulesRepository(conn, test.Clock)
+
+	t.Run("inserts new rules and replaces existing rules for a competition", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		rules := app.DefaultSuspensionRules(8)
+
+		if err := repo.Save(&rules); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		rules.YellowThresholds = []app.YellowCardThreshold{{Yellows: 5, Matches: 1, Before: 19}}
+		rules.StraightRedMatches = 1
+
+		if err := repo.Save(&rules); err != nil {
+			t.Fatalf("Error when updating record in the database: %s", err.Error())
+		}
+
+		row := conn.QueryRow("select count(*) from suspension_rules")
+
+		var count int
+
+		if err := row.Scan(&count); err != nil {
+			t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+		}
+
+		r, err := repo.ByCompetitionID(8)
+
+		if err != nil {
+			t.Fatalf("Error when retrieving a record from the database: %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(1, count)
+		a.Equal(uint64(8), r.CompetitionID)
+		a.Equal([]app.YellowCardThreshold{{Yellows: 5, Matches: 1, Before: 19}}, r.YellowThresholds)
+		a.Equal(1, r.StraightRedMatches)
+		a.Equal(1, r.SecondYellowMatches)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", r.UpdatedAt.UTC().String())
+	})
+
+	t.Run("returns not found error if competition has no rules", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		_, err := repo.ByCompetitionID(8)
+
+		assert.Equal(t, errors.ErrorNotFound, err)
+	})
+}
+
+func TestSuspensionRepository_ReplaceForTeam(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "suspension")
+	repo := postgres.NewSuspensionRepository(conn, test.Clock)
+
+	t.Run("replaces existing suspensions for team and season only", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		first := app.TeamSuspensions{
+			TeamID:   1,
+			SeasonID: 16036,
+			Suspensions: []app.Suspension{
+				newSuspension(100, 1, 16036, 55, []uint64{56}),
+				newSuspension(101, 1, 16036, 57, []uint64{58}),
+			},
+		}
+
+		other := app.TeamSuspensions{
+			TeamID:      1,
+			SeasonID:    13133,
+			Suspensions: []app.Suspension{newSuspension(100, 1, 13133, 10, []uint64{11})},
+		}
+
+		for _, s := range []*app.TeamSuspensions{&first, &other} {
+			if err := repo.ReplaceForTeam(s); err != nil {
+				t.Fatalf("Error when inserting records into the database: %s", err.Error())
+			}
+		}
+
+		first.Suspensions = first.Suspensions[:1]
+
+		if err := repo.ReplaceForTeam(&first); err != nil {
+			t.Fatalf("Error when replacing records in the database: %s", err.Error())
+		}
+
+		row := conn.QueryRow("select count(*) from suspension")
+
+		var count int
+
+		if err := row.Scan(&count); err != nil {
+			t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+		}
+
+		assert.Equal(t, 2, count)
+	})
+}
+
+func TestSuspensionRepository_ByFixtureID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "suspension")
+	repo := postgres.NewSuspensionRepository(conn, test.Clock)
+
+	t.Run("returns suspensions banning players from fixture", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		home := app.TeamSuspensions{
+			TeamID:   1,
+			SeasonID: 16036,
+			Suspensions: []app.Suspension{
+				newSuspension(100, 1, 16036, 55, []uint64{56, 58, 60}),
+				newSuspension(101, 1, 16036, 55, []uint64{56}),
+			},
+		}
+
+		away := app.TeamSuspensions{
+			TeamID:      2,
+			SeasonID:    16036,
+			Suspensions: []app.Suspension{newSuspension(200, 2, 16036, 57, []uint64{58})},
+		}
+
+		for _, s := range []*app.TeamSuspensions{&home, &away} {
+			if err := repo.ReplaceForTeam(s); err != nil {
+				t.Fatalf("Error when inserting records into the database: %s", err.Error())
+			}
+		}
+
+		suspensions, err := repo.ByFixtureID(58)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(suspensions))
+		a.Equal(uint64(100), suspensions[0].PlayerID)
+		a.Equal(uint64(1), suspensions[0].TeamID)
+		a.Equal(uint64(16036), suspensions[0].SeasonID)
+		a.Equal(app.SuspensionReasonStraightRed, suspensions[0].Reason)
+		a.Equal(uint64(55), suspensions[0].TriggerFixtureID)
+		a.Equal(3, suspensions[0].Matches)
+		a.Equal([]uint64{56, 58, 60}, suspensions[0].FixtureIDs)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", suspensions[0].CreatedAt.UTC().String())
+		a.Equal(uint64(200), suspensions[1].PlayerID)
+	})
+}
+
+func TestSuspensionRepository_ByPlayerID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "suspension")
+	repo := postgres.NewSuspensionRepository(conn, test.Clock)
+
+	t.Run("returns suspensions for player ordered by season and trigger fixture", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		s := app.TeamSuspensions{
+			TeamID:   1,
+			SeasonID: 16036,
+			Suspensions: []app.Suspension{
+				newSuspension(100, 1, 16036, 70, []uint64{71}),
+				newSuspension(100, 1, 16036, 55, []uint64{56}),
+				newSuspension(101, 1, 16036, 55, []uint64{56}),
+			},
+		}
+
+		if err := repo.ReplaceForTeam(&s); err != nil {
+			t.Fatalf("Error when inserting records into the database: %s", err.Error())
+		}
+
+		suspensions, err := repo.ByPlayerID(100)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(suspensions))
+		a.Equal(uint64(55), suspensions[0].TriggerFixtureID)
+		a.Equal(uint64(70), suspensions[1].TriggerFixtureID)
+	})
+}
+
+func newSuspension(playerID, teamID, seasonID, trigger uint64, fixtures []uint64) app.Suspension {
+	return app.Suspension{
+		PlayerID:         playerID,
+		TeamID:           teamID,
+		SeasonID:         seasonID,
+		Reason:           app.SuspensionReasonStraightRed,
+		TriggerFixtureID: trigger,
+		Matches:          3,
+		FixtureIDs:       fixtures,
+	}
+}
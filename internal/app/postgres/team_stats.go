@@ -15,7 +15,7 @@ type TeamStatsRepository struct {
 	clock      clockwork.Clock
 }
 
-func (t *TeamStatsRepository) InsertTeamStats(a *app.TeamStats) error {
+func (t *TeamStatsRepository) InsertTeamStats(a *app.TeamStats, events ...app.DomainEvent) error {
 	query := `
 	INSERT INTO sportmonks_team_stats (fixture_id, team_id, goals, shots_total, shots_on_goal, shots_off_goal, shots_blocked, 
 	shots_inside_box, shots_outside_box, passes_total, passes_accuracy, passes_percentage, attacks_total, attacks_dangerous,
@@ -23,7 +23,10 @@ func (t *TeamStatsRepository) InsertTeamStats(a *app.TeamStats) error {
 	free_kicks, throw_ins, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14,
 	$15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28)`
 
-	_, err := t.connection.Exec(
+	err := writeWithEvents(
+		t.connection,
+		t.clock,
+		events,
 		query,
 		a.FixtureID,
 		a.TeamID,
@@ -58,7 +61,7 @@ func (t *TeamStatsRepository) InsertTeamStats(a *app.TeamStats) error {
 	return err
 }
 
-func (t *TeamStatsRepository) UpdateTeamStats(a *app.TeamStats) error {
+func (t *TeamStatsRepository) UpdateTeamStats(a *app.TeamStats, events ...app.DomainEvent) error {
 	if _, err := t.ByFixtureAndTeam(a.FixtureID, a.TeamID); err != nil {
 		return err
 	}
@@ -70,7 +73,10 @@ func (t *TeamStatsRepository) UpdateTeamStats(a *app.TeamStats) error {
 	possession = $17, yellow_cards = $18, red_cards = $19, saves = $20, substitutions = $21, goal_kicks = $22, 
 	goal_attempts = $23, free_kicks = $24, throw_ins = $25, updated_at = $26, goals = $27 where fixture_id = $1 AND team_id = $2`
 
-	_, err := t.connection.Exec(
+	err := writeWithEvents(
+		t.connection,
+		t.clock,
+		events,
 		query,
 		a.FixtureID,
 		a.TeamID,
@@ -13,12 +13,15 @@ type FixtureTeamXGRepository struct {
 	clock      clockwork.Clock
 }
 
-func (r *FixtureTeamXGRepository) Insert(f *app.FixtureTeamXG) error {
+func (r *FixtureTeamXGRepository) Insert(f *app.FixtureTeamXG, events ...app.DomainEvent) error {
 	query := `
 	INSERT INTO understat_fixture_team_xg (id, sportmonks_fixture_id, home, away, created_at, updated_at)
 	VALUES ($1, $2, $3, $4, $5, $6)`
 
-	_, err := r.connection.Exec(
+	err := writeWithEvents(
+		r.connection,
+		r.clock,
+		events,
 		query,
 		f.ID,
 		f.FixtureID,
@@ -31,7 +34,7 @@ func (r *FixtureTeamXGRepository) Insert(f *app.FixtureTeamXG) error {
 	return err
 }
 
-func (r *FixtureTeamXGRepository) Update(f *app.FixtureTeamXG) error {
+func (r *FixtureTeamXGRepository) Update(f *app.FixtureTeamXG, events ...app.DomainEvent) error {
 	query := `SELECT EXISTS(SELECT 1 from understat_fixture_team_xg where id = $1)`
 
 	var exists bool
@@ -42,7 +45,7 @@ func (r *FixtureTeamXGRepository) Update(f *app.FixtureTeamXG) error {
 
 	query = `UPDATE understat_fixture_team_xg set home = $2, away = $3, updated_at = $4 where id = $1`
 
-	_, err := r.connection.Exec(query, f.ID, f.Home, f.Away, r.clock.Now().Unix())
+	err := writeWithEvents(r.connection, r.clock, events, query, f.ID, f.Home, f.Away, r.clock.Now().Unix())
 
 	return err
 }
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"time"
+)
+
+type TransferRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+// Save inserts the transfer provided or updates the existing transfer of the player between the same teams on the
+// same date.
+func (r *TransferRepository) Save(t *app.Transfer) error {
+	query := `
+	INSERT INTO sportmonks_transfer (player_id, from_team_id, to_team_id, season_id, date, type, fee, created_at,
+	updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	ON CONFLICT (player_id, from_team_id, to_team_id, date) DO UPDATE SET season_id = $4, type = $6, fee = $7,
+	updated_at = $9`
+
+	_, err := r.connection.Exec(
+		query,
+		t.PlayerID,
+		t.FromTeamID,
+		t.ToTeamID,
+		t.SeasonID,
+		t.Date.Unix(),
+		t.Type,
+		t.Fee,
+		r.clock.Now().Unix(),
+		r.clock.Now().Unix(),
+	)
+
+	return err
+}
+
+func (r *TransferRepository) ByPlayerID(id uint64) ([]app.Transfer, error) {
+	query := `SELECT * FROM sportmonks_transfer where player_id = $1 ORDER BY date ASC`
+
+	rows, err := r.connection.Query(query, id)
+
+	if err != nil {
+		return []app.Transfer{}, err
+	}
+
+	defer rows.Close()
+
+	var transfers []app.Transfer
+
+	for rows.Next() {
+		var t app.Transfer
+		var season sql.NullInt64
+		var fee sql.NullString
+		var date, created, updated int64
+
+		err := rows.Scan(
+			&t.PlayerID,
+			&t.FromTeamID,
+			&t.ToTeamID,
+			&season,
+			&date,
+			&t.Type,
+			&fee,
+			&created,
+			&updated,
+		)
+
+		if err != nil {
+			return transfers, err
+		}
+
+		if season.Valid {
+			s := uint64(season.Int64)
+			t.SeasonID = &s
+		}
+
+		if fee.Valid {
+			t.Fee = &fee.String
+		}
+
+		t.Date = time.Unix(date, 0)
+		t.CreatedAt = time.Unix(created, 0)
+		t.UpdatedAt = time.Unix(updated, 0)
+
+		transfers = append(transfers, t)
+	}
+
+	return transfers, nil
+}
+
+func NewTransferRepository(connection *sql.DB, clock clockwork.Clock) *TransferRepository {
+	return &TransferRepository{connection: connection, clock: clock}
+}
@@ -0,0 +1,109 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestTransferRepository_Save(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_transfer")
+	repo := postgres.NewTransferRepository(conn, test.Clock)
+
+	t.Run("inserts new transfers and updates existing transfers", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		transfer := newTransfer(1, 14, time.Unix(1561939200, 0), nil)
+
+		if err := repo.Save(&transfer); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		fee := "€20M"
+		transfer.Fee = &fee
+
+		if err := repo.Save(&transfer); err != nil {
+			t.Fatalf("Error when updating record in the database: %s", err.Error())
+		}
+
+		row := conn.QueryRow("select count(*) from sportmonks_transfer")
+
+		var count int
+
+		if err := row.Scan(&count); err != nil {
+			t.Errorf("Error when scanning rows returned by the database: %s", err.Error())
+		}
+
+		transfers, err := repo.ByPlayerID(219591)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(1, count)
+		a.Equal("€20M", *transfers[0].Fee)
+		a.Equal(uint64(16036), *transfers[0].SeasonID)
+	})
+}
+
+func TestTransferRepository_ByPlayerID(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "sportmonks_transfer")
+	repo := postgres.NewTransferRepository(conn, test.Clock)
+
+	t.Run("returns transfers for player ordered by date", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		fee := "€20M"
+
+		transfers := []app.Transfer{
+			newTransfer(1, 14, time.Unix(1561939200, 0), &fee),
+			newTransfer(20, 1, time.Unix(1530403200, 0), nil),
+		}
+
+		for _, x := range transfers {
+			if err := repo.Save(&x); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		fetched, err := repo.ByPlayerID(219591)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(fetched))
+		a.Equal(uint64(219591), fetched[0].PlayerID)
+		a.Equal(uint64(20), fetched[0].FromTeamID)
+		a.Equal(uint64(1), fetched[0].ToTeamID)
+		a.Equal(int64(1530403200), fetched[0].Date.Unix())
+		a.Equal("Transfer", fetched[0].Type)
+		a.Nil(fetched[0].Fee)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", fetched[0].CreatedAt.UTC().String())
+		a.Equal(uint64(14), fetched[1].ToTeamID)
+		a.Equal("€20M", *fetched[1].Fee)
+	})
+}
+
+func newTransfer(from, to uint64, date time.Time, fee *string) app.Transfer {
+	season := uint64(16036)
+
+	return app.Transfer{
+		PlayerID:   219591,
+		FromTeamID: from,
+		ToTeamID:   to,
+		SeasonID:   &season,
+		Date:       date,
+		Type:       "Transfer",
+		Fee:        fee,
+	}
+}
@@ -0,0 +1,225 @@
+package postgres
+
+import (
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"time"
+)
+
+type WebhookSubscriptionRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+func (r *WebhookSubscriptionRepository) Insert(s *app.WebhookSubscription) error {
+	competitions := s.CompetitionIDs
+
+	if competitions == nil {
+		competitions = []uint64{}
+	}
+
+	s.CreatedAt = r.clock.Now()
+
+	return r.queryBuilder().
+		Insert("webhook_subscription").
+		Columns("url", "event_types", "competition_ids", "secret", "created_at").
+		Values(s.URL, pq.Array(s.EventTypes), pq.Array(competitions), s.Secret, s.CreatedAt.Unix()).
+		Suffix("RETURNING id").
+		QueryRow().
+		Scan(&s.ID)
+}
+
+func (r *WebhookSubscriptionRepository) Delete(id uint64) error {
+	res, err := r.queryBuilder().
+		Delete("webhook_subscription").
+		Where(sq.Eq{"id": id}).
+		Exec()
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return errors.ErrorNotFound
+	}
+
+	return nil
+}
+
+func (r *WebhookSubscriptionRepository) All() ([]app.WebhookSubscription, error) {
+	rows, err := r.queryBuilder().
+		Select("id", "url", "event_types", "competition_ids", "secret", "created_at").
+		From("webhook_subscription").
+		OrderBy("id ASC").
+		Query()
+
+	if err != nil {
+		return []app.WebhookSubscription{}, err
+	}
+
+	defer rows.Close()
+
+	var subs []app.WebhookSubscription
+
+	for rows.Next() {
+		var s app.WebhookSubscription
+		var created int64
+
+		err := rows.Scan(&s.ID, &s.URL, pq.Array(&s.EventTypes), pq.Array(&s.CompetitionIDs), &s.Secret, &created)
+
+		if err != nil {
+			return subs, err
+		}
+
+		s.CreatedAt = time.Unix(created, 0)
+
+		subs = append(subs, s)
+	}
+
+	return subs, rows.Err()
+}
+
+func (r *WebhookSubscriptionRepository) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(r.connection)
+}
+
+func NewWebhookSubscriptionRepository(connection *sql.DB, clock clockwork.Clock) *WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{connection: connection, clock: clock}
+}
+
+type WebhookDeliveryRepository struct {
+	connection *sql.DB
+	clock      clockwork.Clock
+}
+
+func (r *WebhookDeliveryRepository) Insert(d *app.WebhookDelivery) error {
+	d.CreatedAt = r.clock.Now()
+
+	return r.queryBuilder().
+		Insert("webhook_delivery").
+		Columns("subscription_id", "event_name", "payload", "status", "attempts", "next_attempt_at", "created_at").
+		Values(
+			d.SubscriptionID,
+			d.EventName,
+			string(d.Payload),
+			d.Status,
+			d.Attempts,
+			d.NextAttemptAt.Unix(),
+			d.CreatedAt.Unix(),
+		).
+		Suffix("RETURNING id").
+		QueryRow().
+		Scan(&d.ID)
+}
+
+func (r *WebhookDeliveryRepository) Update(d *app.WebhookDelivery) error {
+	var delivered *int64
+
+	if d.DeliveredAt != nil {
+		t := d.DeliveredAt.Unix()
+		delivered = &t
+	}
+
+	_, err := r.queryBuilder().
+		Update("webhook_delivery").
+		Set("status", d.Status).
+		Set("attempts", d.Attempts).
+		Set("next_attempt_at", d.NextAttemptAt.Unix()).
+		Set("delivered_at", delivered).
+		Where(sq.Eq{"id": d.ID}).
+		Exec()
+
+	return err
+}
+
+func (r *WebhookDeliveryRepository) Due(afterID uint64, limit uint64) ([]app.WebhookDelivery, error) {
+	rows, err := r.queryBuilder().
+		Select(
+			"id",
+			"subscription_id",
+			"event_name",
+			"payload",
+			"status",
+			"attempts",
+			"next_attempt_at",
+			"created_at",
+			"delivered_at",
+		).
+		From("webhook_delivery").
+		Where(sq.Eq{"status": app.WebhookDeliveryPending}).
+		Where(sq.LtOrEq{"next_attempt_at": r.clock.Now().Unix()}).
+		Where(sq.Gt{"id": afterID}).
+		OrderBy("id ASC").
+		Limit(limit).
+		Query()
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var deliveries []app.WebhookDelivery
+
+	for rows.Next() {
+		var d app.WebhookDelivery
+		var next, created int64
+		var delivered *int64
+
+		err := rows.Scan(
+			&d.ID,
+			&d.SubscriptionID,
+			&d.EventName,
+			&d.Payload,
+			&d.Status,
+			&d.Attempts,
+			&next,
+			&created,
+			&delivered,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		d.NextAttemptAt = time.Unix(next, 0)
+		d.CreatedAt = time.Unix(created, 0)
+
+		if delivered != nil {
+			t := time.Unix(*delivered, 0)
+			d.DeliveredAt = &t
+		}
+
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, rows.Err()
+}
+
+func (r *WebhookDeliveryRepository) InsertAttempt(a *app.WebhookDeliveryAttempt) error {
+	_, err := r.queryBuilder().
+		Insert("webhook_delivery_attempt").
+		Columns("delivery_id", "attempt", "status_code", "error", "attempted_at").
+		Values(a.DeliveryID, a.Attempt, a.StatusCode, a.Error, a.AttemptedAt.Unix()).
+		Exec()
+
+	return err
+}
+
+func (r *WebhookDeliveryRepository) queryBuilder() sq.StatementBuilderType {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(r.connection)
+}
+
+func NewWebhookDeliveryRepository(connection *sql.DB, clock clockwork.Clock) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{connection: connection, clock: clock}
+}
@@ -0,0 +1,169 @@
+package postgres_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"github.com/statistico/statistico-football-data/internal/app/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestWebhookSubscriptionRepository_Insert(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "webhook_subscription")
+	repo := postgres.NewWebhookSubscriptionRepository(conn, test.Clock)
+
+	t.Run("increases table count and returns subscriptions ordered by ID", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		one := newWebhookSubscription([]uint64{8, 564})
+		two := newWebhookSubscription(nil)
+
+		for _, s := range []*app.WebhookSubscription{one, two} {
+			if err := repo.Insert(s); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		subs, err := repo.All()
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(subs))
+		a.Equal(one.ID, subs[0].ID)
+		a.Equal("https://example.com/hooks", subs[0].URL)
+		a.Equal([]string{"result.finalised", "fixture-xg.updated"}, subs[0].EventTypes)
+		a.Equal([]uint64{8, 564}, subs[0].CompetitionIDs)
+		a.Equal("s3cr3t", subs[0].Secret)
+		a.Equal("2019-01-14 11:25:00 +0000 UTC", subs[0].CreatedAt.UTC().String())
+		a.Equal(0, len(subs[1].CompetitionIDs))
+	})
+}
+
+func TestWebhookSubscriptionRepository_Delete(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "webhook_subscription")
+	repo := postgres.NewWebhookSubscriptionRepository(conn, test.Clock)
+
+	t.Run("removes subscription from the database", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		s := newWebhookSubscription(nil)
+
+		if err := repo.Insert(s); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		if err := repo.Delete(s.ID); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		subs, err := repo.All()
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 0, len(subs))
+	})
+
+	t.Run("returns not found error if subscription does not exist", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		err := repo.Delete(99)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "the resource requested does not exist", err.Error())
+	})
+}
+
+func TestWebhookDeliveryRepository_Due(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, "webhook_subscription")
+	subRepo := postgres.NewWebhookSubscriptionRepository(conn, test.Clock)
+	repo := postgres.NewWebhookDeliveryRepository(conn, test.Clock)
+
+	t.Run("returns pending deliveries whose next attempt is due", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		s := newWebhookSubscription(nil)
+
+		if err := subRepo.Insert(s); err != nil {
+			t.Fatalf("Error when inserting record into the database: %s", err.Error())
+		}
+
+		now := test.Clock.Now()
+
+		due := newWebhookDelivery(s.ID, now.Add(-time.Minute))
+		later := newWebhookDelivery(s.ID, now.Add(time.Minute))
+		delivered := newWebhookDelivery(s.ID, now.Add(-time.Minute))
+
+		for _, d := range []*app.WebhookDelivery{due, later, delivered} {
+			if err := repo.Insert(d); err != nil {
+				t.Fatalf("Error when inserting record into the database: %s", err.Error())
+			}
+		}
+
+		delivered.Status = app.WebhookDeliveryDelivered
+		delivered.Attempts = 1
+		delivered.DeliveredAt = &now
+
+		if err := repo.Update(delivered); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		code := 500
+		reason := "webhook endpoint responded with status 500"
+
+		attempt := app.WebhookDeliveryAttempt{DeliveryID: due.ID, Attempt: 1, StatusCode: &code, Error: &reason, AttemptedAt: now}
+
+		if err := repo.InsertAttempt(&attempt); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		deliveries, err := repo.Due(0, 10)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(1, len(deliveries))
+		a.Equal(due.ID, deliveries[0].ID)
+		a.Equal(s.ID, deliveries[0].SubscriptionID)
+		a.Equal("result.finalised", deliveries[0].EventName)
+		a.JSONEq(`{"event":"result.finalised","fixture_id":45}`, string(deliveries[0].Payload))
+		a.Equal("pending", deliveries[0].Status)
+		a.Equal("2019-01-14 11:24:00 +0000 UTC", deliveries[0].NextAttemptAt.UTC().String())
+		a.Nil(deliveries[0].DeliveredAt)
+	})
+}
+
+func newWebhookSubscription(competitions []uint64) *app.WebhookSubscription {
+	return &app.WebhookSubscription{
+		URL:            "https://example.com/hooks",
+		EventTypes:     []string{"result.finalised", "fixture-xg.updated"},
+		CompetitionIDs: competitions,
+		Secret:         "s3cr3t",
+	}
+}
+
+func newWebhookDelivery(subscriptionID uint64, next time.Time) *app.WebhookDelivery {
+	return &app.WebhookDelivery{
+		SubscriptionID: subscriptionID,
+		EventName:      "result.finalised",
+		Payload:        []byte(`{"event":"result.finalised","fixture_id":45}`),
+		Status:         app.WebhookDeliveryPending,
+		NextAttemptAt:  next,
+	}
+}
@@ -0,0 +1,189 @@
+package process
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+)
+
+// seasonCheckpoints records the seasons and fixtures persisted by runs processing seasons one at a time so a run
+// that does not finish can be resumed, skipping the seasons and fixtures already persisted. A run not resuming
+// clears the checkpoints of previous attempts and the checkpoints of a run are cleared once it completes.
+type seasonCheckpoints struct {
+	checkpointRepo app.CheckpointRepository
+	resume         bool
+	logger         *logrus.Logger
+}
+
+// begin returns the progress of a run of the command processing the seasons provided, loading the seasons and
+// fixtures completed by previous attempts of the run when resuming.
+func (s seasonCheckpoints) begin(command, option string, seasonIDs []uint64) (*seasonProgress, error) {
+	p := seasonProgress{
+		run:            app.CheckpointRun(command, option),
+		checkpointRepo: s.checkpointRepo,
+		logger:         s.logger,
+		fixtures:       map[uint64]bool{},
+		total:          len(seasonIDs),
+	}
+
+	if !s.resume {
+		if err := s.checkpointRepo.Clear(p.run); err != nil {
+			s.logger.Warningf("Error '%s' occurred when clearing checkpoints for '%s'", err.Error(), p.run)
+		}
+
+		p.pending = seasonIDs
+
+		return &p, nil
+	}
+
+	seasons, err := s.checkpointRepo.Completed(p.run, app.CheckpointSeason)
+
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures, err := s.checkpointRepo.Completed(p.run, app.CheckpointFixture)
+
+	if err != nil {
+		return nil, err
+	}
+
+	completed := map[uint64]bool{}
+
+	for _, id := range seasons {
+		completed[id] = true
+	}
+
+	for _, id := range fixtures {
+		p.fixtures[id] = true
+	}
+
+	for _, id := range seasonIDs {
+		if completed[id] {
+			p.completed++
+			continue
+		}
+
+		p.pending = append(p.pending, id)
+	}
+
+	s.logger.Infof(
+		"Resuming '%s' with %d of %d seasons and %d fixtures already complete",
+		p.run,
+		p.completed,
+		p.total,
+		len(p.fixtures),
+	)
+
+	return &p, nil
+}
+
+// seasonProgress tracks the seasons and fixtures persisted by a run, recording each as it completes. Fixtures and
+// seasons that fail to persist are not recorded so they are processed again when the run is resumed.
+type seasonProgress struct {
+	run            string
+	checkpointRepo app.CheckpointRepository
+	logger         *logrus.Logger
+	pending        []uint64
+	fixtures       map[uint64]bool
+	fixture        *uint64
+	fixtureFailed  bool
+	seasonFailed   bool
+	received       bool
+	total          int
+	completed      int
+	failed         int
+}
+
+// persist is called before each item of the season in progress is persisted, returning false if the fixture the
+// item belongs to was persisted by a previous attempt of the run. Items of a fixture are received consecutively so
+// the previous fixture is recorded as persisted once an item of another fixture is received.
+func (p *seasonProgress) persist(fixtureID uint64) bool {
+	p.received = true
+
+	if p.fixture != nil && *p.fixture != fixtureID {
+		p.completeFixture()
+	}
+
+	p.fixture = &fixtureID
+
+	return !p.fixtures[fixtureID]
+}
+
+// failFixture records that an item of the fixture in progress failed to persist, neither the fixture nor its
+// season are recorded as persisted.
+func (p *seasonProgress) failFixture() {
+	p.fixtureFailed = true
+	p.seasonFailed = true
+}
+
+// failSeason records that the data of the season could not be requested, the season is not recorded as persisted
+// so it is requested again when resuming.
+func (p *seasonProgress) failSeason(seasonID uint64, err error) {
+	p.logger.Errorf("Error '%s' occurred when requesting season %d for '%s'", err.Error(), seasonID, p.run)
+
+	p.seasonFailed = true
+	p.completeSeason(seasonID)
+}
+
+// completeSeason records the season as persisted and logs the progress of the run. A season without data is not
+// recorded as the data provider does not report failed requests, such a season is requested again when resuming.
+func (p *seasonProgress) completeSeason(seasonID uint64) {
+	if p.fixture != nil {
+		p.completeFixture()
+	}
+
+	if p.seasonFailed {
+		p.failed++
+	} else if p.received {
+		p.complete(app.CheckpointSeason, seasonID)
+	}
+
+	p.fixture = nil
+	p.received = false
+	p.seasonFailed = false
+	p.completed++
+
+	p.logger.Infof(
+		"Processed season %d of '%s': %d of %d seasons complete (%d%%)",
+		seasonID,
+		p.run,
+		p.completed,
+		p.total,
+		p.completed*100/p.total,
+	)
+}
+
+// finish clears the checkpoints of the run once every season has been processed. Checkpoints are kept if a season
+// failed so resuming the run only processes the seasons and fixtures that failed.
+func (p *seasonProgress) finish() {
+	if p.failed > 0 {
+		p.logger.Warningf("'%s' finished with %d of %d seasons incomplete, resume the run to retry them", p.run, p.failed, p.total)
+		return
+	}
+
+	if err := p.checkpointRepo.Clear(p.run); err != nil {
+		p.logger.Warningf("Error '%s' occurred when clearing checkpoints for '%s'", err.Error(), p.run)
+	}
+}
+
+func (p *seasonProgress) completeFixture() {
+	id := *p.fixture
+
+	if p.fixtureFailed {
+		p.fixtureFailed = false
+		return
+	}
+
+	if p.fixtures[id] {
+		return
+	}
+
+	p.fixtures[id] = true
+	p.complete(app.CheckpointFixture, id)
+}
+
+func (p *seasonProgress) complete(unit string, id uint64) {
+	if err := p.checkpointRepo.Complete(p.run, unit, id); err != nil {
+		p.logger.Warningf("Error '%s' occurred when recording %s %d as complete for '%s'", err.Error(), unit, id, p.run)
+	}
+}
@@ -0,0 +1,299 @@
+package process
+
+import (
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/importer"
+	"io"
+	"os"
+	"time"
+)
+
+const importCSV = "import:csv"
+
+// CSVImportProcessor imports fixtures, results and team stats from CSV files described by an importer.Spec.
+// Teams are resolved by name alias using the ExternalIDResolver and rows that cannot be resolved are written
+// to the reject file configured by the spec.
+type CSVImportProcessor struct {
+	fixtureRepo   app.FixtureRepository
+	resultRepo    app.ResultRepository
+	teamStatsRepo app.TeamStatsRepository
+	idGenerator   app.FixtureIDGenerator
+	resolver      app.ExternalIDResolver
+	logger        *logrus.Logger
+}
+
+func (c CSVImportProcessor) Process(command string, option string, done chan bool) {
+	switch command {
+	case importCSV:
+		go c.processFile(option, done)
+	default:
+		c.logger.Fatalf("Command %s is not supported", command)
+		return
+	}
+}
+
+func (c CSVImportProcessor) processFile(specPath string, done chan bool) {
+	spec, err := importer.LoadSpec(specPath)
+
+	if err != nil {
+		c.logger.Fatalf("Error loading csv import spec: %s", err.Error())
+		return
+	}
+
+	for alias, id := range spec.TeamAliases {
+		if err := c.resolver.Link(app.EntityTeam, id, spec.Provider, alias); err != nil {
+			c.logger.Errorf("Error linking team alias in csv import processor: %s", err.Error())
+		}
+	}
+
+	in, err := os.Open(spec.File)
+
+	if err != nil {
+		c.logger.Fatalf("Error opening csv file %s: %s", spec.File, err.Error())
+		return
+	}
+
+	defer in.Close()
+
+	reader, err := importer.NewReader(in, spec)
+
+	if err != nil {
+		c.logger.Fatalf("Error reading csv file %s: %s", spec.File, err.Error())
+		return
+	}
+
+	out, err := os.Create(spec.RejectFile)
+
+	if err != nil {
+		c.logger.Fatalf("Error creating csv reject file %s: %s", spec.RejectFile, err.Error())
+		return
+	}
+
+	defer out.Close()
+
+	rejects, err := importer.NewRejectWriter(out, reader.Header())
+
+	if err != nil {
+		c.logger.Fatalf("Error writing csv reject file %s: %s", spec.RejectFile, err.Error())
+		return
+	}
+
+	imported := 0
+
+	for {
+		rec, err := reader.Next()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err == nil {
+			err = c.importRecord(rec, spec)
+		}
+
+		if err != nil {
+			if rejectErr := rejects.Reject(rec.Line, rec.Values, err.Error()); rejectErr != nil {
+				c.logger.Errorf("Error writing row %d to csv reject file: %s", rec.Line, rejectErr.Error())
+			}
+
+			continue
+		}
+
+		imported++
+	}
+
+	c.logger.Infof(
+		"Imported %d rows from %s, %d rows rejected and written to %s",
+		imported,
+		spec.File,
+		rejects.Count(),
+		spec.RejectFile,
+	)
+
+	done <- true
+}
+
+func (c CSVImportProcessor) importRecord(rec *importer.Record, spec *importer.Spec) error {
+	homeID, err := c.resolver.Resolve(app.EntityTeam, spec.Provider, rec.HomeTeam)
+
+	if err != nil {
+		return fmt.Errorf("unable to resolve home team alias '%s'", rec.HomeTeam)
+	}
+
+	awayID, err := c.resolver.Resolve(app.EntityTeam, spec.Provider, rec.AwayTeam)
+
+	if err != nil {
+		return fmt.Errorf("unable to resolve away team alias '%s'", rec.AwayTeam)
+	}
+
+	fixture, err := c.fixture(rec, spec, homeID, awayID)
+
+	if err != nil {
+		return err
+	}
+
+	if err := c.persistResult(fixture.ID, rec.Result); err != nil {
+		return err
+	}
+
+	if !rec.HasStats {
+		return nil
+	}
+
+	rec.HomeStats.FixtureID, rec.HomeStats.TeamID = fixture.ID, homeID
+	rec.AwayStats.FixtureID, rec.AwayStats.TeamID = fixture.ID, awayID
+
+	if err := c.persistTeamStats(rec.HomeStats); err != nil {
+		return err
+	}
+
+	return c.persistTeamStats(rec.AwayStats)
+}
+
+func (c CSVImportProcessor) fixture(rec *importer.Record, spec *importer.Spec, homeID, awayID uint64) (*app.Fixture, error) {
+	from := time.Date(rec.Date.Year(), rec.Date.Month(), rec.Date.Day(), 0, 0, 0, 0, rec.Date.Location())
+	to := from.Add((24 * time.Hour) - time.Second)
+
+	query := app.FixtureRepositoryQuery{
+		HomeTeamID: &homeID,
+		AwayTeamID: &awayID,
+		DateFrom:   &from,
+		DateTo:     &to,
+	}
+
+	fixtures, err := c.fixtureRepo.Get(query)
+
+	if err != nil {
+		return nil, fmt.Errorf("error fetching fixture: %s", err.Error())
+	}
+
+	if len(fixtures) > 0 {
+		return &fixtures[0], nil
+	}
+
+	if spec.SeasonID == 0 {
+		return nil, fmt.Errorf("fixture does not exist and no season_id is configured to create it")
+	}
+
+	id, err := c.idGenerator.NextID()
+
+	if err != nil {
+		return nil, fmt.Errorf("error generating fixture ID: %s", err.Error())
+	}
+
+	date := rec.Date
+
+	if !rec.HasTime {
+		date = from
+	}
+
+	fixture := app.Fixture{
+		ID:         id,
+		SeasonID:   spec.SeasonID,
+		HomeTeamID: homeID,
+		AwayTeamID: awayID,
+		Date:       date,
+	}
+
+	if err := c.fixtureRepo.Insert(&fixture); err != nil {
+		return nil, fmt.Errorf("error inserting fixture: %s", err.Error())
+	}
+
+	return &fixture, nil
+}
+
+func (c CSVImportProcessor) persistResult(fixtureID uint64, x app.Result) error {
+	x.FixtureID = fixtureID
+
+	existing, err := c.resultRepo.ByFixtureID(fixtureID)
+
+	if err != nil {
+		if err := c.resultRepo.Insert(&x); err != nil {
+			return fmt.Errorf("error inserting result: %s", err.Error())
+		}
+
+		return nil
+	}
+
+	mergeResult(existing, &x)
+
+	if err := c.resultRepo.Update(existing); err != nil {
+		return fmt.Errorf("error updating result: %s", err.Error())
+	}
+
+	return nil
+}
+
+func (c CSVImportProcessor) persistTeamStats(x app.TeamStats) error {
+	existing, err := c.teamStatsRepo.ByFixtureAndTeam(x.FixtureID, x.TeamID)
+
+	if err != nil || existing == nil {
+		if err := c.teamStatsRepo.InsertTeamStats(&x); err != nil {
+			return fmt.Errorf("error inserting team stats: %s", err.Error())
+		}
+
+		return nil
+	}
+
+	mergeTeamStats(existing, &x)
+
+	if err := c.teamStatsRepo.UpdateTeamStats(existing); err != nil {
+		return fmt.Errorf("error updating team stats: %s", err.Error())
+	}
+
+	return nil
+}
+
+// mergeResult copies the values provided by a CSV row onto an existing Result so values the CSV file does not
+// provide, such as formations, are not overwritten.
+func mergeResult(existing, csv *app.Result) {
+	mergeInt(&existing.HomeScore, csv.HomeScore)
+	mergeInt(&existing.AwayScore, csv.AwayScore)
+	mergeString(&existing.HalfTimeScore, csv.HalfTimeScore)
+	mergeString(&existing.FullTimeScore, csv.FullTimeScore)
+}
+
+// mergeTeamStats copies the values provided by a CSV row onto existing TeamStats so values the CSV file does
+// not provide, such as possession, are not overwritten.
+func mergeTeamStats(existing, csv *app.TeamStats) {
+	mergeInt(&existing.Goals, csv.Goals)
+	mergeInt(&existing.TeamShots.Total, csv.TeamShots.Total)
+	mergeInt(&existing.TeamShots.OnGoal, csv.TeamShots.OnGoal)
+	mergeInt(&existing.Fouls, csv.Fouls)
+	mergeInt(&existing.Corners, csv.Corners)
+	mergeInt(&existing.Offsides, csv.Offsides)
+	mergeInt(&existing.YellowCards, csv.YellowCards)
+	mergeInt(&existing.RedCards, csv.RedCards)
+}
+
+func mergeInt(existing **int, value *int) {
+	if value != nil {
+		*existing = value
+	}
+}
+
+func mergeString(existing **string, value *string) {
+	if value != nil {
+		*existing = value
+	}
+}
+
+func NewCSVImportProcessor(
+	f app.FixtureRepository,
+	r app.ResultRepository,
+	t app.TeamStatsRepository,
+	g app.FixtureIDGenerator,
+	x app.ExternalIDResolver,
+	log *logrus.Logger,
+) *CSVImportProcessor {
+	return &CSVImportProcessor{
+		fixtureRepo:   f,
+		resultRepo:    r,
+		teamStatsRepo: t,
+		idGenerator:   g,
+		resolver:      x,
+		logger:        log,
+	}
+}
@@ -0,0 +1,151 @@
+package process_test
+
+import (
+	"fmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/process"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const importCSV = `Div,Date,Time,HomeTeam,AwayTeam,FTHG,FTAG,HTHG,HTAG,HS,AS,HC,AC
+E0,12/09/2020,12:30,Fulham,Arsenal,0,3,0,1,5,13,2,3
+E0,12/09/2020,15:00,Crystal Palace,Southampton,1,0,1,0,9,9,7,3
+`
+
+func TestCSVImportProcessor_Process(t *testing.T) {
+	t.Run("imports rows into existing fixtures and writes unresolvable rows to the reject file", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		statsRepo := new(mock.TeamStatsRepository)
+		generator := new(mock.FixtureIDGenerator)
+		resolver := new(mock.ExternalIDResolver)
+		logger, _ := test.NewNullLogger()
+
+		processor := process.NewCSVImportProcessor(fixtureRepo, resultRepo, statsRepo, generator, resolver, logger)
+
+		dir, spec := writeImport(t, `{"file": "%s", "season_id": 17420, "team_aliases": {"Fulham": 11}}`)
+
+		resolver.On("Link", app.EntityTeam, uint64(11), app.ProviderFootballData, "Fulham").Return(nil)
+		resolver.On("Resolve", app.EntityTeam, app.ProviderFootballData, "Fulham").Return(uint64(11), nil)
+		resolver.On("Resolve", app.EntityTeam, app.ProviderFootballData, "Arsenal").Return(uint64(19), nil)
+		resolver.On("Resolve", app.EntityTeam, app.ProviderFootballData, "Crystal Palace").Return(uint64(0), errors.ErrorNotFound)
+
+		formation := "4-4-2"
+		existing := app.Result{FixtureID: 16475287, HomeFormation: &formation}
+
+		fixtureRepo.On("Get", m.AnythingOfType("app.FixtureRepositoryQuery")).Return([]app.Fixture{{ID: 16475287}}, nil)
+		resultRepo.On("ByFixtureID", uint64(16475287)).Return(&existing, nil)
+		resultRepo.On("Update", m.MatchedBy(func(r *app.Result) bool {
+			return *r.HomeFormation == "4-4-2" && *r.FullTimeScore == "0-3" && *r.HalfTimeScore == "0-1"
+		})).Return(nil)
+		statsRepo.On("ByFixtureAndTeam", uint64(16475287), uint64(11)).Return(&app.TeamStats{}, errors.ErrorNotFound)
+		statsRepo.On("ByFixtureAndTeam", uint64(16475287), uint64(19)).Return(&app.TeamStats{}, errors.ErrorNotFound)
+		statsRepo.On("InsertTeamStats", m.MatchedBy(func(s *app.TeamStats) bool {
+			return s.TeamID == 11 && *s.TeamShots.Total == 5 && *s.Corners == 2
+		})).Return(nil)
+		statsRepo.On("InsertTeamStats", m.MatchedBy(func(s *app.TeamStats) bool {
+			return s.TeamID == 19 && *s.TeamShots.Total == 13 && *s.Corners == 3
+		})).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process("import:csv", spec, done)
+
+		<-done
+
+		rejects, err := ioutil.ReadFile(filepath.Join(dir, "E0.csv.rejects.csv"))
+
+		if err != nil {
+			t.Fatalf("Error reading reject file: %s", err.Error())
+		}
+
+		expected := "line,reason,Div,Date,Time,HomeTeam,AwayTeam,FTHG,FTAG,HTHG,HTAG,HS,AS,HC,AC\n" +
+			"3,unable to resolve home team alias 'Crystal Palace',E0,12/09/2020,15:00,Crystal Palace,Southampton,1,0,1,0,9,9,7,3\n"
+
+		assert.Equal(t, expected, string(rejects))
+		resolver.AssertExpectations(t)
+		fixtureRepo.AssertExpectations(t)
+		resultRepo.AssertExpectations(t)
+		statsRepo.AssertExpectations(t)
+		generator.AssertNotCalled(t, "NextID")
+	})
+
+	t.Run("creates fixture in configured season if fixture does not exist", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		statsRepo := new(mock.TeamStatsRepository)
+		generator := new(mock.FixtureIDGenerator)
+		resolver := new(mock.ExternalIDResolver)
+		logger, _ := test.NewNullLogger()
+
+		processor := process.NewCSVImportProcessor(fixtureRepo, resultRepo, statsRepo, generator, resolver, logger)
+
+		_, spec := writeImport(t, `{"file": "%s", "season_id": 17420}`)
+
+		resolver.On("Resolve", app.EntityTeam, app.ProviderFootballData, m.AnythingOfType("string")).Return(uint64(1), nil)
+
+		date := time.Date(2020, 9, 12, 12, 30, 0, 0, time.UTC)
+
+		fixtureRepo.On("Get", m.AnythingOfType("app.FixtureRepositoryQuery")).Return([]app.Fixture{}, nil)
+		generator.On("NextID").Return(uint64(1000000000), nil).Once()
+		generator.On("NextID").Return(uint64(1000000001), nil).Once()
+		fixtureRepo.On("Insert", m.MatchedBy(func(f *app.Fixture) bool {
+			return f.ID == 1000000000 && f.SeasonID == 17420 && f.Date.Equal(date)
+		})).Return(nil)
+		fixtureRepo.On("Insert", m.MatchedBy(func(f *app.Fixture) bool {
+			return f.ID == 1000000001
+		})).Return(nil)
+		resultRepo.On("ByFixtureID", m.AnythingOfType("uint64")).Return(&app.Result{}, errors.ErrorNotFound)
+		resultRepo.On("Insert", m.AnythingOfType("*app.Result")).Return(nil)
+		statsRepo.On("ByFixtureAndTeam", m.AnythingOfType("uint64"), uint64(1)).Return(&app.TeamStats{}, errors.ErrorNotFound)
+		statsRepo.On("InsertTeamStats", m.AnythingOfType("*app.TeamStats")).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process("import:csv", spec, done)
+
+		<-done
+
+		fixtureRepo.AssertExpectations(t)
+		generator.AssertExpectations(t)
+		resultRepo.AssertNumberOfCalls(t, "Insert", 2)
+		statsRepo.AssertNumberOfCalls(t, "InsertTeamStats", 4)
+	})
+}
+
+func writeImport(t *testing.T, spec string) (string, string) {
+	dir, err := ioutil.TempDir("", "import")
+
+	if err != nil {
+		t.Fatalf("Error creating temp dir: %s", err.Error())
+	}
+
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	file := filepath.Join(dir, "E0.csv")
+
+	if err := ioutil.WriteFile(file, []byte(importCSV), 0644); err != nil {
+		t.Fatalf("Error writing csv file: %s", err.Error())
+	}
+
+	path := filepath.Join(dir, "spec.json")
+
+	if err := ioutil.WriteFile(path, []byte(fmt.Sprintf(spec, file)), 0644); err != nil {
+		t.Fatalf("Error writing spec file: %s", err.Error())
+	}
+
+	return dir, path
+}
@@ -0,0 +1,247 @@
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/override"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const overrideAdd = "override:add"
+const overrideList = "override:list"
+const overrideExpire = "override:expire"
+
+// DataOverrideProcessor manages the manual corrections applied to ingested entities. Adding an override
+// re-persists the corrected entity, if it has already been ingested, so queries reading the stored values
+// directly also see the correction.
+type DataOverrideProcessor struct {
+	overrideRepo  app.DataOverrideRepository
+	fixtureRepo   app.FixtureRepository
+	resultRepo    app.ResultRepository
+	teamStatsRepo app.TeamStatsRepository
+	out           io.Writer
+	logger        *logrus.Logger
+}
+
+func (d DataOverrideProcessor) Process(command string, option string, done chan bool) {
+	switch command {
+	case overrideAdd:
+		go d.add(option, done)
+	case overrideList:
+		go d.list(option, done)
+	case overrideExpire:
+		id, _ := strconv.Atoi(option)
+		go d.expire(uint64(id), done)
+	default:
+		d.logger.Fatalf("Command %s is not supported", command)
+		return
+	}
+}
+
+func (d DataOverrideProcessor) add(option string, done chan bool) {
+	var o app.DataOverride
+
+	if err := json.Unmarshal([]byte(option), &o); err != nil {
+		d.logger.Fatalf("Error parsing data override: %s", err.Error())
+		return
+	}
+
+	if err := validateOverride(&o); err != nil {
+		d.logger.Fatalf("Data override is invalid: %s", err.Error())
+		return
+	}
+
+	if err := d.overrideRepo.Insert(&o); err != nil {
+		d.logger.Fatalf("Error inserting data override: %s", err.Error())
+		return
+	}
+
+	if err := d.persist(o); err != nil {
+		d.logger.Warnf("Data override %d saved but not yet applied to stored %s: %s", o.ID, o.Entity, err.Error())
+	}
+
+	d.logger.Infof("Data override %d added for %s %s field %s", o.ID, o.Entity, o.Key, o.Field)
+
+	done <- true
+}
+
+// persist reads and writes the entity an override applies to using the override aware repositories so the
+// corrected value is stored.
+func (d DataOverrideProcessor) persist(o app.DataOverride) error {
+	ids, err := parseOverrideKey(o.Key)
+
+	if err != nil {
+		return err
+	}
+
+	switch o.Entity {
+	case app.EntityFixture:
+		x, err := d.fixtureRepo.ByID(ids[0])
+
+		if err != nil {
+			return err
+		}
+
+		return d.fixtureRepo.Update(x)
+	case app.EntityResult:
+		x, err := d.resultRepo.ByFixtureID(ids[0])
+
+		if err != nil {
+			return err
+		}
+
+		return d.resultRepo.Update(x)
+	case app.EntityTeamStats:
+		x, err := d.teamStatsRepo.ByFixtureAndTeam(ids[0], ids[1])
+
+		if err != nil {
+			return err
+		}
+
+		return d.teamStatsRepo.UpdateTeamStats(x)
+	}
+
+	return nil
+}
+
+func (d DataOverrideProcessor) list(option string, done chan bool) {
+	overrides, err := d.overrideRepo.All(option == "all")
+
+	if err != nil {
+		d.logger.Fatalf("Error fetching data overrides: %s", err.Error())
+		return
+	}
+
+	w := tabwriter.NewWriter(d.out, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tENTITY\tKEY\tFIELD\tVALUE\tREASON\tAUTHOR\tCREATED\tEXPIRES")
+
+	for _, o := range overrides {
+		expires := ""
+
+		if o.ExpiresAt != nil {
+			expires = o.ExpiresAt.UTC().Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(
+			w,
+			"%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			o.ID,
+			o.Entity,
+			o.Key,
+			o.Field,
+			o.Value,
+			o.Reason,
+			o.Author,
+			o.CreatedAt.UTC().Format(time.RFC3339),
+			expires,
+		)
+	}
+
+	if err := w.Flush(); err != nil {
+		d.logger.Errorf("Error writing data overrides: %s", err.Error())
+	}
+
+	done <- true
+}
+
+func (d DataOverrideProcessor) expire(id uint64, done chan bool) {
+	if err := d.overrideRepo.Expire(id); err != nil {
+		d.logger.Fatalf("Error expiring data override %d: %s", id, err.Error())
+		return
+	}
+
+	d.logger.Infof("Data override %d expired, the provider value is restored on the next ingestion", id)
+
+	done <- true
+}
+
+func validateOverride(o *app.DataOverride) error {
+	var target interface{}
+	keys := 1
+
+	switch o.Entity {
+	case app.EntityFixture:
+		target = &app.Fixture{}
+	case app.EntityResult:
+		target = &app.Result{}
+	case app.EntityTeamStats:
+		target = &app.TeamStats{}
+		keys = 2
+	default:
+		return fmt.Errorf("entity must be one of %s, %s or %s", app.EntityFixture, app.EntityResult, app.EntityTeamStats)
+	}
+
+	ids, err := parseOverrideKey(o.Key)
+
+	if err != nil || len(ids) != keys {
+		return fmt.Errorf("key '%s' is not valid for entity %s", o.Key, o.Entity)
+	}
+
+	if o.Reason == "" || o.Author == "" {
+		return fmt.Errorf("reason and author are required")
+	}
+
+	fields := override.Fields(target)
+
+	if !contains(fields, o.Field) {
+		return fmt.Errorf(
+			"field '%s' cannot be overridden for entity %s, fields available are %s",
+			o.Field,
+			o.Entity,
+			strings.Join(fields, ", "),
+		)
+	}
+
+	return override.Apply(target, []app.DataOverride{*o})
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseOverrideKey(key string) ([]uint64, error) {
+	var ids []uint64
+
+	for _, k := range strings.Split(key, ":") {
+		id, err := strconv.ParseUint(k, 10, 64)
+
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func NewDataOverrideProcessor(
+	o app.DataOverrideRepository,
+	f app.FixtureRepository,
+	r app.ResultRepository,
+	t app.TeamStatsRepository,
+	out io.Writer,
+	log *logrus.Logger,
+) *DataOverrideProcessor {
+	return &DataOverrideProcessor{
+		overrideRepo:  o,
+		fixtureRepo:   f,
+		resultRepo:    r,
+		teamStatsRepo: t,
+		out:           out,
+		logger:        log,
+	}
+}
@@ -0,0 +1,122 @@
+package process_test
+
+import (
+	"bytes"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/process"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestDataOverrideProcessor_Process(t *testing.T) {
+	t.Run("adds override and re-persists the entity it applies to", func(t *testing.T) {
+		t.Helper()
+
+		overrideRepo := new(mock.DataOverrideRepository)
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		statsRepo := new(mock.TeamStatsRepository)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewDataOverrideProcessor(overrideRepo, fixtureRepo, resultRepo, statsRepo, &bytes.Buffer{}, logger)
+
+		stats := app.TeamStats{FixtureID: 16475287, TeamID: 1}
+
+		overrideRepo.On("Insert", m.MatchedBy(func(o *app.DataOverride) bool {
+			return o.Entity == app.EntityTeamStats && o.Key == "16475287:1" && o.Field == "shots_total" &&
+				o.Value == "12" && o.Reason == "Opta" && o.Author == "joe"
+		})).Run(func(args m.Arguments) {
+			args.Get(0).(*app.DataOverride).ID = 7
+		}).Return(nil)
+		statsRepo.On("ByFixtureAndTeam", uint64(16475287), uint64(1)).Return(&stats, nil)
+		statsRepo.On("UpdateTeamStats", &stats).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process(
+			"override:add",
+			`{"entity": "team_stats", "key": "16475287:1", "field": "shots_total", "value": "12", "reason": "Opta", "author": "joe"}`,
+			done,
+		)
+
+		<-done
+
+		overrideRepo.AssertExpectations(t)
+		statsRepo.AssertExpectations(t)
+		assert.Equal(t, "Data override 7 added for team_stats 16475287:1 field shots_total", hook.LastEntry().Message)
+	})
+
+	t.Run("lists overrides including expired overrides", func(t *testing.T) {
+		t.Helper()
+
+		overrideRepo := new(mock.DataOverrideRepository)
+		logger, _ := test.NewNullLogger()
+		out := bytes.Buffer{}
+
+		processor := process.NewDataOverrideProcessor(
+			overrideRepo,
+			new(mock.FixtureRepository),
+			new(mock.ResultRepository),
+			new(mock.TeamStatsRepository),
+			&out,
+			logger,
+		)
+
+		expires := time.Date(2021, 1, 2, 10, 0, 0, 0, time.UTC)
+
+		overrideRepo.On("All", true).Return([]app.DataOverride{
+			{
+				ID:        1,
+				Entity:    app.EntityResult,
+				Key:       "16475287",
+				Field:     "home_score",
+				Value:     "2",
+				Reason:    "Match report",
+				Author:    "joe",
+				CreatedAt: time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC),
+				ExpiresAt: &expires,
+			},
+		}, nil)
+
+		done := make(chan bool)
+
+		processor.Process("override:list", "all", done)
+
+		<-done
+
+		expected := "ID  ENTITY  KEY       FIELD       VALUE  REASON        AUTHOR  CREATED               EXPIRES\n" +
+			"1   result  16475287  home_score  2      Match report  joe     2021-01-01T10:00:00Z  2021-01-02T10:00:00Z\n"
+
+		assert.Equal(t, expected, out.String())
+	})
+
+	t.Run("expires override", func(t *testing.T) {
+		t.Helper()
+
+		overrideRepo := new(mock.DataOverrideRepository)
+		logger, _ := test.NewNullLogger()
+
+		processor := process.NewDataOverrideProcessor(
+			overrideRepo,
+			new(mock.FixtureRepository),
+			new(mock.ResultRepository),
+			new(mock.TeamStatsRepository),
+			&bytes.Buffer{},
+			logger,
+		)
+
+		overrideRepo.On("Expire", uint64(7)).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process("override:expire", "7", done)
+
+		<-done
+
+		overrideRepo.AssertExpectations(t)
+	})
+}
@@ -46,9 +46,9 @@ func (e EventProcessor) processAllSeasons(done chan bool) {
 		return
 	}
 
-	goals, subs, cards := e.requester.EventsBySeasonIDs(ids)
+	goals, subs, cards, missed := e.requester.EventsBySeasonIDs(ids)
 
-	go e.parseEvents(goals, subs, cards, done)
+	go e.parseEvents(goals, subs, cards, missed, done)
 }
 
 func (e EventProcessor) processCurrentSeason(done chan bool) {
@@ -59,21 +59,21 @@ func (e EventProcessor) processCurrentSeason(done chan bool) {
 		return
 	}
 
-	goals, subs, cards := e.requester.EventsBySeasonIDs(ids)
+	goals, subs, cards, missed := e.requester.EventsBySeasonIDs(ids)
 
-	go e.parseEvents(goals, subs, cards, done)
+	go e.parseEvents(goals, subs, cards, missed, done)
 }
 
 func (e EventProcessor) processEventsBySeasonID(seasonID uint64, done chan bool) {
-	goals, subs, cards := e.requester.EventsBySeasonIDs([]uint64{seasonID})
+	goals, subs, cards, missed := e.requester.EventsBySeasonIDs([]uint64{seasonID})
 
-	go e.parseEvents(goals, subs, cards, done)
+	go e.parseEvents(goals, subs, cards, missed, done)
 }
 
-func (e EventProcessor) parseEvents(g <-chan app.GoalEvent, s <-chan app.SubstitutionEvent, c <-chan app.CardEvent, done chan bool) {
+func (e EventProcessor) parseEvents(g <-chan app.GoalEvent, s <-chan app.SubstitutionEvent, c <-chan app.CardEvent, m <-chan app.MissedPenaltyEvent, done chan bool) {
 	var wg = sync.WaitGroup{}
 
-	wg.Add(3)
+	wg.Add(4)
 
 	go func(c <-chan app.CardEvent) {
 		for card := range c {
@@ -99,6 +99,14 @@ func (e EventProcessor) parseEvents(g <-chan app.GoalEvent, s <-chan app.Substit
 		wg.Done()
 	}(s)
 
+	go func(m <-chan app.MissedPenaltyEvent) {
+		for missed := range m {
+			e.persistMissedPenaltyEvent(missed)
+		}
+
+		wg.Done()
+	}(m)
+
 	wg.Wait()
 
 	done <- true
@@ -106,6 +114,10 @@ func (e EventProcessor) parseEvents(g <-chan app.GoalEvent, s <-chan app.Substit
 
 func (e EventProcessor) persistCardEvent(x app.CardEvent) {
 	if _, err := e.eventRepo.CardEventByID(x.ID); err == nil {
+		if err := e.eventRepo.UpdateCardEvent(&x); err != nil {
+			e.logger.Warningf("Error '%s' occurred when updating card event struct: %+v\n,", err.Error(), x)
+		}
+
 		return
 	}
 
@@ -116,6 +128,10 @@ func (e EventProcessor) persistCardEvent(x app.CardEvent) {
 
 func (e EventProcessor) persistGoalEvent(x app.GoalEvent) {
 	if _, err := e.eventRepo.GoalEventByID(x.ID); err == nil {
+		if err := e.eventRepo.UpdateGoalEvent(&x); err != nil {
+			e.logger.Warningf("Error '%s' occurred when updating goal event struct: %+v\n,", err.Error(), x)
+		}
+
 		return
 	}
 
@@ -126,6 +142,10 @@ func (e EventProcessor) persistGoalEvent(x app.GoalEvent) {
 
 func (e EventProcessor) persistSubstitutionEvent(x app.SubstitutionEvent) {
 	if _, err := e.eventRepo.SubstitutionEventByID(x.ID); err == nil {
+		if err := e.eventRepo.UpdateSubstitutionEvent(&x); err != nil {
+			e.logger.Warningf("Error '%s' occurred when updating substitution event struct: %+v\n,", err.Error(), x)
+		}
+
 		return
 	}
 
@@ -134,6 +154,20 @@ func (e EventProcessor) persistSubstitutionEvent(x app.SubstitutionEvent) {
 	}
 }
 
+func (e EventProcessor) persistMissedPenaltyEvent(x app.MissedPenaltyEvent) {
+	if _, err := e.eventRepo.MissedPenaltyEventByID(x.ID); err == nil {
+		if err := e.eventRepo.UpdateMissedPenaltyEvent(&x); err != nil {
+			e.logger.Warningf("Error '%s' occurred when updating missed penalty event struct: %+v\n,", err.Error(), x)
+		}
+
+		return
+	}
+
+	if err := e.eventRepo.InsertMissedPenaltyEvent(&x); err != nil {
+		e.logger.Warningf("Error '%s' occurred when inserting missed penalty event struct: %+v\n,", err.Error(), x)
+	}
+}
+
 func NewEventProcessor(r app.EventRepository, s app.SeasonRepository, q app.EventRequester, c clockwork.Clock, log *logrus.Logger) *EventProcessor {
 	return &EventProcessor{eventRepo: r, seasonRepo: s, requester: q, clock: c, logger: log}
 }
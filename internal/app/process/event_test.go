@@ -54,7 +54,11 @@ func TestEventProcessor_Process(t *testing.T) {
 
 		cardCh := cardEventChannel(cards)
 
-		requester.On("EventsBySeasonIDs", []uint64{12}).Return(goalCh, subCh, cardCh)
+		missedOne := newMissedPenaltyEvent(7)
+
+		missedCh := missedPenaltyEventChannel([]app.MissedPenaltyEvent{missedOne})
+
+		requester.On("EventsBySeasonIDs", []uint64{12}).Return(goalCh, subCh, cardCh, missedCh)
 
 		eventRepo.On("GoalEventByID", uint64(10)).Return(&app.GoalEvent{}, errors.New("not found"))
 		eventRepo.On("GoalEventByID", uint64(20)).Return(&app.GoalEvent{}, errors.New("not found"))
@@ -71,6 +75,9 @@ func TestEventProcessor_Process(t *testing.T) {
 		eventRepo.On("InsertCardEvent", &cardOne).Return(nil)
 		eventRepo.On("InsertCardEvent", &cardTwo).Return(nil)
 
+		eventRepo.On("MissedPenaltyEventByID", uint64(7)).Return(&app.MissedPenaltyEvent{}, errors.New("not found"))
+		eventRepo.On("InsertMissedPenaltyEvent", &missedOne).Return(nil)
+
 		processor.Process("events:by-season-id", "12", done)
 
 		<-done
@@ -81,7 +88,7 @@ func TestEventProcessor_Process(t *testing.T) {
 		assert.Nil(t, hook.LastEntry())
 	})
 
-	t.Run("does not insert events into repository when processing events by season id command", func(t *testing.T) {
+	t.Run("updates existing goals and does not insert existing events into repository when processing events by season id command", func(t *testing.T) {
 		t.Helper()
 
 		eventRepo := new(mock.EventRepository)
@@ -121,23 +128,37 @@ func TestEventProcessor_Process(t *testing.T) {
 
 		cardCh := cardEventChannel(cards)
 
-		requester.On("EventsBySeasonIDs", []uint64{12}).Return(goalCh, subCh, cardCh)
+		missedOne := newMissedPenaltyEvent(7)
+
+		missedCh := missedPenaltyEventChannel([]app.MissedPenaltyEvent{missedOne})
+
+		requester.On("EventsBySeasonIDs", []uint64{12}).Return(goalCh, subCh, cardCh, missedCh)
 
 		eventRepo.On("GoalEventByID", uint64(10)).Return(&goalOne, nil)
 		eventRepo.On("GoalEventByID", uint64(20)).Return(&goalTwo, nil)
+		eventRepo.On("UpdateGoalEvent", &goalOne).Return(nil)
+		eventRepo.On("UpdateGoalEvent", &goalTwo).Return(nil)
 		eventRepo.AssertNotCalled(t, "InsertGoalEvent", &goalOne)
 		eventRepo.AssertNotCalled(t, "InsertGoalEvent", &goalTwo)
 
 		eventRepo.On("SubstitutionEventByID", uint64(55)).Return(&subOne, nil)
 		eventRepo.On("SubstitutionEventByID", uint64(2)).Return(&subTwo, nil)
+		eventRepo.On("UpdateSubstitutionEvent", &subOne).Return(nil)
+		eventRepo.On("UpdateSubstitutionEvent", &subTwo).Return(nil)
 		eventRepo.AssertNotCalled(t, "InsertSubstitutionEvent", &subOne)
 		eventRepo.AssertNotCalled(t, "InsertSubstitutionEvent", &subTwo)
 
 		eventRepo.On("CardEventByID", uint64(1)).Return(&cardOne, nil)
 		eventRepo.On("CardEventByID", uint64(2)).Return(&cardTwo, nil)
+		eventRepo.On("UpdateCardEvent", &cardOne).Return(nil)
+		eventRepo.On("UpdateCardEvent", &cardTwo).Return(nil)
 		eventRepo.AssertNotCalled(t, "InsertCardEvent", &cardOne)
 		eventRepo.AssertNotCalled(t, "InsertCardEvent", &cardTwo)
 
+		eventRepo.On("MissedPenaltyEventByID", uint64(7)).Return(&missedOne, nil)
+		eventRepo.On("UpdateMissedPenaltyEvent", &missedOne).Return(nil)
+		eventRepo.AssertNotCalled(t, "InsertMissedPenaltyEvent", &missedOne)
+
 		processor.Process("events:by-season-id", "12", done)
 
 		<-done
@@ -188,7 +209,11 @@ func TestEventProcessor_Process(t *testing.T) {
 
 		cardCh := cardEventChannel(cards)
 
-		requester.On("EventsBySeasonIDs", []uint64{12}).Return(goalCh, subCh, cardCh)
+		missedOne := newMissedPenaltyEvent(7)
+
+		missedCh := missedPenaltyEventChannel([]app.MissedPenaltyEvent{missedOne})
+
+		requester.On("EventsBySeasonIDs", []uint64{12}).Return(goalCh, subCh, cardCh, missedCh)
 
 		eventRepo.On("GoalEventByID", uint64(10)).Return(&app.GoalEvent{}, errors.New("not found"))
 		eventRepo.On("GoalEventByID", uint64(20)).Return(&app.GoalEvent{}, errors.New("not found"))
@@ -205,6 +230,9 @@ func TestEventProcessor_Process(t *testing.T) {
 		eventRepo.On("InsertCardEvent", &cardOne).Return(nil)
 		eventRepo.On("InsertCardEvent", &cardTwo).Return(errors.New("error occurred"))
 
+		eventRepo.On("MissedPenaltyEventByID", uint64(7)).Return(&app.MissedPenaltyEvent{}, errors.New("not found"))
+		eventRepo.On("InsertMissedPenaltyEvent", &missedOne).Return(errors.New("error occurred"))
+
 		processor.Process("events:by-season-id", "12", done)
 
 		<-done
@@ -212,7 +240,7 @@ func TestEventProcessor_Process(t *testing.T) {
 		requester.AssertExpectations(t)
 		seasonRepo.AssertExpectations(t)
 		eventRepo.AssertExpectations(t)
-		assert.Equal(t, 3, len(hook.Entries))
+		assert.Equal(t, 4, len(hook.Entries))
 		assert.Equal(t, logrus.WarnLevel, hook.LastEntry().Level)
 	})
 }
@@ -236,12 +264,24 @@ func newGoalEvent(id uint64) app.GoalEvent {
 		FixtureID: uint64(45),
 		TeamID:    uint64(4509),
 		PlayerID:  uint64(3401),
+		Type:      app.GoalTypeGoal,
 		Minute:    82,
 		Score:     "0-1",
 		CreatedAt: time.Unix(1546965200, 0),
 	}
 }
 
+func newMissedPenaltyEvent(id uint64) app.MissedPenaltyEvent {
+	return app.MissedPenaltyEvent{
+		ID:        id,
+		FixtureID: uint64(45),
+		TeamID:    uint64(4509),
+		PlayerID:  uint64(3401),
+		Minute:    31,
+		CreatedAt: time.Unix(1546965200, 0),
+	}
+}
+
 func newSubstitutionEvent(id uint64) app.SubstitutionEvent {
 	true := true
 	return app.SubstitutionEvent{
@@ -291,3 +331,15 @@ func subEventChannel(subs []app.SubstitutionEvent) chan app.SubstitutionEvent {
 
 	return ch
 }
+
+func missedPenaltyEventChannel(missed []app.MissedPenaltyEvent) chan app.MissedPenaltyEvent {
+	ch := make(chan app.MissedPenaltyEvent, len(missed))
+
+	for _, m := range missed {
+		ch <- m
+	}
+
+	close(ch)
+
+	return ch
+}
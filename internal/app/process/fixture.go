@@ -16,6 +16,8 @@ type FixtureProcessor struct {
 	fixtureRepo app.FixtureRepository
 	seasonRepo  app.SeasonRepository
 	requester   app.FixtureRequester
+	statuses    fixtureStatusGuard
+	checkpoints seasonCheckpoints
 	logger      *logrus.Logger
 }
 
@@ -25,10 +27,10 @@ func (f FixtureProcessor) Process(command string, option string, done chan bool)
 		go f.processCurrentSeason(done)
 	case fixturesBySeasonId:
 		id, _ := strconv.Atoi(option)
-		go f.processSeason(uint64(id), done)
+		go f.processSeasons(command, option, []uint64{uint64(id)}, done)
 	case fixturesByCompetitionId:
 		id, _ := strconv.Atoi(option)
-		go f.processCompetition(uint64(id), done)
+		go f.processCompetition(command, option, uint64(id), done)
 	default:
 		f.logger.Fatalf("Command %s is not supported", command)
 		return
@@ -48,13 +50,7 @@ func (f FixtureProcessor) processCurrentSeason(done chan bool) {
 	go f.persistFixtures(ch, done)
 }
 
-func (f FixtureProcessor) processSeason(seasonID uint64, done chan bool) {
-	ch := f.requester.FixturesBySeasonIDs([]uint64{seasonID})
-
-	go f.persistFixtures(ch, done)
-}
-
-func (f FixtureProcessor) processCompetition(competitionID uint64, done chan bool) {
+func (f FixtureProcessor) processCompetition(command, option string, competitionID uint64, done chan bool) {
 	seasons, err := f.seasonRepo.ByCompetitionId(competitionID, "name_asc")
 
 	if err != nil {
@@ -68,9 +64,39 @@ func (f FixtureProcessor) processCompetition(competitionID uint64, done chan boo
 		ids = append(ids, season.ID)
 	}
 
-	ch := f.requester.FixturesBySeasonIDs(ids)
+	f.processSeasons(command, option, ids, done)
+}
 
-	go f.persistFixtures(ch, done)
+// processSeasons requests and persists fixtures one season at a time, recording checkpoints as seasons and fixtures
+// are persisted so the run can be resumed.
+func (f FixtureProcessor) processSeasons(command, option string, ids []uint64, done chan bool) {
+	progress, err := f.checkpoints.begin(command, option, ids)
+
+	if err != nil {
+		f.logger.Fatalf("Error when loading checkpoints in fixture processor: %s", err.Error())
+		return
+	}
+
+	for _, id := range progress.pending {
+		fixtures, err := f.requester.FixturesBySeasonID(id)
+
+		if err != nil {
+			progress.failSeason(id, err)
+			continue
+		}
+
+		for _, fixture := range fixtures {
+			if progress.persist(fixture.ID) && !f.persist(fixture) {
+				progress.failFixture()
+			}
+		}
+
+		progress.completeSeason(id)
+	}
+
+	progress.finish()
+
+	done <- true
 }
 
 func (f FixtureProcessor) persistFixtures(ch <-chan app.Fixture, done chan bool) {
@@ -81,32 +107,59 @@ func (f FixtureProcessor) persistFixtures(ch <-chan app.Fixture, done chan bool)
 	done <- true
 }
 
-func (f FixtureProcessor) persist(x app.Fixture) {
-	if x.Status != nil && (*x.Status == "Deleted" || *x.Status == "POSTP"){
+// persist inserts, updates or deletes the fixture, returning false if the fixture could not be stored.
+func (f FixtureProcessor) persist(x app.Fixture) bool {
+	if x.Status != nil && (*x.Status == app.FixtureStatusDeleted || *x.Status == app.FixtureStatusPostponed){
 		if err := f.fixtureRepo.Delete(x.ID); err != nil {
 			f.logger.Warningf("Error '%s' occurred when delete fixture: %d\n,", err.Error(), x.ID)
+			return false
 		}
 
-		return
+		return true
 	}
 
-	_, err := f.fixtureRepo.ByID(x.ID)
+	existing, err := f.fixtureRepo.ByID(x.ID)
 
 	if err != nil {
 		if err := f.fixtureRepo.Insert(&x); err != nil {
 			f.logger.Warningf("Error '%s' occurred when inserting fixture struct: %+v\n,", err.Error(), x)
+			return false
 		}
 
-		return
+		return true
 	}
 
-	if err := f.fixtureRepo.Update(&x); err != nil {
+	f.statuses.check(x.ID, existing.Status, x.Status)
+
+	var events []app.DomainEvent
+
+	if !existing.Date.Equal(x.Date) {
+		events = append(events, app.FixtureRescheduled{FixtureID: x.ID, PreviousDate: existing.Date, Date: x.Date})
+	}
+
+	if err := f.fixtureRepo.Update(&x, events...); err != nil {
 		f.logger.Warningf("Error '%s' occurred when updating fixture struct: %+v\n,", err.Error(), x)
+		return false
 	}
 
-	return
+	return true
 }
 
-func NewFixtureProcessor(f app.FixtureRepository, s app.SeasonRepository, r app.FixtureRequester, log *logrus.Logger) *FixtureProcessor {
-	return &FixtureProcessor{fixtureRepo: f, seasonRepo: s, requester: r, logger: log}
+func NewFixtureProcessor(
+	f app.FixtureRepository,
+	s app.SeasonRepository,
+	r app.FixtureRequester,
+	a app.FixtureStatusAnomalyRepository,
+	k app.CheckpointRepository,
+	resume bool,
+	log *logrus.Logger,
+) *FixtureProcessor {
+	return &FixtureProcessor{
+		fixtureRepo: f,
+		seasonRepo:  s,
+		requester:   r,
+		statuses:    fixtureStatusGuard{anomalyRepo: a, logger: log},
+		checkpoints: seasonCheckpoints{checkpointRepo: k, resume: resume, logger: log},
+		logger:      log,
+	}
 }
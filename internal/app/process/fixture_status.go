@@ -0,0 +1,27 @@
+package process
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+)
+
+// fixtureStatusGuard logs and stores fixture status changes that are not allowed transitions. The new status is
+// still persisted as the data provider remains the source of truth for fixture statuses.
+type fixtureStatusGuard struct {
+	anomalyRepo app.FixtureStatusAnomalyRepository
+	logger      *logrus.Logger
+}
+
+func (g fixtureStatusGuard) check(fixtureID uint64, from, to *app.FixtureStatus) {
+	if from == nil || to == nil || from.CanTransitionTo(*to) {
+		return
+	}
+
+	g.logger.Warningf("Fixture %d status changed from '%s' to '%s' which is not an allowed transition", fixtureID, *from, *to)
+
+	a := app.FixtureStatusAnomaly{FixtureID: fixtureID, From: *from, To: *to}
+
+	if err := g.anomalyRepo.Insert(&a); err != nil {
+		g.logger.Errorf("Error '%s' occurred when storing status anomaly for fixture %d", err.Error(), fixtureID)
+	}
+}
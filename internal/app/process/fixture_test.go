@@ -8,6 +8,7 @@ import (
 	"github.com/statistico/statistico-football-data/internal/app/mock"
 	"github.com/statistico/statistico-football-data/internal/app/process"
 	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
 	"testing"
 	"time"
 )
@@ -19,9 +20,14 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		fixtureRepo := new(mock.FixtureRepository)
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.FixtureRequester)
+		anomalyRepo := new(mock.FixtureStatusAnomalyRepository)
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, logger)
+		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, anomalyRepo, checkpointRepo, false, logger)
+
+		checkpointRepo.On("Clear", "fixtures:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "fixtures:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -32,8 +38,6 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		fixtures[0] = one
 		fixtures[1] = two
 
-		ch := fixtureChannel(fixtures)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -42,7 +46,8 @@ func TestFixtureProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("FixturesBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("FixturesBySeasonID", uint64(1)).Return(fixtures, nil)
+		requester.On("FixturesBySeasonID", uint64(2)).Return([]app.Fixture(nil), nil)
 
 		fixtureRepo.On("ByID", uint64(34)).Return(&app.Fixture{}, errors.New("not Found"))
 		fixtureRepo.On("ByID", uint64(400)).Return(&app.Fixture{}, errors.New("not Found"))
@@ -56,7 +61,7 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		requester.AssertExpectations(t)
 		seasonRepo.AssertExpectations(t)
 		fixtureRepo.AssertExpectations(t)
-		assert.Nil(t, hook.LastEntry())
+		assert.Equal(t, "Processed season 2 of 'fixtures:by-competition-id 5': 2 of 2 seasons complete (100%)", hook.LastEntry().Message)
 	})
 
 	t.Run("updates existing fixture into repository when processing fixtures by competition id command", func(t *testing.T) {
@@ -65,9 +70,14 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		fixtureRepo := new(mock.FixtureRepository)
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.FixtureRequester)
+		anomalyRepo := new(mock.FixtureStatusAnomalyRepository)
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, logger)
+		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, anomalyRepo, checkpointRepo, false, logger)
+
+		checkpointRepo.On("Clear", "fixtures:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "fixtures:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 	
@@ -78,8 +88,6 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		fixtures[0] = one
 		fixtures[1] = two
 
-		ch := fixtureChannel(fixtures)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -88,10 +96,11 @@ func TestFixtureProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("FixturesBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("FixturesBySeasonID", uint64(1)).Return(fixtures, nil)
+		requester.On("FixturesBySeasonID", uint64(2)).Return([]app.Fixture(nil), nil)
 
-		fixtureRepo.On("ByID", uint64(34)).Return(&app.Fixture{}, nil)
-		fixtureRepo.On("ByID", uint64(400)).Return(&app.Fixture{}, nil)
+		fixtureRepo.On("ByID", uint64(34)).Return(&app.Fixture{ID: 34, Date: one.Date}, nil)
+		fixtureRepo.On("ByID", uint64(400)).Return(&app.Fixture{ID: 400, Date: two.Date}, nil)
 		fixtureRepo.On("Update", &one).Return(nil)
 		fixtureRepo.On("Update", &two).Return(nil)
 
@@ -102,7 +111,7 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		requester.AssertExpectations(t)
 		seasonRepo.AssertExpectations(t)
 		fixtureRepo.AssertExpectations(t)
-		assert.Nil(t, hook.LastEntry())
+		assert.Equal(t, "Processed season 2 of 'fixtures:by-competition-id 5': 2 of 2 seasons complete (100%)", hook.LastEntry().Message)
 	})
 
 	t.Run("logs error when unable to insert fixture into repository when processing fixtures by competition id command", func(t *testing.T) {
@@ -111,9 +120,14 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		fixtureRepo := new(mock.FixtureRepository)
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.FixtureRequester)
+		anomalyRepo := new(mock.FixtureStatusAnomalyRepository)
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, logger)
+		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, anomalyRepo, checkpointRepo, false, logger)
+
+		checkpointRepo.On("Clear", "fixtures:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "fixtures:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -124,8 +138,6 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		fixtures[0] = one
 		fixtures[1] = two
 
-		ch := fixtureChannel(fixtures)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -134,7 +146,8 @@ func TestFixtureProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("FixturesBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("FixturesBySeasonID", uint64(1)).Return(fixtures, nil)
+		requester.On("FixturesBySeasonID", uint64(2)).Return([]app.Fixture(nil), nil)
 
 		fixtureRepo.On("ByID", uint64(34)).Return(&app.Fixture{}, errors.New("not Found"))
 		fixtureRepo.On("ByID", uint64(400)).Return(&app.Fixture{}, errors.New("not Found"))
@@ -148,8 +161,9 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		requester.AssertExpectations(t)
 		seasonRepo.AssertExpectations(t)
 		fixtureRepo.AssertExpectations(t)
-		assert.Equal(t, 1, len(hook.Entries))
-		assert.Equal(t, logrus.WarnLevel, hook.LastEntry().Level)
+		assert.Equal(t, 4, len(hook.Entries))
+		assert.Equal(t, logrus.WarnLevel, hook.Entries[0].Level)
+		assert.Equal(t, "'fixtures:by-competition-id 5' finished with 1 of 2 seasons incomplete, resume the run to retry them", hook.LastEntry().Message)
 	})
 
 	t.Run("logs error when unable to update fixture into repository when processing fixtures by competition id command", func(t *testing.T) {
@@ -158,9 +172,14 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		fixtureRepo := new(mock.FixtureRepository)
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.FixtureRequester)
+		anomalyRepo := new(mock.FixtureStatusAnomalyRepository)
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, logger)
+		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, anomalyRepo, checkpointRepo, false, logger)
+
+		checkpointRepo.On("Clear", "fixtures:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "fixtures:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -171,8 +190,6 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		fixtures[0] = one
 		fixtures[1] = two
 
-		ch := fixtureChannel(fixtures)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -181,10 +198,11 @@ func TestFixtureProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("FixturesBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("FixturesBySeasonID", uint64(1)).Return(fixtures, nil)
+		requester.On("FixturesBySeasonID", uint64(2)).Return([]app.Fixture(nil), nil)
 
-		fixtureRepo.On("ByID", uint64(34)).Return(&app.Fixture{}, nil)
-		fixtureRepo.On("ByID", uint64(400)).Return(&app.Fixture{}, nil)
+		fixtureRepo.On("ByID", uint64(34)).Return(&app.Fixture{ID: 34, Date: one.Date}, nil)
+		fixtureRepo.On("ByID", uint64(400)).Return(&app.Fixture{ID: 400, Date: two.Date}, nil)
 		fixtureRepo.On("Update", &one).Return(errors.New("error occurred"))
 		fixtureRepo.On("Update", &two).Return(nil)
 
@@ -195,8 +213,9 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		requester.AssertExpectations(t)
 		seasonRepo.AssertExpectations(t)
 		fixtureRepo.AssertExpectations(t)
-		assert.Equal(t, 1, len(hook.Entries))
-		assert.Equal(t, logrus.WarnLevel, hook.LastEntry().Level)
+		assert.Equal(t, 4, len(hook.Entries))
+		assert.Equal(t, logrus.WarnLevel, hook.Entries[0].Level)
+		assert.Equal(t, "'fixtures:by-competition-id 5' finished with 1 of 2 seasons incomplete, resume the run to retry them", hook.LastEntry().Message)
 	})
 
 	t.Run("inserts new fixture into repository when processing fixture current season command", func(t *testing.T) {
@@ -205,9 +224,12 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		fixtureRepo := new(mock.FixtureRepository)
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.FixtureRequester)
+		anomalyRepo := new(mock.FixtureStatusAnomalyRepository)
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, logger)
+		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, anomalyRepo, checkpointRepo, false, logger)
+
 
 		done := make(chan bool)
 
@@ -247,9 +269,12 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		fixtureRepo := new(mock.FixtureRepository)
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.FixtureRequester)
+		anomalyRepo := new(mock.FixtureStatusAnomalyRepository)
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, logger)
+		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, anomalyRepo, checkpointRepo, false, logger)
+
 
 		done := make(chan bool)
 
@@ -268,8 +293,8 @@ func TestFixtureProcessor_Process(t *testing.T) {
 
 		requester.On("FixturesBySeasonIDs", ids).Return(ch)
 
-		fixtureRepo.On("ByID", uint64(34)).Return(&app.Fixture{}, nil)
-		fixtureRepo.On("ByID", uint64(400)).Return(&app.Fixture{}, nil)
+		fixtureRepo.On("ByID", uint64(34)).Return(&app.Fixture{ID: 34, Date: one.Date}, nil)
+		fixtureRepo.On("ByID", uint64(400)).Return(&app.Fixture{ID: 400, Date: two.Date}, nil)
 		fixtureRepo.On("Update", &one).Return(nil)
 		fixtureRepo.On("Update", &two).Return(nil)
 
@@ -289,9 +314,12 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		fixtureRepo := new(mock.FixtureRepository)
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.FixtureRequester)
+		anomalyRepo := new(mock.FixtureStatusAnomalyRepository)
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, logger)
+		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, anomalyRepo, checkpointRepo, false, logger)
+
 
 		done := make(chan bool)
 
@@ -332,9 +360,12 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		fixtureRepo := new(mock.FixtureRepository)
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.FixtureRequester)
+		anomalyRepo := new(mock.FixtureStatusAnomalyRepository)
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, logger)
+		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, anomalyRepo, checkpointRepo, false, logger)
+
 
 		done := make(chan bool)
 
@@ -353,8 +384,8 @@ func TestFixtureProcessor_Process(t *testing.T) {
 
 		requester.On("FixturesBySeasonIDs", ids).Return(ch)
 
-		fixtureRepo.On("ByID", uint64(34)).Return(&app.Fixture{}, nil)
-		fixtureRepo.On("ByID", uint64(400)).Return(&app.Fixture{}, nil)
+		fixtureRepo.On("ByID", uint64(34)).Return(&app.Fixture{ID: 34, Date: one.Date}, nil)
+		fixtureRepo.On("ByID", uint64(400)).Return(&app.Fixture{ID: 400, Date: two.Date}, nil)
 		fixtureRepo.On("Update", &one).Return(errors.New("error occurred"))
 		fixtureRepo.On("Update", &two).Return(nil)
 
@@ -375,16 +406,21 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		fixtureRepo := new(mock.FixtureRepository)
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.FixtureRequester)
+		anomalyRepo := new(mock.FixtureStatusAnomalyRepository)
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, logger)
+		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, anomalyRepo, checkpointRepo, false, logger)
+
+		checkpointRepo.On("Clear", "fixtures:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "fixtures:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
 		one := newFixture(34)
 		two := newFixture(400)
 
-		status := "Deleted"
+		status := app.FixtureStatusDeleted
 
 		two.Status = &status
 
@@ -392,8 +428,6 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		fixtures[0] = one
 		fixtures[1] = two
 
-		ch := fixtureChannel(fixtures)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -402,7 +436,8 @@ func TestFixtureProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("FixturesBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("FixturesBySeasonID", uint64(1)).Return(fixtures, nil)
+		requester.On("FixturesBySeasonID", uint64(2)).Return([]app.Fixture(nil), nil)
 
 		fixtureRepo.On("ByID", uint64(34)).Return(&app.Fixture{}, errors.New("not Found"))
 		fixtureRepo.On("Delete", uint64(400)).Return(nil)
@@ -415,7 +450,7 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		requester.AssertExpectations(t)
 		seasonRepo.AssertExpectations(t)
 		fixtureRepo.AssertExpectations(t)
-		assert.Nil(t, hook.LastEntry())
+		assert.Equal(t, "Processed season 2 of 'fixtures:by-competition-id 5': 2 of 2 seasons complete (100%)", hook.LastEntry().Message)
 	})
 
 	t.Run("delete fixture from repository if fixture status is POSTP", func(t *testing.T) {
@@ -424,16 +459,21 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		fixtureRepo := new(mock.FixtureRepository)
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.FixtureRequester)
+		anomalyRepo := new(mock.FixtureStatusAnomalyRepository)
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, logger)
+		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, anomalyRepo, checkpointRepo, false, logger)
+
+		checkpointRepo.On("Clear", "fixtures:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "fixtures:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
 		one := newFixture(34)
 		two := newFixture(400)
 
-		status := "POSTP"
+		status := app.FixtureStatusPostponed
 
 		two.Status = &status
 
@@ -441,8 +481,6 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		fixtures[0] = one
 		fixtures[1] = two
 
-		ch := fixtureChannel(fixtures)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -451,7 +489,8 @@ func TestFixtureProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("FixturesBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("FixturesBySeasonID", uint64(1)).Return(fixtures, nil)
+		requester.On("FixturesBySeasonID", uint64(2)).Return([]app.Fixture(nil), nil)
 
 		fixtureRepo.On("ByID", uint64(34)).Return(&app.Fixture{}, errors.New("not Found"))
 		fixtureRepo.On("Delete", uint64(400)).Return(nil)
@@ -464,7 +503,88 @@ func TestFixtureProcessor_Process(t *testing.T) {
 		requester.AssertExpectations(t)
 		seasonRepo.AssertExpectations(t)
 		fixtureRepo.AssertExpectations(t)
-		assert.Nil(t, hook.LastEntry())
+		assert.Equal(t, "Processed season 2 of 'fixtures:by-competition-id 5': 2 of 2 seasons complete (100%)", hook.LastEntry().Message)
+	})
+
+	t.Run("stores fixture rescheduled event with the update when the date of an existing fixture changes", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.FixtureRequester)
+		anomalyRepo := new(mock.FixtureStatusAnomalyRepository)
+		checkpointRepo := new(mock.CheckpointRepository)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, anomalyRepo, checkpointRepo, false, logger)
+
+		checkpointRepo.On("Clear", "fixtures:by-season-id 14567").Return(nil)
+		checkpointRepo.On("Complete", "fixtures:by-season-id 14567", m.Anything, m.Anything).Return(nil)
+
+		done := make(chan bool)
+
+		one := newFixture(34)
+		two := newFixture(400)
+
+		previous := time.Unix(1547986929, 0)
+
+		requester.On("FixturesBySeasonID", uint64(14567)).Return([]app.Fixture{one, two}, nil)
+		fixtureRepo.On("ByID", uint64(34)).Return(&app.Fixture{ID: 34, Date: previous}, nil)
+		fixtureRepo.On("ByID", uint64(400)).Return(&app.Fixture{ID: 400, Date: two.Date}, nil)
+		fixtureRepo.On("Update", &one, app.FixtureRescheduled{FixtureID: 34, PreviousDate: previous, Date: one.Date}).Return(nil)
+		fixtureRepo.On("Update", &two).Return(nil)
+
+		processor.Process("fixtures:by-season-id", "14567", done)
+
+		<-done
+
+		fixtureRepo.AssertExpectations(t)
+		fixtureRepo.AssertNumberOfCalls(t, "Update", 2)
+		assert.Equal(t, 1, len(hook.Entries))
+		assert.Equal(t, logrus.InfoLevel, hook.LastEntry().Level)
+	})
+
+	t.Run("logs and stores anomaly when fixture status change is not an allowed transition", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.FixtureRequester)
+		anomalyRepo := new(mock.FixtureStatusAnomalyRepository)
+		checkpointRepo := new(mock.CheckpointRepository)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewFixtureProcessor(fixtureRepo, seasonRepo, requester, anomalyRepo, checkpointRepo, false, logger)
+
+		checkpointRepo.On("Clear", "fixtures:by-season-id 14567").Return(nil)
+		checkpointRepo.On("Complete", "fixtures:by-season-id 14567", m.Anything, m.Anything).Return(nil)
+
+		done := make(chan bool)
+
+		one := newFixture(34)
+		two := newFixture(400)
+
+		finished, notStarted, live := app.FixtureStatusFullTime, app.FixtureStatusNotStarted, app.FixtureStatusLive
+		one.Status = &notStarted
+		two.Status = &finished
+
+		requester.On("FixturesBySeasonID", uint64(14567)).Return([]app.Fixture{one, two}, nil)
+		fixtureRepo.On("ByID", uint64(34)).Return(&app.Fixture{ID: 34, Date: one.Date, Status: &finished}, nil)
+		fixtureRepo.On("ByID", uint64(400)).Return(&app.Fixture{ID: 400, Date: two.Date, Status: &live}, nil)
+		fixtureRepo.On("Update", &one).Return(nil)
+		fixtureRepo.On("Update", &two).Return(nil)
+		anomalyRepo.On("Insert", &app.FixtureStatusAnomaly{FixtureID: 34, From: "FT", To: "NS"}).Return(nil)
+
+		processor.Process("fixtures:by-season-id", "14567", done)
+
+		<-done
+
+		fixtureRepo.AssertExpectations(t)
+		anomalyRepo.AssertExpectations(t)
+		anomalyRepo.AssertNumberOfCalls(t, "Insert", 1)
+		assert.Equal(t, 2, len(hook.Entries))
+		assert.Equal(t, logrus.WarnLevel, hook.Entries[0].Level)
+		assert.Equal(t, "Fixture 34 status changed from 'FT' to 'NS' which is not an allowed transition", hook.Entries[0].Message)
 	})
 }
 
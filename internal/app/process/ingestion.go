@@ -0,0 +1,136 @@
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/ingestion"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const ingestionSet = "ingestion:set"
+const ingestionList = "ingestion:list"
+const ingestionRemove = "ingestion:remove"
+
+// IngestionPolicyProcessor manages the ingestion policies determining the datasets, tier and history depth
+// ingested for each competition. Removing a policy returns the competition to the default policy.
+type IngestionPolicyProcessor struct {
+	policyRepo      app.IngestionPolicyRepository
+	competitionRepo app.CompetitionRepository
+	out             io.Writer
+	logger          *logrus.Logger
+}
+
+func (i IngestionPolicyProcessor) Process(command string, option string, done chan bool) {
+	switch command {
+	case ingestionSet:
+		go i.set(option, done)
+	case ingestionList:
+		go i.list(done)
+	case ingestionRemove:
+		id, _ := strconv.Atoi(option)
+		go i.remove(uint64(id), done)
+	default:
+		i.logger.Fatalf("Command %s is not supported", command)
+		return
+	}
+}
+
+func (i IngestionPolicyProcessor) set(option string, done chan bool) {
+	var p app.IngestionPolicy
+
+	if err := json.Unmarshal([]byte(option), &p); err != nil {
+		i.logger.Fatalf("Error parsing ingestion policy: %s", err.Error())
+		return
+	}
+
+	if err := ingestion.Prepare(&p); err != nil {
+		i.logger.Fatalf("Ingestion policy is invalid: %s", err.Error())
+		return
+	}
+
+	if _, err := i.competitionRepo.ByID(p.CompetitionID); err != nil {
+		i.logger.Fatalf("Error fetching competition %d for ingestion policy: %s", p.CompetitionID, err.Error())
+		return
+	}
+
+	if err := i.policyRepo.Save(&p); err != nil {
+		i.logger.Fatalf("Error saving ingestion policy: %s", err.Error())
+		return
+	}
+
+	fmt.Fprintf(
+		i.out,
+		"Ingestion policy saved for competition %d: tier %s, history depth %d, datasets %s\n",
+		p.CompetitionID,
+		p.Tier,
+		p.HistoryDepth,
+		policyDatasets(p),
+	)
+
+	done <- true
+}
+
+func (i IngestionPolicyProcessor) list(done chan bool) {
+	policies, err := i.policyRepo.All()
+
+	if err != nil {
+		i.logger.Fatalf("Error fetching ingestion policies: %s", err.Error())
+		return
+	}
+
+	tw := tabwriter.NewWriter(i.out, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "COMPETITION\tTIER\tHISTORY\tDATASETS\tUPDATED")
+
+	for _, p := range policies {
+		fmt.Fprintf(
+			tw,
+			"%d\t%s\t%d\t%s\t%s\n",
+			p.CompetitionID,
+			p.Tier,
+			p.HistoryDepth,
+			policyDatasets(p),
+			p.UpdatedAt.UTC().Format(time.RFC3339),
+		)
+	}
+
+	if err := tw.Flush(); err != nil {
+		i.logger.Errorf("Error writing ingestion policies: %s", err.Error())
+	}
+
+	done <- true
+}
+
+func (i IngestionPolicyProcessor) remove(competitionID uint64, done chan bool) {
+	if err := i.policyRepo.Delete(competitionID); err != nil {
+		i.logger.Fatalf("Error removing ingestion policy for competition %d: %s", competitionID, err.Error())
+		return
+	}
+
+	i.logger.Infof("Ingestion policy for competition %d removed, the default policy now applies", competitionID)
+
+	done <- true
+}
+
+func policyDatasets(p app.IngestionPolicy) string {
+	if len(p.Datasets) == 0 {
+		return "none"
+	}
+
+	return strings.Join(p.Datasets, ",")
+}
+
+func NewIngestionPolicyProcessor(
+	p app.IngestionPolicyRepository,
+	c app.CompetitionRepository,
+	out io.Writer,
+	log *logrus.Logger,
+) *IngestionPolicyProcessor {
+	return &IngestionPolicyProcessor{policyRepo: p, competitionRepo: c, out: out, logger: log}
+}
@@ -0,0 +1,94 @@
+package process_test
+
+import (
+	"bytes"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/process"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestIngestionPolicyProcessor_Process(t *testing.T) {
+	t.Run("saves policy for an existing competition", func(t *testing.T) {
+		t.Helper()
+
+		policyRepo := new(mock.IngestionPolicyRepository)
+		competitionRepo := new(mock.CompetitionRepository)
+		logger, _ := test.NewNullLogger()
+		out := bytes.Buffer{}
+
+		processor := process.NewIngestionPolicyProcessor(policyRepo, competitionRepo, &out, logger)
+
+		competitionRepo.On("ByID", uint64(8)).Return(&app.Competition{ID: 8}, nil)
+		policyRepo.On("Save", m.MatchedBy(func(p *app.IngestionPolicy) bool {
+			return p.CompetitionID == 8 && p.Tier == app.IngestionTierHigh && p.HistoryDepth == 3 &&
+				len(p.Datasets) == 2 && p.Datasets[0] == "fixtures" && p.Datasets[1] == "results"
+		})).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process(
+			"ingestion:set",
+			`{"competition_id": 8, "datasets": ["fixtures", "results"], "tier": "high", "history_depth": 3}`,
+			done,
+		)
+
+		<-done
+
+		policyRepo.AssertExpectations(t)
+		assert.Equal(t, "Ingestion policy saved for competition 8: tier high, history depth 3, datasets fixtures,results\n", out.String())
+	})
+
+	t.Run("lists policies", func(t *testing.T) {
+		t.Helper()
+
+		policyRepo := new(mock.IngestionPolicyRepository)
+		logger, _ := test.NewNullLogger()
+		out := bytes.Buffer{}
+
+		processor := process.NewIngestionPolicyProcessor(policyRepo, new(mock.CompetitionRepository), &out, logger)
+
+		updated := time.Date(2021, 2, 15, 9, 0, 0, 0, time.UTC)
+
+		policyRepo.On("All").Return([]app.IngestionPolicy{
+			{CompetitionID: 8, Datasets: []string{"fixtures", "results"}, Tier: app.IngestionTierHigh, UpdatedAt: updated},
+			{CompetitionID: 1326, Datasets: []string{}, Tier: app.IngestionTierLow, HistoryDepth: 2, UpdatedAt: updated},
+		}, nil)
+
+		done := make(chan bool)
+
+		processor.Process("ingestion:list", "", done)
+
+		<-done
+
+		expected := "COMPETITION  TIER  HISTORY  DATASETS          UPDATED\n" +
+			"8            high  0        fixtures,results  2021-02-15T09:00:00Z\n" +
+			"1326         low   2        none              2021-02-15T09:00:00Z\n"
+
+		assert.Equal(t, expected, out.String())
+	})
+
+	t.Run("removes policy for competition", func(t *testing.T) {
+		t.Helper()
+
+		policyRepo := new(mock.IngestionPolicyRepository)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewIngestionPolicyProcessor(policyRepo, new(mock.CompetitionRepository), &bytes.Buffer{}, logger)
+
+		policyRepo.On("Delete", uint64(8)).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process("ingestion:remove", "8", done)
+
+		<-done
+
+		policyRepo.AssertExpectations(t)
+		assert.Equal(t, "Ingestion policy for competition 8 removed, the default policy now applies", hook.LastEntry().Message)
+	})
+}
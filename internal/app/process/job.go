@@ -0,0 +1,114 @@
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+const jobEnqueue = "job:enqueue"
+const jobList = "job:list"
+
+// jobDefaultMaxAttempts is the number of times a job is run before it is marked as failed if the number of attempts
+// is not provided when the job is enqueued.
+const jobDefaultMaxAttempts = 3
+
+const jobListLimit = 50
+
+// JobProcessor enqueues console commands to be run by worker processes and lists recent jobs.
+type JobProcessor struct {
+	queue  app.JobQueue
+	out    io.Writer
+	logger *logrus.Logger
+}
+
+func (j JobProcessor) Process(command string, option string, done chan bool) {
+	switch command {
+	case jobEnqueue:
+		go j.enqueue(option, done)
+	case jobList:
+		go j.list(done)
+	default:
+		j.logger.Fatalf("Command %s is not supported", command)
+		return
+	}
+}
+
+func (j JobProcessor) enqueue(option string, done chan bool) {
+	var job app.Job
+
+	if err := json.Unmarshal([]byte(option), &job); err != nil {
+		j.logger.Fatalf("Error parsing job: %s", err.Error())
+		return
+	}
+
+	if job.Command == "" {
+		j.logger.Fatal("A job requires the command to run")
+		return
+	}
+
+	if job.MaxAttempts <= 0 {
+		job.MaxAttempts = jobDefaultMaxAttempts
+	}
+
+	if err := j.queue.Enqueue(&job); err != nil {
+		j.logger.Fatalf("Error enqueuing job: %s", err.Error())
+		return
+	}
+
+	fmt.Fprintf(j.out, "Job %d enqueued to run '%s %s' from %s\n", job.ID, job.Command, job.Option, job.NotBefore.UTC().Format(time.RFC3339))
+
+	done <- true
+}
+
+func (j JobProcessor) list(done chan bool) {
+	jobs, err := j.queue.Recent(jobListLimit)
+
+	if err != nil {
+		j.logger.Fatalf("Error fetching jobs: %s", err.Error())
+		return
+	}
+
+	tw := tabwriter.NewWriter(j.out, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "ID\tCOMMAND\tOPTION\tPRIORITY\tSTATUS\tATTEMPTS\tNOT BEFORE\tWORKER\tLAST ERROR")
+
+	for _, job := range jobs {
+		fmt.Fprintf(
+			tw,
+			"%d\t%s\t%s\t%d\t%s\t%d/%d\t%s\t%s\t%s\n",
+			job.ID,
+			job.Command,
+			job.Option,
+			job.Priority,
+			job.Status,
+			job.Attempts,
+			job.MaxAttempts,
+			job.NotBefore.UTC().Format(time.RFC3339),
+			stringOrEmpty(job.WorkerID),
+			stringOrEmpty(job.LastError),
+		)
+	}
+
+	if err := tw.Flush(); err != nil {
+		j.logger.Errorf("Error writing jobs: %s", err.Error())
+	}
+
+	done <- true
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func NewJobProcessor(q app.JobQueue, out io.Writer, log *logrus.Logger) *JobProcessor {
+	return &JobProcessor{queue: q, out: out, logger: log}
+}
@@ -0,0 +1,77 @@
+package process_test
+
+import (
+	"bytes"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/process"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestJobProcessor_Process(t *testing.T) {
+	t.Run("enqueues job using default max attempts", func(t *testing.T) {
+		t.Helper()
+
+		queue := new(mock.JobQueue)
+		logger, _ := test.NewNullLogger()
+		out := bytes.Buffer{}
+
+		processor := process.NewJobProcessor(queue, &out, logger)
+
+		queue.On("Enqueue", m.MatchedBy(func(j *app.Job) bool {
+			return j.Command == "team-stats:by-season-id" && j.Option == "16036" && j.Priority == 10 &&
+				j.MaxAttempts == 3 && j.NotBefore.Equal(time.Date(2021, 2, 12, 2, 0, 0, 0, time.UTC))
+		})).Run(func(args m.Arguments) {
+			args.Get(0).(*app.Job).ID = 45
+		}).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process(
+			"job:enqueue",
+			`{"command": "team-stats:by-season-id", "option": "16036", "priority": 10, "not_before": "2021-02-12T02:00:00Z"}`,
+			done,
+		)
+
+		<-done
+
+		queue.AssertExpectations(t)
+		assert.Equal(t, "Job 45 enqueued to run 'team-stats:by-season-id 16036' from 2021-02-12T02:00:00Z\n", out.String())
+	})
+
+	t.Run("lists recent jobs", func(t *testing.T) {
+		t.Helper()
+
+		queue := new(mock.JobQueue)
+		logger, _ := test.NewNullLogger()
+		out := bytes.Buffer{}
+
+		processor := process.NewJobProcessor(queue, &out, logger)
+
+		worker, reason := "worker-1", "exit status 1"
+		date := time.Date(2021, 2, 12, 2, 0, 0, 0, time.UTC)
+
+		jobs := []app.Job{
+			{ID: 46, Command: "results:by-season-id", Option: "16036", Status: "running", Attempts: 1, MaxAttempts: 3, NotBefore: date, WorkerID: &worker},
+			{ID: 45, Command: "season", Priority: 5, Status: "failed", Attempts: 3, MaxAttempts: 3, NotBefore: date, LastError: &reason},
+		}
+
+		queue.On("Recent", uint64(50)).Return(jobs, nil)
+
+		done := make(chan bool)
+
+		processor.Process("job:list", "", done)
+
+		<-done
+
+		expected := "ID  COMMAND               OPTION  PRIORITY  STATUS   ATTEMPTS  NOT BEFORE            WORKER    LAST ERROR\n" +
+			"46  results:by-season-id  16036   0         running  1/3       2021-02-12T02:00:00Z  worker-1  \n" +
+			"45  season                        5         failed   3/3       2021-02-12T02:00:00Z            exit status 1\n"
+
+		assert.Equal(t, expected, out.String())
+	})
+}
@@ -0,0 +1,89 @@
+package process
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"strconv"
+	"time"
+)
+
+const lineupByDate = "lineup:by-date"
+const lineupByFixtureId = "lineup:by-fixture-id"
+const lineupBySeasonId = "lineup:by-season-id"
+const lineupCurrentSeason = "lineup:current-season"
+
+// LineupProcessor fetches data from external data source using the LineupRequester
+// before persisting to the storage engine using the LineupRepository.
+type LineupProcessor struct {
+	lineupRepo      app.LineupRepository
+	competitionRepo app.CompetitionRepository
+	seasonRepo      app.SeasonRepository
+	requester       app.LineupRequester
+	logger          *logrus.Logger
+}
+
+func (l LineupProcessor) Process(command string, option string, done chan bool) {
+	switch command {
+	case lineupByDate:
+		go l.processByDate(option, done)
+	case lineupByFixtureId:
+		id, _ := strconv.Atoi(option)
+		go l.persistLineups(l.requester.LineupsByFixtureIDs([]uint64{uint64(id)}), done)
+	case lineupBySeasonId:
+		id, _ := strconv.Atoi(option)
+		go l.persistLineups(l.requester.LineupsBySeasonIDs([]uint64{uint64(id)}), done)
+	case lineupCurrentSeason:
+		go l.processCurrentSeason(done)
+	default:
+		l.logger.Fatalf("Command %s is not supported", command)
+		return
+	}
+}
+
+func (l LineupProcessor) processByDate(date string, done chan bool) {
+	d, err := time.Parse("2006-01-02", date)
+
+	if err != nil {
+		l.logger.Fatalf("Error parsing date in lineup processor: %s", err.Error())
+		return
+	}
+
+	ids, err := l.competitionRepo.IDs()
+
+	if err != nil {
+		l.logger.Fatalf("Error fetching competition IDs in lineup processor: %s", err.Error())
+		return
+	}
+
+	go l.persistLineups(l.requester.LineupsByDate(d, ids), done)
+}
+
+func (l LineupProcessor) processCurrentSeason(done chan bool) {
+	ids, err := l.seasonRepo.CurrentSeasonIDs()
+
+	if err != nil {
+		l.logger.Fatalf("Error when retrieving season ids: %s", err.Error())
+		return
+	}
+
+	go l.persistLineups(l.requester.LineupsBySeasonIDs(ids), done)
+}
+
+func (l LineupProcessor) persistLineups(ch <-chan app.Lineup, done chan bool) {
+	for x := range ch {
+		if err := l.lineupRepo.Save(&x); err != nil {
+			l.logger.Warningf(
+				"Error '%s' occurred when saving lineup for fixture %d team %d",
+				err.Error(),
+				x.FixtureID,
+				x.TeamID,
+			)
+		}
+	}
+
+	done <- true
+}
+
+func NewLineupProcessor(r app.LineupRepository, c app.CompetitionRepository, s app.SeasonRepository, q app.LineupRequester, log *logrus.Logger) *LineupProcessor {
+	return &LineupProcessor{lineupRepo: r, competitionRepo: c, seasonRepo: s, requester: q, logger: log}
+}
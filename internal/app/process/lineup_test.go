@@ -0,0 +1,115 @@
+package process_test
+
+import (
+	"errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/process"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestLineupProcessor_Process(t *testing.T) {
+	t.Run("saves lineups for fixtures on date when processing lineup by date command", func(t *testing.T) {
+		t.Helper()
+
+		lineupRepo := new(mock.LineupRepository)
+		competitionRepo := new(mock.CompetitionRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.LineupRequester)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewLineupProcessor(lineupRepo, competitionRepo, seasonRepo, requester, logger)
+
+		home := app.Lineup{FixtureID: 45, TeamID: 1, Players: []app.LineupPlayer{{PlayerID: 10}}}
+		away := app.Lineup{FixtureID: 45, TeamID: 14, Players: []app.LineupPlayer{{PlayerID: 20}}}
+
+		date := time.Date(2019, 9, 22, 0, 0, 0, 0, time.UTC)
+
+		competitionRepo.On("IDs").Return([]uint64{8, 9}, nil)
+		requester.On("LineupsByDate", date, []uint64{8, 9}).Return(lineupChannel([]app.Lineup{home, away}))
+		lineupRepo.On("Save", &home).Return(nil).Once()
+		lineupRepo.On("Save", &away).Return(nil).Once()
+
+		done := make(chan bool)
+
+		processor.Process("lineup:by-date", "2019-09-22", done)
+
+		<-done
+
+		requester.AssertExpectations(t)
+		competitionRepo.AssertExpectations(t)
+		lineupRepo.AssertExpectations(t)
+		assert.Nil(t, hook.LastEntry())
+	})
+
+	t.Run("saves lineups for current seasons when processing lineup current season command", func(t *testing.T) {
+		t.Helper()
+
+		lineupRepo := new(mock.LineupRepository)
+		competitionRepo := new(mock.CompetitionRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.LineupRequester)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewLineupProcessor(lineupRepo, competitionRepo, seasonRepo, requester, logger)
+
+		home := app.Lineup{FixtureID: 45, TeamID: 1}
+
+		seasonRepo.On("CurrentSeasonIDs").Return([]uint64{16036}, nil)
+		requester.On("LineupsBySeasonIDs", []uint64{16036}).Return(lineupChannel([]app.Lineup{home}))
+		lineupRepo.On("Save", &home).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process("lineup:current-season", "", done)
+
+		<-done
+
+		requester.AssertExpectations(t)
+		seasonRepo.AssertExpectations(t)
+		lineupRepo.AssertExpectations(t)
+		assert.Nil(t, hook.LastEntry())
+	})
+
+	t.Run("logs warning if lineup cannot be saved", func(t *testing.T) {
+		t.Helper()
+
+		lineupRepo := new(mock.LineupRepository)
+		competitionRepo := new(mock.CompetitionRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.LineupRequester)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewLineupProcessor(lineupRepo, competitionRepo, seasonRepo, requester, logger)
+
+		home := app.Lineup{FixtureID: 45, TeamID: 1}
+
+		requester.On("LineupsByFixtureIDs", []uint64{45}).Return(lineupChannel([]app.Lineup{home}))
+		lineupRepo.On("Save", &home).Return(errors.New("oh damn"))
+
+		done := make(chan bool)
+
+		processor.Process("lineup:by-fixture-id", "45", done)
+
+		<-done
+
+		assert.Equal(t, 1, len(hook.Entries))
+		assert.Equal(t, "Error 'oh damn' occurred when saving lineup for fixture 45 team 1", hook.LastEntry().Message)
+		competitionRepo.AssertNotCalled(t, "IDs")
+	})
+}
+
+func lineupChannel(lineups []app.Lineup) chan app.Lineup {
+	ch := make(chan app.Lineup, len(lineups))
+
+	for _, l := range lineups {
+		ch <- l
+	}
+
+	close(ch)
+
+	return ch
+}
@@ -0,0 +1,209 @@
+package process
+
+import (
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"time"
+)
+
+const live = "live"
+
+const livePollInterval = 30 * time.Second
+
+// liveFixtureWindow is how long after kick-off a fixture without a final status is polled before it is given up
+// on, protecting against fixtures that are suspended and never resumed by the data provider.
+const liveFixtureWindow = 4 * time.Hour
+
+// LiveProcessor polls the external data source using the LiveRequester for fixtures in play on the current day,
+// updating results, team stats, events and fixture status until every fixture of the day has a final status. Events
+// no longer returned for a fixture are removed. A ResultFinalised event is published with the stored result when a
+// fixture transitions to a finished status.
+type LiveProcessor struct {
+	fixtureRepo app.FixtureRepository
+	results     ResultProcessor
+	teamStats   TeamStatsProcessor
+	events      EventProcessor
+	requester   app.LiveRequester
+	statuses    fixtureStatusGuard
+	clock       clockwork.Clock
+	logger      *logrus.Logger
+}
+
+func (l LiveProcessor) Process(command string, option string, done chan bool) {
+	switch command {
+	case live:
+		go l.processLive(done)
+	default:
+		l.logger.Fatalf("Command %s is not supported", command)
+		return
+	}
+}
+
+func (l LiveProcessor) processLive(done chan bool) {
+	now := l.clock.Now()
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	to := from.AddDate(0, 0, 1).Add(-time.Second)
+	sort := "date_asc"
+
+	fixtures, err := l.fixtureRepo.Get(app.FixtureRepositoryQuery{DateFrom: &from, DateTo: &to, SortBy: &sort})
+
+	if err != nil {
+		l.logger.Fatalf("Error when retrieving fixtures in live processor: %s", err.Error())
+		return
+	}
+
+	byID := make(map[uint64]*app.Fixture, len(fixtures))
+
+	for i := range fixtures {
+		byID[fixtures[i].ID] = &fixtures[i]
+	}
+
+	for {
+		ids, pending := inPlayFixtureIDs(fixtures, l.clock.Now())
+
+		if pending == 0 {
+			break
+		}
+
+		if len(ids) > 0 {
+			for x := range l.requester.LiveFixtures(ids) {
+				if f, ok := byID[x.FixtureID]; ok {
+					l.persist(x, f)
+				}
+			}
+		}
+
+		<-l.clock.After(livePollInterval)
+	}
+
+	done <- true
+}
+
+// inPlayFixtureIDs returns the IDs of fixtures that have kicked off without reaching a final status alongside the
+// number of fixtures still to reach a final status, including those yet to kick off.
+func inPlayFixtureIDs(fixtures []app.Fixture, now time.Time) ([]uint64, int) {
+	var ids []uint64
+	pending := 0
+
+	for _, f := range fixtures {
+		if f.IsFinal() || f.Date.Add(liveFixtureWindow).Before(now) {
+			continue
+		}
+
+		pending++
+
+		if !f.Date.After(now) {
+			ids = append(ids, f.ID)
+		}
+	}
+
+	return ids, pending
+}
+
+func (l LiveProcessor) persist(x app.LiveFixture, f *app.Fixture) {
+	l.results.persist(x.Result)
+
+	for _, stats := range x.TeamStats {
+		l.teamStats.persist(stats)
+	}
+
+	for _, goal := range x.Goals {
+		l.events.persistGoalEvent(goal)
+	}
+
+	for _, card := range x.Cards {
+		l.events.persistCardEvent(card)
+	}
+
+	for _, sub := range x.Substitutions {
+		l.events.persistSubstitutionEvent(sub)
+	}
+
+	for _, missed := range x.MissedPenalties {
+		l.events.persistMissedPenaltyEvent(missed)
+	}
+
+	l.removeStaleEvents(x)
+
+	if f.Status != nil && *f.Status == x.Status {
+		return
+	}
+
+	status := x.Status
+	l.statuses.check(f.ID, f.Status, &status)
+	f.Status = &status
+
+	var events []app.DomainEvent
+
+	if f.IsFinished() {
+		events = append(events, l.resultFinalised(f.ID)...)
+	}
+
+	if err := l.fixtureRepo.Update(f, events...); err != nil {
+		l.logger.Warningf("Error '%s' occurred when updating status for fixture %d", err.Error(), f.ID)
+	}
+}
+
+// resultFinalised returns the ResultFinalised event built from the stored result of the fixture, so the event
+// carries the score after overrides are applied, or no event if the result cannot be retrieved.
+func (l LiveProcessor) resultFinalised(fixtureID uint64) []app.DomainEvent {
+	res, err := l.results.resultRepo.ByFixtureID(fixtureID)
+
+	if err != nil {
+		l.logger.Warningf("Error '%s' occurred when retrieving result for finished fixture %d", err.Error(), fixtureID)
+		return nil
+	}
+
+	return []app.DomainEvent{app.ResultFinalised{FixtureID: fixtureID, HomeScore: res.HomeScore, AwayScore: res.AwayScore}}
+}
+
+// removeStaleEvents deletes stored events of the fixture no longer returned by the data provider, such as goals
+// ruled out by VAR or cards rescinded after being shown. The provider occasionally omits a type of event from a
+// response so events of a type are only removed if the provider returns at least one event of that type, leaving
+// the last event of a type in place if it is removed by the provider.
+func (l LiveProcessor) removeStaleEvents(x app.LiveFixture) {
+	var keep app.FixtureEventIDs
+
+	for _, goal := range x.Goals {
+		keep.Goals = append(keep.Goals, goal.ID)
+	}
+
+	for _, card := range x.Cards {
+		keep.Cards = append(keep.Cards, card.ID)
+	}
+
+	for _, sub := range x.Substitutions {
+		keep.Substitutions = append(keep.Substitutions, sub.ID)
+	}
+
+	for _, missed := range x.MissedPenalties {
+		keep.MissedPenalties = append(keep.MissedPenalties, missed.ID)
+	}
+
+	if err := l.events.eventRepo.DeleteFixtureEventsExcept(x.FixtureID, keep); err != nil {
+		l.logger.Warningf("Error '%s' occurred when removing stale events for fixture %d", err.Error(), x.FixtureID)
+	}
+}
+
+func NewLiveProcessor(
+	f app.FixtureRepository,
+	r app.ResultRepository,
+	t app.TeamStatsRepository,
+	e app.EventRepository,
+	a app.FixtureStatusAnomalyRepository,
+	q app.LiveRequester,
+	c clockwork.Clock,
+	log *logrus.Logger,
+) *LiveProcessor {
+	return &LiveProcessor{
+		fixtureRepo: f,
+		results:     ResultProcessor{resultRepo: r, clock: c, logger: log},
+		teamStats:   TeamStatsProcessor{teamStatsRepo: t, clock: c, logger: log},
+		events:      EventProcessor{eventRepo: e, clock: c, logger: log},
+		requester:   q,
+		statuses:    fixtureStatusGuard{anomalyRepo: a, logger: log},
+		clock:       c,
+		logger:      log,
+	}
+}
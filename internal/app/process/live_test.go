@@ -0,0 +1,230 @@
+package process_test
+
+import (
+	"errors"
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/process"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestLiveProcessor_Process(t *testing.T) {
+	t.Run("polls fixtures in play until every fixture of the day has a final status", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		teamStatsRepo := new(mock.TeamStatsRepository)
+		eventRepo := new(mock.EventRepository)
+		anomalyRepo := new(mock.FixtureStatusAnomalyRepository)
+		requester := new(mock.LiveRequester)
+		clock := clockwork.NewFakeClockAt(time.Date(2019, 9, 22, 14, 0, 0, 0, time.UTC))
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewLiveProcessor(fixtureRepo, resultRepo, teamStatsRepo, eventRepo, anomalyRepo, requester, clock, logger)
+
+		liveStatus, finished := app.FixtureStatusLive, app.FixtureStatusFullTime
+		kickOff := time.Date(2019, 9, 22, 13, 0, 0, 0, time.UTC)
+
+		fixtures := []app.Fixture{
+			{ID: 44, Date: time.Date(2019, 9, 22, 11, 0, 0, 0, time.UTC), Status: &finished},
+			{ID: 45, Date: kickOff, Status: &liveStatus},
+		}
+
+		first := app.LiveFixture{
+			FixtureID: 45,
+			Status:    "LIVE",
+			Result:    newResult(45),
+			TeamStats: []app.TeamStats{{FixtureID: 45, TeamID: 4509}},
+			Goals:     []app.GoalEvent{newGoalEvent(1)},
+		}
+
+		second := app.LiveFixture{
+			FixtureID: 45,
+			Status:    "FT",
+			Result:    newResult(45),
+			Goals:     []app.GoalEvent{newGoalEvent(1)},
+		}
+
+		fixtureRepo.On("Get", m.AnythingOfType("app.FixtureRepositoryQuery")).Return(fixtures, nil)
+		requester.On("LiveFixtures", []uint64{45}).Return(liveFixtureChannel(first)).Once()
+		requester.On("LiveFixtures", []uint64{45}).Return(liveFixtureChannel(second)).Once()
+		// The stored result carries the overridden score the ResultFinalised event is built from.
+		home, away := 2, 0
+		resultRepo.On("ByFixtureID", uint64(45)).Return(&app.Result{FixtureID: 45, HomeScore: &home, AwayScore: &away}, nil)
+		resultRepo.On("Update", &first.Result).Return(nil).Twice()
+		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(4509)).Return(&app.TeamStats{}, errors.New("not found"))
+		teamStatsRepo.On("InsertTeamStats", &first.TeamStats[0], app.TeamStatsUpdated{FixtureID: 45, TeamID: 4509}).Return(nil).Once()
+		eventRepo.On("GoalEventByID", uint64(1)).Return(&app.GoalEvent{}, errors.New("not found")).Once()
+		eventRepo.On("InsertGoalEvent", &first.Goals[0]).Return(nil).Once()
+		eventRepo.On("GoalEventByID", uint64(1)).Return(&app.GoalEvent{ID: 1}, nil).Once()
+		eventRepo.On("UpdateGoalEvent", &second.Goals[0]).Return(nil).Once()
+		eventRepo.On("DeleteFixtureEventsExcept", uint64(45), app.FixtureEventIDs{Goals: []uint64{1}}).Return(nil).Twice()
+		fixtureRepo.On("Update", &app.Fixture{ID: 45, Date: kickOff, Status: &finished}, app.ResultFinalised{FixtureID: 45, HomeScore: &home, AwayScore: &away}).Return(nil).Once()
+
+		done := make(chan bool)
+
+		processor.Process("live", "", done)
+
+		clock.BlockUntil(1)
+		clock.Advance(30 * time.Second)
+		clock.BlockUntil(1)
+		clock.Advance(30 * time.Second)
+
+		<-done
+
+		fixtureRepo.AssertExpectations(t)
+		requester.AssertExpectations(t)
+		resultRepo.AssertExpectations(t)
+		teamStatsRepo.AssertExpectations(t)
+		eventRepo.AssertExpectations(t)
+		assert.Nil(t, hook.LastEntry())
+	})
+
+	t.Run("does not poll fixtures that kicked off outside of the live window", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		teamStatsRepo := new(mock.TeamStatsRepository)
+		eventRepo := new(mock.EventRepository)
+		anomalyRepo := new(mock.FixtureStatusAnomalyRepository)
+		requester := new(mock.LiveRequester)
+		clock := clockwork.NewFakeClockAt(time.Date(2019, 9, 22, 20, 0, 0, 0, time.UTC))
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewLiveProcessor(fixtureRepo, resultRepo, teamStatsRepo, eventRepo, anomalyRepo, requester, clock, logger)
+
+		suspended := app.FixtureStatusSuspended
+
+		fixtures := []app.Fixture{
+			{ID: 45, Date: time.Date(2019, 9, 22, 13, 0, 0, 0, time.UTC), Status: &suspended},
+		}
+
+		fixtureRepo.On("Get", m.AnythingOfType("app.FixtureRepositoryQuery")).Return(fixtures, nil)
+
+		done := make(chan bool)
+
+		processor.Process("live", "", done)
+
+		<-done
+
+		fixtureRepo.AssertExpectations(t)
+		requester.AssertNotCalled(t, "LiveFixtures", []uint64{45})
+		assert.Nil(t, hook.LastEntry())
+	})
+
+	t.Run("logs warning if fixture status cannot be updated", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		teamStatsRepo := new(mock.TeamStatsRepository)
+		eventRepo := new(mock.EventRepository)
+		anomalyRepo := new(mock.FixtureStatusAnomalyRepository)
+		requester := new(mock.LiveRequester)
+		clock := clockwork.NewFakeClockAt(time.Date(2019, 9, 22, 14, 0, 0, 0, time.UTC))
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewLiveProcessor(fixtureRepo, resultRepo, teamStatsRepo, eventRepo, anomalyRepo, requester, clock, logger)
+
+		notStarted := app.FixtureStatusNotStarted
+
+		fixtures := []app.Fixture{
+			{ID: 45, Date: time.Date(2019, 9, 22, 13, 0, 0, 0, time.UTC), Status: &notStarted},
+		}
+
+		finished := app.LiveFixture{FixtureID: 45, Status: "FT", Result: newResult(45)}
+
+		fixtureRepo.On("Get", m.AnythingOfType("app.FixtureRepositoryQuery")).Return(fixtures, nil)
+		requester.On("LiveFixtures", []uint64{45}).Return(liveFixtureChannel(finished)).Once()
+		resultRepo.On("ByFixtureID", uint64(45)).Return(&app.Result{FixtureID: 45}, nil)
+		resultRepo.On("Update", &finished.Result).Return(nil)
+		eventRepo.On("DeleteFixtureEventsExcept", uint64(45), app.FixtureEventIDs{}).Return(nil)
+		fixtureRepo.On("Update", m.AnythingOfType("*app.Fixture"), app.ResultFinalised{FixtureID: 45}).Return(errors.New("oh damn"))
+
+		done := make(chan bool)
+
+		processor.Process("live", "", done)
+
+		clock.BlockUntil(1)
+		clock.Advance(30 * time.Second)
+
+		<-done
+
+		requester.AssertExpectations(t)
+		fixtureRepo.AssertExpectations(t)
+		assert.Equal(t, 1, len(hook.Entries))
+		assert.Equal(t, logrus.WarnLevel, hook.LastEntry().Level)
+		assert.Equal(t, "Error 'oh damn' occurred when updating status for fixture 45", hook.LastEntry().Message)
+	})
+
+	t.Run("removes events no longer returned by the data provider", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		teamStatsRepo := new(mock.TeamStatsRepository)
+		eventRepo := new(mock.EventRepository)
+		anomalyRepo := new(mock.FixtureStatusAnomalyRepository)
+		requester := new(mock.LiveRequester)
+		clock := clockwork.NewFakeClockAt(time.Date(2019, 9, 22, 14, 0, 0, 0, time.UTC))
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewLiveProcessor(fixtureRepo, resultRepo, teamStatsRepo, eventRepo, anomalyRepo, requester, clock, logger)
+
+		finished := app.FixtureStatusFullTime
+		kickOff := time.Date(2019, 9, 22, 13, 0, 0, 0, time.UTC)
+
+		fixtures := []app.Fixture{{ID: 45, Date: kickOff}}
+
+		x := app.LiveFixture{
+			FixtureID: 45,
+			Status:    "FT",
+			Result:    newResult(45),
+			Cards:     []app.CardEvent{newCardEvent(3)},
+		}
+
+		fixtureRepo.On("Get", m.AnythingOfType("app.FixtureRepositoryQuery")).Return(fixtures, nil)
+		requester.On("LiveFixtures", []uint64{45}).Return(liveFixtureChannel(x)).Once()
+		resultRepo.On("ByFixtureID", uint64(45)).Return(&app.Result{FixtureID: 45}, nil)
+		resultRepo.On("Update", &x.Result).Return(nil)
+		eventRepo.On("CardEventByID", uint64(3)).Return(&app.CardEvent{ID: 3}, nil)
+		eventRepo.On("UpdateCardEvent", &x.Cards[0]).Return(nil)
+		eventRepo.On("DeleteFixtureEventsExcept", uint64(45), app.FixtureEventIDs{Cards: []uint64{3}}).Return(errors.New("oh damn"))
+		fixtureRepo.On("Update", &app.Fixture{ID: 45, Date: kickOff, Status: &finished}, app.ResultFinalised{FixtureID: 45}).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process("live", "", done)
+
+		clock.BlockUntil(1)
+		clock.Advance(30 * time.Second)
+
+		<-done
+
+		eventRepo.AssertExpectations(t)
+		fixtureRepo.AssertExpectations(t)
+		assert.Equal(t, 1, len(hook.Entries))
+		assert.Equal(t, logrus.WarnLevel, hook.LastEntry().Level)
+		assert.Equal(t, "Error 'oh damn' occurred when removing stale events for fixture 45", hook.LastEntry().Message)
+	})
+}
+
+func liveFixtureChannel(fixtures ...app.LiveFixture) chan app.LiveFixture {
+	ch := make(chan app.LiveFixture, len(fixtures))
+
+	for _, f := range fixtures {
+		ch <- f
+	}
+
+	close(ch)
+
+	return ch
+}
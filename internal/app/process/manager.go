@@ -0,0 +1,148 @@
+package process
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"strconv"
+)
+
+const manager = "manager"
+const managerCurrentSeason = "manager:current-season"
+const managerBySeasonId = "manager:by-season-id"
+
+// ManagerProcessor fetches the managers in charge of each team for each fixture using the ManagerRequester,
+// persisting managers using the ManagerRepository and sightings using the ManagerSightingRepository. Each team's
+// tenure history is rebuilt from every sighting stored for the team using the ManagerTenureRepository.
+type ManagerProcessor struct {
+	managerRepo  app.ManagerRepository
+	sightingRepo app.ManagerSightingRepository
+	tenureRepo   app.ManagerTenureRepository
+	seasonRepo   app.SeasonRepository
+	requester    app.ManagerRequester
+	logger       *logrus.Logger
+}
+
+func (m ManagerProcessor) Process(command string, option string, done chan bool) {
+	switch command {
+	case manager:
+		go m.processAllSeasons(done)
+	case managerCurrentSeason:
+		go m.processCurrentSeason(done)
+	case managerBySeasonId:
+		id, _ := strconv.Atoi(option)
+		go m.processSeasons([]uint64{uint64(id)}, done)
+	default:
+		m.logger.Fatalf("Command %s is not supported", command)
+		return
+	}
+}
+
+func (m ManagerProcessor) processAllSeasons(done chan bool) {
+	ids, err := m.seasonRepo.IDs()
+
+	if err != nil {
+		m.logger.Fatalf("Error when retrieving season ids: %s", err.Error())
+		return
+	}
+
+	m.processSeasons(ids, done)
+}
+
+func (m ManagerProcessor) processCurrentSeason(done chan bool) {
+	ids, err := m.seasonRepo.CurrentSeasonIDs()
+
+	if err != nil {
+		m.logger.Fatalf("Error when retrieving season ids: %s", err.Error())
+		return
+	}
+
+	m.processSeasons(ids, done)
+}
+
+func (m ManagerProcessor) processSeasons(ids []uint64, done chan bool) {
+	ch := m.requester.ManagerSightingsBySeasonIDs(ids)
+
+	persisted := map[uint64]bool{}
+	teams := map[uint64]bool{}
+
+	for s := range ch {
+		if !persisted[s.Manager.ID] {
+			m.persistManager(&s.Manager)
+			persisted[s.Manager.ID] = true
+		}
+
+		if err := m.sightingRepo.Save(&s); err != nil {
+			m.logger.Warningf(
+				"Error '%s' occurred when persisting manager sighting for team %d and fixture %d",
+				err.Error(),
+				s.TeamID,
+				s.FixtureID,
+			)
+			continue
+		}
+
+		teams[s.TeamID] = true
+	}
+
+	for teamID := range teams {
+		m.persistTenures(teamID)
+	}
+
+	done <- true
+}
+
+func (m ManagerProcessor) persistManager(x *app.Manager) {
+	_, err := m.managerRepo.ByID(x.ID)
+
+	if err != nil {
+		if err := m.managerRepo.Insert(x); err != nil {
+			m.logger.Warningf("Error '%s' occurred when inserting manager struct: %+v\n,", err.Error(), *x)
+		}
+
+		return
+	}
+
+	if err := m.managerRepo.Update(x); err != nil {
+		m.logger.Warningf("Error '%s' occurred when updating manager struct: %+v\n,", err.Error(), *x)
+	}
+}
+
+func (m ManagerProcessor) persistTenures(teamID uint64) {
+	sightings, err := m.sightingRepo.ByTeamID(teamID)
+
+	if err != nil {
+		m.logger.Warningf("Error '%s' occurred when fetching manager sightings for team %d", err.Error(), teamID)
+		return
+	}
+
+	existing, err := m.tenureRepo.ByTeamID(teamID)
+
+	if err != nil {
+		m.logger.Warningf("Error '%s' occurred when fetching manager tenures for team %d", err.Error(), teamID)
+		return
+	}
+
+	tenures := app.BuildManagerTenures(teamID, sightings, existing)
+
+	if err := m.tenureRepo.ReplaceForTeam(teamID, tenures); err != nil {
+		m.logger.Warningf("Error '%s' occurred when persisting manager tenures for team %d", err.Error(), teamID)
+	}
+}
+
+func NewManagerProcessor(
+	m app.ManagerRepository,
+	g app.ManagerSightingRepository,
+	t app.ManagerTenureRepository,
+	s app.SeasonRepository,
+	r app.ManagerRequester,
+	log *logrus.Logger,
+) *ManagerProcessor {
+	return &ManagerProcessor{
+		managerRepo:  m,
+		sightingRepo: g,
+		tenureRepo:   t,
+		seasonRepo:   s,
+		requester:    r,
+		logger:       log,
+	}
+}
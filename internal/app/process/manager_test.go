@@ -0,0 +1,208 @@
+package process_test
+
+import (
+	"errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/process"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestManagerProcessor_Process(t *testing.T) {
+	t.Run("persists managers and sightings and rebuilds tenure history for each team", func(t *testing.T) {
+		t.Helper()
+
+		managerRepo := new(mock.ManagerRepository)
+		sightingRepo := new(mock.ManagerSightingRepository)
+		tenureRepo := new(mock.ManagerTenureRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.ManagerRequester)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewManagerProcessor(managerRepo, sightingRepo, tenureRepo, seasonRepo, requester, logger)
+
+		created := time.Unix(1546300900, 0)
+
+		existing := []app.ManagerTenure{
+			{TeamID: 1, ManagerID: 10, From: time.Unix(1546300800, 0), To: time.Unix(1556668800, 0), CreatedAt: created},
+		}
+
+		received := []app.ManagerSighting{
+			{Manager: app.Manager{ID: 10}, TeamID: 1, FixtureID: 100, Date: time.Unix(1565445600, 0)},
+			{Manager: app.Manager{ID: 20}, TeamID: 1, FixtureID: 101, Date: time.Unix(1577836800, 0)},
+		}
+
+		stored := []app.ManagerSighting{
+			{Manager: app.Manager{ID: 10}, TeamID: 1, FixtureID: 90, Date: time.Unix(1546300800, 0)},
+			{Manager: app.Manager{ID: 10}, TeamID: 1, FixtureID: 95, Date: time.Unix(1556668800, 0)},
+			{Manager: app.Manager{ID: 10}, TeamID: 1, FixtureID: 100, Date: time.Unix(1565445600, 0)},
+			{Manager: app.Manager{ID: 20}, TeamID: 1, FixtureID: 101, Date: time.Unix(1577836800, 0)},
+		}
+
+		seasonRepo.On("CurrentSeasonIDs").Return([]uint64{16036}, nil)
+		requester.On("ManagerSightingsBySeasonIDs", []uint64{16036}).Return(managerSightingChannel(received))
+		managerRepo.On("ByID", uint64(10)).Return(&app.Manager{ID: 10}, nil)
+		managerRepo.On("ByID", uint64(20)).Return(&app.Manager{}, errors.New("not found"))
+		managerRepo.On("Update", &app.Manager{ID: 10}).Return(nil)
+		managerRepo.On("Insert", &app.Manager{ID: 20}).Return(nil)
+		sightingRepo.On("Save", &received[0]).Return(nil)
+		sightingRepo.On("Save", &received[1]).Return(nil)
+		sightingRepo.On("ByTeamID", uint64(1)).Return(stored, nil)
+		tenureRepo.On("ByTeamID", uint64(1)).Return(existing, nil)
+		tenureRepo.On("ReplaceForTeam", uint64(1), m.MatchedBy(func(t []app.ManagerTenure) bool {
+			return len(t) == 2 &&
+				t[0].ManagerID == 10 && t[0].From.Unix() == 1546300800 && t[0].To.Unix() == 1565445600 &&
+				t[0].CreatedAt.Equal(created) &&
+				t[1].ManagerID == 20 && t[1].From.Unix() == 1577836800 && t[1].CreatedAt.IsZero()
+		})).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process("manager:current-season", "", done)
+
+		<-done
+
+		managerRepo.AssertExpectations(t)
+		sightingRepo.AssertExpectations(t)
+		tenureRepo.AssertExpectations(t)
+		requester.AssertExpectations(t)
+		assert.Nil(t, hook.LastEntry())
+	})
+
+	t.Run("rebuilds tenure boundaries from stored sightings when an older season is processed after a newer one", func(t *testing.T) {
+		t.Helper()
+
+		managerRepo := new(mock.ManagerRepository)
+		sightingRepo := new(mock.ManagerSightingRepository)
+		tenureRepo := new(mock.ManagerTenureRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.ManagerRequester)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewManagerProcessor(managerRepo, sightingRepo, tenureRepo, seasonRepo, requester, logger)
+
+		existing := []app.ManagerTenure{
+			{TeamID: 1, ManagerID: 10, From: time.Unix(1546300800, 0), To: time.Unix(1589500800, 0)},
+		}
+
+		stored := []app.ManagerSighting{
+			{Manager: app.Manager{ID: 10}, TeamID: 1, FixtureID: 90, Date: time.Unix(1546300800, 0)},
+			{Manager: app.Manager{ID: 10}, TeamID: 1, FixtureID: 95, Date: time.Unix(1551398400, 0)},
+			{Manager: app.Manager{ID: 20}, TeamID: 1, FixtureID: 100, Date: time.Unix(1556668800, 0)},
+			{Manager: app.Manager{ID: 20}, TeamID: 1, FixtureID: 101, Date: time.Unix(1565445600, 0)},
+			{Manager: app.Manager{ID: 10}, TeamID: 1, FixtureID: 110, Date: time.Unix(1580515200, 0)},
+			{Manager: app.Manager{ID: 10}, TeamID: 1, FixtureID: 120, Date: time.Unix(1589500800, 0)},
+		}
+
+		ch := managerSightingChannel([]app.ManagerSighting{stored[3], stored[2]})
+
+		requester.On("ManagerSightingsBySeasonIDs", []uint64{16036}).Return(ch)
+		managerRepo.On("ByID", uint64(20)).Return(&app.Manager{ID: 20}, nil)
+		managerRepo.On("Update", &app.Manager{ID: 20}).Return(nil)
+		sightingRepo.On("Save", m.AnythingOfType("*app.ManagerSighting")).Return(nil)
+		sightingRepo.On("ByTeamID", uint64(1)).Return(stored, nil)
+		tenureRepo.On("ByTeamID", uint64(1)).Return(existing, nil)
+		tenureRepo.On("ReplaceForTeam", uint64(1), m.MatchedBy(func(t []app.ManagerTenure) bool {
+			return len(t) == 3 &&
+				t[0].ManagerID == 10 && t[0].From.Unix() == 1546300800 && t[0].To.Unix() == 1551398400 &&
+				t[1].ManagerID == 20 && t[1].From.Unix() == 1556668800 && t[1].To.Unix() == 1565445600 &&
+				t[2].ManagerID == 10 && t[2].From.Unix() == 1580515200 && t[2].To.Unix() == 1589500800
+		})).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process("manager:by-season-id", "16036", done)
+
+		<-done
+
+		sightingRepo.AssertNumberOfCalls(t, "Save", 2)
+		tenureRepo.AssertExpectations(t)
+		assert.Nil(t, hook.LastEntry())
+	})
+
+	t.Run("logs warning and does not rebuild tenures if sighting cannot be persisted", func(t *testing.T) {
+		t.Helper()
+
+		managerRepo := new(mock.ManagerRepository)
+		sightingRepo := new(mock.ManagerSightingRepository)
+		tenureRepo := new(mock.ManagerTenureRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.ManagerRequester)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewManagerProcessor(managerRepo, sightingRepo, tenureRepo, seasonRepo, requester, logger)
+
+		ch := managerSightingChannel([]app.ManagerSighting{
+			{Manager: app.Manager{ID: 10}, TeamID: 1, FixtureID: 100, Date: time.Unix(1565445600, 0)},
+		})
+
+		requester.On("ManagerSightingsBySeasonIDs", []uint64{16036}).Return(ch)
+		managerRepo.On("ByID", uint64(10)).Return(&app.Manager{ID: 10}, nil)
+		managerRepo.On("Update", &app.Manager{ID: 10}).Return(nil)
+		sightingRepo.On("Save", m.AnythingOfType("*app.ManagerSighting")).Return(errors.New("oh damn"))
+
+		done := make(chan bool)
+
+		processor.Process("manager:by-season-id", "16036", done)
+
+		<-done
+
+		assert.Equal(
+			t,
+			"Error 'oh damn' occurred when persisting manager sighting for team 1 and fixture 100",
+			hook.LastEntry().Message,
+		)
+		sightingRepo.AssertNotCalled(t, "ByTeamID", uint64(1))
+		tenureRepo.AssertNotCalled(t, "ReplaceForTeam", uint64(1), m.Anything)
+	})
+
+	t.Run("logs warning if tenures cannot be persisted", func(t *testing.T) {
+		t.Helper()
+
+		managerRepo := new(mock.ManagerRepository)
+		sightingRepo := new(mock.ManagerSightingRepository)
+		tenureRepo := new(mock.ManagerTenureRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.ManagerRequester)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewManagerProcessor(managerRepo, sightingRepo, tenureRepo, seasonRepo, requester, logger)
+
+		sightings := []app.ManagerSighting{
+			{Manager: app.Manager{ID: 10}, TeamID: 1, FixtureID: 100, Date: time.Unix(1565445600, 0)},
+		}
+
+		requester.On("ManagerSightingsBySeasonIDs", []uint64{16036}).Return(managerSightingChannel(sightings))
+		managerRepo.On("ByID", uint64(10)).Return(&app.Manager{ID: 10}, nil)
+		managerRepo.On("Update", &app.Manager{ID: 10}).Return(nil)
+		sightingRepo.On("Save", m.AnythingOfType("*app.ManagerSighting")).Return(nil)
+		sightingRepo.On("ByTeamID", uint64(1)).Return(sightings, nil)
+		tenureRepo.On("ByTeamID", uint64(1)).Return([]app.ManagerTenure{}, nil)
+		tenureRepo.On("ReplaceForTeam", uint64(1), m.Anything).Return(errors.New("oh damn"))
+
+		done := make(chan bool)
+
+		processor.Process("manager:by-season-id", "16036", done)
+
+		<-done
+
+		assert.Equal(t, "Error 'oh damn' occurred when persisting manager tenures for team 1", hook.LastEntry().Message)
+		seasonRepo.AssertNotCalled(t, "CurrentSeasonIDs")
+	})
+}
+
+func managerSightingChannel(sightings []app.ManagerSighting) chan app.ManagerSighting {
+	ch := make(chan app.ManagerSighting, len(sightings))
+
+	for _, s := range sightings {
+		ch <- s
+	}
+
+	close(ch)
+
+	return ch
+}
@@ -0,0 +1,155 @@
+package process
+
+import (
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/importer"
+	"io"
+	"os"
+	"strconv"
+	"time"
+)
+
+const oddsByFixtureId = "odds:by-fixture-id"
+const oddsUpcoming = "odds:upcoming"
+const oddsCSV = "odds:csv"
+
+// OddsProcessor captures bookmaker prices from SportMonks or an odds CSV file. Every captured price is persisted,
+// including prices back-filled with a capture time earlier than the latest price stored. A price is only skipped if
+// a price captured at the same time is already stored for the same selection so re-running an import is safe.
+type OddsProcessor struct {
+	oddsRepo    app.OddsRepository
+	fixtureRepo app.FixtureRepository
+	requester   app.OddsRequester
+	clock       clockwork.Clock
+	logger      *logrus.Logger
+}
+
+func (o OddsProcessor) Process(command string, option string, done chan bool) {
+	switch command {
+	case oddsByFixtureId:
+		id, _ := strconv.Atoi(option)
+		go o.processFixtures([]uint64{uint64(id)}, done)
+	case oddsUpcoming:
+		go o.processUpcoming(option, done)
+	case oddsCSV:
+		go o.processCSV(option, done)
+	default:
+		o.logger.Fatalf("Command %s is not supported", command)
+		return
+	}
+}
+
+func (o OddsProcessor) processFixtures(ids []uint64, done chan bool) {
+	ch := o.requester.OddsByFixtureIDs(ids)
+
+	go o.persistOdds(ch, done)
+}
+
+// processUpcoming captures prices for fixtures kicking off within the number of hours provided as the option,
+// defaulting to 24 hours.
+func (o OddsProcessor) processUpcoming(option string, done chan bool) {
+	hours := 24
+
+	if option != "" {
+		h, err := strconv.Atoi(option)
+
+		if err != nil {
+			o.logger.Fatalf("Error parsing hours option in odds processor: %s", err.Error())
+			return
+		}
+
+		hours = h
+	}
+
+	from := o.clock.Now()
+	to := from.Add(time.Duration(hours) * time.Hour)
+
+	ids, err := o.fixtureRepo.GetIDs(app.FixtureRepositoryQuery{DateFrom: &from, DateTo: &to})
+
+	if err != nil {
+		o.logger.Fatalf("Error fetching upcoming fixture IDs in odds processor: %s", err.Error())
+		return
+	}
+
+	o.processFixtures(ids, done)
+}
+
+func (o OddsProcessor) processCSV(path string, done chan bool) {
+	in, err := os.Open(path)
+
+	if err != nil {
+		o.logger.Fatalf("Error opening odds csv file %s: %s", path, err.Error())
+		return
+	}
+
+	reader, err := importer.NewOddsReader(in)
+
+	if err != nil {
+		in.Close()
+		o.logger.Fatalf("Error reading odds csv file %s: %s", path, err.Error())
+		return
+	}
+
+	ch := make(chan app.Odds, 1000)
+
+	go func() {
+		defer close(ch)
+		defer in.Close()
+
+		for {
+			x, err := reader.Next()
+
+			if err == io.EOF {
+				return
+			}
+
+			if err != nil {
+				o.logger.Warnf("Skipping row in odds csv file %s: %s", path, err.Error())
+				continue
+			}
+
+			ch <- *x
+		}
+	}()
+
+	go o.persistOdds(ch, done)
+}
+
+func (o OddsProcessor) persistOdds(ch <-chan app.Odds, done chan bool) {
+	for x := range ch {
+		o.persist(x)
+	}
+
+	done <- true
+}
+
+// persist inserts the price unless the same price capture is already stored, so repeated requests and imports do
+// not duplicate history while prices captured earlier than the latest stored price are kept.
+func (o OddsProcessor) persist(x app.Odds) {
+	exists, err := o.oddsRepo.Exists(&x)
+
+	if err != nil {
+		o.logger.Errorf("Error '%s' occurred when checking odds struct exists: %+v\n,", err.Error(), x)
+		return
+	}
+
+	if exists {
+		return
+	}
+
+	if err := o.oddsRepo.Insert(&x); err != nil {
+		o.logger.Errorf("Error '%s' occurred when inserting odds struct: %+v\n,", err.Error(), x)
+	}
+}
+
+func NewOddsProcessor(
+	r app.OddsRepository,
+	f app.FixtureRepository,
+	q app.OddsRequester,
+	c clockwork.Clock,
+	log *logrus.Logger,
+) *OddsProcessor {
+	return &OddsProcessor{oddsRepo: r, fixtureRepo: f, requester: q, clock: c, logger: log}
+}
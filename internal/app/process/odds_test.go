@@ -0,0 +1,150 @@
+package process_test
+
+import (
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/process"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestOddsProcessor_Process(t *testing.T) {
+	t.Run("inserts odds unless the same price capture is already stored", func(t *testing.T) {
+		t.Helper()
+
+		oddsRepo := new(mock.OddsRepository)
+		fixtureRepo := new(mock.FixtureRepository)
+		requester := new(mock.OddsRequester)
+		clock := clockwork.NewFakeClock()
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewOddsProcessor(oddsRepo, fixtureRepo, requester, clock, logger)
+
+		captured := time.Unix(1600000000, 0)
+
+		home := newOdds("1", 2.10, captured)
+		draw := newOdds("X", 3.40, captured.Add(-time.Hour))
+		away := newOdds("2", 3.75, captured)
+
+		requester.On("OddsByFixtureIDs", []uint64{16475287}).Return(oddsChannel([]app.Odds{home, draw, away}))
+
+		oddsRepo.On("Exists", &home).Return(true, nil)
+		oddsRepo.On("Exists", &draw).Return(false, nil)
+		oddsRepo.On("Exists", &away).Return(false, nil)
+		oddsRepo.On("Insert", &draw).Return(nil)
+		oddsRepo.On("Insert", &away).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process("odds:by-fixture-id", "16475287", done)
+
+		<-done
+
+		requester.AssertExpectations(t)
+		oddsRepo.AssertExpectations(t)
+		oddsRepo.AssertNumberOfCalls(t, "Insert", 2)
+		assert.Nil(t, hook.LastEntry())
+	})
+
+	t.Run("captures odds for fixtures kicking off within the hours provided", func(t *testing.T) {
+		t.Helper()
+
+		oddsRepo := new(mock.OddsRepository)
+		fixtureRepo := new(mock.FixtureRepository)
+		requester := new(mock.OddsRequester)
+		clock := clockwork.NewFakeClockAt(time.Date(2020, 9, 12, 9, 0, 0, 0, time.UTC))
+		logger, _ := test.NewNullLogger()
+
+		processor := process.NewOddsProcessor(oddsRepo, fixtureRepo, requester, clock, logger)
+
+		fixtureRepo.On("GetIDs", m.MatchedBy(func(q app.FixtureRepositoryQuery) bool {
+			return q.DateFrom.Equal(clock.Now()) && q.DateTo.Equal(clock.Now().Add(6*time.Hour))
+		})).Return([]uint64{16475287, 16475288}, nil)
+		requester.On("OddsByFixtureIDs", []uint64{16475287, 16475288}).Return(oddsChannel([]app.Odds{}))
+
+		done := make(chan bool)
+
+		processor.Process("odds:upcoming", "6", done)
+
+		<-done
+
+		fixtureRepo.AssertExpectations(t)
+		requester.AssertExpectations(t)
+	})
+
+	t.Run("inserts odds parsed from csv file and logs rows that cannot be parsed", func(t *testing.T) {
+		t.Helper()
+
+		oddsRepo := new(mock.OddsRepository)
+		fixtureRepo := new(mock.FixtureRepository)
+		requester := new(mock.OddsRequester)
+		clock := clockwork.NewFakeClock()
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewOddsProcessor(oddsRepo, fixtureRepo, requester, clock, logger)
+
+		dir, err := ioutil.TempDir("", "odds")
+
+		if err != nil {
+			t.Fatalf("Error creating temp dir: %s", err.Error())
+		}
+
+		defer os.RemoveAll(dir)
+
+		file := filepath.Join(dir, "odds.csv")
+
+		csv := "fixture_id,bookmaker_id,bookmaker,market,selection,price,captured_at\n" +
+			"16475287,2,bet365,3Way Result,1,2.10,2020-09-12T10:15:00Z\n" +
+			"16475287,2,bet365,3Way Result,X,,2020-09-12T10:15:00Z\n"
+
+		if err := ioutil.WriteFile(file, []byte(csv), 0644); err != nil {
+			t.Fatalf("Error writing csv file: %s", err.Error())
+		}
+
+		oddsRepo.On("Exists", m.AnythingOfType("*app.Odds")).Return(false, nil)
+		oddsRepo.On("Insert", m.MatchedBy(func(o *app.Odds) bool {
+			return o.Selection == "1" && o.Price == 2.10 && o.CapturedAt.Unix() == 1599905700
+		})).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process("odds:csv", file, done)
+
+		<-done
+
+		oddsRepo.AssertExpectations(t)
+		assert.Equal(t, 1, len(hook.AllEntries()))
+		assert.Contains(t, hook.LastEntry().Message, "line 3: value '' for column price is not a valid price")
+	})
+}
+
+func newOdds(selection string, price float32, captured time.Time) app.Odds {
+	return app.Odds{
+		FixtureID:   16475287,
+		BookmakerID: 2,
+		Bookmaker:   "bet365",
+		Market:      "3Way Result",
+		Selection:   selection,
+		Price:       price,
+		CapturedAt:  captured,
+	}
+}
+
+func oddsChannel(odds []app.Odds) chan app.Odds {
+	ch := make(chan app.Odds, len(odds))
+
+	for _, o := range odds {
+		ch <- o
+	}
+
+	close(ch)
+
+	return ch
+}
@@ -0,0 +1,95 @@
+package process
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+)
+
+const outboxRelay = "outbox:relay"
+
+const outboxRelayBatchSize = 100
+
+// outboxMaxAttempts is the number of times a message is relayed before it is dead lettered.
+const outboxMaxAttempts = 10
+
+// OutboxRelayProcessor drains domain events stored in the outbox by publishing each to the EventPublisher
+// provided. Messages that fail to publish are marked as failed and remain in the outbox to be relayed again on
+// the next run, until the message has failed outboxMaxAttempts times and is dead lettered.
+type OutboxRelayProcessor struct {
+	outboxRepo app.OutboxRepository
+	publisher  app.EventPublisher
+	logger     *logrus.Logger
+}
+
+func (o OutboxRelayProcessor) Process(command string, option string, done chan bool) {
+	switch command {
+	case outboxRelay:
+		go o.processRelay(done)
+	default:
+		o.logger.Fatalf("Command %s is not supported", command)
+		return
+	}
+}
+
+func (o OutboxRelayProcessor) processRelay(done chan bool) {
+	var afterID uint64
+
+	for {
+		messages, err := o.outboxRepo.Pending(afterID, outboxRelayBatchSize)
+
+		if err != nil {
+			o.logger.Fatalf("Error when retrieving pending outbox messages: %s", err.Error())
+			return
+		}
+
+		if len(messages) == 0 {
+			break
+		}
+
+		for _, msg := range messages {
+			o.relay(msg)
+			afterID = msg.ID
+		}
+	}
+
+	done <- true
+}
+
+func (o OutboxRelayProcessor) relay(msg app.OutboxMessage) {
+	e, err := app.DecodeDomainEvent(msg.Name, msg.Payload)
+
+	if err == nil {
+		err = o.publisher.Publish(e)
+	}
+
+	if err != nil {
+		o.logger.Warningf("Error '%s' occurred when relaying outbox message %d", err.Error(), msg.ID)
+
+		if msg.Attempts+1 >= outboxMaxAttempts {
+			o.deadLetter(msg, err)
+			return
+		}
+
+		if err := o.outboxRepo.MarkFailed(msg.ID, err.Error()); err != nil {
+			o.logger.Errorf("Error '%s' occurred when marking outbox message %d as failed", err.Error(), msg.ID)
+		}
+
+		return
+	}
+
+	if err := o.outboxRepo.MarkRelayed(msg.ID); err != nil {
+		o.logger.Errorf("Error '%s' occurred when marking outbox message %d as relayed", err.Error(), msg.ID)
+	}
+}
+
+func (o OutboxRelayProcessor) deadLetter(msg app.OutboxMessage, reason error) {
+	o.logger.Errorf("Outbox message %d dead lettered after %d failed attempts", msg.ID, outboxMaxAttempts)
+
+	if err := o.outboxRepo.MarkDeadLettered(msg.ID, reason.Error()); err != nil {
+		o.logger.Errorf("Error '%s' occurred when marking outbox message %d as dead lettered", err.Error(), msg.ID)
+	}
+}
+
+func NewOutboxRelayProcessor(r app.OutboxRepository, p app.EventPublisher, log *logrus.Logger) *OutboxRelayProcessor {
+	return &OutboxRelayProcessor{outboxRepo: r, publisher: p, logger: log}
+}
@@ -0,0 +1,112 @@
+package process_test
+
+import (
+	"errors"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/process"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOutboxRelayProcessor_Process(t *testing.T) {
+	t.Run("publishes pending outbox messages and marks them as relayed", func(t *testing.T) {
+		t.Helper()
+
+		outboxRepo := new(mock.OutboxRepository)
+		publisher := new(mock.EventPublisher)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewOutboxRelayProcessor(outboxRepo, publisher, logger)
+
+		done := make(chan bool)
+
+		messages := []app.OutboxMessage{
+			{ID: 4, Name: "result.finalised", AggregateID: 45, Payload: []byte(`{"fixture_id":45,"home_score":2,"away_score":1}`)},
+			{ID: 7, Name: "team-stats.updated", AggregateID: 45, Payload: []byte(`{"fixture_id":45,"team_id":1}`)},
+		}
+
+		home, away := 2, 1
+
+		outboxRepo.On("Pending", uint64(0), uint64(100)).Return(messages, nil)
+		outboxRepo.On("Pending", uint64(7), uint64(100)).Return([]app.OutboxMessage{}, nil)
+		publisher.On("Publish", app.ResultFinalised{FixtureID: 45, HomeScore: &home, AwayScore: &away}).Return(nil)
+		publisher.On("Publish", app.TeamStatsUpdated{FixtureID: 45, TeamID: 1}).Return(nil)
+		outboxRepo.On("MarkRelayed", uint64(4)).Return(nil)
+		outboxRepo.On("MarkRelayed", uint64(7)).Return(nil)
+
+		processor.Process("outbox:relay", "", done)
+
+		<-done
+
+		outboxRepo.AssertExpectations(t)
+		publisher.AssertExpectations(t)
+		assert.Nil(t, hook.LastEntry())
+	})
+
+	t.Run("marks message as failed and logs warning if unable to publish", func(t *testing.T) {
+		t.Helper()
+
+		outboxRepo := new(mock.OutboxRepository)
+		publisher := new(mock.EventPublisher)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewOutboxRelayProcessor(outboxRepo, publisher, logger)
+
+		done := make(chan bool)
+
+		messages := []app.OutboxMessage{
+			{ID: 4, Name: "fixture.moved", AggregateID: 45, Payload: []byte(`{}`)},
+			{ID: 5, Name: "team-stats.updated", AggregateID: 45, Payload: []byte(`{"fixture_id":45,"team_id":1}`)},
+		}
+
+		outboxRepo.On("Pending", uint64(0), uint64(100)).Return(messages, nil)
+		outboxRepo.On("Pending", uint64(5), uint64(100)).Return([]app.OutboxMessage{}, nil)
+		publisher.On("Publish", app.TeamStatsUpdated{FixtureID: 45, TeamID: 1}).Return(errors.New("handler failed"))
+		outboxRepo.On("MarkFailed", uint64(4), "domain event 'fixture.moved' is not supported").Return(nil)
+		outboxRepo.On("MarkFailed", uint64(5), "handler failed").Return(nil)
+
+		processor.Process("outbox:relay", "", done)
+
+		<-done
+
+		outboxRepo.AssertExpectations(t)
+		outboxRepo.AssertNotCalled(t, "MarkRelayed", uint64(5))
+		assert.Equal(t, 2, len(hook.Entries))
+		assert.Equal(t, logrus.WarnLevel, hook.LastEntry().Level)
+		assert.Equal(t, "Error 'handler failed' occurred when relaying outbox message 5", hook.LastEntry().Message)
+	})
+
+	t.Run("dead letters message and logs error when the final attempt fails", func(t *testing.T) {
+		t.Helper()
+
+		outboxRepo := new(mock.OutboxRepository)
+		publisher := new(mock.EventPublisher)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewOutboxRelayProcessor(outboxRepo, publisher, logger)
+
+		done := make(chan bool)
+
+		messages := []app.OutboxMessage{
+			{ID: 5, Name: "team-stats.updated", AggregateID: 45, Payload: []byte(`{"fixture_id":45,"team_id":1}`), Attempts: 9},
+		}
+
+		outboxRepo.On("Pending", uint64(0), uint64(100)).Return(messages, nil)
+		outboxRepo.On("Pending", uint64(5), uint64(100)).Return([]app.OutboxMessage{}, nil)
+		publisher.On("Publish", app.TeamStatsUpdated{FixtureID: 45, TeamID: 1}).Return(errors.New("handler failed"))
+		outboxRepo.On("MarkDeadLettered", uint64(5), "handler failed").Return(nil)
+
+		processor.Process("outbox:relay", "", done)
+
+		<-done
+
+		outboxRepo.AssertExpectations(t)
+		outboxRepo.AssertNotCalled(t, "MarkFailed", uint64(5), "handler failed")
+		assert.Equal(t, 2, len(hook.Entries))
+		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, "Outbox message 5 dead lettered after 10 failed attempts", hook.LastEntry().Message)
+	})
+}
@@ -17,6 +17,7 @@ type PlayerStatsProcessor struct {
 	competitionRepo app.CompetitionRepository
 	seasonRepo      app.SeasonRepository
 	requester       app.PlayerStatRequester
+	checkpoints     seasonCheckpoints
 	clock           clockwork.Clock
 	logger          *logrus.Logger
 }
@@ -27,10 +28,10 @@ func (p PlayerStatsProcessor) Process(command string, option string, done chan b
 		go p.processByDate(option, done)
 	case playerStatsBySeasonId:
 		id, _ := strconv.Atoi(option)
-		go p.processSeason(uint64(id), done)
+		go p.processSeasons(command, option, []uint64{uint64(id)}, done)
 	case playerStatsByCompetitionId:
 		id, _ := strconv.Atoi(option)
-		go p.processCompetition(uint64(id), done)
+		go p.processCompetition(command, option, uint64(id), done)
 	default:
 		p.logger.Fatalf("Command %s is not supported", command)
 		return
@@ -57,13 +58,7 @@ func (p PlayerStatsProcessor) processByDate(date string, done chan bool) {
 	go p.persistStats(ch, done)
 }
 
-func (p PlayerStatsProcessor) processSeason(seasonID uint64, done chan bool) {
-	ch := p.requester.PlayerStatsBySeasonIDs([]uint64{seasonID})
-
-	go p.persistStats(ch, done)
-}
-
-func (p PlayerStatsProcessor) processCompetition(competitionID uint64, done chan bool) {
+func (p PlayerStatsProcessor) processCompetition(command, option string, competitionID uint64, done chan bool) {
 	seasons, err := p.seasonRepo.ByCompetitionId(competitionID, "name_asc")
 
 	if err != nil {
@@ -77,9 +72,39 @@ func (p PlayerStatsProcessor) processCompetition(competitionID uint64, done chan
 		ids = append(ids, s.ID)
 	}
 
-	ch := p.requester.PlayerStatsBySeasonIDs(ids)
+	p.processSeasons(command, option, ids, done)
+}
 
-	go p.persistStats(ch, done)
+// processSeasons requests and persists player stats one season at a time, recording checkpoints as seasons and
+// fixtures are persisted so the run can be resumed.
+func (p PlayerStatsProcessor) processSeasons(command, option string, ids []uint64, done chan bool) {
+	progress, err := p.checkpoints.begin(command, option, ids)
+
+	if err != nil {
+		p.logger.Fatalf("Error when loading checkpoints in player stats processor: %s", err.Error())
+		return
+	}
+
+	for _, id := range progress.pending {
+		season, err := p.requester.PlayerStatsBySeasonID(id)
+
+		if err != nil {
+			progress.failSeason(id, err)
+			continue
+		}
+
+		for _, stats := range season {
+			if progress.persist(stats.FixtureID) && !p.persist(stats) {
+				progress.failFixture()
+			}
+		}
+
+		progress.completeSeason(id)
+	}
+
+	progress.finish()
+
+	done <- true
 }
 
 func (p PlayerStatsProcessor) persistStats(ch <-chan *app.PlayerStats, done chan bool) {
@@ -90,22 +115,25 @@ func (p PlayerStatsProcessor) persistStats(ch <-chan *app.PlayerStats, done chan
 	done <- true
 }
 
-func (p PlayerStatsProcessor) persist(x *app.PlayerStats) {
+// persist inserts or updates the player stats, returning false if the stats could not be stored.
+func (p PlayerStatsProcessor) persist(x *app.PlayerStats) bool {
 	_, err := p.playerStatsRepo.ByFixtureAndPlayer(x.FixtureID, x.PlayerID)
 
 	if err != nil {
 		if err := p.playerStatsRepo.Insert(x); err != nil {
 			p.logger.Errorf("Error '%s' occurred when inserting player stats struct: %+v\n,", err.Error(), *x)
+			return false
 		}
 
-		return
+		return true
 	}
 
 	if err := p.playerStatsRepo.Update(x); err != nil {
 		p.logger.Errorf("Error '%s' occurred when updating player stats struct: %+v\n,", err.Error(), *x)
+		return false
 	}
 
-	return
+	return true
 }
 
 func NewPlayerStatsProcessor(
@@ -113,6 +141,8 @@ func NewPlayerStatsProcessor(
 	c app.CompetitionRepository,
 	s app.SeasonRepository,
 	q app.PlayerStatRequester,
+	k app.CheckpointRepository,
+	resume bool,
 	cl clockwork.Clock,
 	log *logrus.Logger,
 ) *PlayerStatsProcessor {
@@ -121,6 +151,7 @@ func NewPlayerStatsProcessor(
 		competitionRepo: c,
 		seasonRepo: s,
 		requester: q,
+		checkpoints: seasonCheckpoints{checkpointRepo: k, resume: resume, logger: log},
 		clock: cl,
 		logger: log,
 	}
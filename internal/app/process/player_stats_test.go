@@ -9,6 +9,7 @@ import (
 	"github.com/statistico/statistico-football-data/internal/app/mock"
 	"github.com/statistico/statistico-football-data/internal/app/process"
 	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
 	"testing"
 	"time"
 )
@@ -22,9 +23,13 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.PlayerStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "player-stats:by-season-id 45").Return(nil)
+		checkpointRepo.On("Complete", "player-stats:by-season-id 45", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -35,9 +40,7 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		stats[0] = one
 		stats[1] = two
 
-		ch := playerStatsChannel(stats)
-
-		requester.On("PlayerStatsBySeasonIDs", []uint64{45}).Return(ch)
+		requester.On("PlayerStatsBySeasonID", uint64(45)).Return(stats, nil)
 		playerStatsRepo.On("ByFixtureAndPlayer", uint64(45), uint64(99)).Return(&app.PlayerStats{}, errors.New("not found"))
 		playerStatsRepo.On("ByFixtureAndPlayer", uint64(45), uint64(5)).Return(&app.PlayerStats{}, errors.New("not found"))
 		playerStatsRepo.On("Insert", one).Return(nil)
@@ -49,7 +52,7 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 
 		requester.AssertExpectations(t)
 		playerStatsRepo.AssertExpectations(t)
-		assert.Nil(t, hook.LastEntry())
+		assert.Equal(t, "Processed season 45 of 'player-stats:by-season-id 45': 1 of 1 seasons complete (100%)", hook.LastEntry().Message)
 	})
 
 	t.Run("logs error if unable to insert player stats into repository when processing player stats by season id command", func(t *testing.T) {
@@ -60,9 +63,13 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.PlayerStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "player-stats:by-season-id 45").Return(nil)
+		checkpointRepo.On("Complete", "player-stats:by-season-id 45", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -73,9 +80,7 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		stats[0] = one
 		stats[1] = two
 
-		ch := playerStatsChannel(stats)
-
-		requester.On("PlayerStatsBySeasonIDs", []uint64{45}).Return(ch)
+		requester.On("PlayerStatsBySeasonID", uint64(45)).Return(stats, nil)
 		playerStatsRepo.On("ByFixtureAndPlayer", uint64(45), uint64(99)).Return(&app.PlayerStats{}, errors.New("not found"))
 		playerStatsRepo.On("ByFixtureAndPlayer", uint64(45), uint64(5)).Return(&app.PlayerStats{}, errors.New("not found"))
 		playerStatsRepo.On("Insert", one).Return(errors.New("error occurred"))
@@ -87,8 +92,9 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 
 		requester.AssertExpectations(t)
 		playerStatsRepo.AssertExpectations(t)
-		assert.Equal(t, 1, len(hook.Entries))
-		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, 3, len(hook.Entries))
+		assert.Equal(t, logrus.ErrorLevel, hook.Entries[0].Level)
+		assert.Equal(t, "'player-stats:by-season-id 45' finished with 1 of 1 seasons incomplete, resume the run to retry them", hook.LastEntry().Message)
 	})
 
 	t.Run("update existing player stats into repository when processing player stats by season id command", func(t *testing.T) {
@@ -99,9 +105,13 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.PlayerStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "player-stats:by-season-id 45").Return(nil)
+		checkpointRepo.On("Complete", "player-stats:by-season-id 45", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -112,9 +122,7 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		stats[0] = one
 		stats[1] = two
 
-		ch := playerStatsChannel(stats)
-
-		requester.On("PlayerStatsBySeasonIDs", []uint64{45}).Return(ch)
+		requester.On("PlayerStatsBySeasonID", uint64(45)).Return(stats, nil)
 		playerStatsRepo.On("ByFixtureAndPlayer", uint64(45), uint64(99)).Return(one, nil)
 		playerStatsRepo.On("ByFixtureAndPlayer", uint64(45), uint64(5)).Return(two, nil)
 		playerStatsRepo.On("Update", one).Return(nil)
@@ -128,7 +136,7 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		playerStatsRepo.AssertExpectations(t)
 		competitionRepo.AssertExpectations(t)
 		seasonRepo.AssertExpectations(t)
-		assert.Nil(t, hook.LastEntry())
+		assert.Equal(t, "Processed season 45 of 'player-stats:by-season-id 45': 1 of 1 seasons complete (100%)", hook.LastEntry().Message)
 	})
 
 	t.Run("logs error if unable to update player stats into repository when processing player stats by season id command", func(t *testing.T) {
@@ -139,9 +147,13 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.PlayerStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "player-stats:by-season-id 45").Return(nil)
+		checkpointRepo.On("Complete", "player-stats:by-season-id 45", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -152,9 +164,7 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		stats[0] = one
 		stats[1] = two
 
-		ch := playerStatsChannel(stats)
-
-		requester.On("PlayerStatsBySeasonIDs", []uint64{45}).Return(ch)
+		requester.On("PlayerStatsBySeasonID", uint64(45)).Return(stats, nil)
 		playerStatsRepo.On("ByFixtureAndPlayer", uint64(45), uint64(99)).Return(one, nil)
 		playerStatsRepo.On("ByFixtureAndPlayer", uint64(45), uint64(5)).Return(two, nil)
 		playerStatsRepo.On("Update", one).Return(nil)
@@ -168,8 +178,9 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		playerStatsRepo.AssertExpectations(t)
 		competitionRepo.AssertExpectations(t)
 		seasonRepo.AssertExpectations(t)
-		assert.Equal(t, 1, len(hook.Entries))
-		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, 3, len(hook.Entries))
+		assert.Equal(t, logrus.ErrorLevel, hook.Entries[0].Level)
+		assert.Equal(t, "'player-stats:by-season-id 45' finished with 1 of 1 seasons incomplete, resume the run to retry them", hook.LastEntry().Message)
 	})
 
 	t.Run("inserts new player stats into repository when processing player stats by date command", func(t *testing.T) {
@@ -180,9 +191,10 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.PlayerStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
 
 		done := make(chan bool)
 
@@ -223,9 +235,10 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.PlayerStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
 
 		done := make(chan bool)
 
@@ -267,9 +280,10 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.PlayerStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
 
 		done := make(chan bool)
 
@@ -311,9 +325,10 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.PlayerStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
 
 		done := make(chan bool)
 
@@ -356,9 +371,13 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.PlayerStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "player-stats:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "player-stats:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -369,8 +388,6 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		stats[0] = one
 		stats[1] = two
 
-		ch := playerStatsChannel(stats)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -379,7 +396,8 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("PlayerStatsBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("PlayerStatsBySeasonID", uint64(1)).Return(stats, nil)
+		requester.On("PlayerStatsBySeasonID", uint64(2)).Return([]*app.PlayerStats(nil), nil)
 		playerStatsRepo.On("ByFixtureAndPlayer", uint64(45), uint64(99)).Return(&app.PlayerStats{}, errors.New("not found"))
 		playerStatsRepo.On("ByFixtureAndPlayer", uint64(45), uint64(5)).Return(&app.PlayerStats{}, errors.New("not found"))
 		playerStatsRepo.On("Insert", one).Return(nil)
@@ -392,7 +410,7 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		requester.AssertExpectations(t)
 		playerStatsRepo.AssertExpectations(t)
 		competitionRepo.AssertExpectations(t)
-		assert.Nil(t, hook.LastEntry())
+		assert.Equal(t, "Processed season 2 of 'player-stats:by-competition-id 5': 2 of 2 seasons complete (100%)", hook.LastEntry().Message)
 	})
 
 	t.Run("logs error if unable to insert player stats into repository when processing player stats by competition id command", func(t *testing.T) {
@@ -403,9 +421,13 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.PlayerStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "player-stats:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "player-stats:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -416,8 +438,6 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		stats[0] = one
 		stats[1] = two
 
-		ch := playerStatsChannel(stats)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -426,7 +446,8 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("PlayerStatsBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("PlayerStatsBySeasonID", uint64(1)).Return(stats, nil)
+		requester.On("PlayerStatsBySeasonID", uint64(2)).Return([]*app.PlayerStats(nil), nil)
 		playerStatsRepo.On("ByFixtureAndPlayer", uint64(45), uint64(99)).Return(&app.PlayerStats{}, errors.New("not found"))
 		playerStatsRepo.On("ByFixtureAndPlayer", uint64(45), uint64(5)).Return(&app.PlayerStats{}, errors.New("not found"))
 		playerStatsRepo.On("Insert", one).Return(errors.New("error occurred"))
@@ -439,8 +460,9 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		requester.AssertExpectations(t)
 		playerStatsRepo.AssertExpectations(t)
 		competitionRepo.AssertExpectations(t)
-		assert.Equal(t, 1, len(hook.Entries))
-		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, 4, len(hook.Entries))
+		assert.Equal(t, logrus.ErrorLevel, hook.Entries[0].Level)
+		assert.Equal(t, "'player-stats:by-competition-id 5' finished with 1 of 2 seasons incomplete, resume the run to retry them", hook.LastEntry().Message)
 	})
 
 	t.Run("update existing player stats into repository when processing player stats by competition id command", func(t *testing.T) {
@@ -451,9 +473,13 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.PlayerStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "player-stats:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "player-stats:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -464,8 +490,6 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		stats[0] = one
 		stats[1] = two
 
-		ch := playerStatsChannel(stats)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -474,7 +498,8 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("PlayerStatsBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("PlayerStatsBySeasonID", uint64(1)).Return(stats, nil)
+		requester.On("PlayerStatsBySeasonID", uint64(2)).Return([]*app.PlayerStats(nil), nil)
 		playerStatsRepo.On("ByFixtureAndPlayer", uint64(45), uint64(99)).Return(one, nil)
 		playerStatsRepo.On("ByFixtureAndPlayer", uint64(45), uint64(5)).Return(two, nil)
 		playerStatsRepo.On("Update", one).Return(nil)
@@ -487,7 +512,7 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		requester.AssertExpectations(t)
 		playerStatsRepo.AssertExpectations(t)
 		competitionRepo.AssertExpectations(t)
-		assert.Nil(t, hook.LastEntry())
+		assert.Equal(t, "Processed season 2 of 'player-stats:by-competition-id 5': 2 of 2 seasons complete (100%)", hook.LastEntry().Message)
 	})
 
 	t.Run("logs error if unable to update player stats into repository when processing player stats by competition id command", func(t *testing.T) {
@@ -498,9 +523,13 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.PlayerStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "player-stats:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "player-stats:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -511,8 +540,6 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		stats[0] = one
 		stats[1] = two
 
-		ch := playerStatsChannel(stats)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -521,7 +548,8 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("PlayerStatsBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("PlayerStatsBySeasonID", uint64(1)).Return(stats, nil)
+		requester.On("PlayerStatsBySeasonID", uint64(2)).Return([]*app.PlayerStats(nil), nil)
 		playerStatsRepo.On("ByFixtureAndPlayer", uint64(45), uint64(99)).Return(&app.PlayerStats{}, nil)
 		playerStatsRepo.On("ByFixtureAndPlayer", uint64(45), uint64(5)).Return(&app.PlayerStats{}, nil)
 		playerStatsRepo.On("Update", one).Return(nil)
@@ -534,8 +562,73 @@ func TestPlayerStatsProcessor_Process(t *testing.T) {
 		requester.AssertExpectations(t)
 		playerStatsRepo.AssertExpectations(t)
 		competitionRepo.AssertExpectations(t)
-		assert.Equal(t, 1, len(hook.Entries))
-		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, 4, len(hook.Entries))
+		assert.Equal(t, logrus.ErrorLevel, hook.Entries[0].Level)
+		assert.Equal(t, "'player-stats:by-competition-id 5' finished with 1 of 2 seasons incomplete, resume the run to retry them", hook.LastEntry().Message)
+	})
+
+	t.Run("records fixtures as complete once every player stat of the fixture is persisted", func(t *testing.T) {
+		t.Helper()
+
+		playerStatsRepo := new(mock.PlayerStatsRepository)
+		competitionRepo := new(mock.CompetitionRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.PlayerStatsRequester)
+		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		done := make(chan bool)
+
+		stats := []*app.PlayerStats{newPlayerStats(45, 1), newPlayerStats(45, 2), newPlayerStats(46, 1)}
+
+		var completed []uint64
+
+		checkpointRepo.On("Clear", "player-stats:by-season-id 12").Return(nil)
+		checkpointRepo.On("Complete", "player-stats:by-season-id 12", app.CheckpointFixture, m.AnythingOfType("uint64")).
+			Run(func(args m.Arguments) { completed = append(completed, args.Get(2).(uint64)) }).
+			Return(nil)
+		checkpointRepo.On("Complete", "player-stats:by-season-id 12", app.CheckpointSeason, uint64(12)).Return(nil)
+
+		requester.On("PlayerStatsBySeasonID", uint64(12)).Return(stats, nil)
+		playerStatsRepo.On("ByFixtureAndPlayer", m.AnythingOfType("uint64"), m.AnythingOfType("uint64")).Return(&app.PlayerStats{}, nil)
+		playerStatsRepo.On("Update", m.AnythingOfType("*app.PlayerStats")).Return(nil)
+
+		processor.Process("player-stats:by-season-id", "12", done)
+
+		<-done
+
+		checkpointRepo.AssertExpectations(t)
+		checkpointRepo.AssertNumberOfCalls(t, "Clear", 2)
+		assert.Equal(t, []uint64{45, 46}, completed)
+		assert.Equal(t, "Processed season 12 of 'player-stats:by-season-id 12': 1 of 1 seasons complete (100%)", hook.LastEntry().Message)
+	})
+
+	t.Run("does not record a season without player stats as complete", func(t *testing.T) {
+		t.Helper()
+
+		playerStatsRepo := new(mock.PlayerStatsRepository)
+		competitionRepo := new(mock.CompetitionRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.PlayerStatsRequester)
+		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
+		logger, _ := test.NewNullLogger()
+
+		processor := process.NewPlayerStatsProcessor(playerStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		done := make(chan bool)
+
+		checkpointRepo.On("Clear", "player-stats:by-season-id 12").Return(nil)
+		requester.On("PlayerStatsBySeasonID", uint64(12)).Return([]*app.PlayerStats(nil), nil)
+
+		processor.Process("player-stats:by-season-id", "12", done)
+
+		<-done
+
+		checkpointRepo.AssertNotCalled(t, "Complete", m.Anything, m.Anything, m.Anything)
 	})
 }
 
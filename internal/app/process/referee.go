@@ -0,0 +1,92 @@
+package process
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"strconv"
+)
+
+const referee = "referee"
+const refereeCurrentSeason = "referee:current-season"
+const refereeBySeasonId = "referee:by-season-id"
+
+// RefereeProcessor fetches data from external data source using the RefereeRequester
+// before persisting to the storage engine using the RefereeRepository.
+type RefereeProcessor struct {
+	refereeRepo app.RefereeRepository
+	seasonRepo  app.SeasonRepository
+	requester   app.RefereeRequester
+	logger      *logrus.Logger
+}
+
+func (r RefereeProcessor) Process(command string, option string, done chan bool) {
+	switch command {
+	case referee:
+		go r.processAllSeasons(done)
+	case refereeCurrentSeason:
+		go r.processCurrentSeason(done)
+	case refereeBySeasonId:
+		id, _ := strconv.Atoi(option)
+		go r.persistReferees(r.requester.RefereesBySeasonIDs([]uint64{uint64(id)}), done)
+	default:
+		r.logger.Fatalf("Command %s is not supported", command)
+		return
+	}
+}
+
+func (r RefereeProcessor) processAllSeasons(done chan bool) {
+	ids, err := r.seasonRepo.IDs()
+
+	if err != nil {
+		r.logger.Fatalf("Error when retrieving season ids: %s", err.Error())
+		return
+	}
+
+	go r.persistReferees(r.requester.RefereesBySeasonIDs(ids), done)
+}
+
+func (r RefereeProcessor) processCurrentSeason(done chan bool) {
+	ids, err := r.seasonRepo.CurrentSeasonIDs()
+
+	if err != nil {
+		r.logger.Fatalf("Error when retrieving season ids: %s", err.Error())
+		return
+	}
+
+	go r.persistReferees(r.requester.RefereesBySeasonIDs(ids), done)
+}
+
+func (r RefereeProcessor) persistReferees(ch <-chan app.Referee, done chan bool) {
+	persisted := map[uint64]bool{}
+
+	for x := range ch {
+		if persisted[x.ID] {
+			continue
+		}
+
+		r.persist(&x)
+		persisted[x.ID] = true
+	}
+
+	done <- true
+}
+
+func (r RefereeProcessor) persist(x *app.Referee) {
+	_, err := r.refereeRepo.ByID(x.ID)
+
+	if err != nil {
+		if err := r.refereeRepo.Insert(x); err != nil {
+			r.logger.Warningf("Error '%s' occurred when inserting referee struct: %+v\n,", err.Error(), *x)
+		}
+
+		return
+	}
+
+	if err := r.refereeRepo.Update(x); err != nil {
+		r.logger.Warningf("Error '%s' occurred when updating referee struct: %+v\n,", err.Error(), *x)
+	}
+}
+
+func NewRefereeProcessor(r app.RefereeRepository, s app.SeasonRepository, q app.RefereeRequester, log *logrus.Logger) *RefereeProcessor {
+	return &RefereeProcessor{refereeRepo: r, seasonRepo: s, requester: q, logger: log}
+}
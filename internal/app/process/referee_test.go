@@ -0,0 +1,85 @@
+package process_test
+
+import (
+	"errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/process"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRefereeProcessor_Process(t *testing.T) {
+	t.Run("inserts new and updates existing referees once when processing referee command", func(t *testing.T) {
+		t.Helper()
+
+		refereeRepo := new(mock.RefereeRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.RefereeRequester)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewRefereeProcessor(refereeRepo, seasonRepo, requester, logger)
+
+		oliver := app.Referee{ID: 14532, CommonName: "M. Oliver"}
+		taylor := app.Referee{ID: 14533, CommonName: "A. Taylor"}
+
+		ids := []uint64{45, 51}
+
+		seasonRepo.On("IDs").Return(ids, nil)
+		requester.On("RefereesBySeasonIDs", ids).Return(refereeChannel([]app.Referee{oliver, taylor, oliver}))
+		refereeRepo.On("ByID", uint64(14532)).Return(&app.Referee{}, errors.New("not found")).Once()
+		refereeRepo.On("ByID", uint64(14533)).Return(&app.Referee{ID: 14533}, nil).Once()
+		refereeRepo.On("Insert", &oliver).Return(nil).Once()
+		refereeRepo.On("Update", &taylor).Return(nil).Once()
+
+		done := make(chan bool)
+
+		processor.Process("referee", "", done)
+
+		<-done
+
+		requester.AssertExpectations(t)
+		seasonRepo.AssertExpectations(t)
+		refereeRepo.AssertExpectations(t)
+		assert.Nil(t, hook.LastEntry())
+	})
+
+	t.Run("logs warning if referee cannot be inserted", func(t *testing.T) {
+		t.Helper()
+
+		refereeRepo := new(mock.RefereeRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.RefereeRequester)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewRefereeProcessor(refereeRepo, seasonRepo, requester, logger)
+
+		oliver := app.Referee{ID: 14532}
+
+		requester.On("RefereesBySeasonIDs", []uint64{16036}).Return(refereeChannel([]app.Referee{oliver}))
+		refereeRepo.On("ByID", uint64(14532)).Return(&app.Referee{}, errors.New("not found"))
+		refereeRepo.On("Insert", &oliver).Return(errors.New("oh damn"))
+
+		done := make(chan bool)
+
+		processor.Process("referee:by-season-id", "16036", done)
+
+		<-done
+
+		assert.Equal(t, 1, len(hook.Entries))
+		seasonRepo.AssertNotCalled(t, "IDs")
+	})
+}
+
+func refereeChannel(referees []app.Referee) chan app.Referee {
+	ch := make(chan app.Referee, len(referees))
+
+	for _, r := range referees {
+		ch <- r
+	}
+
+	close(ch)
+
+	return ch
+}
@@ -15,6 +15,7 @@ type ResultProcessor struct {
 	resultRepo  app.ResultRepository
 	seasonRepo  app.SeasonRepository
 	requester   app.ResultRequester
+	checkpoints seasonCheckpoints
 	clock       clockwork.Clock
 	logger      *logrus.Logger
 }
@@ -25,10 +26,10 @@ func (r ResultProcessor) Process(command string, option string, done chan bool)
 		go r.processCurrentSeason(done)
 	case resultsBySeasonId:
 		id, _ := strconv.Atoi(option)
-		go r.processSeason(uint64(id), done)
+		go r.processSeasons(command, option, []uint64{uint64(id)}, done)
 	case resultsByCompetitionId:
 		id, _ := strconv.Atoi(option)
-		go r.processCompetition(uint64(id), done)
+		go r.processCompetition(command, option, uint64(id), done)
 	default:
 		r.logger.Fatalf("Command %s is not supported", command)
 		return
@@ -48,13 +49,7 @@ func (r ResultProcessor) processCurrentSeason(done chan bool) {
 	go r.persistResults(ch, done)
 }
 
-func (r ResultProcessor) processSeason(seasonID uint64, done chan bool) {
-	ch := r.requester.ResultsBySeasonIDs([]uint64{seasonID})
-
-	go r.persistResults(ch, done)
-}
-
-func (r ResultProcessor) processCompetition(competitionID uint64, done chan bool) {
+func (r ResultProcessor) processCompetition(command, option string, competitionID uint64, done chan bool) {
 	seasons, err := r.seasonRepo.ByCompetitionId(competitionID, "name_asc")
 
 	if err != nil {
@@ -68,9 +63,39 @@ func (r ResultProcessor) processCompetition(competitionID uint64, done chan bool
 		ids = append(ids, season.ID)
 	}
 
-	ch := r.requester.ResultsBySeasonIDs(ids)
+	r.processSeasons(command, option, ids, done)
+}
 
-	go r.persistResults(ch, done)
+// processSeasons requests and persists results one season at a time, recording checkpoints as seasons and fixtures
+// are persisted so the run can be resumed.
+func (r ResultProcessor) processSeasons(command, option string, ids []uint64, done chan bool) {
+	progress, err := r.checkpoints.begin(command, option, ids)
+
+	if err != nil {
+		r.logger.Fatalf("Error when loading checkpoints in result processor: %s", err.Error())
+		return
+	}
+
+	for _, id := range progress.pending {
+		results, err := r.requester.ResultsBySeasonID(id)
+
+		if err != nil {
+			progress.failSeason(id, err)
+			continue
+		}
+
+		for _, result := range results {
+			if progress.persist(result.FixtureID) && !r.persist(result) {
+				progress.failFixture()
+			}
+		}
+
+		progress.completeSeason(id)
+	}
+
+	progress.finish()
+
+	done <- true
 }
 
 func (r ResultProcessor) persistResults(ch <-chan app.Result, done chan bool) {
@@ -81,24 +106,44 @@ func (r ResultProcessor) persistResults(ch <-chan app.Result, done chan bool) {
 	done <- true
 }
 
-func (r ResultProcessor) persist(x app.Result) {
+// persist inserts or updates the result, returning false if the result could not be stored. No ResultFinalised
+// event is published as results ingested here include those of fixtures finished long ago, the LiveProcessor
+// publishes the event when a fixture transitions to a finished status.
+func (r ResultProcessor) persist(x app.Result) bool {
 	_, err := r.resultRepo.ByFixtureID(x.FixtureID)
 
 	if err != nil {
 		if err := r.resultRepo.Insert(&x); err != nil {
 			r.logger.Errorf("Error '%s' occurred when inserting result struct: %+v\n,", err.Error(), x)
+			return false
 		}
 
-		return
+		return true
 	}
 
 	if err := r.resultRepo.Update(&x); err != nil {
 		r.logger.Errorf("Error '%s' occurred when updating result struct: %+v\n,", err.Error(), x)
+		return false
 	}
 
-	return
+	return true
 }
 
-func NewResultProcessor(r app.ResultRepository, f app.SeasonRepository, q app.ResultRequester, c clockwork.Clock, log *logrus.Logger) *ResultProcessor {
-	return &ResultProcessor{resultRepo: r, seasonRepo: f, requester: q, clock: c, logger: log}
+func NewResultProcessor(
+	r app.ResultRepository,
+	f app.SeasonRepository,
+	q app.ResultRequester,
+	k app.CheckpointRepository,
+	resume bool,
+	c clockwork.Clock,
+	log *logrus.Logger,
+) *ResultProcessor {
+	return &ResultProcessor{
+		resultRepo:  r,
+		seasonRepo:  f,
+		requester:   q,
+		checkpoints: seasonCheckpoints{checkpointRepo: k, resume: resume, logger: log},
+		clock:       c,
+		logger:      log,
+	}
 }
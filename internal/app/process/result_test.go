@@ -9,6 +9,7 @@ import (
 	"github.com/statistico/statistico-football-data/internal/app/mock"
 	"github.com/statistico/statistico-football-data/internal/app/process"
 	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
 	"testing"
 )
 
@@ -20,9 +21,13 @@ func TestResultProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.ResultRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "results:by-season-id 34").Return(nil)
+		checkpointRepo.On("Complete", "results:by-season-id 34", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -31,9 +36,7 @@ func TestResultProcessor_Process(t *testing.T) {
 		results := make([]app.Result, 1)
 		results[0] = res
 
-		ch := resultChannel(results)
-
-		requester.On("ResultsBySeasonIDs", []uint64{34}).Return(ch)
+		requester.On("ResultsBySeasonID", uint64(34)).Return(results, nil)
 		resultRepo.On("ByFixtureID", uint64(34)).Return(&app.Result{}, errors.New("not found"))
 		resultRepo.On("Insert", &res).Return(nil)
 		processor.Process("results:by-season-id", "34", done)
@@ -42,7 +45,7 @@ func TestResultProcessor_Process(t *testing.T) {
 
 		requester.AssertExpectations(t)
 		resultRepo.AssertExpectations(t)
-		assert.Nil(t, hook.LastEntry())
+		assert.Equal(t, "Processed season 34 of 'results:by-season-id 34': 1 of 1 seasons complete (100%)", hook.LastEntry().Message)
 	})
 
 	t.Run("updates existing result into repository when processing result by season id command", func(t *testing.T) {
@@ -52,9 +55,13 @@ func TestResultProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.ResultRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "results:by-season-id 34").Return(nil)
+		checkpointRepo.On("Complete", "results:by-season-id 34", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -63,9 +70,7 @@ func TestResultProcessor_Process(t *testing.T) {
 		results := make([]app.Result, 1)
 		results[0] = res
 
-		ch := resultChannel(results)
-
-		requester.On("ResultsBySeasonIDs", []uint64{34}).Return(ch)
+		requester.On("ResultsBySeasonID", uint64(34)).Return(results, nil)
 		resultRepo.On("ByFixtureID", uint64(34)).Return(&app.Result{}, nil)
 		resultRepo.On("Update", &res).Return(nil)
 		processor.Process("results:by-season-id", "34", done)
@@ -74,7 +79,7 @@ func TestResultProcessor_Process(t *testing.T) {
 
 		requester.AssertExpectations(t)
 		resultRepo.AssertExpectations(t)
-		assert.Nil(t, hook.LastEntry())
+		assert.Equal(t, "Processed season 34 of 'results:by-season-id 34': 1 of 1 seasons complete (100%)", hook.LastEntry().Message)
 	})
 
 	t.Run("logs error when unable to insert result into repository when processing result by season id command", func(t *testing.T) {
@@ -84,9 +89,13 @@ func TestResultProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.ResultRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "results:by-season-id 34").Return(nil)
+		checkpointRepo.On("Complete", "results:by-season-id 34", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -95,9 +104,7 @@ func TestResultProcessor_Process(t *testing.T) {
 		results := make([]app.Result, 1)
 		results[0] = res
 
-		ch := resultChannel(results)
-
-		requester.On("ResultsBySeasonIDs", []uint64{34}).Return(ch)
+		requester.On("ResultsBySeasonID", uint64(34)).Return(results, nil)
 		resultRepo.On("ByFixtureID", uint64(34)).Return(&app.Result{}, errors.New("not found"))
 		resultRepo.On("Insert", &res).Return(errors.New("error occurred"))
 		processor.Process("results:by-season-id", "34", done)
@@ -106,8 +113,9 @@ func TestResultProcessor_Process(t *testing.T) {
 
 		requester.AssertExpectations(t)
 		resultRepo.AssertExpectations(t)
-		assert.Equal(t, 1, len(hook.Entries))
-		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, 3, len(hook.Entries))
+		assert.Equal(t, logrus.ErrorLevel, hook.Entries[0].Level)
+		assert.Equal(t, "'results:by-season-id 34' finished with 1 of 1 seasons incomplete, resume the run to retry them", hook.LastEntry().Message)
 	})
 
 	t.Run("logs error when unable to update result into repository when processing result by season id command", func(t *testing.T) {
@@ -117,9 +125,13 @@ func TestResultProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.ResultRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "results:by-season-id 34").Return(nil)
+		checkpointRepo.On("Complete", "results:by-season-id 34", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -128,9 +140,7 @@ func TestResultProcessor_Process(t *testing.T) {
 		results := make([]app.Result, 1)
 		results[0] = res
 
-		ch := resultChannel(results)
-
-		requester.On("ResultsBySeasonIDs", []uint64{34}).Return(ch)
+		requester.On("ResultsBySeasonID", uint64(34)).Return(results, nil)
 		resultRepo.On("ByFixtureID", uint64(34)).Return(&app.Result{}, nil)
 		resultRepo.On("Update", &res).Return(errors.New("error occurred"))
 		processor.Process("results:by-season-id", "34", done)
@@ -140,8 +150,9 @@ func TestResultProcessor_Process(t *testing.T) {
 		requester.AssertExpectations(t)
 		resultRepo.AssertExpectations(t)
 		seasonRepo.AssertExpectations(t)
-		assert.Equal(t, 1, len(hook.Entries))
-		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, 3, len(hook.Entries))
+		assert.Equal(t, logrus.ErrorLevel, hook.Entries[0].Level)
+		assert.Equal(t, "'results:by-season-id 34' finished with 1 of 1 seasons incomplete, resume the run to retry them", hook.LastEntry().Message)
 	})
 
 	t.Run("inserts new result into repository when processing result by competition id command", func(t *testing.T) {
@@ -151,9 +162,13 @@ func TestResultProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.ResultRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "results:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "results:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -162,8 +177,6 @@ func TestResultProcessor_Process(t *testing.T) {
 		results := make([]app.Result, 1)
 		results[0] = res
 
-		ch := resultChannel(results)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -172,7 +185,8 @@ func TestResultProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("ResultsBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("ResultsBySeasonID", uint64(1)).Return(results, nil)
+		requester.On("ResultsBySeasonID", uint64(2)).Return([]app.Result(nil), nil)
 		resultRepo.On("ByFixtureID", uint64(34)).Return(&app.Result{}, errors.New("not found"))
 		resultRepo.On("Insert", &res).Return(nil)
 		processor.Process("results:by-competition-id", "5", done)
@@ -181,7 +195,7 @@ func TestResultProcessor_Process(t *testing.T) {
 
 		requester.AssertExpectations(t)
 		resultRepo.AssertExpectations(t)
-		assert.Nil(t, hook.LastEntry())
+		assert.Equal(t, "Processed season 2 of 'results:by-competition-id 5': 2 of 2 seasons complete (100%)", hook.LastEntry().Message)
 	})
 
 	t.Run("updates existing result into repository when processing result by competition id command", func(t *testing.T) {
@@ -191,9 +205,13 @@ func TestResultProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.ResultRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "results:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "results:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -202,8 +220,6 @@ func TestResultProcessor_Process(t *testing.T) {
 		results := make([]app.Result, 1)
 		results[0] = res
 
-		ch := resultChannel(results)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -212,7 +228,8 @@ func TestResultProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("ResultsBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("ResultsBySeasonID", uint64(1)).Return(results, nil)
+		requester.On("ResultsBySeasonID", uint64(2)).Return([]app.Result(nil), nil)
 		resultRepo.On("ByFixtureID", uint64(34)).Return(&app.Result{}, nil)
 		resultRepo.On("Update", &res).Return(nil)
 		processor.Process("results:by-competition-id", "5", done)
@@ -222,7 +239,7 @@ func TestResultProcessor_Process(t *testing.T) {
 		requester.AssertExpectations(t)
 		resultRepo.AssertExpectations(t)
 		seasonRepo.AssertExpectations(t)
-		assert.Nil(t, hook.LastEntry())
+		assert.Equal(t, "Processed season 2 of 'results:by-competition-id 5': 2 of 2 seasons complete (100%)", hook.LastEntry().Message)
 	})
 
 	t.Run("logs error when unable to insert result into repository when processing result by competition id command", func(t *testing.T) {
@@ -232,9 +249,13 @@ func TestResultProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.ResultRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "results:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "results:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -243,8 +264,6 @@ func TestResultProcessor_Process(t *testing.T) {
 		results := make([]app.Result, 1)
 		results[0] = res
 
-		ch := resultChannel(results)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -253,7 +272,8 @@ func TestResultProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("ResultsBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("ResultsBySeasonID", uint64(1)).Return(results, nil)
+		requester.On("ResultsBySeasonID", uint64(2)).Return([]app.Result(nil), nil)
 		resultRepo.On("ByFixtureID", uint64(34)).Return(&app.Result{}, errors.New("not found"))
 		resultRepo.On("Insert", &res).Return(errors.New("error occurred"))
 		processor.Process("results:by-competition-id", "5", done)
@@ -262,8 +282,9 @@ func TestResultProcessor_Process(t *testing.T) {
 
 		requester.AssertExpectations(t)
 		resultRepo.AssertExpectations(t)
-		assert.Equal(t, 1, len(hook.Entries))
-		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, 4, len(hook.Entries))
+		assert.Equal(t, logrus.ErrorLevel, hook.Entries[0].Level)
+		assert.Equal(t, "'results:by-competition-id 5' finished with 1 of 2 seasons incomplete, resume the run to retry them", hook.LastEntry().Message)
 	})
 
 	t.Run("logs error when unable to update result into repository when processing result by competition id command", func(t *testing.T) {
@@ -273,9 +294,13 @@ func TestResultProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.ResultRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "results:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "results:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -284,8 +309,6 @@ func TestResultProcessor_Process(t *testing.T) {
 		results := make([]app.Result, 1)
 		results[0] = res
 
-		ch := resultChannel(results)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -294,7 +317,8 @@ func TestResultProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("ResultsBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("ResultsBySeasonID", uint64(1)).Return(results, nil)
+		requester.On("ResultsBySeasonID", uint64(2)).Return([]app.Result(nil), nil)
 		resultRepo.On("ByFixtureID", uint64(34)).Return(&app.Result{}, nil)
 		resultRepo.On("Update", &res).Return(errors.New("error occurred"))
 		processor.Process("results:by-competition-id", "5", done)
@@ -304,8 +328,9 @@ func TestResultProcessor_Process(t *testing.T) {
 		requester.AssertExpectations(t)
 		resultRepo.AssertExpectations(t)
 		seasonRepo.AssertExpectations(t)
-		assert.Equal(t, 1, len(hook.Entries))
-		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, 4, len(hook.Entries))
+		assert.Equal(t, logrus.ErrorLevel, hook.Entries[0].Level)
+		assert.Equal(t, "'results:by-competition-id 5' finished with 1 of 2 seasons incomplete, resume the run to retry them", hook.LastEntry().Message)
 	})
 
 	t.Run("inserts new result into repository when processing result current season command", func(t *testing.T) {
@@ -315,9 +340,11 @@ func TestResultProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.ResultRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
 
 		done := make(chan bool)
 
@@ -349,9 +376,11 @@ func TestResultProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.ResultRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
 
 		done := make(chan bool)
 
@@ -384,9 +413,11 @@ func TestResultProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.ResultRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
 
 		done := make(chan bool)
 
@@ -419,9 +450,11 @@ func TestResultProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.ResultRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
 
 		done := make(chan bool)
 
@@ -447,6 +480,115 @@ func TestResultProcessor_Process(t *testing.T) {
 		assert.Equal(t, 1, len(hook.Entries))
 		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
 	})
+
+	t.Run("skips seasons and fixtures completed by a previous run when resuming result by competition id command", func(t *testing.T) {
+		t.Helper()
+
+		resultRepo := new(mock.ResultRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.ResultRequester)
+		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, checkpointRepo, true, clock, logger)
+
+
+		done := make(chan bool)
+
+		res := newResult(35)
+
+		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
+			[]app.Season{
+				*newSeason(1, false),
+				*newSeason(2, false),
+				*newSeason(3, true),
+			},
+			nil,
+		)
+
+		checkpointRepo.On("Completed", "results:by-competition-id 5", app.CheckpointSeason).Return([]uint64{1}, nil)
+		checkpointRepo.On("Completed", "results:by-competition-id 5", app.CheckpointFixture).Return([]uint64{34}, nil)
+		checkpointRepo.On("Complete", "results:by-competition-id 5", app.CheckpointFixture, uint64(35)).Return(nil)
+		checkpointRepo.On("Complete", "results:by-competition-id 5", app.CheckpointSeason, uint64(2)).Return(nil)
+		checkpointRepo.On("Clear", "results:by-competition-id 5").Return(nil)
+
+		requester.On("ResultsBySeasonID", uint64(2)).Return([]app.Result{newResult(34), res}, nil)
+		requester.On("ResultsBySeasonID", uint64(3)).Return([]app.Result(nil), nil)
+		resultRepo.On("ByFixtureID", uint64(35)).Return(&app.Result{}, errors.New("not found"))
+		resultRepo.On("Insert", &res).Return(nil)
+		processor.Process("results:by-competition-id", "5", done)
+
+		<-done
+
+		requester.AssertExpectations(t)
+		resultRepo.AssertExpectations(t)
+		checkpointRepo.AssertExpectations(t)
+		requester.AssertNotCalled(t, "ResultsBySeasonID", uint64(1))
+		resultRepo.AssertNotCalled(t, "ByFixtureID", uint64(34))
+		checkpointRepo.AssertNotCalled(t, "Complete", "results:by-competition-id 5", app.CheckpointSeason, uint64(3))
+		checkpointRepo.AssertNumberOfCalls(t, "Clear", 1)
+
+		a := assert.New(t)
+
+		a.Equal(3, len(hook.Entries))
+		a.Equal("Resuming 'results:by-competition-id 5' with 1 of 3 seasons and 1 fixtures already complete", hook.Entries[0].Message)
+		a.Equal("Processed season 2 of 'results:by-competition-id 5': 2 of 3 seasons complete (66%)", hook.Entries[1].Message)
+		a.Equal("Processed season 3 of 'results:by-competition-id 5': 3 of 3 seasons complete (100%)", hook.Entries[2].Message)
+	})
+
+	t.Run("does not record seasons and fixtures that failed when processing result by competition id command", func(t *testing.T) {
+		t.Helper()
+
+		resultRepo := new(mock.ResultRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.ResultRequester)
+		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewResultProcessor(resultRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		done := make(chan bool)
+
+		one := newResult(34)
+		two := newResult(35)
+
+		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
+			[]app.Season{
+				*newSeason(1, false),
+				*newSeason(2, true),
+			},
+			nil,
+		)
+
+		checkpointRepo.On("Clear", "results:by-competition-id 5").Return(nil).Once()
+		checkpointRepo.On("Complete", "results:by-competition-id 5", app.CheckpointFixture, uint64(35)).Return(nil)
+
+		requester.On("ResultsBySeasonID", uint64(1)).Return([]app.Result(nil), errors.New("rate limited"))
+		requester.On("ResultsBySeasonID", uint64(2)).Return([]app.Result{one, two}, nil)
+		resultRepo.On("ByFixtureID", uint64(34)).Return(&app.Result{}, nil)
+		resultRepo.On("ByFixtureID", uint64(35)).Return(&app.Result{}, nil)
+		resultRepo.On("Update", &one).Return(errors.New("error occurred"))
+		resultRepo.On("Update", &two).Return(nil)
+		processor.Process("results:by-competition-id", "5", done)
+
+		<-done
+
+		requester.AssertExpectations(t)
+		resultRepo.AssertExpectations(t)
+		checkpointRepo.AssertExpectations(t)
+		checkpointRepo.AssertNotCalled(t, "Complete", "results:by-competition-id 5", app.CheckpointFixture, uint64(34))
+		checkpointRepo.AssertNotCalled(t, "Complete", "results:by-competition-id 5", app.CheckpointSeason, uint64(1))
+		checkpointRepo.AssertNotCalled(t, "Complete", "results:by-competition-id 5", app.CheckpointSeason, uint64(2))
+		checkpointRepo.AssertNumberOfCalls(t, "Clear", 1)
+
+		a := assert.New(t)
+
+		a.Equal(5, len(hook.Entries))
+		a.Equal("Error 'rate limited' occurred when requesting season 1 for 'results:by-competition-id 5'", hook.Entries[0].Message)
+		a.Equal("'results:by-competition-id 5' finished with 2 of 2 seasons incomplete, resume the run to retry them", hook.LastEntry().Message)
+	})
 }
 
 func newResult(f uint64) app.Result {
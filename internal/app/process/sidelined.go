@@ -0,0 +1,76 @@
+package process
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"strconv"
+)
+
+const sidelined = "sidelined"
+const sidelinedCurrentSeason = "sidelined:current-season"
+const sidelinedBySeasonId = "sidelined:by-season-id"
+
+// SidelinedProcessor fetches data from external data source using the SidelinedRequester
+// before persisting to the storage engine using the SidelinedRepository.
+type SidelinedProcessor struct {
+	sidelinedRepo app.SidelinedRepository
+	seasonRepo    app.SeasonRepository
+	requester     app.SidelinedRequester
+	logger        *logrus.Logger
+}
+
+func (s SidelinedProcessor) Process(command string, option string, done chan bool) {
+	switch command {
+	case sidelined:
+		go s.processAllSeasons(done)
+	case sidelinedCurrentSeason:
+		go s.processCurrentSeason(done)
+	case sidelinedBySeasonId:
+		id, _ := strconv.Atoi(option)
+		go s.persistSidelined(s.requester.SidelinedBySeasonIDs([]uint64{uint64(id)}), done)
+	default:
+		s.logger.Fatalf("Command %s is not supported", command)
+		return
+	}
+}
+
+func (s SidelinedProcessor) processAllSeasons(done chan bool) {
+	ids, err := s.seasonRepo.IDs()
+
+	if err != nil {
+		s.logger.Fatalf("Error when retrieving season ids: %s", err.Error())
+		return
+	}
+
+	go s.persistSidelined(s.requester.SidelinedBySeasonIDs(ids), done)
+}
+
+func (s SidelinedProcessor) processCurrentSeason(done chan bool) {
+	ids, err := s.seasonRepo.CurrentSeasonIDs()
+
+	if err != nil {
+		s.logger.Fatalf("Error when retrieving season ids: %s", err.Error())
+		return
+	}
+
+	go s.persistSidelined(s.requester.SidelinedBySeasonIDs(ids), done)
+}
+
+func (s SidelinedProcessor) persistSidelined(ch <-chan app.TeamSidelined, done chan bool) {
+	for x := range ch {
+		if err := s.sidelinedRepo.ReplaceForTeam(&x); err != nil {
+			s.logger.Warningf(
+				"Error '%s' occurred when replacing sidelined records for team %d season %d",
+				err.Error(),
+				x.TeamID,
+				x.SeasonID,
+			)
+		}
+	}
+
+	done <- true
+}
+
+func NewSidelinedProcessor(r app.SidelinedRepository, s app.SeasonRepository, q app.SidelinedRequester, log *logrus.Logger) *SidelinedProcessor {
+	return &SidelinedProcessor{sidelinedRepo: r, seasonRepo: s, requester: q, logger: log}
+}
@@ -0,0 +1,83 @@
+package process_test
+
+import (
+	"errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/process"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSidelinedProcessor_Process(t *testing.T) {
+	t.Run("replaces sidelined records for each team when processing sidelined command", func(t *testing.T) {
+		t.Helper()
+
+		sidelinedRepo := new(mock.SidelinedRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.SidelinedRequester)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewSidelinedProcessor(sidelinedRepo, seasonRepo, requester, logger)
+
+		westHam := app.TeamSidelined{TeamID: 1, SeasonID: 16036, Sidelined: []app.Sidelined{{PlayerID: 37429}}}
+		united := app.TeamSidelined{TeamID: 14, SeasonID: 16036}
+
+		ids := []uint64{16036}
+
+		seasonRepo.On("CurrentSeasonIDs").Return(ids, nil)
+		requester.On("SidelinedBySeasonIDs", ids).Return(teamSidelinedChannel([]app.TeamSidelined{westHam, united}))
+		sidelinedRepo.On("ReplaceForTeam", &westHam).Return(nil).Once()
+		sidelinedRepo.On("ReplaceForTeam", &united).Return(nil).Once()
+
+		done := make(chan bool)
+
+		processor.Process("sidelined:current-season", "", done)
+
+		<-done
+
+		requester.AssertExpectations(t)
+		seasonRepo.AssertExpectations(t)
+		sidelinedRepo.AssertExpectations(t)
+		assert.Nil(t, hook.LastEntry())
+	})
+
+	t.Run("logs warning if sidelined records cannot be replaced", func(t *testing.T) {
+		t.Helper()
+
+		sidelinedRepo := new(mock.SidelinedRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.SidelinedRequester)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewSidelinedProcessor(sidelinedRepo, seasonRepo, requester, logger)
+
+		westHam := app.TeamSidelined{TeamID: 1, SeasonID: 16036}
+
+		requester.On("SidelinedBySeasonIDs", []uint64{16036}).Return(teamSidelinedChannel([]app.TeamSidelined{westHam}))
+		sidelinedRepo.On("ReplaceForTeam", &westHam).Return(errors.New("oh damn"))
+
+		done := make(chan bool)
+
+		processor.Process("sidelined:by-season-id", "16036", done)
+
+		<-done
+
+		assert.Equal(t, 1, len(hook.Entries))
+		assert.Equal(t, "Error 'oh damn' occurred when replacing sidelined records for team 1 season 16036", hook.LastEntry().Message)
+		seasonRepo.AssertNotCalled(t, "IDs")
+	})
+}
+
+func teamSidelinedChannel(teams []app.TeamSidelined) chan app.TeamSidelined {
+	ch := make(chan app.TeamSidelined, len(teams))
+
+	for _, t := range teams {
+		ch <- t
+	}
+
+	close(ch)
+
+	return ch
+}
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"github.com/jonboulle/clockwork"
 	"github.com/sirupsen/logrus"
 	"github.com/statistico/statistico-football-data/internal/app"
 )
@@ -8,10 +9,13 @@ import (
 const squad = "squad"
 const squadCurrentSeason = "squad:current-season"
 
+// SquadProcessor persists the squads returned by the SquadRequester. When a squad is already stored the players
+// joining and leaving the squad since the previous run are recorded as squad movements along with the squad update.
 type SquadProcessor struct {
 	squadRepo  app.SquadRepository
 	seasonRepo app.SeasonRepository
 	requester  app.SquadRequester
+	clock      clockwork.Clock
 	logger     *logrus.Logger
 }
 
@@ -62,7 +66,7 @@ func (s SquadProcessor) persistSquads(ch <-chan *app.Squad, done chan bool) {
 }
 
 func (s SquadProcessor) persist(x *app.Squad) {
-	_, err := s.squadRepo.BySeasonAndTeam(x.TeamID, x.SeasonID)
+	existing, err := s.squadRepo.BySeasonAndTeam(x.SeasonID, x.TeamID)
 
 	if err != nil {
 		if newErr := s.squadRepo.Insert(x); newErr != nil {
@@ -72,13 +76,42 @@ func (s SquadProcessor) persist(x *app.Squad) {
 		return
 	}
 
-	if newErr := s.squadRepo.Update(x); newErr != nil {
+	if newErr := s.squadRepo.Update(x, s.movements(existing, x)...); newErr != nil {
 		s.logger.Warningf("Error '%s' occurred when updating squad struct: %+v\n,", newErr.Error(), *x)
 	}
 
 	return
 }
 
-func NewSquadProcessor(s app.SquadRepository, a app.SeasonRepository, r app.SquadRequester, log *logrus.Logger) *SquadProcessor {
-	return &SquadProcessor{squadRepo: s, seasonRepo: a, requester: r, logger: log}
+// movements returns the players that have joined and left the squad since the previous squad was stored.
+func (s SquadProcessor) movements(previous, current *app.Squad) []app.SquadMovement {
+	joined, left := app.DiffSquads(previous, current)
+
+	ids := map[string][]uint64{app.SquadMovementJoined: joined, app.SquadMovementLeft: left}
+
+	var movements []app.SquadMovement
+
+	for _, direction := range []string{app.SquadMovementJoined, app.SquadMovementLeft} {
+		for _, id := range ids[direction] {
+			movements = append(movements, app.SquadMovement{
+				SeasonID:   current.SeasonID,
+				TeamID:     current.TeamID,
+				PlayerID:   id,
+				Direction:  direction,
+				DetectedAt: s.clock.Now(),
+			})
+		}
+	}
+
+	return movements
+}
+
+func NewSquadProcessor(
+	s app.SquadRepository,
+	a app.SeasonRepository,
+	r app.SquadRequester,
+	c clockwork.Clock,
+	log *logrus.Logger,
+) *SquadProcessor {
+	return &SquadProcessor{squadRepo: s, seasonRepo: a, requester: r, clock: c, logger: log}
 }
@@ -2,6 +2,7 @@ package process_test
 
 import (
 	"errors"
+	"github.com/jonboulle/clockwork"
 	"github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/statistico/statistico-football-data/internal/app"
@@ -21,7 +22,7 @@ func TestSquadProcessor_Process(t *testing.T) {
 		requester := new(mock.SquadRequester)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, logger)
+		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, clockwork.NewFakeClock(), logger)
 
 		done := make(chan bool)
 
@@ -40,8 +41,8 @@ func TestSquadProcessor_Process(t *testing.T) {
 
 		requester.On("SquadsBySeasonIDs", ids).Return(ch)
 
-		squadRepo.On("BySeasonAndTeam", uint64(1), uint64(12962)).Return(&app.Squad{}, errors.New("not Found"))
-		squadRepo.On("BySeasonAndTeam", uint64(14), uint64(12962)).Return(&app.Squad{}, errors.New("not Found"))
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(1)).Return(&app.Squad{}, errors.New("not Found"))
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(14)).Return(&app.Squad{}, errors.New("not Found"))
 		squadRepo.On("Insert", whu).Return(nil)
 		squadRepo.On("Insert", ncu).Return(nil)
 
@@ -63,7 +64,7 @@ func TestSquadProcessor_Process(t *testing.T) {
 		requester := new(mock.SquadRequester)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, logger)
+		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, clockwork.NewFakeClock(), logger)
 
 		done := make(chan bool)
 
@@ -82,8 +83,8 @@ func TestSquadProcessor_Process(t *testing.T) {
 
 		requester.On("SquadsBySeasonIDs", ids).Return(ch)
 
-		squadRepo.On("BySeasonAndTeam", uint64(1), uint64(12962)).Return(whu, nil)
-		squadRepo.On("BySeasonAndTeam", uint64(14), uint64(12962)).Return(ncu, nil)
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(1)).Return(whu, nil)
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(14)).Return(ncu, nil)
 		squadRepo.On("Update", &whu).Return(nil)
 		squadRepo.On("Update", &ncu).Return(nil)
 
@@ -105,7 +106,7 @@ func TestSquadProcessor_Process(t *testing.T) {
 		requester := new(mock.SquadRequester)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, logger)
+		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, clockwork.NewFakeClock(), logger)
 
 		done := make(chan bool)
 
@@ -124,8 +125,8 @@ func TestSquadProcessor_Process(t *testing.T) {
 
 		requester.On("SquadsBySeasonIDs", ids).Return(ch)
 
-		squadRepo.On("BySeasonAndTeam", uint64(1), uint64(12962)).Return(&app.Squad{}, errors.New("not Found"))
-		squadRepo.On("BySeasonAndTeam", uint64(14), uint64(12962)).Return(&app.Squad{}, errors.New("not Found"))
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(1)).Return(&app.Squad{}, errors.New("not Found"))
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(14)).Return(&app.Squad{}, errors.New("not Found"))
 		squadRepo.On("Insert", whu).Return(errors.New("error occurred"))
 		squadRepo.On("Insert", ncu).Return(nil)
 
@@ -148,7 +149,7 @@ func TestSquadProcessor_Process(t *testing.T) {
 		requester := new(mock.SquadRequester)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, logger)
+		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, clockwork.NewFakeClock(), logger)
 
 		done := make(chan bool)
 
@@ -167,8 +168,8 @@ func TestSquadProcessor_Process(t *testing.T) {
 
 		requester.On("SquadsBySeasonIDs", ids).Return(ch)
 
-		squadRepo.On("BySeasonAndTeam", uint64(1), uint64(12962)).Return(whu, nil)
-		squadRepo.On("BySeasonAndTeam", uint64(14), uint64(12962)).Return(ncu, nil)
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(1)).Return(whu, nil)
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(14)).Return(ncu, nil)
 		squadRepo.On("Update", &whu).Return(errors.New("error occurred"))
 		squadRepo.On("Update", &ncu).Return(nil)
 
@@ -191,7 +192,7 @@ func TestSquadProcessor_Process(t *testing.T) {
 		requester := new(mock.SquadRequester)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, logger)
+		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, clockwork.NewFakeClock(), logger)
 
 		done := make(chan bool)
 
@@ -210,8 +211,8 @@ func TestSquadProcessor_Process(t *testing.T) {
 
 		requester.On("SquadsBySeasonIDs", ids).Return(ch)
 
-		squadRepo.On("BySeasonAndTeam", uint64(1), uint64(12962)).Return(&app.Squad{}, errors.New("not Found"))
-		squadRepo.On("BySeasonAndTeam", uint64(14), uint64(12962)).Return(&app.Squad{}, errors.New("not Found"))
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(1)).Return(&app.Squad{}, errors.New("not Found"))
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(14)).Return(&app.Squad{}, errors.New("not Found"))
 		squadRepo.On("Insert", whu).Return(nil)
 		squadRepo.On("Insert", ncu).Return(nil)
 
@@ -233,7 +234,7 @@ func TestSquadProcessor_Process(t *testing.T) {
 		requester := new(mock.SquadRequester)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, logger)
+		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, clockwork.NewFakeClock(), logger)
 
 		done := make(chan bool)
 
@@ -252,8 +253,8 @@ func TestSquadProcessor_Process(t *testing.T) {
 
 		requester.On("SquadsBySeasonIDs", ids).Return(ch)
 
-		squadRepo.On("BySeasonAndTeam", uint64(1), uint64(12962)).Return(whu, nil)
-		squadRepo.On("BySeasonAndTeam", uint64(14), uint64(12962)).Return(ncu, nil)
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(1)).Return(whu, nil)
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(14)).Return(ncu, nil)
 		squadRepo.On("Update", &whu).Return(nil)
 		squadRepo.On("Update", &ncu).Return(nil)
 
@@ -275,7 +276,7 @@ func TestSquadProcessor_Process(t *testing.T) {
 		requester := new(mock.SquadRequester)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, logger)
+		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, clockwork.NewFakeClock(), logger)
 
 		done := make(chan bool)
 
@@ -294,8 +295,8 @@ func TestSquadProcessor_Process(t *testing.T) {
 
 		requester.On("SquadsBySeasonIDs", ids).Return(ch)
 
-		squadRepo.On("BySeasonAndTeam", uint64(1), uint64(12962)).Return(&app.Squad{}, errors.New("not Found"))
-		squadRepo.On("BySeasonAndTeam", uint64(14), uint64(12962)).Return(&app.Squad{}, errors.New("not Found"))
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(1)).Return(&app.Squad{}, errors.New("not Found"))
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(14)).Return(&app.Squad{}, errors.New("not Found"))
 		squadRepo.On("Insert", whu).Return(errors.New("error occurred"))
 		squadRepo.On("Insert", ncu).Return(nil)
 
@@ -318,7 +319,7 @@ func TestSquadProcessor_Process(t *testing.T) {
 		requester := new(mock.SquadRequester)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, logger)
+		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, clockwork.NewFakeClock(), logger)
 
 		done := make(chan bool)
 
@@ -337,8 +338,8 @@ func TestSquadProcessor_Process(t *testing.T) {
 
 		requester.On("SquadsBySeasonIDs", ids).Return(ch)
 
-		squadRepo.On("BySeasonAndTeam", uint64(1), uint64(12962)).Return(whu, nil)
-		squadRepo.On("BySeasonAndTeam", uint64(14), uint64(12962)).Return(ncu, nil)
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(1)).Return(whu, nil)
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(14)).Return(ncu, nil)
 		squadRepo.On("Update", &whu).Return(errors.New("error occurred"))
 		squadRepo.On("Update", &ncu).Return(nil)
 
@@ -352,6 +353,54 @@ func TestSquadProcessor_Process(t *testing.T) {
 		assert.Equal(t, 1, len(hook.Entries))
 		assert.Equal(t, logrus.WarnLevel, hook.LastEntry().Level)
 	})
+
+	t.Run("records players joining and leaving an existing squad", func(t *testing.T) {
+		t.Helper()
+
+		squadRepo := new(mock.SquadRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.SquadRequester)
+		clock := clockwork.NewFakeClockAt(time.Unix(1547465100, 0))
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewSquadProcessor(squadRepo, seasonRepo, requester, clock, logger)
+
+		done := make(chan bool)
+
+		existing := newSquad(12962, 1)
+		current := newSquad(12962, 1)
+		current.PlayerIDs = []uint64{34, 89, 102, 103}
+
+		ids := []uint64{12962}
+
+		seasonRepo.On("CurrentSeasonIDs").Return(ids, nil)
+		requester.On("SquadsBySeasonIDs", ids).Return(squadChannel([]*app.Squad{current}))
+		squadRepo.On("BySeasonAndTeam", uint64(12962), uint64(1)).Return(existing, nil)
+		movement := func(player uint64, direction string) app.SquadMovement {
+			return app.SquadMovement{
+				SeasonID:   12962,
+				TeamID:     1,
+				PlayerID:   player,
+				Direction:  direction,
+				DetectedAt: time.Unix(1547465100, 0),
+			}
+		}
+
+		squadRepo.On(
+			"Update",
+			&current,
+			movement(102, "joined"),
+			movement(103, "joined"),
+			movement(57, "left"),
+		).Return(nil)
+
+		processor.Process("squad:current-season", "", done)
+
+		<-done
+
+		squadRepo.AssertExpectations(t)
+		assert.Nil(t, hook.LastEntry())
+	})
 }
 
 func newSquad(season, team uint64) *app.Squad {
@@ -0,0 +1,132 @@
+package process
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"strconv"
+	"sync"
+)
+
+const stage = "stage"
+const stageCurrentSeason = "stage:current-season"
+const stageBySeasonId = "stage:by-season-id"
+
+// StageProcessor fetches data from external data source using the StageRequester
+// before persisting to the storage engine using the StageRepository and GroupRepository.
+type StageProcessor struct {
+	stageRepo  app.StageRepository
+	groupRepo  app.GroupRepository
+	seasonRepo app.SeasonRepository
+	requester  app.StageRequester
+	logger     *logrus.Logger
+}
+
+func (s StageProcessor) Process(command string, option string, done chan bool) {
+	switch command {
+	case stage:
+		go s.processAllSeasons(done)
+	case stageCurrentSeason:
+		go s.processCurrentSeason(done)
+	case stageBySeasonId:
+		id, _ := strconv.Atoi(option)
+		st, gr := s.requester.StagesBySeasonIDs([]uint64{uint64(id)})
+		go s.parseStages(st, gr, done)
+	default:
+		s.logger.Fatalf("Command %s is not supported", command)
+		return
+	}
+}
+
+func (s StageProcessor) processAllSeasons(done chan bool) {
+	ids, err := s.seasonRepo.IDs()
+
+	if err != nil {
+		s.logger.Fatalf("Error when retrieving season ids: %s", err.Error())
+		return
+	}
+
+	st, gr := s.requester.StagesBySeasonIDs(ids)
+
+	go s.parseStages(st, gr, done)
+}
+
+func (s StageProcessor) processCurrentSeason(done chan bool) {
+	ids, err := s.seasonRepo.CurrentSeasonIDs()
+
+	if err != nil {
+		s.logger.Fatalf("Error when retrieving season ids: %s", err.Error())
+		return
+	}
+
+	st, gr := s.requester.StagesBySeasonIDs(ids)
+
+	go s.parseStages(st, gr, done)
+}
+
+func (s StageProcessor) parseStages(st <-chan app.Stage, gr <-chan app.Group, done chan bool) {
+	var wg = sync.WaitGroup{}
+
+	wg.Add(2)
+
+	go func(st <-chan app.Stage) {
+		for x := range st {
+			s.persistStage(&x)
+		}
+
+		wg.Done()
+	}(st)
+
+	go func(gr <-chan app.Group) {
+		for x := range gr {
+			s.persistGroup(&x)
+		}
+
+		wg.Done()
+	}(gr)
+
+	wg.Wait()
+
+	done <- true
+}
+
+func (s StageProcessor) persistStage(x *app.Stage) {
+	_, err := s.stageRepo.ByID(x.ID)
+
+	if err != nil {
+		if err := s.stageRepo.Insert(x); err != nil {
+			s.logger.Warningf("Error '%s' occurred when inserting stage struct: %+v\n,", err.Error(), *x)
+		}
+
+		return
+	}
+
+	if err := s.stageRepo.Update(x); err != nil {
+		s.logger.Warningf("Error '%s' occurred when updating stage struct: %+v\n,", err.Error(), *x)
+	}
+}
+
+func (s StageProcessor) persistGroup(x *app.Group) {
+	_, err := s.groupRepo.ByID(x.ID)
+
+	if err != nil {
+		if err := s.groupRepo.Insert(x); err != nil {
+			s.logger.Warningf("Error '%s' occurred when inserting group struct: %+v\n,", err.Error(), *x)
+		}
+
+		return
+	}
+
+	if err := s.groupRepo.Update(x); err != nil {
+		s.logger.Warningf("Error '%s' occurred when updating group struct: %+v\n,", err.Error(), *x)
+	}
+}
+
+func NewStageProcessor(
+	s app.StageRepository,
+	g app.GroupRepository,
+	r app.SeasonRepository,
+	q app.StageRequester,
+	log *logrus.Logger,
+) *StageProcessor {
+	return &StageProcessor{stageRepo: s, groupRepo: g, seasonRepo: r, requester: q, logger: log}
+}
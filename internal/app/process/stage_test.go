@@ -0,0 +1,109 @@
+package process_test
+
+import (
+	"errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/process"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestStageProcessor_Process(t *testing.T) {
+	t.Run("inserts new and updates existing stages and groups when processing stage command", func(t *testing.T) {
+		t.Helper()
+
+		stageRepo := new(mock.StageRepository)
+		groupRepo := new(mock.GroupRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.StageRequester)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewStageProcessor(stageRepo, groupRepo, seasonRepo, requester, logger)
+
+		groupStage := app.Stage{ID: 77443861, Name: "Group Stage"}
+		knockOut := app.Stage{ID: 77443862, Name: "Round of 16"}
+		groupA := app.Group{ID: 240, Name: "Group A", StageID: 77443861}
+
+		ids := []uint64{45, 51}
+
+		seasonRepo.On("IDs").Return(ids, nil)
+		requester.On("StagesBySeasonIDs", ids).Return(
+			stageChannel([]app.Stage{groupStage, knockOut}),
+			groupChannel([]app.Group{groupA}),
+		)
+		stageRepo.On("ByID", uint64(77443861)).Return(&app.Stage{}, errors.New("not found"))
+		stageRepo.On("ByID", uint64(77443862)).Return(&app.Stage{ID: 77443862}, nil)
+		stageRepo.On("Insert", &groupStage).Return(nil)
+		stageRepo.On("Update", &knockOut).Return(nil)
+		groupRepo.On("ByID", uint64(240)).Return(&app.Group{}, errors.New("not found"))
+		groupRepo.On("Insert", &groupA).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process("stage", "", done)
+
+		<-done
+
+		requester.AssertExpectations(t)
+		seasonRepo.AssertExpectations(t)
+		stageRepo.AssertExpectations(t)
+		groupRepo.AssertExpectations(t)
+		assert.Nil(t, hook.LastEntry())
+	})
+
+	t.Run("logs warning if stage cannot be inserted", func(t *testing.T) {
+		t.Helper()
+
+		stageRepo := new(mock.StageRepository)
+		groupRepo := new(mock.GroupRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.StageRequester)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewStageProcessor(stageRepo, groupRepo, seasonRepo, requester, logger)
+
+		knockOut := app.Stage{ID: 77443862}
+
+		requester.On("StagesBySeasonIDs", []uint64{16036}).Return(
+			stageChannel([]app.Stage{knockOut}),
+			groupChannel([]app.Group{}),
+		)
+		stageRepo.On("ByID", uint64(77443862)).Return(&app.Stage{}, errors.New("not found"))
+		stageRepo.On("Insert", &knockOut).Return(errors.New("oh damn"))
+
+		done := make(chan bool)
+
+		processor.Process("stage:by-season-id", "16036", done)
+
+		<-done
+
+		assert.Equal(t, 1, len(hook.Entries))
+		seasonRepo.AssertNotCalled(t, "IDs")
+	})
+}
+
+func stageChannel(stages []app.Stage) chan app.Stage {
+	ch := make(chan app.Stage, len(stages))
+
+	for _, s := range stages {
+		ch <- s
+	}
+
+	close(ch)
+
+	return ch
+}
+
+func groupChannel(groups []app.Group) chan app.Group {
+	ch := make(chan app.Group, len(groups))
+
+	for _, g := range groups {
+		ch <- g
+	}
+
+	close(ch)
+
+	return ch
+}
@@ -0,0 +1,187 @@
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/standings"
+	"strconv"
+)
+
+const standingsAll = "standings"
+const standingsCurrentSeason = "standings:current-season"
+const standingsBySeasonId = "standings:by-season-id"
+const standingsRules = "standings:rules"
+
+var standingsTables = []string{app.StandingsTableOverall, app.StandingsTableHome, app.StandingsTableAway}
+
+// StandingsProcessor calculates league tables using the standings Engine and persists a snapshot of the overall,
+// home and away tables for each round of a season that has started. Each season is loaded once and the tables of
+// every round are calculated in memory. The standings:rules command configures the points and tie breakers used to
+// calculate tables for a competition.
+type StandingsProcessor struct {
+	engine       *standings.Engine
+	roundRepo    app.RoundRepository
+	seasonRepo   app.SeasonRepository
+	snapshotRepo app.StandingsSnapshotRepository
+	rulesRepo    app.StandingsRulesRepository
+	clock        clockwork.Clock
+	logger       *logrus.Logger
+}
+
+func (s StandingsProcessor) Process(command string, option string, done chan bool) {
+	switch command {
+	case standingsAll:
+		go s.processAllSeasons(done)
+	case standingsCurrentSeason:
+		go s.processCurrentSeason(done)
+	case standingsBySeasonId:
+		id, _ := strconv.Atoi(option)
+		go s.processSeasons([]uint64{uint64(id)}, done)
+	case standingsRules:
+		go s.saveRules(option, done)
+	default:
+		s.logger.Fatalf("Command %s is not supported", command)
+		return
+	}
+}
+
+func (s StandingsProcessor) processAllSeasons(done chan bool) {
+	ids, err := s.seasonRepo.IDs()
+
+	if err != nil {
+		s.logger.Fatalf("Error when retrieving season ids: %s", err.Error())
+		return
+	}
+
+	s.processSeasons(ids, done)
+}
+
+func (s StandingsProcessor) processCurrentSeason(done chan bool) {
+	ids, err := s.seasonRepo.CurrentSeasonIDs()
+
+	if err != nil {
+		s.logger.Fatalf("Error when retrieving season ids: %s", err.Error())
+		return
+	}
+
+	s.processSeasons(ids, done)
+}
+
+func (s StandingsProcessor) processSeasons(ids []uint64, done chan bool) {
+	for _, id := range ids {
+		rounds, err := s.roundRepo.BySeasonID(id)
+
+		if err != nil {
+			s.logger.Warningf("Error '%s' occurred when retrieving rounds for season %d", err.Error(), id)
+			continue
+		}
+
+		var started []app.Round
+
+		for _, r := range rounds {
+			if !r.StartDate.After(s.clock.Now()) {
+				started = append(started, r)
+			}
+		}
+
+		if len(started) == 0 {
+			continue
+		}
+
+		season, err := s.engine.Season(id)
+
+		if err != nil {
+			s.logger.Warningf("Error '%s' occurred when loading season %d to calculate standings", err.Error(), id)
+			continue
+		}
+
+		for _, r := range started {
+			s.snapshotRound(season, &r)
+		}
+	}
+
+	done <- true
+}
+
+func (s StandingsProcessor) snapshotRound(season *standings.Season, r *app.Round) {
+	for _, table := range standingsTables {
+		rows := season.AtRound(r, table)
+
+		if len(rows) == 0 {
+			continue
+		}
+
+		snapshot := app.StandingsSnapshot{
+			SeasonID:  r.SeasonID,
+			RoundID:   r.ID,
+			Table:     table,
+			Standings: rows,
+		}
+
+		if err := s.snapshotRepo.Save(&snapshot); err != nil {
+			s.logger.Warningf("Error '%s' occurred when saving %s standings for round %d", err.Error(), table, r.ID)
+		}
+	}
+}
+
+func (s StandingsProcessor) saveRules(option string, done chan bool) {
+	var rules app.StandingsRules
+
+	if err := json.Unmarshal([]byte(option), &rules); err != nil {
+		s.logger.Fatalf("Error parsing standings rules: %s", err.Error())
+		return
+	}
+
+	if err := validateStandingsRules(&rules); err != nil {
+		s.logger.Fatalf("Standings rules are invalid: %s", err.Error())
+		return
+	}
+
+	if err := s.rulesRepo.Save(&rules); err != nil {
+		s.logger.Fatalf("Error saving standings rules: %s", err.Error())
+		return
+	}
+
+	s.logger.Infof("Standings rules saved for competition %d", rules.CompetitionID)
+
+	done <- true
+}
+
+func validateStandingsRules(r *app.StandingsRules) error {
+	if r.CompetitionID == 0 {
+		return fmt.Errorf("competition_id is required")
+	}
+
+	for _, t := range r.TieBreakers {
+		switch t {
+		case app.TieBreakGoalDifference, app.TieBreakGoalsScored, app.TieBreakHeadToHead:
+		default:
+			return fmt.Errorf("tie breaker '%s' is not supported", t)
+		}
+	}
+
+	return nil
+}
+
+func NewStandingsProcessor(
+	e *standings.Engine,
+	r app.RoundRepository,
+	s app.SeasonRepository,
+	ss app.StandingsSnapshotRepository,
+	sr app.StandingsRulesRepository,
+	c clockwork.Clock,
+	log *logrus.Logger,
+) *StandingsProcessor {
+	return &StandingsProcessor{
+		engine:       e,
+		roundRepo:    r,
+		seasonRepo:   s,
+		snapshotRepo: ss,
+		rulesRepo:    sr,
+		clock:        c,
+		logger:       log,
+	}
+}
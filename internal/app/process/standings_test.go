@@ -0,0 +1,126 @@
+package process_test
+
+import (
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/process"
+	"github.com/statistico/statistico-football-data/internal/app/standings"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestStandingsProcessor_Process(t *testing.T) {
+	t.Run("saves overall, home and away snapshots for each round that has started loading the season once", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		resultRepo := new(mock.ResultRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		rulesRepo := new(mock.StandingsRulesRepository)
+		roundRepo := new(mock.RoundRepository)
+		snapshotRepo := new(mock.StandingsSnapshotRepository)
+		clock := clockwork.NewFakeClockAt(time.Date(2020, 3, 10, 12, 0, 0, 0, time.UTC))
+		logger, hook := test.NewNullLogger()
+
+		engine := standings.NewEngine(fixtureRepo, resultRepo, seasonRepo, rulesRepo)
+		processor := process.NewStandingsProcessor(engine, roundRepo, seasonRepo, snapshotRepo, rulesRepo, clock, logger)
+
+		rounds := []app.Round{
+			{
+				ID:        194967,
+				SeasonID:  16036,
+				StartDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				ID:        194968,
+				SeasonID:  16036,
+				StartDate: time.Date(2020, 3, 7, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2020, 3, 8, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				ID:        194969,
+				SeasonID:  16036,
+				StartDate: time.Date(2020, 3, 14, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC),
+			},
+		}
+
+		fixtures := []app.Fixture{
+			{ID: 1, HomeTeamID: 10, AwayTeamID: 20, Date: time.Date(2020, 3, 1, 15, 0, 0, 0, time.UTC)},
+			{ID: 2, HomeTeamID: 30, AwayTeamID: 40, Date: time.Date(2020, 3, 7, 15, 0, 0, 0, time.UTC)},
+		}
+
+		home, away := 2, 1
+
+		roundRepo.On("BySeasonID", uint64(16036)).Return(rounds, nil)
+		seasonRepo.On("ByID", uint64(16036)).Return(&app.Season{ID: 16036, CompetitionID: 8}, nil)
+		rulesRepo.On("ByCompetitionID", uint64(8)).Return(&app.StandingsRules{}, errors.ErrorNotFound)
+		fixtureRepo.On("Get", m.AnythingOfType("app.FixtureRepositoryQuery")).Return(fixtures, nil)
+		resultRepo.On("BySeasonID", uint64(16036)).Return([]app.Result{
+			{FixtureID: 1, HomeScore: &home, AwayScore: &away},
+			{FixtureID: 2, HomeScore: &home, AwayScore: &away},
+		}, nil)
+
+		for _, table := range []string{"overall", "home", "away"} {
+			tbl := table
+
+			snapshotRepo.On("Save", m.MatchedBy(func(s *app.StandingsSnapshot) bool {
+				return s.SeasonID == 16036 && s.RoundID == 194967 && s.Table == tbl && len(s.Standings) == 2
+			})).Return(nil).Once()
+			snapshotRepo.On("Save", m.MatchedBy(func(s *app.StandingsSnapshot) bool {
+				return s.SeasonID == 16036 && s.RoundID == 194968 && s.Table == tbl && len(s.Standings) == 4
+			})).Return(nil).Once()
+		}
+
+		done := make(chan bool)
+
+		processor.Process("standings:by-season-id", "16036", done)
+
+		<-done
+
+		snapshotRepo.AssertExpectations(t)
+		snapshotRepo.AssertNumberOfCalls(t, "Save", 6)
+		seasonRepo.AssertNumberOfCalls(t, "ByID", 1)
+		fixtureRepo.AssertNumberOfCalls(t, "Get", 1)
+		resultRepo.AssertNumberOfCalls(t, "BySeasonID", 1)
+		seasonRepo.AssertNotCalled(t, "IDs")
+		assert.Nil(t, hook.LastEntry())
+	})
+
+	t.Run("saves standings rules for a competition", func(t *testing.T) {
+		t.Helper()
+
+		rulesRepo := new(mock.StandingsRulesRepository)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewStandingsProcessor(nil, nil, nil, nil, rulesRepo, clockwork.NewFakeClock(), logger)
+
+		rules := app.StandingsRules{
+			CompetitionID: 8,
+			PointsForWin:  3,
+			PointsForDraw: 1,
+			TieBreakers:   []string{"head_to_head", "goal_difference"},
+		}
+
+		rulesRepo.On("Save", &rules).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process(
+			"standings:rules",
+			`{"competition_id": 8, "points_for_win": 3, "points_for_draw": 1, "tie_breakers": ["head_to_head", "goal_difference"]}`,
+			done,
+		)
+
+		<-done
+
+		rulesRepo.AssertExpectations(t)
+		assert.Equal(t, "Standings rules saved for competition 8", hook.LastEntry().Message)
+	})
+}
@@ -0,0 +1,146 @@
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/suspension"
+	"strconv"
+)
+
+const suspensionAll = "suspension"
+const suspensionCurrentSeason = "suspension:current-season"
+const suspensionBySeasonId = "suspension:by-season-id"
+const suspensionRules = "suspension:rules"
+
+// SuspensionProcessor calculates player suspensions using the suspension Tracker and replaces the suspensions
+// persisted for each team in a season. The suspension:rules command configures the yellow card thresholds and ban
+// lengths used to calculate suspensions for a competition.
+type SuspensionProcessor struct {
+	tracker        *suspension.Tracker
+	seasonRepo     app.SeasonRepository
+	suspensionRepo app.SuspensionRepository
+	rulesRepo      app.SuspensionRulesRepository
+	logger         *logrus.Logger
+}
+
+func (s SuspensionProcessor) Process(command string, option string, done chan bool) {
+	switch command {
+	case suspensionAll:
+		go s.processAllSeasons(done)
+	case suspensionCurrentSeason:
+		go s.processCurrentSeason(done)
+	case suspensionBySeasonId:
+		id, _ := strconv.Atoi(option)
+		go s.processSeasons([]uint64{uint64(id)}, done)
+	case suspensionRules:
+		go s.saveRules(option, done)
+	default:
+		s.logger.Fatalf("Command %s is not supported", command)
+		return
+	}
+}
+
+func (s SuspensionProcessor) processAllSeasons(done chan bool) {
+	ids, err := s.seasonRepo.IDs()
+
+	if err != nil {
+		s.logger.Fatalf("Error when retrieving season ids: %s", err.Error())
+		return
+	}
+
+	s.processSeasons(ids, done)
+}
+
+func (s SuspensionProcessor) processCurrentSeason(done chan bool) {
+	ids, err := s.seasonRepo.CurrentSeasonIDs()
+
+	if err != nil {
+		s.logger.Fatalf("Error when retrieving season ids: %s", err.Error())
+		return
+	}
+
+	s.processSeasons(ids, done)
+}
+
+func (s SuspensionProcessor) processSeasons(ids []uint64, done chan bool) {
+	for _, id := range ids {
+		teams, err := s.tracker.ForSeason(id)
+
+		if err != nil {
+			s.logger.Warningf("Error '%s' occurred when calculating suspensions for season %d", err.Error(), id)
+			continue
+		}
+
+		for _, t := range teams {
+			if err := s.suspensionRepo.ReplaceForTeam(&t); err != nil {
+				s.logger.Warningf(
+					"Error '%s' occurred when saving suspensions for team %d and season %d",
+					err.Error(),
+					t.TeamID,
+					t.SeasonID,
+				)
+			}
+		}
+	}
+
+	done <- true
+}
+
+func (s SuspensionProcessor) saveRules(option string, done chan bool) {
+	var rules app.SuspensionRules
+
+	if err := json.Unmarshal([]byte(option), &rules); err != nil {
+		s.logger.Fatalf("Error parsing suspension rules: %s", err.Error())
+		return
+	}
+
+	if err := validateSuspensionRules(&rules); err != nil {
+		s.logger.Fatalf("Suspension rules are invalid: %s", err.Error())
+		return
+	}
+
+	if err := s.rulesRepo.Save(&rules); err != nil {
+		s.logger.Fatalf("Error saving suspension rules: %s", err.Error())
+		return
+	}
+
+	s.logger.Infof("Suspension rules saved for competition %d", rules.CompetitionID)
+
+	done <- true
+}
+
+func validateSuspensionRules(r *app.SuspensionRules) error {
+	if r.CompetitionID == 0 {
+		return fmt.Errorf("competition_id is required")
+	}
+
+	if r.StraightRedMatches < 0 || r.SecondYellowMatches < 0 {
+		return fmt.Errorf("ban lengths cannot be negative")
+	}
+
+	for _, t := range r.YellowThresholds {
+		if t.Yellows <= 0 || t.Matches <= 0 || t.Before < 0 {
+			return fmt.Errorf("yellow card thresholds require a positive number of yellows and matches")
+		}
+	}
+
+	return nil
+}
+
+func NewSuspensionProcessor(
+	t *suspension.Tracker,
+	s app.SeasonRepository,
+	sr app.SuspensionRepository,
+	r app.SuspensionRulesRepository,
+	log *logrus.Logger,
+) *SuspensionProcessor {
+	return &SuspensionProcessor{
+		tracker:        t,
+		seasonRepo:     s,
+		suspensionRepo: sr,
+		rulesRepo:      r,
+		logger:         log,
+	}
+}
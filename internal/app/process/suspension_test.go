@@ -0,0 +1,121 @@
+package process_test
+
+import (
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/process"
+	"github.com/statistico/statistico-football-data/internal/app/suspension"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestSuspensionProcessor_Process(t *testing.T) {
+	t.Run("replaces suspensions for each team in season", func(t *testing.T) {
+		t.Helper()
+
+		fixtureRepo := new(mock.FixtureRepository)
+		eventRepo := new(mock.EventRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		rulesRepo := new(mock.SuspensionRulesRepository)
+		suspensionRepo := new(mock.SuspensionRepository)
+		clock := clockwork.NewFakeClockAt(time.Date(2020, 3, 5, 12, 0, 0, 0, time.UTC))
+		logger, hook := test.NewNullLogger()
+
+		tracker := suspension.NewTracker(fixtureRepo, eventRepo, seasonRepo, rulesRepo, clock)
+		processor := process.NewSuspensionProcessor(tracker, seasonRepo, suspensionRepo, rulesRepo, logger)
+
+		fixtures := []app.Fixture{
+			{ID: 1, HomeTeamID: 10, AwayTeamID: 20, Date: time.Date(2020, 3, 1, 15, 0, 0, 0, time.UTC)},
+			{ID: 2, HomeTeamID: 20, AwayTeamID: 10, Date: time.Date(2020, 3, 8, 15, 0, 0, 0, time.UTC)},
+		}
+
+		cards := []*app.CardEvent{{ID: 1, TeamID: 20, PlayerID: 200, FixtureID: 1, Type: app.CardTypeRed, Minute: 40}}
+
+		seasonRepo.On("ByID", uint64(16036)).Return(&app.Season{ID: 16036, CompetitionID: 8}, nil)
+		rulesRepo.On("ByCompetitionID", uint64(8)).Return(&app.SuspensionRules{}, errors.ErrorNotFound)
+		fixtureRepo.On("Get", m.AnythingOfType("app.FixtureRepositoryQuery")).Return(fixtures, nil)
+		eventRepo.On("CardEventsForFixture", uint64(1)).Return(cards, nil)
+
+		suspensionRepo.On("ReplaceForTeam", m.MatchedBy(func(t *app.TeamSuspensions) bool {
+			return t.TeamID == 10 && t.SeasonID == 16036 && len(t.Suspensions) == 0
+		})).Return(nil).Once()
+
+		suspensionRepo.On("ReplaceForTeam", m.MatchedBy(func(t *app.TeamSuspensions) bool {
+			return t.TeamID == 20 &&
+				len(t.Suspensions) == 1 &&
+				t.Suspensions[0].PlayerID == 200 &&
+				t.Suspensions[0].Reason == app.SuspensionReasonStraightRed &&
+				t.Suspensions[0].FixtureIDs[0] == 2
+		})).Return(nil).Once()
+
+		done := make(chan bool)
+
+		processor.Process("suspension:by-season-id", "16036", done)
+
+		<-done
+
+		suspensionRepo.AssertExpectations(t)
+		eventRepo.AssertNotCalled(t, "CardEventsForFixture", uint64(2))
+		seasonRepo.AssertNotCalled(t, "IDs")
+		assert.Nil(t, hook.LastEntry())
+	})
+
+	t.Run("logs warning if suspensions cannot be calculated for season", func(t *testing.T) {
+		t.Helper()
+
+		seasonRepo := new(mock.SeasonRepository)
+		suspensionRepo := new(mock.SuspensionRepository)
+		logger, hook := test.NewNullLogger()
+
+		tracker := suspension.NewTracker(nil, nil, seasonRepo, nil, clockwork.NewFakeClock())
+		processor := process.NewSuspensionProcessor(tracker, seasonRepo, suspensionRepo, nil, logger)
+
+		seasonRepo.On("CurrentSeasonIDs").Return([]uint64{16036}, nil)
+		seasonRepo.On("ByID", uint64(16036)).Return(&app.Season{}, errors.ErrorNotFound)
+
+		done := make(chan bool)
+
+		processor.Process("suspension:current-season", "", done)
+
+		<-done
+
+		suspensionRepo.AssertNotCalled(t, "ReplaceForTeam", m.Anything)
+		assert.Equal(t, "Error 'the resource requested does not exist' occurred when calculating suspensions for season 16036", hook.LastEntry().Message)
+	})
+
+	t.Run("saves suspension rules for a competition", func(t *testing.T) {
+		t.Helper()
+
+		rulesRepo := new(mock.SuspensionRulesRepository)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewSuspensionProcessor(nil, nil, nil, rulesRepo, logger)
+
+		rules := app.SuspensionRules{
+			CompetitionID:       8,
+			YellowThresholds:    []app.YellowCardThreshold{{Yellows: 5, Matches: 1, Before: 19}},
+			StraightRedMatches:  3,
+			SecondYellowMatches: 1,
+		}
+
+		rulesRepo.On("Save", &rules).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process(
+			"suspension:rules",
+			`{"competition_id": 8, "yellow_thresholds": [{"yellows": 5, "matches": 1, "before": 19}], "straight_red_matches": 3, "second_yellow_matches": 1}`,
+			done,
+		)
+
+		<-done
+
+		rulesRepo.AssertExpectations(t)
+		assert.Equal(t, "Suspension rules saved for competition 8", hook.LastEntry().Message)
+	})
+}
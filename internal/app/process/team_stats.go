@@ -4,6 +4,7 @@ import (
 	"github.com/jonboulle/clockwork"
 	"github.com/sirupsen/logrus"
 	"github.com/statistico/statistico-football-data/internal/app"
+	"reflect"
 	"strconv"
 	"time"
 )
@@ -17,6 +18,7 @@ type TeamStatsProcessor struct {
 	competitionRepo    app.CompetitionRepository
 	seasonRepo    app.SeasonRepository
 	requester     app.TeamStatsRequester
+	checkpoints   seasonCheckpoints
 	clock         clockwork.Clock
 	logger        *logrus.Logger
 }
@@ -27,10 +29,10 @@ func (t TeamStatsProcessor) Process(command string, option string, done chan boo
 		go t.processByDate(option, done)
 	case teamStatsBySeasonId:
 		id, _ := strconv.Atoi(option)
-		go t.processSeason(uint64(id), done)
+		go t.processSeasons(command, option, []uint64{uint64(id)}, done)
 	case teamStatsByCompetitionId:
 		id, _ := strconv.Atoi(option)
-		go t.processCompetition(uint64(id), done)
+		go t.processCompetition(command, option, uint64(id), done)
 	default:
 		t.logger.Fatalf("Command %s is not supported", command)
 		return
@@ -57,13 +59,7 @@ func (t TeamStatsProcessor) processByDate(date string, done chan bool) {
 	go t.persistStats(ch, done)
 }
 
-func (t TeamStatsProcessor) processSeason(seasonID uint64, done chan bool) {
-	ch := t.requester.TeamStatsBySeasonIDs([]uint64{seasonID})
-
-	go t.persistStats(ch, done)
-}
-
-func (t TeamStatsProcessor) processCompetition(competitionID uint64, done chan bool) {
+func (t TeamStatsProcessor) processCompetition(command, option string, competitionID uint64, done chan bool) {
 	seasons, err := t.seasonRepo.ByCompetitionId(competitionID, "name_asc")
 
 	if err != nil {
@@ -77,9 +73,39 @@ func (t TeamStatsProcessor) processCompetition(competitionID uint64, done chan b
 		ids = append(ids, season.ID)
 	}
 
-	ch := t.requester.TeamStatsBySeasonIDs(ids)
+	t.processSeasons(command, option, ids, done)
+}
 
-	go t.persistStats(ch, done)
+// processSeasons requests and persists team stats one season at a time, recording checkpoints as seasons and
+// fixtures are persisted so the run can be resumed.
+func (t TeamStatsProcessor) processSeasons(command, option string, ids []uint64, done chan bool) {
+	progress, err := t.checkpoints.begin(command, option, ids)
+
+	if err != nil {
+		t.logger.Fatalf("Error when loading checkpoints in team stats processor: %s", err.Error())
+		return
+	}
+
+	for _, id := range progress.pending {
+		season, err := t.requester.TeamStatsBySeasonID(id)
+
+		if err != nil {
+			progress.failSeason(id, err)
+			continue
+		}
+
+		for _, stats := range season {
+			if progress.persist(stats.FixtureID) && !t.persist(stats) {
+				progress.failFixture()
+			}
+		}
+
+		progress.completeSeason(id)
+	}
+
+	progress.finish()
+
+	done <- true
 }
 
 func (t TeamStatsProcessor) persistStats(ch <-chan app.TeamStats, done chan bool) {
@@ -90,22 +116,42 @@ func (t TeamStatsProcessor) persistStats(ch <-chan app.TeamStats, done chan bool
 	done <- true
 }
 
-func (t TeamStatsProcessor) persist(x app.TeamStats) {
-	_, err := t.teamStatsRepo.ByFixtureAndTeam(x.FixtureID, x.TeamID)
+// persist inserts or updates the team stats, publishing a TeamStatsUpdated event if the stats are new or changed.
+// False is returned if the stats could not be stored.
+func (t TeamStatsProcessor) persist(x app.TeamStats) bool {
+	existing, err := t.teamStatsRepo.ByFixtureAndTeam(x.FixtureID, x.TeamID)
+
+	e := app.TeamStatsUpdated{FixtureID: x.FixtureID, TeamID: x.TeamID}
 
 	if err != nil {
-		if err := t.teamStatsRepo.InsertTeamStats(&x); err != nil {
+		if err := t.teamStatsRepo.InsertTeamStats(&x, e); err != nil {
 			t.logger.Errorf("Error '%s' occurred when inserting team stats struct: %+v\n,", err.Error(), x)
+			return false
 		}
 
-		return
+		return true
+	}
+
+	var events []app.DomainEvent
+
+	if statsChanged(existing, x) {
+		events = append(events, e)
 	}
 
-	if err := t.teamStatsRepo.UpdateTeamStats(&x); err != nil {
+	if err := t.teamStatsRepo.UpdateTeamStats(&x, events...); err != nil {
 		t.logger.Errorf("Error '%s' occurred when updating team stats struct: %+v\n,", err.Error(), x)
+		return false
 	}
 
-	return
+	return true
+}
+
+// statsChanged returns true if the stats differ from the stats stored, ignoring when the stats were stored.
+func statsChanged(stored *app.TeamStats, x app.TeamStats) bool {
+	s := *stored
+	s.CreatedAt, s.UpdatedAt = x.CreatedAt, x.UpdatedAt
+
+	return !reflect.DeepEqual(s, x)
 }
 
 func NewTeamStatsProcessor(
@@ -113,6 +159,8 @@ func NewTeamStatsProcessor(
 	c app.CompetitionRepository,
 	s app.SeasonRepository,
 	q app.TeamStatsRequester,
+	k app.CheckpointRepository,
+	resume bool,
 	cl clockwork.Clock,
 	log *logrus.Logger,
 ) *TeamStatsProcessor {
@@ -121,6 +169,7 @@ func NewTeamStatsProcessor(
 		competitionRepo: c,
 		seasonRepo: s,
 		requester: q,
+		checkpoints: seasonCheckpoints{checkpointRepo: k, resume: resume, logger: log},
 		clock: cl,
 		logger: log,
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/statistico/statistico-football-data/internal/app/mock"
 	"github.com/statistico/statistico-football-data/internal/app/process"
 	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
 	"testing"
 	"time"
 )
@@ -22,9 +23,13 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.TeamStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "team-stats:by-season-id 45").Return(nil)
+		checkpointRepo.On("Complete", "team-stats:by-season-id 45", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -35,13 +40,11 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		stats[0] = home
 		stats[1] = away
 
-		ch := teamStatsChannel(stats)
-
-		requester.On("TeamStatsBySeasonIDs", []uint64{45}).Return(ch)
+		requester.On("TeamStatsBySeasonID", uint64(45)).Return(stats, nil)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(99)).Return(&app.TeamStats{}, errors.New("not found"))
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(2)).Return(&app.TeamStats{}, errors.New("not found"))
-		teamStatsRepo.On("InsertTeamStats", &home).Return(nil)
-		teamStatsRepo.On("InsertTeamStats", &away).Return(nil)
+		teamStatsRepo.On("InsertTeamStats", &home, app.TeamStatsUpdated{FixtureID: home.FixtureID, TeamID: home.TeamID}).Return(nil)
+		teamStatsRepo.On("InsertTeamStats", &away, app.TeamStatsUpdated{FixtureID: away.FixtureID, TeamID: away.TeamID}).Return(nil)
 
 		processor.Process("team-stats:by-season-id", "45", done)
 
@@ -49,7 +52,7 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 
 		requester.AssertExpectations(t)
 		teamStatsRepo.AssertExpectations(t)
-		assert.Nil(t, hook.LastEntry())
+		assert.Equal(t, "Processed season 45 of 'team-stats:by-season-id 45': 1 of 1 seasons complete (100%)", hook.LastEntry().Message)
 	})
 
 	t.Run("log errors if unable to insert team stats into repository when processing team stats by season id command", func(t *testing.T) {
@@ -60,9 +63,13 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.TeamStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "team-stats:by-season-id 45").Return(nil)
+		checkpointRepo.On("Complete", "team-stats:by-season-id 45", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -73,13 +80,11 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		stats[0] = home
 		stats[1] = away
 
-		ch := teamStatsChannel(stats)
-
-		requester.On("TeamStatsBySeasonIDs", []uint64{45}).Return(ch)
+		requester.On("TeamStatsBySeasonID", uint64(45)).Return(stats, nil)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(99)).Return(&app.TeamStats{}, errors.New("not found"))
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(2)).Return(&app.TeamStats{}, errors.New("not found"))
-		teamStatsRepo.On("InsertTeamStats", &home).Return(errors.New("error occurred"))
-		teamStatsRepo.On("InsertTeamStats", &away).Return(nil)
+		teamStatsRepo.On("InsertTeamStats", &home, app.TeamStatsUpdated{FixtureID: home.FixtureID, TeamID: home.TeamID}).Return(errors.New("error occurred"))
+		teamStatsRepo.On("InsertTeamStats", &away, app.TeamStatsUpdated{FixtureID: away.FixtureID, TeamID: away.TeamID}).Return(nil)
 
 		processor.Process("team-stats:by-season-id", "45", done)
 
@@ -87,8 +92,9 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 
 		requester.AssertExpectations(t)
 		teamStatsRepo.AssertExpectations(t)
-		assert.Equal(t, 1, len(hook.Entries))
-		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, 3, len(hook.Entries))
+		assert.Equal(t, logrus.ErrorLevel, hook.Entries[0].Level)
+		assert.Equal(t, "'team-stats:by-season-id 45' finished with 1 of 1 seasons incomplete, resume the run to retry them", hook.LastEntry().Message)
 	})
 
 	t.Run("update existing team stats into repository when processing team stats by season id command", func(t *testing.T) {
@@ -99,9 +105,13 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.TeamStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "team-stats:by-season-id 45").Return(nil)
+		checkpointRepo.On("Complete", "team-stats:by-season-id 45", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -112,13 +122,11 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		stats[0] = home
 		stats[1] = away
 
-		ch := teamStatsChannel(stats)
-
-		requester.On("TeamStatsBySeasonIDs", []uint64{45}).Return(ch)
+		requester.On("TeamStatsBySeasonID", uint64(45)).Return(stats, nil)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(99)).Return(&app.TeamStats{}, nil)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(2)).Return(&app.TeamStats{}, nil)
-		teamStatsRepo.On("UpdateTeamStats", &home).Return(nil)
-		teamStatsRepo.On("UpdateTeamStats", &away).Return(nil)
+		teamStatsRepo.On("UpdateTeamStats", &home, app.TeamStatsUpdated{FixtureID: home.FixtureID, TeamID: home.TeamID}).Return(nil)
+		teamStatsRepo.On("UpdateTeamStats", &away, app.TeamStatsUpdated{FixtureID: away.FixtureID, TeamID: away.TeamID}).Return(nil)
 
 		processor.Process("team-stats:by-season-id", "45", done)
 
@@ -126,7 +134,49 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 
 		requester.AssertExpectations(t)
 		teamStatsRepo.AssertExpectations(t)
-		assert.Nil(t, hook.LastEntry())
+		assert.Equal(t, "Processed season 45 of 'team-stats:by-season-id 45': 1 of 1 seasons complete (100%)", hook.LastEntry().Message)
+	})
+
+	t.Run("does not publish event when existing team stats are unchanged when processing team stats by season id command", func(t *testing.T) {
+		t.Helper()
+
+		teamStatsRepo := new(mock.TeamStatsRepository)
+		competitionRepo := new(mock.CompetitionRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.TeamStatsRequester)
+		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
+		logger, _ := test.NewNullLogger()
+
+		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "team-stats:by-season-id 45").Return(nil)
+		checkpointRepo.On("Complete", "team-stats:by-season-id 45", m.Anything, m.Anything).Return(nil)
+
+		done := make(chan bool)
+
+		corners, shots := 4, 11
+
+		home := newTeamStats(45, 99)
+		home.Corners = &corners
+		home.TeamShots.Total = &shots
+
+		storedCorners, storedShots := 4, 11
+
+		stored := newTeamStats(45, 99)
+		stored.Corners = &storedCorners
+		stored.TeamShots.Total = &storedShots
+		stored.UpdatedAt = time.Unix(1546000000, 0)
+
+		requester.On("TeamStatsBySeasonID", uint64(45)).Return([]app.TeamStats{home}, nil)
+		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(99)).Return(&stored, nil)
+		teamStatsRepo.On("UpdateTeamStats", &home).Return(nil)
+
+		processor.Process("team-stats:by-season-id", "45", done)
+
+		<-done
+
+		teamStatsRepo.AssertExpectations(t)
 	})
 
 	t.Run("log errors if unable to update team stats into repository when processing team stats by season id command", func(t *testing.T) {
@@ -137,9 +187,13 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.TeamStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "team-stats:by-season-id 45").Return(nil)
+		checkpointRepo.On("Complete", "team-stats:by-season-id 45", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -150,13 +204,11 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		stats[0] = home
 		stats[1] = away
 
-		ch := teamStatsChannel(stats)
-
-		requester.On("TeamStatsBySeasonIDs", []uint64{45}).Return(ch)
+		requester.On("TeamStatsBySeasonID", uint64(45)).Return(stats, nil)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(99)).Return(&app.TeamStats{}, nil)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(2)).Return(&app.TeamStats{}, nil)
-		teamStatsRepo.On("UpdateTeamStats", &home).Return(nil)
-		teamStatsRepo.On("UpdateTeamStats", &away).Return(errors.New("error occurred"))
+		teamStatsRepo.On("UpdateTeamStats", &home, app.TeamStatsUpdated{FixtureID: home.FixtureID, TeamID: home.TeamID}).Return(nil)
+		teamStatsRepo.On("UpdateTeamStats", &away, app.TeamStatsUpdated{FixtureID: away.FixtureID, TeamID: away.TeamID}).Return(errors.New("error occurred"))
 
 		processor.Process("team-stats:by-season-id", "45", done)
 
@@ -164,8 +216,9 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 
 		requester.AssertExpectations(t)
 		teamStatsRepo.AssertExpectations(t)
-		assert.Equal(t, 1, len(hook.Entries))
-		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, 3, len(hook.Entries))
+		assert.Equal(t, logrus.ErrorLevel, hook.Entries[0].Level)
+		assert.Equal(t, "'team-stats:by-season-id 45' finished with 1 of 1 seasons incomplete, resume the run to retry them", hook.LastEntry().Message)
 	})
 
 	t.Run("inserts new team stats into repository when processing team stats by date command", func(t *testing.T) {
@@ -176,9 +229,11 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.TeamStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
 
 		done := make(chan bool)
 
@@ -198,8 +253,8 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		requester.On("TeamStatsByDate", date, []uint64{1, 2, 3}).Return(ch)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(99)).Return(&app.TeamStats{}, errors.New("not found"))
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(2)).Return(&app.TeamStats{}, errors.New("not found"))
-		teamStatsRepo.On("InsertTeamStats", &home).Return(nil)
-		teamStatsRepo.On("InsertTeamStats", &away).Return(nil)
+		teamStatsRepo.On("InsertTeamStats", &home, app.TeamStatsUpdated{FixtureID: home.FixtureID, TeamID: home.TeamID}).Return(nil)
+		teamStatsRepo.On("InsertTeamStats", &away, app.TeamStatsUpdated{FixtureID: away.FixtureID, TeamID: away.TeamID}).Return(nil)
 
 		processor.Process("team-stats:by-date", "2021-01-18", done)
 
@@ -218,9 +273,11 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.TeamStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
 
 		done := make(chan bool)
 
@@ -240,8 +297,8 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		requester.On("TeamStatsByDate", date, []uint64{1, 2, 3}).Return(ch)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(99)).Return(&app.TeamStats{}, errors.New("not found"))
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(2)).Return(&app.TeamStats{}, errors.New("not found"))
-		teamStatsRepo.On("InsertTeamStats", &home).Return(errors.New("error occurred"))
-		teamStatsRepo.On("InsertTeamStats", &away).Return(nil)
+		teamStatsRepo.On("InsertTeamStats", &home, app.TeamStatsUpdated{FixtureID: home.FixtureID, TeamID: home.TeamID}).Return(errors.New("error occurred"))
+		teamStatsRepo.On("InsertTeamStats", &away, app.TeamStatsUpdated{FixtureID: away.FixtureID, TeamID: away.TeamID}).Return(nil)
 
 		processor.Process("team-stats:by-date", "2021-01-18", done)
 
@@ -262,9 +319,11 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.TeamStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
 
 		done := make(chan bool)
 
@@ -284,8 +343,8 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		requester.On("TeamStatsByDate", date, []uint64{1, 2, 3}).Return(ch)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(99)).Return(&app.TeamStats{}, nil)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(2)).Return(&app.TeamStats{}, nil)
-		teamStatsRepo.On("UpdateTeamStats", &home).Return(nil)
-		teamStatsRepo.On("UpdateTeamStats", &away).Return(nil)
+		teamStatsRepo.On("UpdateTeamStats", &home, app.TeamStatsUpdated{FixtureID: home.FixtureID, TeamID: home.TeamID}).Return(nil)
+		teamStatsRepo.On("UpdateTeamStats", &away, app.TeamStatsUpdated{FixtureID: away.FixtureID, TeamID: away.TeamID}).Return(nil)
 
 		processor.Process("team-stats:by-date", "2021-01-18", done)
 
@@ -304,9 +363,11 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.TeamStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
 
 		done := make(chan bool)
 
@@ -326,8 +387,8 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		requester.On("TeamStatsByDate", date, []uint64{1, 2, 3}).Return(ch)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(99)).Return(&app.TeamStats{}, nil)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(2)).Return(&app.TeamStats{}, nil)
-		teamStatsRepo.On("UpdateTeamStats", &home).Return(errors.New("error occurred"))
-		teamStatsRepo.On("UpdateTeamStats", &away).Return(nil)
+		teamStatsRepo.On("UpdateTeamStats", &home, app.TeamStatsUpdated{FixtureID: home.FixtureID, TeamID: home.TeamID}).Return(errors.New("error occurred"))
+		teamStatsRepo.On("UpdateTeamStats", &away, app.TeamStatsUpdated{FixtureID: away.FixtureID, TeamID: away.TeamID}).Return(nil)
 
 		processor.Process("team-stats:by-date", "2021-01-18", done)
 
@@ -348,9 +409,13 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.TeamStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "team-stats:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "team-stats:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -361,8 +426,6 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		stats[0] = home
 		stats[1] = away
 
-		ch := teamStatsChannel(stats)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -371,11 +434,12 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("TeamStatsBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("TeamStatsBySeasonID", uint64(1)).Return(stats, nil)
+		requester.On("TeamStatsBySeasonID", uint64(2)).Return([]app.TeamStats(nil), nil)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(99)).Return(&app.TeamStats{}, errors.New("not found"))
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(2)).Return(&app.TeamStats{}, errors.New("not found"))
-		teamStatsRepo.On("InsertTeamStats", &home).Return(nil)
-		teamStatsRepo.On("InsertTeamStats", &away).Return(nil)
+		teamStatsRepo.On("InsertTeamStats", &home, app.TeamStatsUpdated{FixtureID: home.FixtureID, TeamID: home.TeamID}).Return(nil)
+		teamStatsRepo.On("InsertTeamStats", &away, app.TeamStatsUpdated{FixtureID: away.FixtureID, TeamID: away.TeamID}).Return(nil)
 
 		processor.Process("team-stats:by-competition-id", "5", done)
 
@@ -383,7 +447,7 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 
 		requester.AssertExpectations(t)
 		teamStatsRepo.AssertExpectations(t)
-		assert.Nil(t, hook.LastEntry())
+		assert.Equal(t, "Processed season 2 of 'team-stats:by-competition-id 5': 2 of 2 seasons complete (100%)", hook.LastEntry().Message)
 	})
 
 	t.Run("log errors if unable to insert team stats into repository when processing team stats by competition id command", func(t *testing.T) {
@@ -394,9 +458,13 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.TeamStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "team-stats:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "team-stats:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -407,8 +475,6 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		stats[0] = home
 		stats[1] = away
 
-		ch := teamStatsChannel(stats)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -417,11 +483,12 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("TeamStatsBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("TeamStatsBySeasonID", uint64(1)).Return(stats, nil)
+		requester.On("TeamStatsBySeasonID", uint64(2)).Return([]app.TeamStats(nil), nil)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(99)).Return(&app.TeamStats{}, errors.New("not found"))
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(2)).Return(&app.TeamStats{}, errors.New("not found"))
-		teamStatsRepo.On("InsertTeamStats", &home).Return(errors.New("error occurred"))
-		teamStatsRepo.On("InsertTeamStats", &away).Return(nil)
+		teamStatsRepo.On("InsertTeamStats", &home, app.TeamStatsUpdated{FixtureID: home.FixtureID, TeamID: home.TeamID}).Return(errors.New("error occurred"))
+		teamStatsRepo.On("InsertTeamStats", &away, app.TeamStatsUpdated{FixtureID: away.FixtureID, TeamID: away.TeamID}).Return(nil)
 
 		processor.Process("team-stats:by-competition-id", "5", done)
 
@@ -430,8 +497,9 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		requester.AssertExpectations(t)
 		teamStatsRepo.AssertExpectations(t)
 		competitionRepo.AssertExpectations(t)
-		assert.Equal(t, 1, len(hook.Entries))
-		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, 4, len(hook.Entries))
+		assert.Equal(t, logrus.ErrorLevel, hook.Entries[0].Level)
+		assert.Equal(t, "'team-stats:by-competition-id 5' finished with 1 of 2 seasons incomplete, resume the run to retry them", hook.LastEntry().Message)
 	})
 
 	t.Run("updates existing team stats into repository when processing team stats by competition id command", func(t *testing.T) {
@@ -442,9 +510,13 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.TeamStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "team-stats:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "team-stats:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -455,8 +527,6 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		stats[0] = home
 		stats[1] = away
 
-		ch := teamStatsChannel(stats)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -465,11 +535,12 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("TeamStatsBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("TeamStatsBySeasonID", uint64(1)).Return(stats, nil)
+		requester.On("TeamStatsBySeasonID", uint64(2)).Return([]app.TeamStats(nil), nil)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(99)).Return(&app.TeamStats{}, nil)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(2)).Return(&app.TeamStats{}, nil)
-		teamStatsRepo.On("UpdateTeamStats", &home).Return(nil)
-		teamStatsRepo.On("UpdateTeamStats", &away).Return(nil)
+		teamStatsRepo.On("UpdateTeamStats", &home, app.TeamStatsUpdated{FixtureID: home.FixtureID, TeamID: home.TeamID}).Return(nil)
+		teamStatsRepo.On("UpdateTeamStats", &away, app.TeamStatsUpdated{FixtureID: away.FixtureID, TeamID: away.TeamID}).Return(nil)
 
 		processor.Process("team-stats:by-competition-id", "5", done)
 
@@ -477,7 +548,7 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 
 		requester.AssertExpectations(t)
 		teamStatsRepo.AssertExpectations(t)
-		assert.Nil(t, hook.LastEntry())
+		assert.Equal(t, "Processed season 2 of 'team-stats:by-competition-id 5': 2 of 2 seasons complete (100%)", hook.LastEntry().Message)
 	})
 
 	t.Run("log errors if unable to update team stats into repository when processing team stats by competition id command", func(t *testing.T) {
@@ -488,9 +559,13 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		seasonRepo := new(mock.SeasonRepository)
 		requester := new(mock.TeamStatsRequester)
 		clock := clockwork.NewFakeClock()
+		checkpointRepo := new(mock.CheckpointRepository)
 		logger, hook := test.NewNullLogger()
 
-		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, clock, logger)
+		processor := process.NewTeamStatsProcessor(teamStatsRepo, competitionRepo, seasonRepo, requester, checkpointRepo, false, clock, logger)
+
+		checkpointRepo.On("Clear", "team-stats:by-competition-id 5").Return(nil)
+		checkpointRepo.On("Complete", "team-stats:by-competition-id 5", m.Anything, m.Anything).Return(nil)
 
 		done := make(chan bool)
 
@@ -501,8 +576,6 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		stats[0] = home
 		stats[1] = away
 
-		ch := teamStatsChannel(stats)
-
 		seasonRepo.On("ByCompetitionId", uint64(5), "name_asc").Return(
 			[]app.Season{
 				*newSeason(1, false),
@@ -511,11 +584,12 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 			nil,
 		)
 
-		requester.On("TeamStatsBySeasonIDs", []uint64{1, 2}).Return(ch)
+		requester.On("TeamStatsBySeasonID", uint64(1)).Return(stats, nil)
+		requester.On("TeamStatsBySeasonID", uint64(2)).Return([]app.TeamStats(nil), nil)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(99)).Return(&app.TeamStats{}, nil)
 		teamStatsRepo.On("ByFixtureAndTeam", uint64(45), uint64(2)).Return(&app.TeamStats{}, nil)
-		teamStatsRepo.On("UpdateTeamStats", &home).Return(errors.New("error occurred"))
-		teamStatsRepo.On("UpdateTeamStats", &away).Return(nil)
+		teamStatsRepo.On("UpdateTeamStats", &home, app.TeamStatsUpdated{FixtureID: home.FixtureID, TeamID: home.TeamID}).Return(errors.New("error occurred"))
+		teamStatsRepo.On("UpdateTeamStats", &away, app.TeamStatsUpdated{FixtureID: away.FixtureID, TeamID: away.TeamID}).Return(nil)
 
 		processor.Process("team-stats:by-competition-id", "5", done)
 
@@ -524,8 +598,9 @@ func TestTeamStatsProcessor_Process(t *testing.T) {
 		requester.AssertExpectations(t)
 		teamStatsRepo.AssertExpectations(t)
 		competitionRepo.AssertExpectations(t)
-		assert.Equal(t, 1, len(hook.Entries))
-		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, 4, len(hook.Entries))
+		assert.Equal(t, logrus.ErrorLevel, hook.Entries[0].Level)
+		assert.Equal(t, "'team-stats:by-competition-id 5' finished with 1 of 2 seasons incomplete, resume the run to retry them", hook.LastEntry().Message)
 	})
 }
 
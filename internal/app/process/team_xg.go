@@ -4,7 +4,8 @@ import (
 	"fmt"
 	"github.com/sirupsen/logrus"
 	"github.com/statistico/statistico-football-data/internal/app"
-	understat "github.com/statistico/statistico-understat-parser"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/understat"
 	"strconv"
 )
 
@@ -72,6 +73,8 @@ var historicSeasons =  map[string]map[int]string {
 	},
 }
 
+// teamMapper maps Understat team names to SportMonks team names and is only used to match fixtures whose
+// Understat teams have not yet been linked to SportMonks teams via the ExternalIDResolver.
 var teamMapper = map[string]string {
 	"GFC Ajaccio": "Gazélec Ajaccio",
 	"AC Milan": "Milan",
@@ -110,6 +113,8 @@ var teamMapper = map[string]string {
 type FixtureTeamXGProcessor struct {
 	xGRepo app.FixtureTeamXGRepository
 	fixtureRepo app.FixtureRepository
+	seasonRepo app.SeasonRepository
+	resolver app.ExternalIDResolver
 	parser *understat.Parser
 	logger *logrus.Logger
 }
@@ -127,8 +132,25 @@ func (f FixtureTeamXGProcessor) Process(command string, option string, done chan
 }
 
 func (f FixtureTeamXGProcessor) processFixtures(done chan bool, seasons map[string]map[int]string) {
+	ids, err := f.seasonRepo.IDs()
+
+	if err != nil {
+		f.logger.Fatalf("Error when retrieving season ids: %s", err.Error())
+		return
+	}
+
+	ingested := make(map[uint64]bool, len(ids))
+
+	for _, id := range ids {
+		ingested[id] = true
+	}
+
 	for k, v := range seasons {
 		for id, year := range v {
+			if !ingested[uint64(id)] {
+				continue
+			}
+
 			fix, err := f.parser.LeagueFixtures(k, year)
 
 			if err != nil {
@@ -192,7 +214,7 @@ func (f FixtureTeamXGProcessor) createNew(u understat.Fixture, seasonID uint64)
 		Away:      away,
 	}
 
-	if err := f.xGRepo.Insert(xg); err != nil {
+	if err := f.xGRepo.Insert(xg, xgUpdated(xg)); err != nil {
 		f.logger.Warnf("error inserting fixture team xg %s, fixture id %d", u.ID, xg.FixtureID)
 	}
 }
@@ -206,31 +228,91 @@ func (f FixtureTeamXGProcessor) updateExisting(xg *app.FixtureTeamXG, u understa
 		return
 	}
 
-	xg.Home = home
-	xg.Away = away
+	var events []app.DomainEvent
 
-	if err := f.xGRepo.Update(xg); err != nil {
+	if xg.Home != home || xg.Away != away {
+		xg.Home = home
+		xg.Away = away
+		events = append(events, xgUpdated(xg))
+	}
+
+	if err := f.xGRepo.Update(xg, events...); err != nil {
 		f.logger.Warnf("error update fixture team xg %d, fixture id %d", xg.ID, xg.FixtureID)
 	}
 }
 
+func xgUpdated(xg *app.FixtureTeamXG) app.FixtureXGUpdated {
+	return app.FixtureXGUpdated{FixtureID: xg.FixtureID, Home: xg.Home, Away: xg.Away}
+}
+
+// parseFixture matches an Understat fixture to a stored fixture using linked external IDs, falling back to matching
+// team names only if the fixture or its teams have not been linked.
 func (f FixtureTeamXGProcessor) parseFixture(u understat.Fixture, seasonID uint64) (*app.Fixture, error) {
-	home := parseTeam(u.Home.Title)
-	away := parseTeam(u.Away.Title)
+	id, err := f.resolver.Resolve(app.EntityFixture, app.ProviderUnderstat, u.ID)
+
+	if err == nil {
+		return f.fixtureRepo.ByID(id)
+	}
 
-	query := app.FixtureRepositoryQuery{
-		HomeTeamNameLike: &home,
-		AwayTeamNameLike: &away,
-		SeasonIDs: []uint64{seasonID},
+	if err != errors.ErrorNotFound {
+		return nil, f.resolveError(app.EntityFixture, u.ID, err)
+	}
+
+	query := app.FixtureRepositoryQuery{SeasonIDs: []uint64{seasonID}}
+
+	homeID, homeErr := f.resolver.Resolve(app.EntityTeam, app.ProviderUnderstat, u.Home.ID)
+
+	if homeErr != nil && homeErr != errors.ErrorNotFound {
+		return nil, f.resolveError(app.EntityTeam, u.Home.ID, homeErr)
+	}
+
+	awayID, awayErr := f.resolver.Resolve(app.EntityTeam, app.ProviderUnderstat, u.Away.ID)
+
+	if awayErr != nil && awayErr != errors.ErrorNotFound {
+		return nil, f.resolveError(app.EntityTeam, u.Away.ID, awayErr)
+	}
+
+	if homeErr == nil && awayErr == nil {
+		query.HomeTeamID = &homeID
+		query.AwayTeamID = &awayID
+	} else {
+		home := parseTeam(u.Home.Title)
+		away := parseTeam(u.Away.Title)
+
+		query.HomeTeamNameLike = &home
+		query.AwayTeamNameLike = &away
 	}
 
 	fixs, err := f.fixtureRepo.Get(query)
 
 	if err != nil || len(fixs) == 0 {
-		return nil, fmt.Errorf("unable to find matching fixture xg for understat ID %s, home %s, away %s", u.ID, home, away)
+		return nil, fmt.Errorf(
+			"unable to find matching fixture xg for understat ID %s, home %s, away %s",
+			u.ID,
+			u.Home.Title,
+			u.Away.Title,
+		)
 	}
 
-	return &fixs[0], nil
+	fixture := fixs[0]
+
+	f.link(app.EntityFixture, fixture.ID, u.ID)
+	f.link(app.EntityTeam, fixture.HomeTeamID, u.Home.ID)
+	f.link(app.EntityTeam, fixture.AwayTeamID, u.Away.ID)
+
+	return &fixture, nil
+}
+
+func (f FixtureTeamXGProcessor) resolveError(entityType, externalID string, err error) error {
+	f.logger.Errorf("Error '%s' occurred when resolving understat %s ID %s", err.Error(), entityType, externalID)
+
+	return fmt.Errorf("unable to resolve understat %s ID %s: %s", entityType, externalID, err.Error())
+}
+
+func (f FixtureTeamXGProcessor) link(entityType string, entityID uint64, externalID string) {
+	if err := f.resolver.Link(entityType, entityID, app.ProviderUnderstat, externalID); err != nil {
+		f.logger.Warnf("%s", err.Error())
+	}
 }
 
 func parseFloat(str *string) (*float32, error) {
@@ -262,8 +344,10 @@ func parseTeam(team string) string {
 func NewFixtureTeamXGProcessor(
 	r app.FixtureTeamXGRepository,
 	f app.FixtureRepository,
+	s app.SeasonRepository,
+	x app.ExternalIDResolver,
 	p *understat.Parser,
 	l *logrus.Logger,
 ) *FixtureTeamXGProcessor {
-	return &FixtureTeamXGProcessor{xGRepo: r, fixtureRepo: f, parser: p, logger: l}
+	return &FixtureTeamXGProcessor{xGRepo: r, fixtureRepo: f, seasonRepo: s, resolver: x, parser: p, logger: l}
 }
@@ -0,0 +1,71 @@
+package process
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"strconv"
+)
+
+const transfer = "transfer"
+const transferCurrentSeason = "transfer:current-season"
+const transferBySeasonId = "transfer:by-season-id"
+
+// TransferProcessor fetches data from external data source using the TransferRequester
+// before persisting to the storage engine using the TransferRepository.
+type TransferProcessor struct {
+	transferRepo app.TransferRepository
+	seasonRepo   app.SeasonRepository
+	requester    app.TransferRequester
+	logger       *logrus.Logger
+}
+
+func (t TransferProcessor) Process(command string, option string, done chan bool) {
+	switch command {
+	case transfer:
+		go t.processAllSeasons(done)
+	case transferCurrentSeason:
+		go t.processCurrentSeason(done)
+	case transferBySeasonId:
+		id, _ := strconv.Atoi(option)
+		go t.persistTransfers(t.requester.TransfersBySeasonIDs([]uint64{uint64(id)}), done)
+	default:
+		t.logger.Fatalf("Command %s is not supported", command)
+		return
+	}
+}
+
+func (t TransferProcessor) processAllSeasons(done chan bool) {
+	ids, err := t.seasonRepo.IDs()
+
+	if err != nil {
+		t.logger.Fatalf("Error when retrieving season ids: %s", err.Error())
+		return
+	}
+
+	go t.persistTransfers(t.requester.TransfersBySeasonIDs(ids), done)
+}
+
+func (t TransferProcessor) processCurrentSeason(done chan bool) {
+	ids, err := t.seasonRepo.CurrentSeasonIDs()
+
+	if err != nil {
+		t.logger.Fatalf("Error when retrieving season ids: %s", err.Error())
+		return
+	}
+
+	go t.persistTransfers(t.requester.TransfersBySeasonIDs(ids), done)
+}
+
+func (t TransferProcessor) persistTransfers(ch <-chan app.Transfer, done chan bool) {
+	for x := range ch {
+		if err := t.transferRepo.Save(&x); err != nil {
+			t.logger.Warningf("Error '%s' occurred when saving transfer struct: %+v\n,", err.Error(), x)
+		}
+	}
+
+	done <- true
+}
+
+func NewTransferProcessor(r app.TransferRepository, s app.SeasonRepository, q app.TransferRequester, log *logrus.Logger) *TransferProcessor {
+	return &TransferProcessor{transferRepo: r, seasonRepo: s, requester: q, logger: log}
+}
@@ -0,0 +1,85 @@
+package process_test
+
+import (
+	"errors"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/process"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestTransferProcessor_Process(t *testing.T) {
+	t.Run("saves transfers when processing transfer current season command", func(t *testing.T) {
+		t.Helper()
+
+		transferRepo := new(mock.TransferRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.TransferRequester)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewTransferProcessor(transferRepo, seasonRepo, requester, logger)
+
+		in := app.Transfer{PlayerID: 219591, FromTeamID: 20, ToTeamID: 1, Date: time.Unix(1561939200, 0), Type: "Transfer"}
+		loan := app.Transfer{PlayerID: 37429, FromTeamID: 1, ToTeamID: 14, Date: time.Unix(1580428800, 0), Type: "Loan"}
+
+		ids := []uint64{16036}
+
+		seasonRepo.On("CurrentSeasonIDs").Return(ids, nil)
+		requester.On("TransfersBySeasonIDs", ids).Return(transferChannel([]app.Transfer{in, loan}))
+		transferRepo.On("Save", &in).Return(nil).Once()
+		transferRepo.On("Save", &loan).Return(nil).Once()
+
+		done := make(chan bool)
+
+		processor.Process("transfer:current-season", "", done)
+
+		<-done
+
+		requester.AssertExpectations(t)
+		seasonRepo.AssertExpectations(t)
+		transferRepo.AssertExpectations(t)
+		assert.Nil(t, hook.LastEntry())
+	})
+
+	t.Run("logs warning if transfer cannot be saved", func(t *testing.T) {
+		t.Helper()
+
+		transferRepo := new(mock.TransferRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		requester := new(mock.TransferRequester)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewTransferProcessor(transferRepo, seasonRepo, requester, logger)
+
+		in := app.Transfer{PlayerID: 219591, FromTeamID: 20, ToTeamID: 1, Date: time.Unix(1561939200, 0), Type: "Transfer"}
+
+		requester.On("TransfersBySeasonIDs", []uint64{16036}).Return(transferChannel([]app.Transfer{in}))
+		transferRepo.On("Save", &in).Return(errors.New("oh damn"))
+
+		done := make(chan bool)
+
+		processor.Process("transfer:by-season-id", "16036", done)
+
+		<-done
+
+		seasonRepo.AssertNotCalled(t, "CurrentSeasonIDs")
+		assert.Equal(t, 1, len(hook.Entries))
+		assert.Equal(t, logrus.WarnLevel, hook.LastEntry().Level)
+	})
+}
+
+func transferChannel(transfers []app.Transfer) chan app.Transfer {
+	ch := make(chan app.Transfer, len(transfers))
+
+	for _, x := range transfers {
+		ch <- x
+	}
+
+	close(ch)
+
+	return ch
+}
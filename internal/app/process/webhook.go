@@ -0,0 +1,236 @@
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/webhook"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const webhookAdd = "webhook:add"
+const webhookDeliver = "webhook:deliver"
+const webhookList = "webhook:list"
+const webhookRemove = "webhook:remove"
+
+const webhookDeliveryBatchSize = 100
+
+// webhookMaxAttempts is the number of times a delivery is sent before it is marked as failed. Retries back off
+// exponentially from webhookInitialBackoff so the final attempt is made a little over four hours after the first.
+const webhookMaxAttempts = 8
+
+const webhookInitialBackoff = time.Minute
+
+// WebhookProcessor manages webhook subscriptions and sends the deliveries queued for them. Deliveries that are not
+// accepted by the receiver are retried with an exponential backoff on subsequent runs of the deliver command.
+type WebhookProcessor struct {
+	subscriptionRepo app.WebhookSubscriptionRepository
+	deliveryRepo     app.WebhookDeliveryRepository
+	sender           app.WebhookSender
+	out              io.Writer
+	clock            clockwork.Clock
+	logger           *logrus.Logger
+}
+
+func (w WebhookProcessor) Process(command string, option string, done chan bool) {
+	switch command {
+	case webhookAdd:
+		go w.add(option, done)
+	case webhookDeliver:
+		go w.deliver(done)
+	case webhookList:
+		go w.list(done)
+	case webhookRemove:
+		id, _ := strconv.Atoi(option)
+		go w.remove(uint64(id), done)
+	default:
+		w.logger.Fatalf("Command %s is not supported", command)
+		return
+	}
+}
+
+func (w WebhookProcessor) add(option string, done chan bool) {
+	var s app.WebhookSubscription
+
+	if err := json.Unmarshal([]byte(option), &s); err != nil {
+		w.logger.Fatalf("Error parsing webhook subscription: %s", err.Error())
+		return
+	}
+
+	if err := webhook.Prepare(&s); err != nil {
+		w.logger.Fatalf("Webhook subscription is invalid: %s", err.Error())
+		return
+	}
+
+	if err := w.subscriptionRepo.Insert(&s); err != nil {
+		w.logger.Fatalf("Error inserting webhook subscription: %s", err.Error())
+		return
+	}
+
+	fmt.Fprintf(w.out, "Webhook subscription %d added for %s, payloads are signed using secret %s\n", s.ID, s.URL, s.Secret)
+
+	done <- true
+}
+
+func (w WebhookProcessor) list(done chan bool) {
+	subs, err := w.subscriptionRepo.All()
+
+	if err != nil {
+		w.logger.Fatalf("Error fetching webhook subscriptions: %s", err.Error())
+		return
+	}
+
+	tw := tabwriter.NewWriter(w.out, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "ID\tURL\tEVENTS\tCOMPETITIONS\tCREATED")
+
+	for _, s := range subs {
+		competitions := make([]string, len(s.CompetitionIDs))
+
+		for i, id := range s.CompetitionIDs {
+			competitions[i] = strconv.FormatUint(id, 10)
+		}
+
+		fmt.Fprintf(
+			tw,
+			"%d\t%s\t%s\t%s\t%s\n",
+			s.ID,
+			s.URL,
+			strings.Join(s.EventTypes, ","),
+			strings.Join(competitions, ","),
+			s.CreatedAt.UTC().Format(time.RFC3339),
+		)
+	}
+
+	if err := tw.Flush(); err != nil {
+		w.logger.Errorf("Error writing webhook subscriptions: %s", err.Error())
+	}
+
+	done <- true
+}
+
+func (w WebhookProcessor) remove(id uint64, done chan bool) {
+	if err := w.subscriptionRepo.Delete(id); err != nil {
+		w.logger.Fatalf("Error removing webhook subscription %d: %s", id, err.Error())
+		return
+	}
+
+	w.logger.Infof("Webhook subscription %d removed alongside its pending deliveries", id)
+
+	done <- true
+}
+
+func (w WebhookProcessor) deliver(done chan bool) {
+	subs, err := w.subscriptionRepo.All()
+
+	if err != nil {
+		w.logger.Fatalf("Error fetching webhook subscriptions: %s", err.Error())
+		return
+	}
+
+	byID := make(map[uint64]app.WebhookSubscription, len(subs))
+
+	for _, s := range subs {
+		byID[s.ID] = s
+	}
+
+	var afterID uint64
+
+	for {
+		deliveries, err := w.deliveryRepo.Due(afterID, webhookDeliveryBatchSize)
+
+		if err != nil {
+			w.logger.Fatalf("Error fetching due webhook deliveries: %s", err.Error())
+			return
+		}
+
+		if len(deliveries) == 0 {
+			break
+		}
+
+		for _, d := range deliveries {
+			if s, ok := byID[d.SubscriptionID]; ok {
+				w.send(s, d)
+			}
+
+			afterID = d.ID
+		}
+	}
+
+	done <- true
+}
+
+func (w WebhookProcessor) send(s app.WebhookSubscription, d app.WebhookDelivery) {
+	code, err := w.sender.Send(s, d)
+
+	now := w.clock.Now()
+	d.Attempts++
+
+	attempt := app.WebhookDeliveryAttempt{DeliveryID: d.ID, Attempt: d.Attempts, AttemptedAt: now}
+
+	if code != 0 {
+		attempt.StatusCode = &code
+	}
+
+	switch {
+	case err == nil:
+		d.Status = app.WebhookDeliveryDelivered
+		d.DeliveredAt = &now
+	case d.Attempts >= webhookMaxAttempts:
+		reason := err.Error()
+		attempt.Error = &reason
+		d.Status = app.WebhookDeliveryFailed
+
+		w.logger.Warningf(
+			"Error '%s' occurred when delivering webhook %d to %s, giving up after %d attempts",
+			reason,
+			d.ID,
+			s.URL,
+			d.Attempts,
+		)
+	default:
+		reason := err.Error()
+		attempt.Error = &reason
+		d.NextAttemptAt = now.Add(webhookInitialBackoff << uint(d.Attempts-1))
+
+		w.logger.Warningf(
+			"Error '%s' occurred when delivering webhook %d to %s, retrying at %s",
+			reason,
+			d.ID,
+			s.URL,
+			d.NextAttemptAt.UTC().Format(time.RFC3339),
+		)
+	}
+
+	if err := w.deliveryRepo.InsertAttempt(&attempt); err != nil {
+		w.logger.Errorf("Error '%s' occurred when recording attempt for webhook delivery %d", err.Error(), d.ID)
+	}
+
+	if err := w.deliveryRepo.Update(&d); err != nil {
+		w.logger.Errorf("Error '%s' occurred when updating webhook delivery %d", err.Error(), d.ID)
+	}
+}
+
+func NewWebhookProcessor(
+	s app.WebhookSubscriptionRepository,
+	d app.WebhookDeliveryRepository,
+	q app.WebhookSender,
+	out io.Writer,
+	c clockwork.Clock,
+	log *logrus.Logger,
+) *WebhookProcessor {
+	return &WebhookProcessor{
+		subscriptionRepo: s,
+		deliveryRepo:     d,
+		sender:           q,
+		out:              out,
+		clock:            c,
+		logger:           log,
+	}
+}
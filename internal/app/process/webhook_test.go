@@ -0,0 +1,218 @@
+package process_test
+
+import (
+	"bytes"
+	"errors"
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/process"
+	"github.com/statistico/statistico-football-data/internal/app/webhook"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWebhookProcessor_Process(t *testing.T) {
+	now := time.Date(2021, 2, 10, 15, 0, 0, 0, time.UTC)
+
+	t.Run("adds subscription and prints the signing secret", func(t *testing.T) {
+		t.Helper()
+
+		subRepo := new(mock.WebhookSubscriptionRepository)
+		deliveryRepo := new(mock.WebhookDeliveryRepository)
+		logger, _ := test.NewNullLogger()
+		out := bytes.Buffer{}
+
+		processor := process.NewWebhookProcessor(subRepo, deliveryRepo, new(mock.WebhookSender), &out, clockwork.NewFakeClockAt(now), logger)
+
+		subRepo.On("Insert", m.MatchedBy(func(s *app.WebhookSubscription) bool {
+			return s.URL == "https://example.com/hooks" && s.EventTypes[0] == "result.finalised" &&
+				s.CompetitionIDs[0] == 8 && s.Secret == "s3cr3t"
+		})).Run(func(args m.Arguments) {
+			args.Get(0).(*app.WebhookSubscription).ID = 3
+		}).Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process(
+			"webhook:add",
+			`{"url": "https://example.com/hooks", "event_types": ["result.finalised"], "competition_ids": [8], "secret": "s3cr3t"}`,
+			done,
+		)
+
+		<-done
+
+		subRepo.AssertExpectations(t)
+		assert.Equal(t, "Webhook subscription 3 added for https://example.com/hooks, payloads are signed using secret s3cr3t\n", out.String())
+	})
+
+	t.Run("lists subscriptions", func(t *testing.T) {
+		t.Helper()
+
+		subRepo := new(mock.WebhookSubscriptionRepository)
+		logger, _ := test.NewNullLogger()
+		out := bytes.Buffer{}
+
+		processor := process.NewWebhookProcessor(subRepo, new(mock.WebhookDeliveryRepository), new(mock.WebhookSender), &out, clockwork.NewFakeClockAt(now), logger)
+
+		subRepo.On("All").Return([]app.WebhookSubscription{
+			{
+				ID:             3,
+				URL:            "https://example.com/hooks",
+				EventTypes:     []string{"result.finalised", "fixture-xg.updated"},
+				CompetitionIDs: []uint64{8, 564},
+				Secret:         "s3cr3t",
+				CreatedAt:      now,
+			},
+		}, nil)
+
+		done := make(chan bool)
+
+		processor.Process("webhook:list", "", done)
+
+		<-done
+
+		expected := "ID  URL                        EVENTS                               COMPETITIONS  CREATED\n" +
+			"3   https://example.com/hooks  result.finalised,fixture-xg.updated  8,564         2021-02-10T15:00:00Z\n"
+
+		assert.Equal(t, expected, out.String())
+	})
+
+	t.Run("sends due deliveries to a local receiver and records the attempts", func(t *testing.T) {
+		t.Helper()
+
+		var received []string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+
+			if r.Header.Get("X-Statistico-Signature") != webhook.Sign("s3cr3t", body) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			received = append(received, string(body))
+
+			if r.Header.Get("X-Statistico-Delivery") == "12" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		subRepo := new(mock.WebhookSubscriptionRepository)
+		deliveryRepo := new(mock.WebhookDeliveryRepository)
+		logger, hook := test.NewNullLogger()
+		clock := clockwork.NewFakeClockAt(now)
+
+		processor := process.NewWebhookProcessor(subRepo, deliveryRepo, webhook.NewHTTPSender(server.Client()), &bytes.Buffer{}, clock, logger)
+
+		subRepo.On("All").Return([]app.WebhookSubscription{{ID: 3, URL: server.URL, Secret: "s3cr3t"}}, nil)
+
+		deliveries := []app.WebhookDelivery{
+			{ID: 11, SubscriptionID: 3, EventName: "result.finalised", Payload: []byte(`{"fixture_id":45}`), Status: "pending"},
+			{ID: 12, SubscriptionID: 3, EventName: "result.finalised", Payload: []byte(`{"fixture_id":46}`), Status: "pending", Attempts: 2},
+			{ID: 13, SubscriptionID: 3, EventName: "result.finalised", Payload: []byte(`{"fixture_id":47}`), Status: "pending", Attempts: 7},
+		}
+
+		deliveryRepo.On("Due", uint64(0), uint64(100)).Return(deliveries, nil)
+		deliveryRepo.On("Due", uint64(13), uint64(100)).Return([]app.WebhookDelivery{}, nil)
+
+		var attempts []*app.WebhookDeliveryAttempt
+		var updated []*app.WebhookDelivery
+
+		deliveryRepo.On("InsertAttempt", m.AnythingOfType("*app.WebhookDeliveryAttempt")).
+			Run(func(args m.Arguments) { attempts = append(attempts, args.Get(0).(*app.WebhookDeliveryAttempt)) }).
+			Return(nil)
+		deliveryRepo.On("Update", m.AnythingOfType("*app.WebhookDelivery")).
+			Run(func(args m.Arguments) { updated = append(updated, args.Get(0).(*app.WebhookDelivery)) }).
+			Return(nil)
+
+		done := make(chan bool)
+
+		processor.Process("webhook:deliver", "", done)
+
+		<-done
+
+		a := assert.New(t)
+
+		a.Equal([]string{`{"fixture_id":45}`, `{"fixture_id":46}`, `{"fixture_id":47}`}, received)
+		a.Equal(3, len(attempts))
+		a.Equal(3, len(updated))
+
+		a.Equal("delivered", updated[0].Status)
+		a.Equal(1, updated[0].Attempts)
+		a.Equal(now, *updated[0].DeliveredAt)
+		a.Equal(200, *attempts[0].StatusCode)
+		a.Nil(attempts[0].Error)
+
+		a.Equal("pending", updated[1].Status)
+		a.Equal(3, updated[1].Attempts)
+		a.Equal(now.Add(4*time.Minute), updated[1].NextAttemptAt)
+		a.Equal(3, attempts[1].Attempt)
+		a.Equal(500, *attempts[1].StatusCode)
+		a.Equal("webhook endpoint responded with status 500", *attempts[1].Error)
+
+		a.Equal("delivered", updated[2].Status)
+		a.Equal(8, updated[2].Attempts)
+
+		a.Equal(1, len(hook.Entries))
+		a.Equal(logrus.WarnLevel, hook.Entries[0].Level)
+		a.Equal(
+			"Error 'webhook endpoint responded with status 500' occurred when delivering webhook 12 to "+server.URL+
+				", retrying at 2021-02-10T15:04:00Z",
+			hook.Entries[0].Message,
+		)
+	})
+
+	t.Run("marks delivery as failed once the maximum number of attempts is reached", func(t *testing.T) {
+		t.Helper()
+
+		subRepo := new(mock.WebhookSubscriptionRepository)
+		deliveryRepo := new(mock.WebhookDeliveryRepository)
+		sender := new(mock.WebhookSender)
+		logger, hook := test.NewNullLogger()
+
+		processor := process.NewWebhookProcessor(subRepo, deliveryRepo, sender, &bytes.Buffer{}, clockwork.NewFakeClockAt(now), logger)
+
+		sub := app.WebhookSubscription{ID: 3, URL: "http://localhost:1"}
+		delivery := app.WebhookDelivery{ID: 13, SubscriptionID: 3, Status: "pending", Attempts: 7}
+
+		subRepo.On("All").Return([]app.WebhookSubscription{sub}, nil)
+		deliveryRepo.On("Due", uint64(0), uint64(100)).Return([]app.WebhookDelivery{delivery}, nil)
+		deliveryRepo.On("Due", uint64(13), uint64(100)).Return([]app.WebhookDelivery{}, nil)
+		sender.On("Send", sub, delivery).Return(0, errors.New("connection refused"))
+
+		deliveryRepo.On("InsertAttempt", m.MatchedBy(func(a *app.WebhookDeliveryAttempt) bool {
+			return a.DeliveryID == 13 && a.Attempt == 8 && a.StatusCode == nil && *a.Error == "connection refused"
+		})).Return(nil)
+		deliveryRepo.On("Update", m.MatchedBy(func(d *app.WebhookDelivery) bool {
+			return d.ID == 13 && d.Status == "failed" && d.Attempts == 8 && d.DeliveredAt == nil
+		})).Return(errors.New("oh no"))
+
+		done := make(chan bool)
+
+		processor.Process("webhook:deliver", "", done)
+
+		<-done
+
+		deliveryRepo.AssertExpectations(t)
+		assert.Equal(t, 2, len(hook.Entries))
+		assert.Equal(
+			t,
+			"Error 'connection refused' occurred when delivering webhook 13 to http://localhost:1, giving up after 8 attempts",
+			hook.Entries[0].Message,
+		)
+		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, "Error 'oh no' occurred when updating webhook delivery 13", hook.LastEntry().Message)
+	})
+}
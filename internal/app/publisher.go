@@ -0,0 +1,148 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	FixtureRescheduledEvent = "fixture.rescheduled"
+	FixtureXGUpdatedEvent   = "fixture-xg.updated"
+	ResultFinalisedEvent    = "result.finalised"
+	TeamStatsUpdatedEvent   = "team-stats.updated"
+)
+
+// DomainEventNames contains the names of every DomainEvent published by the application.
+var DomainEventNames = []string{
+	FixtureRescheduledEvent,
+	FixtureXGUpdatedEvent,
+	ResultFinalisedEvent,
+	TeamStatsUpdatedEvent,
+}
+
+// DomainEvent is published by processors when persisted data changes in a way systems outside of this application
+// may want to act upon.
+type DomainEvent interface {
+	// Name returns the name identifying the type of event i.e. "result.finalised".
+	Name() string
+	// AggregateID returns the ID of the fixture the event relates to.
+	AggregateID() uint64
+}
+
+// FixtureRescheduled is published when the kick-off date of an existing fixture changes.
+type FixtureRescheduled struct {
+	FixtureID    uint64    `json:"fixture_id"`
+	PreviousDate time.Time `json:"previous_date"`
+	Date         time.Time `json:"date"`
+}
+
+func (f FixtureRescheduled) Name() string {
+	return FixtureRescheduledEvent
+}
+
+func (f FixtureRescheduled) AggregateID() uint64 {
+	return f.FixtureID
+}
+
+// FixtureXGUpdated is published when the expected goals of a fixture are inserted or updated.
+type FixtureXGUpdated struct {
+	FixtureID uint64   `json:"fixture_id"`
+	Home      *float32 `json:"home"`
+	Away      *float32 `json:"away"`
+}
+
+func (f FixtureXGUpdated) Name() string {
+	return FixtureXGUpdatedEvent
+}
+
+func (f FixtureXGUpdated) AggregateID() uint64 {
+	return f.FixtureID
+}
+
+// ResultFinalised is published when the result of a fixture is complete and will no longer change.
+type ResultFinalised struct {
+	FixtureID uint64 `json:"fixture_id"`
+	HomeScore *int   `json:"home_score"`
+	AwayScore *int   `json:"away_score"`
+}
+
+func (r ResultFinalised) Name() string {
+	return ResultFinalisedEvent
+}
+
+func (r ResultFinalised) AggregateID() uint64 {
+	return r.FixtureID
+}
+
+// TeamStatsUpdated is published when the stats of a team in a fixture are inserted or updated.
+type TeamStatsUpdated struct {
+	FixtureID uint64 `json:"fixture_id"`
+	TeamID    uint64 `json:"team_id"`
+}
+
+func (t TeamStatsUpdated) Name() string {
+	return TeamStatsUpdatedEvent
+}
+
+func (t TeamStatsUpdated) AggregateID() uint64 {
+	return t.FixtureID
+}
+
+// DecodeDomainEvent converts the JSON payload of a DomainEvent back into the typed event identified by name.
+func DecodeDomainEvent(name string, payload []byte) (DomainEvent, error) {
+	var err error
+
+	switch name {
+	case FixtureRescheduledEvent:
+		var e FixtureRescheduled
+		err = json.Unmarshal(payload, &e)
+		return e, err
+	case FixtureXGUpdatedEvent:
+		var e FixtureXGUpdated
+		err = json.Unmarshal(payload, &e)
+		return e, err
+	case ResultFinalisedEvent:
+		var e ResultFinalised
+		err = json.Unmarshal(payload, &e)
+		return e, err
+	case TeamStatsUpdatedEvent:
+		var e TeamStatsUpdated
+		err = json.Unmarshal(payload, &e)
+		return e, err
+	default:
+		return nil, fmt.Errorf("domain event '%s' is not supported", name)
+	}
+}
+
+// EventPublisher provides an interface to publish DomainEvent structs to subscribers. Processors publish events
+// alongside the writes causing them using the repositories persisting the data.
+type EventPublisher interface {
+	Publish(e DomainEvent) error
+}
+
+// EventHandler handles a DomainEvent delivered by an EventPublisher, returning an error if the event could not be
+// handled and should be delivered again.
+type EventHandler func(e DomainEvent) error
+
+// OutboxMessage is a DomainEvent stored by an outbox EventPublisher awaiting relay to the EventHandler
+// subscribers.
+type OutboxMessage struct {
+	ID          uint64
+	Name        string
+	AggregateID uint64
+	Payload     []byte
+	Attempts    int
+	LastError   *string
+	CreatedAt   time.Time
+	RelayedAt   *time.Time
+}
+
+// OutboxRepository provides an interface to retrieve and update OutboxMessage structs stored by an outbox
+// EventPublisher. Dead lettered messages are no longer pending and are kept for inspection.
+type OutboxRepository interface {
+	Pending(afterID uint64, limit uint64) ([]OutboxMessage, error)
+	MarkRelayed(id uint64) error
+	MarkFailed(id uint64, reason string) error
+	MarkDeadLettered(id uint64, reason string) error
+}
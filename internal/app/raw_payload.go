@@ -0,0 +1,52 @@
+package app
+
+import (
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+)
+
+// RawPayload is an unmodified response body returned by an external data provider. Payloads are archived so
+// fields dropped when transforming provider structs into domain structs can be recovered later without having
+// to request the data from the provider again.
+type RawPayload struct {
+	Provider  string    `json:"provider"`
+	Endpoint  string    `json:"endpoint"`
+	EntityID  *uint64   `json:"entity_id"`
+	Body      []byte    `json:"body"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// RawPayloadStore provides an interface to persist RawPayload domain struct objects to a storage engine.
+// Implementations are responsible for compressing payload bodies at rest.
+type RawPayloadStore interface {
+	Save(p *RawPayload) error
+	Latest(provider, endpoint string) (*RawPayload, error)
+}
+
+// PayloadEndpoint returns the endpoint used to archive a response for the URL provided. The endpoint is the URL
+// path and query with credentials removed and query parameters sorted so the same request always produces the
+// same endpoint.
+func PayloadEndpoint(u *url.URL) string {
+	query := u.Query()
+	query.Del("api_token")
+
+	if len(query) == 0 {
+		return u.Path
+	}
+
+	return u.Path + "?" + query.Encode()
+}
+
+// PayloadEntityID returns the ID of the entity requested by the URL provided if the final segment of the URL
+// path is numeric i.e. "/fixtures/11867285".
+func PayloadEntityID(u *url.URL) *uint64 {
+	id, err := strconv.ParseUint(path.Base(u.Path), 10, 64)
+
+	if err != nil {
+		return nil
+	}
+
+	return &id
+}
@@ -0,0 +1,55 @@
+package app
+
+import "time"
+
+// Referee domain entity.
+type Referee struct {
+	ID         uint64    `json:"id"`
+	CommonName string    `json:"common_name"`
+	FullName   string    `json:"full_name"`
+	FirstName  string    `json:"first_name"`
+	LastName   string    `json:"last_name"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// RefereeStats contains totals for the fixtures a referee has officiated. Red cards include second yellow cards
+// and penalties are the penalties won by players in the fixtures officiated.
+type RefereeStats struct {
+	RefereeID   uint64 `json:"referee_id"`
+	Fixtures    uint32 `json:"fixtures"`
+	YellowCards uint32 `json:"yellow_cards"`
+	RedCards    uint32 `json:"red_cards"`
+	Fouls       uint32 `json:"fouls"`
+	Penalties   uint32 `json:"penalties"`
+}
+
+// PerGame returns the average of a total across the fixtures officiated.
+func (r RefereeStats) PerGame(total uint32) float32 {
+	if r.Fixtures == 0 {
+		return 0
+	}
+
+	return float32(total) / float32(r.Fixtures)
+}
+
+// RefereeStatsQuery limits the fixtures RefereeStats are calculated from to specific seasons and competitions.
+type RefereeStatsQuery struct {
+	SeasonIDs      []uint64
+	CompetitionIDs []uint64
+}
+
+// RefereeRepository provides an interface to persist Referee domain struct objects to a storage engine.
+type RefereeRepository interface {
+	Insert(r *Referee) error
+	Update(r *Referee) error
+	ByID(id uint64) (*Referee, error)
+	Stats(id uint64, q RefereeStatsQuery) (*RefereeStats, error)
+}
+
+// RefereeRequester provides an interface allowing this application to request data from an external
+// data provider. The requester implementation is responsible for creating the channel, filtering struct data into
+// the channel before closing the channel once successful execution is complete.
+type RefereeRequester interface {
+	RefereesBySeasonIDs(ids []uint64) <-chan Referee
+}
@@ -27,11 +27,14 @@ type Result struct {
 	UpdatedAt          time.Time `json:"updated_at"`
 }
 
-// ResultRepository provides an interface to persist Result domain struct objects to a storage engine.
+// ResultRepository provides an interface to persist Result domain struct objects to a storage engine. The
+// DomainEvent structs provided to Insert and Update are published atomically with the write.
 type ResultRepository interface {
-	Insert(r *Result) error
-	Update(r *Result) error
+	Insert(r *Result, events ...DomainEvent) error
+	Update(r *Result, events ...DomainEvent) error
 	ByFixtureID(id uint64) (*Result, error)
+	// BySeasonID returns the results of every fixture in the season.
+	BySeasonID(seasonID uint64) ([]Result, error)
 }
 
 // ResultRequester provides an interface allowing this application to request data from an external
@@ -39,4 +42,6 @@ type ResultRepository interface {
 // the channel before closing the channel once successful execution is complete.
 type ResultRequester interface {
 	ResultsBySeasonIDs(seasonIDs []uint64) <-chan Result
+	// ResultsBySeasonID requests the results of a single season, returning an error if the request fails.
+	ResultsBySeasonID(seasonID uint64) ([]Result, error)
 }
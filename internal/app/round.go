@@ -20,6 +20,7 @@ type RoundRepository interface {
 	Insert(r *Round) error
 	Update(r *Round) error
 	ByID(id uint64) (*Round, error)
+	BySeasonID(id uint64) ([]Round, error)
 }
 
 // RoundRequester provides an interface allowing this application to request data from an external
@@ -0,0 +1,48 @@
+package app
+
+import (
+	"time"
+)
+
+// Types of sidelined record.
+const (
+	SidelinedTypeInjury     = "injury"
+	SidelinedTypeSuspension = "suspension"
+)
+
+// Sidelined domain entity. A record of a player being unavailable to a team through injury or suspension.
+// Reason is the description provided by the data provider i.e. "Hamstring Injury". A nil ExpectedEndDate
+// means the player is yet to return or the return date is unknown.
+type Sidelined struct {
+	PlayerID        uint64     `json:"player_id"`
+	TeamID          uint64     `json:"team_id"`
+	SeasonID        uint64     `json:"season_id"`
+	Reason          string     `json:"reason"`
+	Type            string     `json:"type"`
+	StartDate       time.Time  `json:"start_date"`
+	ExpectedEndDate *time.Time `json:"expected_end_date"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// TeamSidelined is every sidelined record for a team in a season as reported by a data provider.
+type TeamSidelined struct {
+	TeamID    uint64
+	SeasonID  uint64
+	Sidelined []Sidelined
+}
+
+// SidelinedRepository provides an interface to persist Sidelined domain struct objects to a storage engine.
+type SidelinedRepository interface {
+	ReplaceForTeam(t *TeamSidelined) error
+	ByPlayerID(id uint64) ([]Sidelined, error)
+	// ByTeamAtDate returns the records for a team in a season where the player was unavailable on the date provided.
+	ByTeamAtDate(teamID, seasonID uint64, date time.Time) ([]Sidelined, error)
+}
+
+// SidelinedRequester provides an interface allowing this application to request data from an external
+// data provider. The requester implementation is responsible for creating the channel, filtering struct data into
+// the channel before closing the channel once successful execution is complete.
+type SidelinedRequester interface {
+	SidelinedBySeasonIDs(ids []uint64) <-chan TeamSidelined
+}
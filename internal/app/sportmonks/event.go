@@ -10,48 +10,53 @@ import (
 	"sync"
 )
 
+const missedPenalty = "missed_penalty"
+
 type EventRequester struct {
 	client *spClient.HTTPClient
 	logger *logrus.Logger
 }
 
-func (e EventRequester) EventsByFixtureIDs(ids []uint64) (<-chan app.GoalEvent, <-chan app.SubstitutionEvent, <-chan app.CardEvent) {
+func (e EventRequester) EventsByFixtureIDs(ids []uint64) (<-chan app.GoalEvent, <-chan app.SubstitutionEvent, <-chan app.CardEvent, <-chan app.MissedPenaltyEvent) {
 	goal := make(chan app.GoalEvent, 500)
 	sub := make(chan app.SubstitutionEvent, 500)
 	card := make(chan app.CardEvent, 500)
+	missed := make(chan app.MissedPenaltyEvent, 500)
 
-	go e.parseEvents(ids, goal, sub, card)
+	go e.parseEvents(ids, goal, sub, card, missed)
 
-	return goal, sub, card
+	return goal, sub, card, missed
 }
 
-func (e EventRequester) EventsBySeasonIDs(seasonIDs []uint64) (<-chan app.GoalEvent, <-chan app.SubstitutionEvent, <-chan app.CardEvent) {
+func (e EventRequester) EventsBySeasonIDs(seasonIDs []uint64) (<-chan app.GoalEvent, <-chan app.SubstitutionEvent, <-chan app.CardEvent, <-chan app.MissedPenaltyEvent) {
 	goal := make(chan app.GoalEvent, 500)
 	sub := make(chan app.SubstitutionEvent, 500)
 	card := make(chan app.CardEvent, 500)
+	missed := make(chan app.MissedPenaltyEvent, 500)
 
-	go e.parseBySeasonIDs(seasonIDs, goal, sub, card)
+	go e.parseBySeasonIDs(seasonIDs, goal, sub, card, missed)
 
-	return goal, sub, card
+	return goal, sub, card, missed
 }
 
-func (e EventRequester) parseBySeasonIDs(seasonIDs []uint64, g chan<- app.GoalEvent, s chan<- app.SubstitutionEvent, c chan<- app.CardEvent) {
+func (e EventRequester) parseBySeasonIDs(seasonIDs []uint64, g chan<- app.GoalEvent, s chan<- app.SubstitutionEvent, c chan<- app.CardEvent, m chan<- app.MissedPenaltyEvent) {
 	defer close(g)
 	defer close(s)
 	defer close(c)
+	defer close(m)
 
 	wg := sync.WaitGroup{}
 
 	for _, id := range seasonIDs {
 		wg.Add(1)
-		go e.sendSeasonRequest(id, g, s, c, &wg)
+		go e.sendSeasonRequest(id, g, s, c, m, &wg)
 	}
 
 	wg.Wait()
 }
 
-func (e EventRequester) sendSeasonRequest(seasonID uint64, g chan<- app.GoalEvent, s chan<- app.SubstitutionEvent, c chan<- app.CardEvent, wg *sync.WaitGroup) {
-	res, _, err := e.client.SeasonByID(context.Background(), int(seasonID), []string{"results.cards", "results.goals", "results.substitutions"})
+func (e EventRequester) sendSeasonRequest(seasonID uint64, g chan<- app.GoalEvent, s chan<- app.SubstitutionEvent, c chan<- app.CardEvent, m chan<- app.MissedPenaltyEvent, wg *sync.WaitGroup) {
+	res, _, err := e.client.SeasonByID(context.Background(), int(seasonID), []string{"results.cards", "results.goals", "results.substitutions", "results.events"})
 
 	if err != nil {
 		e.logger.Errorf(
@@ -76,28 +81,31 @@ func (e EventRequester) sendSeasonRequest(seasonID uint64, g chan<- app.GoalEven
 		for _, event := range res.Substitutions() {
 			s <- transformSubstitutionEvent(event)
 		}
+
+		parseMissedPenaltyEvents(res.Events(), m)
 	}
 
 	wg.Done()
 }
 
-func (e EventRequester) parseEvents(ids []uint64, g chan<- app.GoalEvent, s chan<- app.SubstitutionEvent, c chan<- app.CardEvent) {
+func (e EventRequester) parseEvents(ids []uint64, g chan<- app.GoalEvent, s chan<- app.SubstitutionEvent, c chan<- app.CardEvent, m chan<- app.MissedPenaltyEvent) {
 	defer close(g)
 	defer close(s)
 	defer close(c)
+	defer close(m)
 
 	var wg sync.WaitGroup
 
 	for _, id := range ids {
 		wg.Add(1)
-		go e.sendEventRequest(id, g, s, c, &wg)
+		go e.sendEventRequest(id, g, s, c, m, &wg)
 	}
 
 	wg.Wait()
 }
 
-func (e EventRequester) sendEventRequest(fixtureId uint64, g chan<- app.GoalEvent, s chan<- app.SubstitutionEvent, c chan<- app.CardEvent, w *sync.WaitGroup) {
-	includes := []string{"cards", "goals", "substitutions"}
+func (e EventRequester) sendEventRequest(fixtureId uint64, g chan<- app.GoalEvent, s chan<- app.SubstitutionEvent, c chan<- app.CardEvent, m chan<- app.MissedPenaltyEvent, w *sync.WaitGroup) {
+	includes := []string{"cards", "goals", "substitutions", "events"}
 	var filters map[string][]int
 
 	res, _, err := e.client.FixtureByID(context.Background(), int(fixtureId), includes, filters)
@@ -124,6 +132,8 @@ func (e EventRequester) sendEventRequest(fixtureId uint64, g chan<- app.GoalEven
 		s <- transformSubstitutionEvent(event)
 	}
 
+	parseMissedPenaltyEvents(res.Events(), m)
+
 	w.Done()
 }
 
@@ -150,7 +160,9 @@ func transformGoalEvent(s spClient.GoalEvent) app.GoalEvent {
 		TeamID:         uint64(teamId),
 		PlayerID:       uint64(s.PlayerID),
 		PlayerAssistID: helpers.NullableUint64(s.PlayerAssistID),
+		Type:           s.Type,
 		Minute:         s.Minute,
+		ExtraMinute:    s.ExtraMinute,
 		Score:          s.Result,
 	}
 
@@ -171,6 +183,29 @@ func transformSubstitutionEvent(s spClient.SubstitutionEvent) app.SubstitutionEv
 	}
 }
 
+// parseMissedPenaltyEvents filters missed penalties from the generic match events, SportMonks does not provide a
+// dedicated include for them.
+func parseMissedPenaltyEvents(events []spClient.MatchEvent, m chan<- app.MissedPenaltyEvent) {
+	for _, event := range events {
+		if event.Type == missedPenalty {
+			m <- transformMissedPenaltyEvent(event)
+		}
+	}
+}
+
+func transformMissedPenaltyEvent(s spClient.MatchEvent) app.MissedPenaltyEvent {
+	teamId, _ := strconv.Atoi(s.TeamID)
+
+	return app.MissedPenaltyEvent{
+		ID:          uint64(s.ID),
+		FixtureID:   uint64(s.FixtureID),
+		TeamID:      uint64(teamId),
+		PlayerID:    uint64(s.PlayerID),
+		Minute:      s.Minute,
+		ExtraMinute: s.ExtraMinute,
+	}
+}
+
 func NewEventRequester(client *spClient.HTTPClient, log *logrus.Logger) *EventRequester {
 	return &EventRequester{client: client, logger: log}
 }
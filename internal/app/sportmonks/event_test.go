@@ -3,6 +3,7 @@ package sportmonks_test
 import (
 	"bytes"
 	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
 	"github.com/statistico/statistico-football-data/internal/app/mock"
 	"github.com/statistico/statistico-football-data/internal/app/sportmonks"
 	spClient "github.com/statistico/statistico-sportmonks-go-client"
@@ -13,7 +14,7 @@ import (
 )
 
 func TestEventRequester_EventsByFixtureIDs(t *testing.T) {
-	t.Run("returns three channels containing card, goal and substitution events", func(t *testing.T) {
+	t.Run("returns four channels containing card, goal, substitution and missed penalty events", func(t *testing.T) {
 		server := mock.HttpClient(func(req *http.Request) (*http.Response, error) {
 			return &http.Response{
 				StatusCode: 200,
@@ -31,7 +32,7 @@ func TestEventRequester_EventsByFixtureIDs(t *testing.T) {
 
 		requester := sportmonks.NewEventRequester(&client, logger)
 
-		goals, subs, cards := requester.EventsByFixtureIDs([]uint64{uint64(5), uint64(23)})
+		goals, subs, cards, missed := requester.EventsByFixtureIDs([]uint64{uint64(5), uint64(23)})
 
 		goalOne := <-goals
 		goalTwo := <-goals
@@ -42,6 +43,9 @@ func TestEventRequester_EventsByFixtureIDs(t *testing.T) {
 		cardOne := <-cards
 		cardTwo := <-cards
 
+		missedOne := <-missed
+		missedTwo := <-missed
+
 		a := assert.New(t)
 
 		a.Equal(uint64(11867297001), goalOne.ID)
@@ -49,7 +53,9 @@ func TestEventRequester_EventsByFixtureIDs(t *testing.T) {
 		a.Equal(uint64(11867297), goalOne.FixtureID)
 		a.Equal(uint64(95776), goalOne.PlayerID)
 		a.Equal(uint64(13452), *goalOne.PlayerAssistID)
+		a.Equal("goal", goalOne.Type)
 		a.Equal(3, goalOne.Minute)
+		a.Nil(goalOne.ExtraMinute)
 		a.Equal("1-0", goalOne.Score)
 
 		a.Equal(uint64(11867297001), goalTwo.ID)
@@ -89,6 +95,18 @@ func TestEventRequester_EventsByFixtureIDs(t *testing.T) {
 		a.Equal(uint64(13101), cardTwo.PlayerID)
 		a.Equal(uint8(90), cardTwo.Minute)
 		a.Equal("serious foul play", *cardTwo.Reason)
+
+		for _, m := range []app.MissedPenaltyEvent{missedOne, missedTwo} {
+			a.Equal(uint64(11867297009), m.ID)
+			a.Equal(uint64(78), m.TeamID)
+			a.Equal(uint64(11867297), m.FixtureID)
+			a.Equal(uint64(95776), m.PlayerID)
+			a.Equal(45, m.Minute)
+			a.Equal(2, *m.ExtraMinute)
+		}
+
+		_, open := <-missed
+		a.False(open)
 	})
 }
 
@@ -251,6 +269,40 @@ var fixtureEventsResponse = `{
 			  "reason": "serious foul play"
 			}
 		  ]
+		},
+		"events": {
+		  "data": [
+			{
+			  "id": 11867297001,
+			  "team_id": "78",
+			  "type": "goal",
+			  "fixture_id": 11867297,
+			  "player_id": 95776,
+			  "player_name": "N. Maupay",
+			  "related_player_id": 13452,
+			  "related_player_name": null,
+			  "minute": 3,
+			  "extra_minute": null,
+			  "reason": null,
+			  "injuried": null,
+			  "result": "1-0"
+			},
+			{
+			  "id": 11867297009,
+			  "team_id": "78",
+			  "type": "missed_penalty",
+			  "fixture_id": 11867297,
+			  "player_id": 95776,
+			  "player_name": "N. Maupay",
+			  "related_player_id": null,
+			  "related_player_name": null,
+			  "minute": 45,
+			  "extra_minute": 2,
+			  "reason": null,
+			  "injuried": null,
+			  "result": null
+			}
+		  ]
 		}
   	}
 }`
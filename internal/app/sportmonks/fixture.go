@@ -23,6 +23,22 @@ func (f FixtureRequester) FixturesBySeasonIDs(ids []uint64) <-chan app.Fixture {
 	return ch
 }
 
+func (f FixtureRequester) FixturesBySeasonID(seasonID uint64) ([]app.Fixture, error) {
+	res, _, err := f.client.SeasonByID(context.Background(), int(seasonID), []string{"fixtures"})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var fixtures []app.Fixture
+
+	for _, fixture := range res.Fixtures() {
+		fixtures = append(fixtures, transformFixture(fixture))
+	}
+
+	return fixtures, nil
+}
+
 func (f FixtureRequester) parseFixtures(seasonIDs []uint64, ch chan<- app.Fixture) {
 	defer close(ch)
 
@@ -37,7 +53,7 @@ func (f FixtureRequester) parseFixtures(seasonIDs []uint64, ch chan<- app.Fixtur
 }
 
 func (f FixtureRequester) sendSeasonRequests(seasonID uint64, ch chan<- app.Fixture, w *sync.WaitGroup) {
-	res, _, err := f.client.SeasonByID(context.Background(), int(seasonID), []string{"fixtures"})
+	fixtures, err := f.FixturesBySeasonID(seasonID)
 
 	if err != nil {
 		f.logger.Errorf(
@@ -50,24 +66,29 @@ func (f FixtureRequester) sendSeasonRequests(seasonID uint64, ch chan<- app.Fixt
 		return
 	}
 
-	for _, fixture := range res.Fixtures() {
-		ch <- transformFixture(fixture)
+	for _, fixture := range fixtures {
+		ch <- fixture
 	}
 
 	w.Done()
 }
 
 func transformFixture(s spClient.Fixture) app.Fixture {
+	status := app.FixtureStatus(s.Time.Status)
+
 	return app.Fixture{
-		ID:         uint64(s.ID),
-		SeasonID:   uint64(s.SeasonID),
-		RoundID:    helpers.NullableUint64(s.RoundID),
-		VenueID:    helpers.NullableUint64(s.VenueID),
-		HomeTeamID: uint64(s.LocalTeamID),
-		AwayTeamID: uint64(s.VisitorTeamID),
-		RefereeID:  helpers.NullableUint64(s.RefereeID),
-		Date:       time.Unix(int64(s.Time.StartingAt.Timestamp), 0),
-		Status:     &s.Time.Status,
+		ID:          uint64(s.ID),
+		SeasonID:    uint64(s.SeasonID),
+		RoundID:     helpers.NullableUint64(s.RoundID),
+		StageID:     helpers.NullableUint64(s.StageID),
+		GroupID:     helpers.NullableUint64(s.GroupID),
+		AggregateID: helpers.NullableUint64(s.AggregateID),
+		VenueID:     helpers.NullableUint64(s.VenueID),
+		HomeTeamID:  uint64(s.LocalTeamID),
+		AwayTeamID:  uint64(s.VisitorTeamID),
+		RefereeID:   helpers.NullableUint64(s.RefereeID),
+		Date:        time.Unix(int64(s.Time.StartingAt.Timestamp), 0),
+		Status:      &status,
 	}
 }
 
@@ -3,6 +3,7 @@ package sportmonks_test
 import (
 	"bytes"
 	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
 	"github.com/statistico/statistico-football-data/internal/app/mock"
 	"github.com/statistico/statistico-football-data/internal/app/sportmonks"
 	spClient "github.com/statistico/statistico-sportmonks-go-client"
@@ -44,11 +45,14 @@ func TestFixtureRequester_FixturesBySeasonIds(t *testing.T) {
 		a.Equal(uint64(11867285), x.ID)
 		a.Equal(uint64(16036), x.SeasonID)
 		a.Equal(uint64(169657), *x.RoundID)
+		a.Equal(uint64(77443862), *x.StageID)
+		a.Nil(x.GroupID)
+		a.Nil(x.AggregateID)
 		a.Equal(uint64(214), *x.VenueID)
 		a.Equal(uint64(1), x.HomeTeamID)
 		a.Equal(uint64(14), x.AwayTeamID)
 		a.Equal(uint64(14532), *x.RefereeID)
-		a.Equal("FT", *x.Status)
+		a.Equal(app.FixtureStatusFullTime, *x.Status)
 		a.Equal("2019-09-22 13:00:00 +0000 UTC", x.Date.String())
 
 		a.Equal(uint64(11867285), y.ID)
@@ -58,7 +62,7 @@ func TestFixtureRequester_FixturesBySeasonIds(t *testing.T) {
 		a.Equal(uint64(1), y.HomeTeamID)
 		a.Equal(uint64(14), y.AwayTeamID)
 		a.Equal(uint64(14532), *y.RefereeID)
-		a.Equal("FT", *x.Status)
+		a.Equal(app.FixtureStatusFullTime, *x.Status)
 		a.Equal("2019-09-22 13:00:00 +0000 UTC", y.Date.String())
 
 		a.Equal(uint64(11867285), z.ID)
@@ -68,7 +72,7 @@ func TestFixtureRequester_FixturesBySeasonIds(t *testing.T) {
 		a.Equal(uint64(1), z.HomeTeamID)
 		a.Equal(uint64(14), z.AwayTeamID)
 		a.Equal(uint64(14532), *z.RefereeID)
-		a.Equal("FT", *x.Status)
+		a.Equal(app.FixtureStatusFullTime, *x.Status)
 		a.Equal("2019-09-22 13:00:00 +0000 UTC", z.Date.String())
 	})
 }
@@ -0,0 +1,181 @@
+package sportmonks
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+	"sync"
+	"time"
+)
+
+type LineupRequester struct {
+	client *spClient.HTTPClient
+	logger *logrus.Logger
+}
+
+func (l LineupRequester) LineupsByFixtureIDs(ids []uint64) <-chan app.Lineup {
+	ch := make(chan app.Lineup, 100)
+
+	go l.parse(ids, l.sendFixtureRequest, ch)
+
+	return ch
+}
+
+func (l LineupRequester) LineupsBySeasonIDs(ids []uint64) <-chan app.Lineup {
+	ch := make(chan app.Lineup, 500)
+
+	go l.parse(ids, l.sendSeasonRequest, ch)
+
+	return ch
+}
+
+// LineupsByDate returns the lineups for fixtures on the date provided. Lineups are announced by the data provider
+// ahead of kick-off so this is used to capture confirmed lineups for upcoming fixtures.
+func (l LineupRequester) LineupsByDate(date time.Time, competitionIDs []uint64) <-chan app.Lineup {
+	ch := make(chan app.Lineup, 500)
+
+	send := func(id uint64, ch chan<- app.Lineup, wg *sync.WaitGroup) {
+		l.sendByDateRequest(id, date, ch, wg)
+	}
+
+	go l.parse(competitionIDs, send, ch)
+
+	return ch
+}
+
+func (l LineupRequester) parse(ids []uint64, send func(id uint64, ch chan<- app.Lineup, wg *sync.WaitGroup), ch chan<- app.Lineup) {
+	defer close(ch)
+
+	var wg sync.WaitGroup
+
+	for _, id := range ids {
+		wg.Add(1)
+		go send(id, ch, &wg)
+	}
+
+	wg.Wait()
+}
+
+func (l LineupRequester) sendFixtureRequest(id uint64, ch chan<- app.Lineup, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	var filters map[string][]int
+
+	res, _, err := l.client.FixtureByID(context.Background(), int(id), []string{"lineup", "bench"}, filters)
+
+	if err != nil {
+		l.logger.Errorf(
+			"Error when calling client '%s' when making fixture request to parse lineups. Fixture ID %d",
+			err.Error(),
+			id,
+		)
+		return
+	}
+
+	sendLineups(res, ch)
+}
+
+// sendSeasonRequest requests every fixture in a season, rather than results only, so lineups announced for
+// fixtures yet to be played are included.
+func (l LineupRequester) sendSeasonRequest(id uint64, ch chan<- app.Lineup, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	res, _, err := l.client.SeasonByID(context.Background(), int(id), []string{"fixtures.lineup", "fixtures.bench"})
+
+	if err != nil {
+		l.logger.Errorf(
+			"Error when calling client '%s' when making season fixtures request to parse lineups. Season ID %d",
+			err.Error(),
+			id,
+		)
+		return
+	}
+
+	for _, f := range res.Fixtures() {
+		sendLineups(&f, ch)
+	}
+}
+
+func (l LineupRequester) sendByDateRequest(competitionID uint64, date time.Time, ch chan<- app.Lineup, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	res, _, err := l.client.FixturesByDate(
+		context.Background(),
+		date,
+		[]string{"lineup", "bench"},
+		map[string][]int{"leagues": {int(competitionID)}},
+	)
+
+	if err != nil {
+		l.logger.Errorf(
+			"Error when calling client '%s' when making fixtures by date request to parse lineups. Competition ID %d",
+			err.Error(),
+			competitionID,
+		)
+		return
+	}
+
+	for _, f := range res {
+		sendLineups(&f, ch)
+	}
+}
+
+// sendLineups sends a Lineup for each team in the fixture with at least one player named, teams yet to announce
+// their lineup are skipped.
+func sendLineups(f *spClient.Fixture, ch chan<- app.Lineup) {
+	for _, l := range transformLineups(f) {
+		if len(l.Players) > 0 {
+			ch <- l
+		}
+	}
+}
+
+func transformLineups(f *spClient.Fixture) []app.Lineup {
+	home := app.Lineup{
+		FixtureID: uint64(f.ID),
+		TeamID:    uint64(f.LocalTeamID),
+		Formation: f.Formations.LocalTeamFormation,
+		Players:   []app.LineupPlayer{},
+	}
+
+	away := app.Lineup{
+		FixtureID: uint64(f.ID),
+		TeamID:    uint64(f.VisitorTeamID),
+		Formation: f.Formations.VisitorTeamFormation,
+		Players:   []app.LineupPlayer{},
+	}
+
+	add := func(players []spClient.PlayerStats, sub bool) {
+		for _, p := range players {
+			switch p.TeamID {
+			case f.LocalTeamID:
+				home.Players = append(home.Players, transformLineupPlayer(&p, sub))
+			case f.VisitorTeamID:
+				away.Players = append(away.Players, transformLineupPlayer(&p, sub))
+			}
+		}
+	}
+
+	add(f.Lineups(), false)
+	add(f.Bench(), true)
+
+	return []app.Lineup{home, away}
+}
+
+func transformLineupPlayer(p *spClient.PlayerStats, sub bool) app.LineupPlayer {
+	return app.LineupPlayer{
+		PlayerID:          uint64(p.PlayerID),
+		ShirtNumber:       p.Number,
+		Position:          p.Position,
+		FormationPosition: p.FormationPosition,
+		GridX:             p.PositionX,
+		GridY:             p.PositionY,
+		Captain:           p.Captain,
+		IsSubstitute:      sub,
+	}
+}
+
+func NewLineupRequester(client *spClient.HTTPClient, log *logrus.Logger) *LineupRequester {
+	return &LineupRequester{client: client, logger: log}
+}
@@ -0,0 +1,210 @@
+package sportmonks_test
+
+import (
+	"bytes"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/sportmonks"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestLineupRequester_LineupsByFixtureIDs(t *testing.T) {
+	t.Run("returns a lineup for each team in the fixture", func(t *testing.T) {
+		requester := newLineupRequester(t, "/fixtures/11867285", fixtureLineupResponse)
+
+		ch := requester.LineupsByFixtureIDs([]uint64{11867285})
+
+		var lineups []app.Lineup
+
+		for l := range ch {
+			lineups = append(lineups, l)
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(lineups))
+
+		home := lineups[0]
+
+		a.Equal(uint64(11867285), home.FixtureID)
+		a.Equal(uint64(1), home.TeamID)
+		a.Equal("4-1-4-1", *home.Formation)
+		a.Equal(2, len(home.Players))
+		a.Equal(uint64(129665), home.Players[0].PlayerID)
+		a.Equal(1, home.Players[0].ShirtNumber)
+		a.Equal("G", *home.Players[0].Position)
+		a.Equal(1, *home.Players[0].FormationPosition)
+		a.Equal(1, *home.Players[0].GridX)
+		a.Equal(5, *home.Players[0].GridY)
+		a.True(home.Players[0].Captain)
+		a.False(home.Players[0].IsSubstitute)
+		a.Equal(uint64(1384), home.Players[1].PlayerID)
+		a.Equal(17, home.Players[1].ShirtNumber)
+		a.Nil(home.Players[1].FormationPosition)
+		a.Nil(home.Players[1].GridX)
+		a.False(home.Players[1].Captain)
+		a.True(home.Players[1].IsSubstitute)
+
+		away := lineups[1]
+
+		a.Equal(uint64(14), away.TeamID)
+		a.Equal("4-2-3-1", *away.Formation)
+		a.Equal(1, len(away.Players))
+		a.Equal(uint64(3530), away.Players[0].PlayerID)
+	})
+}
+
+func TestLineupRequester_LineupsByDate(t *testing.T) {
+	t.Run("skips teams yet to announce their lineup", func(t *testing.T) {
+		requester := newLineupRequester(t, "/fixtures/date/2019-09-22", dateLineupResponse)
+
+		ch := requester.LineupsByDate(time.Date(2019, 9, 22, 0, 0, 0, 0, time.UTC), []uint64{8})
+
+		var lineups []app.Lineup
+
+		for l := range ch {
+			lineups = append(lineups, l)
+		}
+
+		a := assert.New(t)
+
+		a.Equal(1, len(lineups))
+		a.Equal(uint64(11867285), lineups[0].FixtureID)
+		a.Equal(uint64(1), lineups[0].TeamID)
+		a.Nil(lineups[0].Formation)
+		a.Equal(1, len(lineups[0].Players))
+	})
+}
+
+func newLineupRequester(t *testing.T, path, body string) *sportmonks.LineupRequester {
+	server := mock.HttpClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, path, req.URL.Path)
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+		}, nil
+	})
+
+	client := spClient.HTTPClient{
+		HTTPClient: server,
+		BaseURL:    "http://example.com",
+		Key:        "my-key",
+	}
+
+	logger, _ := test.NewNullLogger()
+
+	return sportmonks.NewLineupRequester(&client, logger)
+}
+
+var fixtureLineupResponse = `{
+	"data": {
+		"id": 11867285,
+		"league_id": 8,
+		"season_id": 16036,
+		"localteam_id": 1,
+		"visitorteam_id": 14,
+		"formations": {
+			"localteam_formation": "4-1-4-1",
+			"visitorteam_formation": "4-2-3-1"
+		},
+		"lineup": {
+			"data": [
+				{
+					"team_id": 1,
+					"fixture_id": 11867285,
+					"player_id": 129665,
+					"player_name": "D. de Gea",
+					"number": 1,
+					"position": "G",
+					"additional_position": null,
+					"formation_position": 1,
+					"posx": 1,
+					"posy": 5,
+					"captain": true
+				},
+				{
+					"team_id": 14,
+					"fixture_id": 11867285,
+					"player_id": 3530,
+					"player_name": "C. Robinson",
+					"number": 9,
+					"position": "F",
+					"additional_position": null,
+					"formation_position": 11,
+					"posx": 5,
+					"posy": 3,
+					"captain": false
+				}
+			]
+		},
+		"bench": {
+			"data": [
+				{
+					"team_id": 1,
+					"fixture_id": 11867285,
+					"player_id": 1384,
+					"player_name": "B. Sharp",
+					"number": 17,
+					"position": "F",
+					"additional_position": null,
+					"formation_position": null,
+					"posx": null,
+					"posy": null,
+					"captain": false
+				}
+			]
+		}
+	}
+}`
+
+var dateLineupResponse = `{
+	"data": [
+		{
+			"id": 11867285,
+			"league_id": 8,
+			"season_id": 16036,
+			"localteam_id": 1,
+			"visitorteam_id": 14,
+			"formations": {
+				"localteam_formation": null,
+				"visitorteam_formation": null
+			},
+			"lineup": {
+				"data": [
+					{
+						"team_id": 1,
+						"fixture_id": 11867285,
+						"player_id": 129665,
+						"player_name": "D. de Gea",
+						"number": 1,
+						"position": "G",
+						"additional_position": null,
+						"formation_position": 1,
+						"posx": 1,
+						"posy": 5,
+						"captain": true
+					}
+				]
+			},
+			"bench": {
+				"data": []
+			}
+		}
+	],
+	"meta": {
+		"pagination": {
+			"total": 1,
+			"count": 1,
+			"per_page": 100,
+			"current_page": 1,
+			"total_pages": 1
+		}
+	}
+}`
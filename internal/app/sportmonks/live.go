@@ -0,0 +1,93 @@
+package sportmonks
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+)
+
+var liveIncludes = []string{"cards", "goals", "substitutions", "events", "stats"}
+
+type LiveRequester struct {
+	client *spClient.HTTPClient
+	logger *logrus.Logger
+}
+
+// LiveFixtures returns a LiveFixture for each of the fixture IDs provided using the livescores endpoint. The
+// endpoint returns every fixture scheduled for the current day, rather than fixtures in play only, so fixtures
+// that reach full time between requests still return their final state.
+func (l LiveRequester) LiveFixtures(ids []uint64) <-chan app.LiveFixture {
+	ch := make(chan app.LiveFixture, len(ids))
+
+	go l.parseLiveScores(ids, ch)
+
+	return ch
+}
+
+func (l LiveRequester) parseLiveScores(ids []uint64, ch chan<- app.LiveFixture) {
+	defer close(ch)
+
+	wanted := make(map[uint64]bool, len(ids))
+
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	var filters map[string][]int
+
+	for page := 1; ; page++ {
+		res, meta, err := l.client.LiveScores(context.Background(), page, liveIncludes, filters)
+
+		if err != nil {
+			l.logger.Errorf("Error when calling client '%s' when making livescores request. Page %d", err.Error(), page)
+			return
+		}
+
+		for _, f := range res {
+			if wanted[uint64(f.ID)] {
+				ch <- transformLiveFixture(f)
+			}
+		}
+
+		if meta == nil || meta.Pagination == nil || page >= meta.Pagination.TotalPages {
+			return
+		}
+	}
+}
+
+func transformLiveFixture(f spClient.Fixture) app.LiveFixture {
+	live := app.LiveFixture{
+		FixtureID: uint64(f.ID),
+		Status:    app.FixtureStatus(f.Time.Status),
+		Result:    transformResult(f),
+	}
+
+	for _, stats := range f.TeamStats() {
+		live.TeamStats = append(live.TeamStats, transformTeamStats(stats))
+	}
+
+	for _, event := range f.Goals() {
+		live.Goals = append(live.Goals, transformGoalEvent(event))
+	}
+
+	for _, event := range f.Cards() {
+		live.Cards = append(live.Cards, transformCardEvent(event))
+	}
+
+	for _, event := range f.Substitutions() {
+		live.Substitutions = append(live.Substitutions, transformSubstitutionEvent(event))
+	}
+
+	for _, event := range f.Events() {
+		if event.Type == missedPenalty {
+			live.MissedPenalties = append(live.MissedPenalties, transformMissedPenaltyEvent(event))
+		}
+	}
+
+	return live
+}
+
+func NewLiveRequester(client *spClient.HTTPClient, log *logrus.Logger) *LiveRequester {
+	return &LiveRequester{client: client, logger: log}
+}
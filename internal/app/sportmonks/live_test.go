@@ -0,0 +1,237 @@
+package sportmonks_test
+
+import (
+	"bytes"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/sportmonks"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestLiveRequester_LiveFixtures(t *testing.T) {
+	t.Run("returns live fixtures for the fixture IDs provided", func(t *testing.T) {
+		server := mock.HttpClient(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "/livescores", req.URL.Path)
+			assert.Equal(t, "1", req.URL.Query().Get("page"))
+
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(liveScoresResponse)),
+			}, nil
+		})
+
+		client := spClient.HTTPClient{
+			HTTPClient: server,
+			BaseURL:    "http://example.com",
+			Key:        "my-key",
+		}
+
+		logger, _ := test.NewNullLogger()
+
+		requester := sportmonks.NewLiveRequester(&client, logger)
+
+		var fixtures []app.LiveFixture
+
+		for f := range requester.LiveFixtures([]uint64{11867285}) {
+			fixtures = append(fixtures, f)
+		}
+
+		a := assert.New(t)
+
+		a.Equal(1, len(fixtures))
+
+		live := fixtures[0]
+
+		a.Equal(uint64(11867285), live.FixtureID)
+		a.Equal(app.FixtureStatusLive, live.Status)
+		a.Equal(uint64(11867285), live.Result.FixtureID)
+		a.Equal(1, *live.Result.HomeScore)
+		a.Equal(0, *live.Result.AwayScore)
+		a.Equal(57, *live.Result.Minutes)
+		a.Equal(1, len(live.TeamStats))
+		a.Equal(uint64(1), live.TeamStats[0].TeamID)
+		a.Equal(7, *live.TeamStats[0].Corners)
+		a.Equal(1, len(live.Goals))
+		a.Equal(uint64(11867285001), live.Goals[0].ID)
+		a.Equal(1, len(live.Cards))
+		a.Equal(uint64(11867285002), live.Cards[0].ID)
+		a.Equal(1, len(live.Substitutions))
+		a.Equal(uint64(11867285003), live.Substitutions[0].ID)
+		a.Equal(1, len(live.MissedPenalties))
+		a.Equal(uint64(11867285004), live.MissedPenalties[0].ID)
+	})
+}
+
+var liveScoresResponse = `{
+	"data": [
+		{
+			"id": 11867285,
+			"league_id": 8,
+			"season_id": 16036,
+			"localteam_id": 1,
+			"visitorteam_id": 14,
+			"formations": {
+				"localteam_formation": "4-1-4-1",
+				"visitorteam_formation": "4-2-3-1"
+			},
+			"scores": {
+				"localteam_score": 1,
+				"visitorteam_score": 0,
+				"localteam_pen_score": null,
+				"visitorteam_pen_score": null,
+				"ht_score": "1-0",
+				"ft_score": null,
+				"et_score": null,
+				"ps_score": null
+			},
+			"time": {
+				"status": "LIVE",
+				"starting_at": {
+					"date_time": "2019-09-22 13:00:00",
+					"date": "2019-09-22",
+					"time": "13:00:00",
+					"timestamp": 1569157200,
+					"timezone": "UTC"
+				},
+				"minute": 57,
+				"second": null,
+				"added_time": null,
+				"extra_minute": null,
+				"injury_time": null
+			},
+			"standings": {
+				"localteam_position": 11,
+				"visitorteam_position": 6
+			},
+			"goals": {
+				"data": [
+					{
+						"id": 11867285001,
+						"team_id": "1",
+						"type": "goal",
+						"fixture_id": 11867285,
+						"player_id": 95776,
+						"player_name": "M. Rashford",
+						"player_assist_id": null,
+						"player_assist_name": null,
+						"minute": 12,
+						"extra_minute": null,
+						"reason": null,
+						"result": "1-0"
+					}
+				]
+			},
+			"cards": {
+				"data": [
+					{
+						"id": 11867285002,
+						"team_id": "14",
+						"type": "yellowcard",
+						"fixture_id": 11867285,
+						"player_id": 1310,
+						"player_name": "F. Fernandez",
+						"minute": 33,
+						"extra_minute": null,
+						"reason": null
+					}
+				]
+			},
+			"substitutions": {
+				"data": [
+					{
+						"id": 11867285003,
+						"team_id": "14",
+						"type": "subst",
+						"fixture_id": 11867285,
+						"player_in_id": 1384,
+						"player_in_name": "B. Sharp",
+						"player_out_id": 3530,
+						"player_out_name": "C. Robinson",
+						"minute": 54,
+						"extra_minute": null,
+						"injuried": null
+					}
+				]
+			},
+			"events": {
+				"data": [
+					{
+						"id": 11867285004,
+						"team_id": "1",
+						"type": "missed_penalty",
+						"fixture_id": 11867285,
+						"player_id": 95776,
+						"player_name": "M. Rashford",
+						"related_player_id": null,
+						"related_player_name": null,
+						"minute": 40,
+						"extra_minute": null,
+						"reason": null,
+						"injuried": null,
+						"result": null
+					}
+				]
+			},
+			"stats": {
+				"data": [
+					{
+						"team_id": 1,
+						"fixture_id": 11867285,
+						"shots": null,
+						"passes": null,
+						"attacks": null,
+						"fouls": 6,
+						"corners": 7,
+						"offsides": 1,
+						"possessiontime": 58,
+						"yellowcards": 0,
+						"redcards": 0,
+						"saves": 1,
+						"substitutions": 0,
+						"goal_kick": 4,
+						"goal_attempts": 9,
+						"free_kick": 8,
+						"throw_in": 12,
+						"goals": 1
+					}
+				]
+			}
+		},
+		{
+			"id": 11867286,
+			"league_id": 8,
+			"season_id": 16036,
+			"localteam_id": 3,
+			"visitorteam_id": 6,
+			"time": {
+				"status": "NS",
+				"starting_at": {
+					"date_time": "2019-09-22 15:30:00",
+					"date": "2019-09-22",
+					"time": "15:30:00",
+					"timestamp": 1569166200,
+					"timezone": "UTC"
+				},
+				"minute": null,
+				"second": null,
+				"added_time": null,
+				"extra_minute": null,
+				"injury_time": null
+			}
+		}
+	],
+	"meta": {
+		"pagination": {
+			"total": 2,
+			"count": 2,
+			"per_page": 100,
+			"current_page": 1,
+			"total_pages": 1
+		}
+	}
+}`
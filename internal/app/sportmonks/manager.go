@@ -0,0 +1,100 @@
+package sportmonks
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+	"sync"
+	"time"
+)
+
+type ManagerRequester struct {
+	client *spClient.HTTPClient
+	logger *logrus.Logger
+}
+
+func (m ManagerRequester) ManagerSightingsBySeasonIDs(ids []uint64) <-chan app.ManagerSighting {
+	ch := make(chan app.ManagerSighting, 1000)
+
+	go m.parseSightings(ids, ch)
+
+	return ch
+}
+
+func (m ManagerRequester) parseSightings(ids []uint64, ch chan<- app.ManagerSighting) {
+	defer close(ch)
+
+	var wg sync.WaitGroup
+
+	for _, id := range ids {
+		wg.Add(1)
+		go m.sendSeasonRequests(id, ch, &wg)
+	}
+
+	wg.Wait()
+}
+
+func (m ManagerRequester) sendSeasonRequests(seasonID uint64, ch chan<- app.ManagerSighting, w *sync.WaitGroup) {
+	defer w.Done()
+
+	includes := []string{"results.localCoach", "results.visitorCoach"}
+
+	season, _, err := m.client.SeasonByID(context.Background(), int(seasonID), includes)
+
+	if err != nil {
+		m.logger.Errorf(
+			"Error when calling client '%s' when making season request to parse managers. Season ID %d",
+			err.Error(),
+			seasonID,
+		)
+		return
+	}
+
+	for _, fixture := range season.Results() {
+		date := time.Unix(int64(fixture.Time.StartingAt.Timestamp), 0)
+
+		if coach := fixture.LocalCoach(); coach != nil {
+			ch <- transformManagerSighting(coach, uint64(fixture.LocalTeamID), uint64(fixture.ID), date)
+		}
+
+		if coach := fixture.VisitorCoach(); coach != nil {
+			ch <- transformManagerSighting(coach, uint64(fixture.VisitorTeamID), uint64(fixture.ID), date)
+		}
+	}
+}
+
+func transformManagerSighting(c *spClient.Coach, teamID, fixtureID uint64, date time.Time) app.ManagerSighting {
+	return app.ManagerSighting{
+		Manager:   transformManager(c),
+		TeamID:    teamID,
+		FixtureID: fixtureID,
+		Date:      date,
+	}
+}
+
+func transformManager(c *spClient.Coach) app.Manager {
+	m := app.Manager{
+		ID:          uint64(c.ID),
+		CountryID:   uint64(c.CountryID),
+		FirstName:   c.FirstName,
+		LastName:    c.LastName,
+		Nationality: c.Nationality,
+	}
+
+	if c.TeamID > 0 {
+		team := uint64(c.TeamID)
+		m.TeamID = &team
+	}
+
+	if c.ImagePath != "" {
+		image := c.ImagePath
+		m.Image = &image
+	}
+
+	return m
+}
+
+func NewManagerRequester(client *spClient.HTTPClient, log *logrus.Logger) *ManagerRequester {
+	return &ManagerRequester{client: client, logger: log}
+}
@@ -0,0 +1,124 @@
+package sportmonks_test
+
+import (
+	"bytes"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/sportmonks"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestManagerRequester_ManagerSightingsBySeasonIDs(t *testing.T) {
+	t.Run("returns manager sighting struct channel", func(t *testing.T) {
+		server := mock.HttpClient(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "results.localCoach,results.visitorCoach", req.URL.Query().Get("include"))
+
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(seasonManagersResponse)),
+			}, nil
+		})
+
+		client := spClient.HTTPClient{
+			HTTPClient: server,
+			BaseURL:    "http://example.com",
+			Key:        "my-key",
+		}
+
+		logger, _ := test.NewNullLogger()
+
+		requester := sportmonks.NewManagerRequester(&client, logger)
+
+		ch := requester.ManagerSightingsBySeasonIDs([]uint64{16036})
+
+		var sightings []app.ManagerSighting
+
+		for s := range ch {
+			sightings = append(sightings, s)
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(sightings))
+		a.Equal(uint64(455360), sightings[0].Manager.ID)
+		a.Equal(uint64(1), *sightings[0].Manager.TeamID)
+		a.Equal(uint64(1161), sightings[0].Manager.CountryID)
+		a.Equal("David", sightings[0].Manager.FirstName)
+		a.Equal("Moyes", sightings[0].Manager.LastName)
+		a.Equal("Scotland", sightings[0].Manager.Nationality)
+		a.Equal("https://cdn.sportmonks.com/images/soccer/players/0/455360.png", *sightings[0].Manager.Image)
+		a.Equal(uint64(1), sightings[0].TeamID)
+		a.Equal(uint64(11867285), sightings[0].FixtureID)
+		a.Equal(int64(1565445600), sightings[0].Date.Unix())
+		a.Equal(uint64(523876), sightings[1].Manager.ID)
+		a.Nil(sightings[1].Manager.Image)
+		a.Equal(uint64(6), sightings[1].TeamID)
+	})
+}
+
+var seasonManagersResponse = `{
+	"data": {
+		"id": 16036,
+		"name": "2019/2020",
+		"league_id": 8,
+		"is_current_season": true,
+		"results": {
+			"data": [
+				{
+					"id": 11867285,
+					"league_id": 8,
+					"season_id": 16036,
+					"localteam_id": 1,
+					"visitorteam_id": 6,
+					"time": {
+						"status": "FT",
+						"starting_at": {
+							"date_time": "2019-08-10 14:00:00",
+							"date": "2019-08-10",
+							"time": "14:00:00",
+							"timestamp": 1565445600,
+							"timezone": "UTC"
+						}
+					},
+					"localCoach": {
+						"data": {
+							"coach_id": 455360,
+							"team_id": 1,
+							"country_id": 1161,
+							"common_name": "D. Moyes",
+							"fullname": "David Moyes",
+							"firstname": "David",
+							"lastname": "Moyes",
+							"nationality": "Scotland",
+							"birthdate": "25/04/1963",
+							"birthcountry": "Scotland",
+							"birthplace": "Glasgow",
+							"image_path": "https://cdn.sportmonks.com/images/soccer/players/0/455360.png"
+						}
+					},
+					"visitorCoach": {
+						"data": {
+							"coach_id": 523876,
+							"team_id": 6,
+							"country_id": 32,
+							"common_name": "J. Mourinho",
+							"fullname": "José Mourinho",
+							"firstname": "José",
+							"lastname": "Mourinho",
+							"nationality": "Portugal",
+							"birthdate": "26/01/1963",
+							"birthcountry": "Portugal",
+							"birthplace": null,
+							"image_path": ""
+						}
+					}
+				}
+			]
+		}
+	}
+}`
@@ -0,0 +1,131 @@
+package sportmonks
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+	"strconv"
+	"time"
+)
+
+const oddsTimeLayout = "2006-01-02 15:04:05.999999"
+
+type OddsRequester struct {
+	client *spClient.HTTPClient
+	logger *logrus.Logger
+}
+
+func (o OddsRequester) OddsByFixtureIDs(ids []uint64) <-chan app.Odds {
+	ch := make(chan app.Odds, 10000)
+
+	go o.parseByFixtureIDs(ids, ch)
+
+	return ch
+}
+
+func (o OddsRequester) parseByFixtureIDs(ids []uint64, ch chan<- app.Odds) {
+	defer close(ch)
+
+	var filters map[string][]int
+
+	for _, id := range ids {
+		res, _, err := o.client.FixtureByID(context.Background(), int(id), []string{"odds"}, filters)
+
+		if err != nil {
+			o.logger.Errorf(
+				"Error when calling client '%s' when making fixtures request to parse odds for fixture %d",
+				err.Error(),
+				id,
+			)
+			continue
+		}
+
+		for _, market := range res.Odds() {
+			for _, bookmaker := range market.BookmakerOdds() {
+				for _, odds := range bookmaker.Odds() {
+					x, err := transformOdds(id, market, bookmaker, odds)
+
+					if err != nil {
+						o.logger.Warnf(
+							"Error parsing odds for fixture %d, market %s and bookmaker %s: %s",
+							id,
+							market.Name,
+							bookmaker.Name,
+							err.Error(),
+						)
+						continue
+					}
+
+					ch <- *x
+				}
+			}
+		}
+	}
+}
+
+func transformOdds(fixtureID uint64, m spClient.MatchOdds, b spClient.BookmakerOdds, o spClient.Odds) (*app.Odds, error) {
+	price, err := strconv.ParseFloat(o.Value, 32)
+
+	if err != nil {
+		return nil, err
+	}
+
+	captured, err := parseOddsTime(o.LastUpdate)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &app.Odds{
+		FixtureID:   fixtureID,
+		BookmakerID: uint64(b.ID),
+		Bookmaker:   b.Name,
+		Market:      m.Name,
+		Selection:   o.Label,
+		Line:        oddsLine(o),
+		Price:       float32(price),
+		CapturedAt:  captured,
+	}, nil
+}
+
+// oddsLine returns the handicap or total a selection is priced against, handicap markets take precedence as
+// SportMonks populates total for some handicap markets.
+func oddsLine(o spClient.Odds) *string {
+	if o.Handicap != nil && *o.Handicap != "" {
+		return o.Handicap
+	}
+
+	if o.Total != "" {
+		total := o.Total
+		return &total
+	}
+
+	return nil
+}
+
+func parseOddsTime(d spClient.DateTime) (time.Time, error) {
+	loc := time.UTC
+
+	if d.Timezone != "" {
+		l, err := time.LoadLocation(d.Timezone)
+
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		loc = l
+	}
+
+	t, err := time.ParseInLocation(oddsTimeLayout, d.Date, loc)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return t.UTC(), nil
+}
+
+func NewOddsRequester(client *spClient.HTTPClient, log *logrus.Logger) *OddsRequester {
+	return &OddsRequester{client: client, logger: log}
+}
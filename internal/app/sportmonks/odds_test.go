@@ -0,0 +1,148 @@
+package sportmonks_test
+
+import (
+	"bytes"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/sportmonks"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestOddsRequester_OddsByFixtureIDs(t *testing.T) {
+	t.Run("returns odds struct channel", func(t *testing.T) {
+		server := mock.HttpClient(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(fixtureOddsResponse)),
+			}, nil
+		})
+
+		client := spClient.HTTPClient{
+			HTTPClient: server,
+			BaseURL:    "http://example.com",
+			Key:        "my-key",
+		}
+
+		logger, hook := test.NewNullLogger()
+
+		requester := sportmonks.NewOddsRequester(&client, logger)
+
+		ch := requester.OddsByFixtureIDs([]uint64{11867285})
+
+		home := <-ch
+		over := <-ch
+
+		a := assert.New(t)
+
+		a.Equal(uint64(11867285), home.FixtureID)
+		a.Equal(uint64(2), home.BookmakerID)
+		a.Equal("bet365", home.Bookmaker)
+		a.Equal("3Way Result", home.Market)
+		a.Equal("1", home.Selection)
+		a.Nil(home.Line)
+		a.Equal(float32(2.10), home.Price)
+		a.Equal("2019-10-05 13:01:00.22753 +0000 UTC", home.CapturedAt.String())
+
+		a.Equal("Over/Under", over.Market)
+		a.Equal("Over", over.Selection)
+		a.Equal("2.5", *over.Line)
+		a.Equal(float32(1.91), over.Price)
+
+		_, ok := <-ch
+
+		a.False(ok)
+		a.Equal(1, len(hook.AllEntries()))
+		a.Equal(
+			"Error parsing odds for fixture 11867285, market Over/Under and bookmaker bet365: strconv.ParseFloat: parsing \"\": invalid syntax",
+			hook.LastEntry().Message,
+		)
+	})
+}
+
+var fixtureOddsResponse = `{
+	"data": {
+		"id": 11867285,
+		"league_id": 8,
+		"season_id": 16036,
+		"localteam_id": 1,
+		"visitorteam_id": 14,
+		"odds": {
+			"data": [
+				{
+					"id": 1,
+					"name": "3Way Result",
+					"suspended": false,
+					"bookmaker": {
+						"data": [
+							{
+								"id": 2,
+								"name": "bet365",
+								"odds": {
+									"data": [
+										{
+											"value": "2.10",
+											"handicap": null,
+											"total": "",
+											"label": "1",
+											"bookmaker_event_id": "40054117",
+											"last_update": {
+												"date": "2019-10-05 13:01:00.227530",
+												"timezone_type": 3,
+												"timezone": "UTC"
+											}
+										}
+									]
+								}
+							}
+						]
+					}
+				},
+				{
+					"id": 12,
+					"name": "Over/Under",
+					"suspended": false,
+					"bookmaker": {
+						"data": [
+							{
+								"id": 2,
+								"name": "bet365",
+								"odds": {
+									"data": [
+										{
+											"value": "1.91",
+											"handicap": null,
+											"total": "2.5",
+											"label": "Over",
+											"bookmaker_event_id": "40054117",
+											"last_update": {
+												"date": "2019-10-05 13:01:00.227530",
+												"timezone_type": 3,
+												"timezone": "UTC"
+											}
+										},
+										{
+											"value": "",
+											"handicap": null,
+											"total": "2.5",
+											"label": "Under",
+											"bookmaker_event_id": "40054117",
+											"last_update": {
+												"date": "2019-10-05 13:01:00.227530",
+												"timezone_type": 3,
+												"timezone": "UTC"
+											}
+										}
+									]
+								}
+							}
+						]
+					}
+				}
+			]
+		}
+	}
+}`
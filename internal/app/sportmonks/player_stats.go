@@ -39,6 +39,28 @@ func (p PlayerStatsRequester) PlayerStatsByDate(date time.Time, competitionIDs [
 	return ch
 }
 
+func (p PlayerStatsRequester) PlayerStatsBySeasonID(seasonID uint64) ([]*app.PlayerStats, error) {
+	res, _, err := p.client.SeasonByID(context.Background(), int(seasonID), []string{"results.lineup", "results.bench"})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var stats []*app.PlayerStats
+
+	for _, res := range res.Results() {
+		for _, s := range res.Lineups() {
+			stats = append(stats, transformPlayerStats(&s, false))
+		}
+
+		for _, s := range res.Bench() {
+			stats = append(stats, transformPlayerStats(&s, true))
+		}
+	}
+
+	return stats, nil
+}
+
 func (p PlayerStatsRequester) parseBySeasonIDs(seasonIDs []uint64, ch chan<- *app.PlayerStats) {
 	defer close(ch)
 
@@ -111,7 +133,7 @@ func (p PlayerStatsRequester) sendByDateRequest(competitionID uint64, date time.
 }
 
 func (p PlayerStatsRequester) sendSeasonRequest(seasonID uint64, ch chan<- *app.PlayerStats, wg *sync.WaitGroup) {
-	res, _, err := p.client.SeasonByID(context.Background(), int(seasonID), []string{"results.lineup", "results.bench"})
+	stats, err := p.PlayerStatsBySeasonID(seasonID)
 
 	if err != nil {
 		p.logger.Errorf(
@@ -124,14 +146,8 @@ func (p PlayerStatsRequester) sendSeasonRequest(seasonID uint64, ch chan<- *app.
 		return
 	}
 
-	for _, res := range res.Results() {
-		for _, stats := range res.Lineups() {
-			ch <- transformPlayerStats(&stats, false)
-		}
-
-		for _, stats := range res.Bench() {
-			ch <- transformPlayerStats(&stats, true)
-		}
+	for _, s := range stats {
+		ch <- s
 	}
 
 	wg.Done()
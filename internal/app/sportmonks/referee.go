@@ -0,0 +1,78 @@
+package sportmonks
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+	"sync"
+)
+
+type RefereeRequester struct {
+	client *spClient.HTTPClient
+	logger *logrus.Logger
+}
+
+func (r RefereeRequester) RefereesBySeasonIDs(ids []uint64) <-chan app.Referee {
+	ch := make(chan app.Referee, 500)
+
+	go r.parseReferees(ids, ch)
+
+	return ch
+}
+
+func (r RefereeRequester) parseReferees(ids []uint64, ch chan<- app.Referee) {
+	defer close(ch)
+
+	var wg sync.WaitGroup
+
+	for _, id := range ids {
+		wg.Add(1)
+		go r.sendSeasonRequests(id, ch, &wg)
+	}
+
+	wg.Wait()
+}
+
+func (r RefereeRequester) sendSeasonRequests(seasonID uint64, ch chan<- app.Referee, w *sync.WaitGroup) {
+	defer w.Done()
+
+	season, _, err := r.client.SeasonByID(context.Background(), int(seasonID), []string{"results.referee"})
+
+	if err != nil {
+		r.logger.Errorf(
+			"Error when calling client '%s' when making season request to parse referees. Season ID %d",
+			err.Error(),
+			seasonID,
+		)
+		return
+	}
+
+	seen := map[int]bool{}
+
+	for _, fixture := range season.Results() {
+		ref := fixture.Referee()
+
+		if ref == nil || seen[ref.ID] {
+			continue
+		}
+
+		seen[ref.ID] = true
+
+		ch <- transformReferee(ref)
+	}
+}
+
+func transformReferee(m *spClient.MatchOfficial) app.Referee {
+	return app.Referee{
+		ID:         uint64(m.ID),
+		CommonName: m.CommonName,
+		FullName:   m.FullName,
+		FirstName:  m.FirstName,
+		LastName:   m.LastName,
+	}
+}
+
+func NewRefereeRequester(client *spClient.HTTPClient, log *logrus.Logger) *RefereeRequester {
+	return &RefereeRequester{client: client, logger: log}
+}
@@ -0,0 +1,97 @@
+package sportmonks_test
+
+import (
+	"bytes"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/sportmonks"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestRefereeRequester_RefereesBySeasonIDs(t *testing.T) {
+	t.Run("returns unique referee struct channel", func(t *testing.T) {
+		server := mock.HttpClient(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "results.referee", req.URL.Query().Get("include"))
+
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(seasonRefereesResponse)),
+			}, nil
+		})
+
+		client := spClient.HTTPClient{
+			HTTPClient: server,
+			BaseURL:    "http://example.com",
+			Key:        "my-key",
+		}
+
+		logger, _ := test.NewNullLogger()
+
+		requester := sportmonks.NewRefereeRequester(&client, logger)
+
+		ch := requester.RefereesBySeasonIDs([]uint64{16036})
+
+		var referees []app.Referee
+
+		for r := range ch {
+			referees = append(referees, r)
+		}
+
+		a := assert.New(t)
+
+		a.Equal(1, len(referees))
+		a.Equal(uint64(14532), referees[0].ID)
+		a.Equal("M. Oliver", referees[0].CommonName)
+		a.Equal("Michael Oliver", referees[0].FullName)
+		a.Equal("Michael", referees[0].FirstName)
+		a.Equal("Oliver", referees[0].LastName)
+	})
+}
+
+var seasonRefereesResponse = `{
+	"data": {
+		"id": 16036,
+		"name": "2019/2020",
+		"league_id": 8,
+		"is_current_season": true,
+		"results": {
+			"data": [
+				{
+					"id": 11867285,
+					"referee_id": 14532,
+					"referee": {
+						"data": {
+							"id": 14532,
+							"common_name": "M. Oliver",
+							"fullname": "Michael Oliver",
+							"firstname": "Michael",
+							"lastname": "Oliver"
+						}
+					}
+				},
+				{
+					"id": 11867286,
+					"referee_id": 14532,
+					"referee": {
+						"data": {
+							"id": 14532,
+							"common_name": "M. Oliver",
+							"fullname": "Michael Oliver",
+							"firstname": "Michael",
+							"lastname": "Oliver"
+						}
+					}
+				},
+				{
+					"id": 11867287,
+					"referee_id": null
+				}
+			]
+		}
+	}
+}`
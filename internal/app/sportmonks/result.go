@@ -21,6 +21,22 @@ func (r ResultRequester) ResultsBySeasonIDs(seasonIDs []uint64) <-chan app.Resul
 	return ch
 }
 
+func (r ResultRequester) ResultsBySeasonID(seasonID uint64) ([]app.Result, error) {
+	season, _, err := r.client.SeasonByID(context.Background(), int(seasonID), []string{"results"})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var results []app.Result
+
+	for _, result := range season.Results() {
+		results = append(results, transformResult(result))
+	}
+
+	return results, nil
+}
+
 func (r ResultRequester) parseResults(seasonIDs []uint64, ch chan<- app.Result) {
 	defer close(ch)
 
@@ -35,7 +51,7 @@ func (r ResultRequester) parseResults(seasonIDs []uint64, ch chan<- app.Result)
 }
 
 func (r ResultRequester) sendSeasonRequests(seasonID uint64, ch chan<- app.Result, w *sync.WaitGroup) {
-	season, _, err := r.client.SeasonByID(context.Background(), int(seasonID), []string{"results"})
+	results, err := r.ResultsBySeasonID(seasonID)
 
 	if err != nil {
 		r.logger.Errorf(
@@ -48,8 +64,8 @@ func (r ResultRequester) sendSeasonRequests(seasonID uint64, ch chan<- app.Resul
 		return
 	}
 
-	for _, result := range season.Results() {
-		ch <- transformResult(result)
+	for _, result := range results {
+		ch <- result
 	}
 
 	w.Done()
@@ -2,6 +2,7 @@ package sportmonks_test
 
 import (
 	"bytes"
+	"errors"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/statistico/statistico-football-data/internal/app/mock"
 	"github.com/statistico/statistico-football-data/internal/app/sportmonks"
@@ -57,6 +58,60 @@ func TestResultRequester_ResultsBySeasonIDs(t *testing.T) {
 	})
 }
 
+func TestResultRequester_ResultsBySeasonID(t *testing.T) {
+	t.Run("returns a slice of result struct", func(t *testing.T) {
+		server := mock.HttpClient(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(seasonResultsResponse)),
+			}, nil
+		})
+
+		client := spClient.HTTPClient{
+			HTTPClient: server,
+			BaseURL:    "http://example.com",
+			Key:        "my-key",
+		}
+
+		logger, _ := test.NewNullLogger()
+
+		requester := sportmonks.NewResultRequester(&client, logger)
+
+		results, err := requester.ResultsBySeasonID(16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(1, len(results))
+		a.Equal(uint64(11867285), results[0].FixtureID)
+		a.Equal(2, *results[0].HomeScore)
+	})
+
+	t.Run("returns error if the request fails", func(t *testing.T) {
+		server := mock.HttpClient(func(req *http.Request) (*http.Response, error) {
+			return nil, errors.New("connection refused")
+		})
+
+		client := spClient.HTTPClient{
+			HTTPClient: server,
+			BaseURL:    "http://example.com",
+			Key:        "my-key",
+		}
+
+		logger, _ := test.NewNullLogger()
+
+		requester := sportmonks.NewResultRequester(&client, logger)
+
+		results, err := requester.ResultsBySeasonID(16036)
+
+		assert.Nil(t, results)
+		assert.NotNil(t, err)
+	})
+}
+
 var seasonResultsResponse = `{
 	"data": {
 		"id": 16029,
@@ -0,0 +1,172 @@
+package sportmonks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const sidelinedDateFormat = "2006-01-02"
+
+// sidelinedTeam mirrors the teams by season resource with the sidelined include. The client's Sidelined struct
+// does not decode end_date so the resource is decoded directly using the client's HTTP client and credentials.
+type sidelinedTeam struct {
+	ID        int `json:"id"`
+	Sidelined struct {
+		Data []struct {
+			PlayerID    int     `json:"player_id"`
+			SeasonID    int     `json:"season_id"`
+			TeamID      int     `json:"team_id"`
+			Description string  `json:"description"`
+			StartDate   string  `json:"start_date"`
+			EndDate     *string `json:"end_date"`
+		} `json:"data"`
+	} `json:"sidelined"`
+}
+
+type sidelinedResponse struct {
+	Data []sidelinedTeam `json:"data"`
+	Meta *spClient.Meta  `json:"meta"`
+}
+
+type SidelinedRequester struct {
+	client *spClient.HTTPClient
+	logger *logrus.Logger
+}
+
+func (s SidelinedRequester) SidelinedBySeasonIDs(ids []uint64) <-chan app.TeamSidelined {
+	ch := make(chan app.TeamSidelined, 100)
+
+	go s.parseSidelined(ids, ch)
+
+	return ch
+}
+
+func (s SidelinedRequester) parseSidelined(ids []uint64, ch chan<- app.TeamSidelined) {
+	defer close(ch)
+
+	var wg sync.WaitGroup
+
+	for _, id := range ids {
+		wg.Add(1)
+		go s.sendSeasonRequests(id, ch, &wg)
+	}
+
+	wg.Wait()
+}
+
+func (s SidelinedRequester) sendSeasonRequests(seasonID uint64, ch chan<- app.TeamSidelined, w *sync.WaitGroup) {
+	defer w.Done()
+
+	for page := 1; ; page++ {
+		res, err := s.teamsBySeasonID(context.Background(), seasonID, page)
+
+		if err != nil {
+			s.logger.Errorf(
+				"Error when calling client '%s' when making sidelined request. Season ID %d",
+				err.Error(),
+				seasonID,
+			)
+			return
+		}
+
+		for _, team := range res.Data {
+			ch <- s.transformTeamSidelined(&team, seasonID)
+		}
+
+		if res.Meta == nil || res.Meta.Pagination == nil || page >= res.Meta.Pagination.TotalPages {
+			return
+		}
+	}
+}
+
+func (s SidelinedRequester) teamsBySeasonID(ctx context.Context, seasonID uint64, page int) (*sidelinedResponse, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/teams/season/%d", s.client.BaseURL, seasonID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.URL.RawQuery = url.Values{
+		"api_token": {s.client.Key},
+		"include":   {"sidelined"},
+		"page":      {strconv.Itoa(page)},
+	}.Encode()
+
+	resp, err := s.client.HTTPClient.Do(req.WithContext(ctx))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request failed with status code %d", resp.StatusCode)
+	}
+
+	var res sidelinedResponse
+
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s SidelinedRequester) transformTeamSidelined(t *sidelinedTeam, seasonID uint64) app.TeamSidelined {
+	team := app.TeamSidelined{TeamID: uint64(t.ID), SeasonID: seasonID}
+
+	for _, x := range t.Sidelined.Data {
+		if uint64(x.SeasonID) != seasonID {
+			continue
+		}
+
+		start, err := time.Parse(sidelinedDateFormat, x.StartDate)
+
+		if err != nil {
+			s.logger.Warningf("Error '%s' parsing sidelined start date for player %d", err.Error(), x.PlayerID)
+			continue
+		}
+
+		sd := app.Sidelined{
+			PlayerID:  uint64(x.PlayerID),
+			TeamID:    uint64(t.ID),
+			SeasonID:  seasonID,
+			Reason:    x.Description,
+			Type:      sidelinedType(x.Description),
+			StartDate: start,
+		}
+
+		if x.EndDate != nil {
+			if end, err := time.Parse(sidelinedDateFormat, *x.EndDate); err == nil {
+				sd.ExpectedEndDate = &end
+			}
+		}
+
+		team.Sidelined = append(team.Sidelined, sd)
+	}
+
+	return team
+}
+
+func sidelinedType(description string) string {
+	if strings.Contains(strings.ToLower(description), "suspen") {
+		return app.SidelinedTypeSuspension
+	}
+
+	return app.SidelinedTypeInjury
+}
+
+func NewSidelinedRequester(client *spClient.HTTPClient, log *logrus.Logger) *SidelinedRequester {
+	return &SidelinedRequester{client: client, logger: log}
+}
@@ -0,0 +1,146 @@
+package sportmonks_test
+
+import (
+	"bytes"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/sportmonks"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSidelinedRequester_SidelinedBySeasonIDs(t *testing.T) {
+	t.Run("returns team sidelined struct channel for each page of teams", func(t *testing.T) {
+		server := mock.HttpClient(func(req *http.Request) (*http.Response, error) {
+			a := assert.New(t)
+
+			a.Equal("/teams/season/16036", req.URL.Path)
+			a.Equal("sidelined", req.URL.Query().Get("include"))
+			a.Equal("my-key", req.URL.Query().Get("api_token"))
+
+			body := sidelinedPageOneResponse
+
+			if req.URL.Query().Get("page") == "2" {
+				body = sidelinedPageTwoResponse
+			}
+
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			}, nil
+		})
+
+		client := spClient.HTTPClient{
+			HTTPClient: server,
+			BaseURL:    "http://example.com",
+			Key:        "my-key",
+		}
+
+		logger, _ := test.NewNullLogger()
+
+		requester := sportmonks.NewSidelinedRequester(&client, logger)
+
+		var teams []app.TeamSidelined
+
+		for x := range requester.SidelinedBySeasonIDs([]uint64{16036}) {
+			teams = append(teams, x)
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(teams))
+		a.Equal(uint64(1), teams[0].TeamID)
+		a.Equal(uint64(16036), teams[0].SeasonID)
+		a.Equal(2, len(teams[0].Sidelined))
+
+		injury := teams[0].Sidelined[0]
+
+		a.Equal(uint64(37429), injury.PlayerID)
+		a.Equal(uint64(1), injury.TeamID)
+		a.Equal("Hamstring Injury", injury.Reason)
+		a.Equal(app.SidelinedTypeInjury, injury.Type)
+		a.Equal("2019-12-01 00:00:00 +0000 UTC", injury.StartDate.String())
+		a.Equal("2020-01-05 00:00:00 +0000 UTC", injury.ExpectedEndDate.String())
+
+		suspension := teams[0].Sidelined[1]
+
+		a.Equal(uint64(1452), suspension.PlayerID)
+		a.Equal("Red Card Suspension", suspension.Reason)
+		a.Equal(app.SidelinedTypeSuspension, suspension.Type)
+		a.Nil(suspension.ExpectedEndDate)
+
+		a.Equal(uint64(14), teams[1].TeamID)
+		a.Equal(0, len(teams[1].Sidelined))
+	})
+}
+
+var sidelinedPageOneResponse = `{
+	"data": [
+		{
+			"id": 1,
+			"name": "West Ham United",
+			"sidelined": {
+				"data": [
+					{
+						"player_id": 37429,
+						"season_id": 16036,
+						"team_id": 1,
+						"description": "Hamstring Injury",
+						"start_date": "2019-12-01",
+						"end_date": "2020-01-05"
+					},
+					{
+						"player_id": 1452,
+						"season_id": 16036,
+						"team_id": 1,
+						"description": "Red Card Suspension",
+						"start_date": "2020-01-10",
+						"end_date": null
+					},
+					{
+						"player_id": 37429,
+						"season_id": 13133,
+						"team_id": 1,
+						"description": "Knee Injury",
+						"start_date": "2019-02-01",
+						"end_date": "2019-03-01"
+					}
+				]
+			}
+		}
+	],
+	"meta": {
+		"pagination": {
+			"total": 2,
+			"count": 1,
+			"per_page": 1,
+			"current_page": 1,
+			"total_pages": 2
+		}
+	}
+}`
+
+var sidelinedPageTwoResponse = `{
+	"data": [
+		{
+			"id": 14,
+			"name": "Manchester United",
+			"sidelined": {
+				"data": []
+			}
+		}
+	],
+	"meta": {
+		"pagination": {
+			"total": 2,
+			"count": 1,
+			"per_page": 1,
+			"current_page": 2,
+			"total_pages": 2
+		}
+	}
+}`
@@ -0,0 +1,96 @@
+package sportmonks
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+	"strconv"
+	"sync"
+)
+
+type StageRequester struct {
+	client *spClient.HTTPClient
+	logger *logrus.Logger
+}
+
+func (s StageRequester) StagesBySeasonIDs(ids []uint64) (<-chan app.Stage, <-chan app.Group) {
+	st := make(chan app.Stage, 100)
+	gr := make(chan app.Group, 100)
+
+	go s.parseStages(ids, st, gr)
+
+	return st, gr
+}
+
+func (s StageRequester) parseStages(ids []uint64, st chan<- app.Stage, gr chan<- app.Group) {
+	defer close(st)
+	defer close(gr)
+
+	var wg sync.WaitGroup
+
+	for _, id := range ids {
+		wg.Add(1)
+		go s.sendSeasonRequest(id, st, gr, &wg)
+	}
+
+	wg.Wait()
+}
+
+func (s StageRequester) sendSeasonRequest(seasonID uint64, st chan<- app.Stage, gr chan<- app.Group, w *sync.WaitGroup) {
+	defer w.Done()
+
+	season, _, err := s.client.SeasonByID(context.Background(), int(seasonID), []string{"stages", "groups"})
+
+	if err != nil {
+		s.logger.Errorf(
+			"Error when calling client '%s' when making season request to parse stages. Season ID %d",
+			err.Error(),
+			seasonID,
+		)
+		return
+	}
+
+	for _, stage := range season.Stages() {
+		st <- transformStage(stage)
+	}
+
+	for _, group := range season.Groups() {
+		gr <- transformGroup(group)
+	}
+}
+
+func transformStage(s spClient.Stage) app.Stage {
+	stage := app.Stage{
+		ID:            uint64(s.ID),
+		Name:          s.Name,
+		Type:          s.Type,
+		CompetitionID: uint64(s.LeagueID),
+		SeasonID:      uint64(s.SeasonID),
+	}
+
+	if s.SortOrder != nil {
+		if order, err := strconv.Atoi(*s.SortOrder); err == nil {
+			stage.SortOrder = &order
+		}
+	}
+
+	if s.HasStandings != nil {
+		stage.HasStandings = *s.HasStandings
+	}
+
+	return stage
+}
+
+func transformGroup(g spClient.Group) app.Group {
+	return app.Group{
+		ID:       uint64(g.ID),
+		Name:     g.Name,
+		StageID:  uint64(g.StageID),
+		SeasonID: uint64(g.SeasonID),
+	}
+}
+
+func NewStageRequester(client *spClient.HTTPClient, log *logrus.Logger) *StageRequester {
+	return &StageRequester{client: client, logger: log}
+}
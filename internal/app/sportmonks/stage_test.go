@@ -0,0 +1,129 @@
+package sportmonks_test
+
+import (
+	"bytes"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/sportmonks"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestStageRequester_StagesBySeasonIDs(t *testing.T) {
+	t.Run("returns stage and group struct channels", func(t *testing.T) {
+		server := mock.HttpClient(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "stages,groups", req.URL.Query().Get("include"))
+
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(seasonStagesResponse)),
+			}, nil
+		})
+
+		client := spClient.HTTPClient{
+			HTTPClient: server,
+			BaseURL:    "http://example.com",
+			Key:        "my-key",
+		}
+
+		logger, _ := test.NewNullLogger()
+
+		requester := sportmonks.NewStageRequester(&client, logger)
+
+		st, gr := requester.StagesBySeasonIDs([]uint64{16036})
+
+		var stages []app.Stage
+		var groups []app.Group
+
+		var wg sync.WaitGroup
+
+		wg.Add(2)
+
+		go func() {
+			for s := range st {
+				stages = append(stages, s)
+			}
+			wg.Done()
+		}()
+
+		go func() {
+			for g := range gr {
+				groups = append(groups, g)
+			}
+			wg.Done()
+		}()
+
+		wg.Wait()
+
+		a := assert.New(t)
+
+		a.Equal(2, len(stages))
+		a.Equal(uint64(77443861), stages[0].ID)
+		a.Equal("Group Stage", stages[0].Name)
+		a.Equal("Group Stage", *stages[0].Type)
+		a.Equal(uint64(2), stages[0].CompetitionID)
+		a.Equal(uint64(16036), stages[0].SeasonID)
+		a.Equal(1, *stages[0].SortOrder)
+		a.True(stages[0].HasStandings)
+		a.Equal(uint64(77443862), stages[1].ID)
+		a.Nil(stages[1].SortOrder)
+		a.False(stages[1].HasStandings)
+
+		a.Equal(1, len(groups))
+		a.Equal(uint64(240), groups[0].ID)
+		a.Equal("Group A", groups[0].Name)
+		a.Equal(uint64(77443861), groups[0].StageID)
+		a.Equal(uint64(16036), groups[0].SeasonID)
+	})
+}
+
+var seasonStagesResponse = `{
+	"data": {
+		"id": 16036,
+		"name": "2019/2020",
+		"league_id": 2,
+		"is_current_season": true,
+		"stages": {
+			"data": [
+				{
+					"id": 77443861,
+					"name": "Group Stage",
+					"type": "Group Stage",
+					"league_id": 2,
+					"season_id": 16036,
+					"sort_order": "1",
+					"has_standings": true
+				},
+				{
+					"id": 77443862,
+					"name": "Round of 16",
+					"type": "Knock Out",
+					"league_id": 2,
+					"season_id": 16036,
+					"sort_order": null,
+					"has_standings": false
+				}
+			]
+		},
+		"groups": {
+			"data": [
+				{
+					"id": 240,
+					"name": "Group A",
+					"league_id": 2,
+					"season_id": 16036,
+					"round_id": null,
+					"round_name": null,
+					"stage_id": 77443861,
+					"stage_name": "Group Stage",
+					"resource": "group"
+				}
+			]
+		}
+	}
+}`
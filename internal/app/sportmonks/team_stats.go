@@ -39,6 +39,24 @@ func (t TeamStatsRequester) TeamStatsByDate(date time.Time, competitionIDs []uin
 	return ch
 }
 
+func (t TeamStatsRequester) TeamStatsBySeasonID(seasonID uint64) ([]app.TeamStats, error) {
+	season, _, err := t.client.SeasonByID(context.Background(), int(seasonID), []string{"results.stats"})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var stats []app.TeamStats
+
+	for _, res := range season.Results() {
+		for _, s := range res.TeamStats() {
+			stats = append(stats, transformTeamStats(s))
+		}
+	}
+
+	return stats, nil
+}
+
 func (t TeamStatsRequester) parseBySeasonIDs(seasonIDs []uint64, ch chan<- app.TeamStats) {
 	defer close(ch)
 
@@ -89,7 +107,7 @@ func (t TeamStatsRequester) parseByFixtureIDs(ids []uint64, ch chan<- app.TeamSt
 }
 
 func (t TeamStatsRequester) sendSeasonRequest(seasonID uint64, ch chan<- app.TeamStats, wg *sync.WaitGroup) {
-	season, _, err := t.client.SeasonByID(context.Background(), int(seasonID), []string{"results.stats"})
+	stats, err := t.TeamStatsBySeasonID(seasonID)
 
 	if err != nil {
 		t.logger.Errorf(
@@ -102,10 +120,8 @@ func (t TeamStatsRequester) sendSeasonRequest(seasonID uint64, ch chan<- app.Tea
 		return
 	}
 
-	for _, res := range season.Results() {
-		for _, stats := range res.TeamStats() {
-			ch <- transformTeamStats(stats)
-		}
+	for _, s := range stats {
+		ch <- s
 	}
 
 	wg.Done()
@@ -0,0 +1,100 @@
+package sportmonks
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+	"sync"
+	"time"
+)
+
+const transferDateFormat = "2006-01-02"
+
+type TransferRequester struct {
+	client *spClient.HTTPClient
+	logger *logrus.Logger
+}
+
+func (t TransferRequester) TransfersBySeasonIDs(ids []uint64) <-chan app.Transfer {
+	ch := make(chan app.Transfer, 500)
+
+	go t.parseTransfers(ids, ch)
+
+	return ch
+}
+
+func (t TransferRequester) parseTransfers(ids []uint64, ch chan<- app.Transfer) {
+	defer close(ch)
+
+	var wg sync.WaitGroup
+
+	for _, id := range ids {
+		wg.Add(1)
+		go t.sendSeasonRequests(id, ch, &wg)
+	}
+
+	wg.Wait()
+}
+
+func (t TransferRequester) sendSeasonRequests(seasonID uint64, ch chan<- app.Transfer, w *sync.WaitGroup) {
+	defer w.Done()
+
+	for page := 1; ; page++ {
+		res, meta, err := t.client.TeamsBySeasonID(context.Background(), int(seasonID), page, []string{"transfers"})
+
+		if err != nil {
+			t.logger.Errorf(
+				"Error when calling client '%s' when making transfer request. Season ID %d",
+				err.Error(),
+				seasonID,
+			)
+			return
+		}
+
+		for _, team := range res {
+			for _, x := range team.Transfers() {
+				tr, err := transformTransfer(&x)
+
+				if err != nil {
+					t.logger.Warningf("Error '%s' parsing transfer date for player %d", err.Error(), x.PlayerID)
+					continue
+				}
+
+				ch <- *tr
+			}
+		}
+
+		if meta == nil || meta.Pagination == nil || page >= meta.Pagination.TotalPages {
+			return
+		}
+	}
+}
+
+func transformTransfer(t *spClient.Transfer) (*app.Transfer, error) {
+	date, err := time.Parse(transferDateFormat, t.Date)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tr := app.Transfer{
+		PlayerID:   uint64(t.PlayerID),
+		FromTeamID: uint64(t.FromTeamID),
+		ToTeamID:   uint64(t.ToTeamID),
+		Date:       date,
+		Type:       t.Type,
+		Fee:        t.Amount,
+	}
+
+	if t.SeasonID != nil {
+		s := uint64(*t.SeasonID)
+		tr.SeasonID = &s
+	}
+
+	return &tr, nil
+}
+
+func NewTransferRequester(client *spClient.HTTPClient, log *logrus.Logger) *TransferRequester {
+	return &TransferRequester{client: client, logger: log}
+}
@@ -0,0 +1,150 @@
+package sportmonks_test
+
+import (
+	"bytes"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/sportmonks"
+	spClient "github.com/statistico/statistico-sportmonks-go-client"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestTransferRequester_TransfersBySeasonIDs(t *testing.T) {
+	t.Run("returns transfer struct channel for each page of teams", func(t *testing.T) {
+		server := mock.HttpClient(func(req *http.Request) (*http.Response, error) {
+			a := assert.New(t)
+
+			a.Equal("/teams/season/16036", req.URL.Path)
+			a.Equal("transfers", req.URL.Query().Get("include"))
+
+			body := transferPageOneResponse
+
+			if req.URL.Query().Get("page") == "2" {
+				body = transferPageTwoResponse
+			}
+
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			}, nil
+		})
+
+		client := spClient.HTTPClient{
+			HTTPClient: server,
+			BaseURL:    "http://example.com",
+			Key:        "my-key",
+		}
+
+		logger, hook := test.NewNullLogger()
+
+		requester := sportmonks.NewTransferRequester(&client, logger)
+
+		var transfers []app.Transfer
+
+		for x := range requester.TransfersBySeasonIDs([]uint64{16036}) {
+			transfers = append(transfers, x)
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(transfers))
+
+		in := transfers[0]
+
+		a.Equal(uint64(219591), in.PlayerID)
+		a.Equal(uint64(20), in.FromTeamID)
+		a.Equal(uint64(1), in.ToTeamID)
+		a.Equal(uint64(16036), *in.SeasonID)
+		a.Equal("2019-07-01 00:00:00 +0000 UTC", in.Date.String())
+		a.Equal("Transfer", in.Type)
+		a.Equal("€20M", *in.Fee)
+
+		loan := transfers[1]
+
+		a.Equal(uint64(37429), loan.PlayerID)
+		a.Equal(uint64(14), loan.ToTeamID)
+		a.Nil(loan.SeasonID)
+		a.Nil(loan.Fee)
+		a.Equal("Loan", loan.Type)
+
+		a.Equal("Error 'parsing time \"\" as \"2006-01-02\": cannot parse \"\" as \"2006\"' parsing transfer date for player 1452", hook.LastEntry().Message)
+	})
+}
+
+var transferPageOneResponse = `{
+	"data": [
+		{
+			"id": 1,
+			"name": "West Ham United",
+			"transfers": {
+				"data": [
+					{
+						"player_id": 219591,
+						"from_team_id": 20,
+						"to_team_id": 1,
+						"season_id": 16036,
+						"transfer": "in",
+						"type": "Transfer",
+						"date": "2019-07-01",
+						"amount": "€20M"
+					}
+				]
+			}
+		}
+	],
+	"meta": {
+		"pagination": {
+			"total": 2,
+			"count": 1,
+			"per_page": 1,
+			"current_page": 1,
+			"total_pages": 2
+		}
+	}
+}`
+
+var transferPageTwoResponse = `{
+	"data": [
+		{
+			"id": 14,
+			"name": "Manchester United",
+			"transfers": {
+				"data": [
+					{
+						"player_id": 37429,
+						"from_team_id": 1,
+						"to_team_id": 14,
+						"season_id": null,
+						"transfer": "in",
+						"type": "Loan",
+						"date": "2020-01-31",
+						"amount": null
+					},
+					{
+						"player_id": 1452,
+						"from_team_id": 1,
+						"to_team_id": 14,
+						"season_id": null,
+						"transfer": "in",
+						"type": "Transfer",
+						"date": "",
+						"amount": null
+					}
+				]
+			}
+		}
+	],
+	"meta": {
+		"pagination": {
+			"total": 2,
+			"count": 1,
+			"per_page": 1,
+			"current_page": 2,
+			"total_pages": 2
+		}
+	}
+}`
@@ -13,18 +13,69 @@ type Squad struct {
 	UpdatedAt time.Time
 }
 
-// SquadRepository provides an interface to persist Squad domain struct objects to a storage engine.
+// Directions a player can move in or out of a squad.
+const (
+	SquadMovementJoined = "joined"
+	SquadMovementLeft   = "left"
+)
+
+// SquadMovement records a player joining or leaving a team's squad for a season, detected by comparing the squad
+// returned by a data provider with the squad previously stored.
+type SquadMovement struct {
+	SeasonID   uint64
+	TeamID     uint64
+	PlayerID   uint64
+	Direction  string
+	DetectedAt time.Time
+}
+
+// SquadRepository provides an interface to persist Squad domain struct objects to a storage engine. The
+// SquadMovement structs provided to Update are recorded atomically with the write.
 type SquadRepository interface {
 	Insert(m *Squad) error
-	Update(m *Squad) error
+	Update(m *Squad, movements ...SquadMovement) error
 	BySeasonAndTeam(seasonId, teamId uint64) (*Squad, error)
 	All() ([]Squad, error)
 	CurrentSeason() ([]Squad, error)
 }
 
+// SquadMovementRepository provides an interface to persist SquadMovement domain struct objects to a storage engine.
+// A movement already recorded for the same player, direction and detection time is not recorded twice.
+type SquadMovementRepository interface {
+	Insert(m *SquadMovement) error
+	BySeasonAndTeam(seasonId, teamId uint64) ([]SquadMovement, error)
+	ByPlayerID(id uint64) ([]SquadMovement, error)
+}
+
 // SquadRequester provides an interface allowing this application to request data from an external
 // data provider. The requester implementation is responsible for creating the channel, filtering struct data into
 // the channel before closing the channel once successful execution is complete.
 type SquadRequester interface {
 	SquadsBySeasonIDs(seasonIDs []uint64) <-chan *Squad
 }
+
+// DiffSquads returns the players that have joined and left a squad between the previous and current squads provided.
+func DiffSquads(previous, current *Squad) (joined []uint64, left []uint64) {
+	before := map[uint64]bool{}
+	after := map[uint64]bool{}
+
+	for _, id := range previous.PlayerIDs {
+		before[id] = true
+	}
+
+	for _, id := range current.PlayerIDs {
+		if !before[id] && !after[id] {
+			joined = append(joined, id)
+		}
+
+		after[id] = true
+	}
+
+	for _, id := range previous.PlayerIDs {
+		if !after[id] {
+			left = append(left, id)
+		}
+	}
+
+	return joined, left
+}
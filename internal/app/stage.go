@@ -0,0 +1,50 @@
+package app
+
+import "time"
+
+// Stage domain entity. A stage is a phase of a competition season such as a group stage, a knockout round or
+// a play-off. Type is provided by the data provider i.e. "Group Stage" or "Knock Out".
+type Stage struct {
+	ID            uint64    `json:"id"`
+	Name          string    `json:"name"`
+	Type          *string   `json:"type"`
+	CompetitionID uint64    `json:"competition_id"`
+	SeasonID      uint64    `json:"season_id"`
+	SortOrder     *int      `json:"sort_order"`
+	HasStandings  bool      `json:"has_standings"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Group domain entity. A group belongs to a stage i.e. "Group A" of a Champions League group stage.
+type Group struct {
+	ID        uint64    `json:"id"`
+	Name      string    `json:"name"`
+	StageID   uint64    `json:"stage_id"`
+	SeasonID  uint64    `json:"season_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// StageRepository provides an interface to persist Stage domain struct objects to a storage engine.
+type StageRepository interface {
+	Insert(s *Stage) error
+	Update(s *Stage) error
+	ByID(id uint64) (*Stage, error)
+	BySeasonID(id uint64) ([]Stage, error)
+}
+
+// GroupRepository provides an interface to persist Group domain struct objects to a storage engine.
+type GroupRepository interface {
+	Insert(g *Group) error
+	Update(g *Group) error
+	ByID(id uint64) (*Group, error)
+	ByStageID(id uint64) ([]Group, error)
+}
+
+// StageRequester provides an interface allowing this application to request data from an external
+// data provider. The requester implementation is responsible for creating the channels, filtering struct data into
+// the channels before closing the channels once successful execution is complete.
+type StageRequester interface {
+	StagesBySeasonIDs(ids []uint64) (<-chan Stage, <-chan Group)
+}
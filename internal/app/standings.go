@@ -0,0 +1,324 @@
+package app
+
+import (
+	"sort"
+	"time"
+)
+
+// Tables standings can be calculated for. Home and away tables only count fixtures a team played at home or away.
+const (
+	StandingsTableOverall = "overall"
+	StandingsTableHome    = "home"
+	StandingsTableAway    = "away"
+)
+
+// Rules used to separate teams level on points, applied in the order configured for a competition.
+const (
+	TieBreakGoalDifference = "goal_difference"
+	TieBreakGoalsScored    = "goals_scored"
+	TieBreakHeadToHead     = "head_to_head"
+)
+
+// StandingsRules configures how a league table is calculated for a competition.
+type StandingsRules struct {
+	CompetitionID uint64    `json:"competition_id"`
+	PointsForWin  int       `json:"points_for_win"`
+	PointsForDraw int       `json:"points_for_draw"`
+	PointsForLoss int       `json:"points_for_loss"`
+	TieBreakers   []string  `json:"tie_breakers"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// DefaultStandingsRules returns the rules used for competitions without configured rules. Three points for a win,
+// one for a draw with teams separated by goal difference, goals scored and then head to head record.
+func DefaultStandingsRules(competitionID uint64) StandingsRules {
+	return StandingsRules{
+		CompetitionID: competitionID,
+		PointsForWin:  3,
+		PointsForDraw: 1,
+		PointsForLoss: 0,
+		TieBreakers:   []string{TieBreakGoalDifference, TieBreakGoalsScored, TieBreakHeadToHead},
+	}
+}
+
+// Standing is a single row of a league table. StageID and GroupID identify the table the row belongs to for seasons
+// split into stages or groups, such as a group stage followed by a championship round.
+type Standing struct {
+	StageID      *uint64 `json:"stage_id"`
+	GroupID      *uint64 `json:"group_id"`
+	Position     int     `json:"position"`
+	TeamID       uint64  `json:"team_id"`
+	Played       int     `json:"played"`
+	Won          int     `json:"won"`
+	Drawn        int     `json:"drawn"`
+	Lost         int     `json:"lost"`
+	GoalsFor     int     `json:"goals_for"`
+	GoalsAgainst int     `json:"goals_against"`
+	Points       int     `json:"points"`
+}
+
+// GoalDifference returns goals scored minus goals conceded.
+func (s Standing) GoalDifference() int {
+	return s.GoalsFor - s.GoalsAgainst
+}
+
+// StandingsSnapshot is a league table persisted as it stood at the end of a round.
+type StandingsSnapshot struct {
+	SeasonID  uint64     `json:"season_id"`
+	RoundID   uint64     `json:"round_id"`
+	Table     string     `json:"table"`
+	Standings []Standing `json:"standings"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// StandingsRulesRepository provides an interface to persist StandingsRules domain struct objects to a storage engine.
+type StandingsRulesRepository interface {
+	Save(r *StandingsRules) error
+	ByCompetitionID(id uint64) (*StandingsRules, error)
+}
+
+// StandingsSnapshotRepository provides an interface to persist StandingsSnapshot domain struct objects to a
+// storage engine. Saving a snapshot replaces any existing snapshot for the same season, round and table.
+type StandingsSnapshotRepository interface {
+	Save(s *StandingsSnapshot) error
+	ByRound(seasonID, roundID uint64, table string) (*StandingsSnapshot, error)
+}
+
+// CalculateStandings builds a league table for each stage and group of the fixtures provided, ordered by stage and
+// then group with positions starting from one in each table. Fixtures without a result are ignored. Teams level on
+// points are separated using the tie breakers configured in the rules provided, teams still level after every tie
+// breaker are ordered by team ID so tables are deterministic.
+func CalculateStandings(fixtures []Fixture, results map[uint64]*Result, rules StandingsRules, table string) []Standing {
+	var standings []Standing
+
+	for _, g := range groupStandingsFixtures(fixtures) {
+		rows := calculateTable(g.fixtures, results, rules, table)
+
+		for i := range rows {
+			rows[i].StageID = g.stageID
+			rows[i].GroupID = g.groupID
+		}
+
+		standings = append(standings, rows...)
+	}
+
+	return standings
+}
+
+type standingsGroup struct {
+	stageID  *uint64
+	groupID  *uint64
+	fixtures []Fixture
+}
+
+// groupStandingsFixtures splits fixtures by stage and group, fixtures without a stage or group sorting first.
+func groupStandingsFixtures(fixtures []Fixture) []*standingsGroup {
+	var groups []*standingsGroup
+	byKey := map[[2]uint64]*standingsGroup{}
+
+	for _, f := range fixtures {
+		key := [2]uint64{optionalID(f.StageID), optionalID(f.GroupID)}
+
+		g, ok := byKey[key]
+
+		if !ok {
+			g = &standingsGroup{stageID: f.StageID, groupID: f.GroupID}
+			byKey[key] = g
+			groups = append(groups, g)
+		}
+
+		g.fixtures = append(g.fixtures, f)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		a, b := groups[i], groups[j]
+
+		if optionalID(a.stageID) != optionalID(b.stageID) {
+			return optionalID(a.stageID) < optionalID(b.stageID)
+		}
+
+		return optionalID(a.groupID) < optionalID(b.groupID)
+	})
+
+	return groups
+}
+
+func optionalID(id *uint64) uint64 {
+	if id == nil {
+		return 0
+	}
+
+	return *id
+}
+
+func calculateTable(fixtures []Fixture, results map[uint64]*Result, rules StandingsRules, table string) []Standing {
+	rows := map[uint64]*Standing{}
+	var played []Fixture
+
+	for _, f := range fixtures {
+		home := standingRow(rows, f.HomeTeamID)
+		away := standingRow(rows, f.AwayTeamID)
+
+		res, ok := results[f.ID]
+
+		if !ok || res == nil || res.HomeScore == nil || res.AwayScore == nil {
+			continue
+		}
+
+		played = append(played, f)
+
+		if table != StandingsTableAway {
+			addStandingResult(home, *res.HomeScore, *res.AwayScore, rules)
+		}
+
+		if table != StandingsTableHome {
+			addStandingResult(away, *res.AwayScore, *res.HomeScore, rules)
+		}
+	}
+
+	var standings []Standing
+
+	for _, s := range rows {
+		standings = append(standings, *s)
+	}
+
+	sort.Slice(standings, func(i, j int) bool {
+		return standings[i].TeamID < standings[j].TeamID
+	})
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		return standings[i].Points > standings[j].Points
+	})
+
+	var sorted []Standing
+
+	for _, level := range splitStandings(standings, func(s Standing) []int { return []int{s.Points} }) {
+		sorted = append(sorted, breakTies(level, rules.TieBreakers, played, results, rules)...)
+	}
+
+	for i := range sorted {
+		sorted[i].Position = i + 1
+	}
+
+	return sorted
+}
+
+func standingRow(rows map[uint64]*Standing, teamID uint64) *Standing {
+	if s, ok := rows[teamID]; ok {
+		return s
+	}
+
+	rows[teamID] = &Standing{TeamID: teamID}
+
+	return rows[teamID]
+}
+
+func addStandingResult(s *Standing, scored, conceded int, rules StandingsRules) {
+	s.Played++
+	s.GoalsFor += scored
+	s.GoalsAgainst += conceded
+
+	switch {
+	case scored > conceded:
+		s.Won++
+		s.Points += rules.PointsForWin
+	case scored < conceded:
+		s.Lost++
+		s.Points += rules.PointsForLoss
+	default:
+		s.Drawn++
+		s.Points += rules.PointsForDraw
+	}
+}
+
+// breakTies orders teams level on points by applying each tie breaker in turn to the teams still level.
+func breakTies(level []Standing, breakers []string, played []Fixture, results map[uint64]*Result, rules StandingsRules) []Standing {
+	if len(level) < 2 || len(breakers) == 0 {
+		return level
+	}
+
+	var key func(s Standing) []int
+
+	switch breakers[0] {
+	case TieBreakGoalDifference:
+		key = func(s Standing) []int { return []int{s.GoalDifference()} }
+	case TieBreakGoalsScored:
+		key = func(s Standing) []int { return []int{s.GoalsFor} }
+	case TieBreakHeadToHead:
+		h2h := headToHead(level, played, results, rules)
+		key = func(s Standing) []int {
+			x := h2h[s.TeamID]
+			return []int{x.Points, x.GoalDifference(), x.GoalsFor}
+		}
+	default:
+		return breakTies(level, breakers[1:], played, results, rules)
+	}
+
+	sort.SliceStable(level, func(i, j int) bool {
+		return compareKeys(key(level[i]), key(level[j])) > 0
+	})
+
+	var sorted []Standing
+
+	for _, group := range splitStandings(level, key) {
+		sorted = append(sorted, breakTies(group, breakers[1:], played, results, rules)...)
+	}
+
+	return sorted
+}
+
+// headToHead builds a mini table from the fixtures played between the teams provided.
+func headToHead(level []Standing, played []Fixture, results map[uint64]*Result, rules StandingsRules) map[uint64]*Standing {
+	rows := map[uint64]*Standing{}
+
+	for _, s := range level {
+		rows[s.TeamID] = &Standing{TeamID: s.TeamID}
+	}
+
+	for _, f := range played {
+		home, homeOk := rows[f.HomeTeamID]
+		away, awayOk := rows[f.AwayTeamID]
+
+		if !homeOk || !awayOk {
+			continue
+		}
+
+		res := results[f.ID]
+
+		addStandingResult(home, *res.HomeScore, *res.AwayScore, rules)
+		addStandingResult(away, *res.AwayScore, *res.HomeScore, rules)
+	}
+
+	return rows
+}
+
+// splitStandings groups consecutive standings sharing the same key.
+func splitStandings(standings []Standing, key func(s Standing) []int) [][]Standing {
+	var groups [][]Standing
+
+	for i, s := range standings {
+		if i > 0 && compareKeys(key(standings[i-1]), key(s)) == 0 {
+			groups[len(groups)-1] = append(groups[len(groups)-1], s)
+			continue
+		}
+
+		groups = append(groups, []Standing{s})
+	}
+
+	return groups
+}
+
+func compareKeys(a, b []int) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] > b[i] {
+				return 1
+			}
+
+			return -1
+		}
+	}
+
+	return 0
+}
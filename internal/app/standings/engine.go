@@ -0,0 +1,125 @@
+package standings
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"time"
+)
+
+// Engine calculates league tables from the fixtures and results held in storage using the StandingsRules
+// configured for the competition a season belongs to.
+type Engine struct {
+	fixtureRepo app.FixtureRepository
+	resultRepo  app.ResultRepository
+	seasonRepo  app.SeasonRepository
+	rulesRepo   app.StandingsRulesRepository
+}
+
+// AtDate returns the tables for a season counting the results of fixtures kicking off on or before the date
+// provided. A table is returned for each stage and group of the season.
+func (e *Engine) AtDate(seasonID uint64, date time.Time, table string) ([]app.Standing, error) {
+	season, err := e.Season(seasonID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return season.AtDate(date, table), nil
+}
+
+// AtRound returns the table for a season as it stood at the end of the final day of the round provided.
+func (e *Engine) AtRound(round *app.Round, table string) ([]app.Standing, error) {
+	season, err := e.Season(round.SeasonID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return season.AtRound(round, table), nil
+}
+
+// Season loads the rules, fixtures and results of a season once so the tables of many dates and rounds can be
+// calculated in memory.
+func (e *Engine) Season(seasonID uint64) (*Season, error) {
+	season, err := e.seasonRepo.ByID(seasonID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := e.Rules(season.CompetitionID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures, err := e.fixtureRepo.Get(app.FixtureRepositoryQuery{SeasonIDs: []uint64{seasonID}})
+
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := e.resultRepo.BySeasonID(seasonID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(map[uint64]*app.Result, len(stored))
+
+	for i := range stored {
+		results[stored[i].FixtureID] = &stored[i]
+	}
+
+	return &Season{fixtures: fixtures, results: results, rules: rules}, nil
+}
+
+// Rules returns the rules configured for a competition falling back to the default rules if none are configured.
+func (e *Engine) Rules(competitionID uint64) (app.StandingsRules, error) {
+	rules, err := e.rulesRepo.ByCompetitionID(competitionID)
+
+	if err == errors.ErrorNotFound {
+		return app.DefaultStandingsRules(competitionID), nil
+	}
+
+	if err != nil {
+		return app.StandingsRules{}, err
+	}
+
+	return *rules, nil
+}
+
+// Season holds the rules, fixtures and results of a single season loaded by the Engine.
+type Season struct {
+	fixtures []app.Fixture
+	results  map[uint64]*app.Result
+	rules    app.StandingsRules
+}
+
+// AtDate returns the tables for the season counting the results of fixtures kicking off on or before the date
+// provided.
+func (s *Season) AtDate(date time.Time, table string) []app.Standing {
+	var fixtures []app.Fixture
+
+	for _, f := range s.fixtures {
+		if !f.Date.After(date) {
+			fixtures = append(fixtures, f)
+		}
+	}
+
+	return app.CalculateStandings(fixtures, s.results, s.rules, table)
+}
+
+// AtRound returns the table for the season as it stood at the end of the final day of the round provided.
+func (s *Season) AtRound(round *app.Round, table string) []app.Standing {
+	return s.AtDate(round.EndDate.Add((24*time.Hour)-time.Second), table)
+}
+
+func NewEngine(
+	f app.FixtureRepository,
+	r app.ResultRepository,
+	s app.SeasonRepository,
+	sr app.StandingsRulesRepository,
+) *Engine {
+	return &Engine{fixtureRepo: f, resultRepo: r, seasonRepo: s, rulesRepo: sr}
+}
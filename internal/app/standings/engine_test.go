@@ -0,0 +1,260 @@
+package standings_test
+
+import (
+	e "errors"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/standings"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestEngine_AtDate(t *testing.T) {
+	date := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("calculates table using default rules separating teams by goal difference", func(t *testing.T) {
+		t.Helper()
+
+		engine, fixtureRepo, resultRepo, seasonRepo, rulesRepo := newEngine()
+
+		seasonRepo.On("ByID", uint64(16036)).Return(&app.Season{ID: 16036, CompetitionID: 8}, nil)
+		rulesRepo.On("ByCompetitionID", uint64(8)).Return(&app.StandingsRules{}, errors.ErrorNotFound)
+		fixtureRepo.On("Get", app.FixtureRepositoryQuery{SeasonIDs: []uint64{16036}}).Return(leagueFixtures(), nil)
+		mockLeagueResults(resultRepo)
+
+		table, err := engine.AtDate(16036, date, app.StandingsTableOverall)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 4, len(table))
+		assertStanding(t, table[0], 1, 10, 3, 2, 0, 1, 6, 1, 6)
+		assertStanding(t, table[1], 2, 20, 3, 2, 0, 1, 2, 1, 6)
+		assertStanding(t, table[2], 3, 30, 3, 1, 1, 1, 2, 5, 4)
+		assertStanding(t, table[3], 4, 40, 3, 0, 1, 2, 1, 4, 1)
+		resultRepo.AssertNumberOfCalls(t, "BySeasonID", 1)
+		resultRepo.AssertNotCalled(t, "ByFixtureID", m.Anything)
+	})
+
+	t.Run("calculates a table for each stage and group of the season", func(t *testing.T) {
+		t.Helper()
+
+		engine, fixtureRepo, resultRepo, seasonRepo, rulesRepo := newEngine()
+
+		stage, groupA, groupB := uint64(77443862), uint64(1), uint64(2)
+
+		fixtures := []app.Fixture{
+			{ID: 1, HomeTeamID: 30, AwayTeamID: 40, StageID: &stage, GroupID: &groupB},
+			{ID: 2, HomeTeamID: 10, AwayTeamID: 20, StageID: &stage, GroupID: &groupA},
+		}
+
+		seasonRepo.On("ByID", uint64(16036)).Return(&app.Season{ID: 16036, CompetitionID: 8}, nil)
+		rulesRepo.On("ByCompetitionID", uint64(8)).Return(&app.StandingsRules{}, errors.ErrorNotFound)
+		fixtureRepo.On("Get", app.FixtureRepositoryQuery{SeasonIDs: []uint64{16036}}).Return(fixtures, nil)
+		mockLeagueResults(resultRepo)
+
+		table, err := engine.AtDate(16036, date, app.StandingsTableOverall)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(4, len(table))
+		assertStanding(t, table[0], 1, 10, 1, 1, 0, 0, 4, 0, 3)
+		assertStanding(t, table[1], 2, 20, 1, 0, 0, 1, 0, 4, 0)
+		assertStanding(t, table[2], 1, 40, 1, 1, 0, 0, 1, 0, 3)
+		assertStanding(t, table[3], 2, 30, 1, 0, 0, 1, 0, 1, 0)
+
+		for _, s := range table[:2] {
+			a.Equal(stage, *s.StageID)
+			a.Equal(groupA, *s.GroupID)
+		}
+
+		for _, s := range table[2:] {
+			a.Equal(stage, *s.StageID)
+			a.Equal(groupB, *s.GroupID)
+		}
+	})
+
+	t.Run("applies configured points and head to head tie breaker", func(t *testing.T) {
+		t.Helper()
+
+		engine, fixtureRepo, resultRepo, seasonRepo, rulesRepo := newEngine()
+
+		rules := app.StandingsRules{
+			CompetitionID: 8,
+			PointsForWin:  2,
+			PointsForDraw: 1,
+			PointsForLoss: 0,
+			TieBreakers:   []string{app.TieBreakHeadToHead, app.TieBreakGoalDifference},
+		}
+
+		seasonRepo.On("ByID", uint64(16036)).Return(&app.Season{ID: 16036, CompetitionID: 8}, nil)
+		rulesRepo.On("ByCompetitionID", uint64(8)).Return(&rules, nil)
+		fixtureRepo.On("Get", app.FixtureRepositoryQuery{SeasonIDs: []uint64{16036}}).Return(leagueFixtures(), nil)
+		mockLeagueResults(resultRepo)
+
+		table, err := engine.AtDate(16036, date, app.StandingsTableOverall)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		// Teams 20 and 10 are level on points, 20 has the worse goal difference but won the head to head fixture.
+		a.Equal(uint64(20), table[0].TeamID)
+		a.Equal(4, table[0].Points)
+		a.Equal(uint64(10), table[1].TeamID)
+		a.Equal(4, table[1].Points)
+		a.Equal(uint64(30), table[2].TeamID)
+		a.Equal(3, table[2].Points)
+		a.Equal(uint64(40), table[3].TeamID)
+		a.Equal(1, table[3].Points)
+	})
+
+	t.Run("calculates home and away tables", func(t *testing.T) {
+		t.Helper()
+
+		engine, fixtureRepo, resultRepo, seasonRepo, rulesRepo := newEngine()
+
+		seasonRepo.On("ByID", uint64(16036)).Return(&app.Season{ID: 16036, CompetitionID: 8}, nil)
+		rulesRepo.On("ByCompetitionID", uint64(8)).Return(&app.StandingsRules{}, errors.ErrorNotFound)
+		fixtureRepo.On("Get", app.FixtureRepositoryQuery{SeasonIDs: []uint64{16036}}).Return(leagueFixtures(), nil)
+		mockLeagueResults(resultRepo)
+
+		home, err := engine.AtDate(16036, date, app.StandingsTableHome)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		away, err := engine.AtDate(16036, date, app.StandingsTableAway)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assertStanding(t, home[0], 1, 10, 2, 1, 0, 1, 4, 1, 3)
+		assertStanding(t, home[1], 2, 30, 1, 0, 1, 0, 1, 1, 1)
+		assertStanding(t, home[2], 3, 20, 1, 0, 0, 1, 0, 1, 0)
+		assertStanding(t, home[3], 4, 40, 2, 0, 0, 2, 0, 3, 0)
+		assertStanding(t, away[0], 1, 20, 2, 2, 0, 0, 2, 0, 6)
+		assertStanding(t, away[1], 2, 10, 1, 1, 0, 0, 2, 0, 3)
+		assertStanding(t, away[2], 3, 30, 2, 1, 0, 1, 1, 4, 3)
+		assertStanding(t, away[3], 4, 40, 1, 0, 1, 0, 1, 1, 1)
+	})
+
+	t.Run("returns error if season does not exist", func(t *testing.T) {
+		t.Helper()
+
+		engine, _, _, seasonRepo, _ := newEngine()
+
+		seasonRepo.On("ByID", uint64(16036)).Return(&app.Season{}, e.New("not found"))
+
+		_, err := engine.AtDate(16036, date, app.StandingsTableOverall)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "not found", err.Error())
+	})
+}
+
+func TestEngine_AtRound(t *testing.T) {
+	t.Run("calculates table including fixtures played on the final day of the round", func(t *testing.T) {
+		t.Helper()
+
+		engine, fixtureRepo, resultRepo, seasonRepo, rulesRepo := newEngine()
+
+		round := app.Round{ID: 194967, SeasonID: 16036, EndDate: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)}
+
+		fixtures := []app.Fixture{
+			{ID: 1, HomeTeamID: 10, AwayTeamID: 20, Date: time.Date(2020, 3, 1, 20, 0, 0, 0, time.UTC)},
+			{ID: 2, HomeTeamID: 30, AwayTeamID: 40, Date: time.Date(2020, 3, 2, 12, 0, 0, 0, time.UTC)},
+		}
+
+		seasonRepo.On("ByID", uint64(16036)).Return(&app.Season{ID: 16036, CompetitionID: 8}, nil)
+		rulesRepo.On("ByCompetitionID", uint64(8)).Return(&app.StandingsRules{}, errors.ErrorNotFound)
+		fixtureRepo.On("Get", app.FixtureRepositoryQuery{SeasonIDs: []uint64{16036}}).Return(fixtures, nil)
+		resultRepo.On("BySeasonID", uint64(16036)).Return(leagueResults()[:2], nil)
+
+		table, err := engine.AtRound(&round, app.StandingsTableOverall)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(table))
+		assertStanding(t, table[0], 1, 20, 1, 1, 0, 0, 1, 0, 3)
+		assertStanding(t, table[1], 2, 10, 1, 0, 0, 1, 0, 1, 0)
+	})
+}
+
+func newEngine() (*standings.Engine, *mock.FixtureRepository, *mock.ResultRepository, *mock.SeasonRepository, *mock.StandingsRulesRepository) {
+	fixtureRepo := new(mock.FixtureRepository)
+	resultRepo := new(mock.ResultRepository)
+	seasonRepo := new(mock.SeasonRepository)
+	rulesRepo := new(mock.StandingsRulesRepository)
+
+	return standings.NewEngine(fixtureRepo, resultRepo, seasonRepo, rulesRepo), fixtureRepo, resultRepo, seasonRepo, rulesRepo
+}
+
+func leagueFixtures() []app.Fixture {
+	return []app.Fixture{
+		{ID: 1, HomeTeamID: 10, AwayTeamID: 20},
+		{ID: 2, HomeTeamID: 10, AwayTeamID: 30},
+		{ID: 3, HomeTeamID: 40, AwayTeamID: 10},
+		{ID: 4, HomeTeamID: 20, AwayTeamID: 30},
+		{ID: 5, HomeTeamID: 40, AwayTeamID: 20},
+		{ID: 6, HomeTeamID: 30, AwayTeamID: 40},
+		{ID: 7, HomeTeamID: 40, AwayTeamID: 30},
+	}
+}
+
+func mockLeagueResults(repo *mock.ResultRepository) {
+	repo.On("BySeasonID", uint64(16036)).Return(leagueResults(), nil)
+}
+
+func leagueResults() []app.Result {
+	scores := [][2]int{
+		{0, 1},
+		{4, 0},
+		{0, 2},
+		{0, 1},
+		{0, 1},
+		{1, 1},
+	}
+
+	var results []app.Result
+
+	for i, s := range scores {
+		home, away := s[0], s[1]
+		results = append(results, app.Result{FixtureID: uint64(i + 1), HomeScore: &home, AwayScore: &away})
+	}
+
+	return results
+}
+
+func assertStanding(t *testing.T, s app.Standing, pos int, team uint64, pl, w, d, l, gf, ga, pts int) {
+	t.Helper()
+
+	a := assert.New(t)
+
+	a.Equal(pos, s.Position)
+	a.Equal(team, s.TeamID)
+	a.Equal(pl, s.Played)
+	a.Equal(w, s.Won)
+	a.Equal(d, s.Drawn)
+	a.Equal(l, s.Lost)
+	a.Equal(gf, s.GoalsFor)
+	a.Equal(ga, s.GoalsAgainst)
+	a.Equal(pts, s.Points)
+}
@@ -0,0 +1,210 @@
+package app
+
+import (
+	"sort"
+	"time"
+)
+
+// Card types recorded against a CardEvent by the data provider.
+const (
+	CardTypeYellow       = "yellowcard"
+	CardTypeRed          = "redcard"
+	CardTypeSecondYellow = "yellowred"
+)
+
+// Reasons a player can be suspended.
+const (
+	SuspensionReasonYellowAccumulation = "yellow_accumulation"
+	SuspensionReasonStraightRed        = "straight_red"
+	SuspensionReasonSecondYellow       = "second_yellow"
+)
+
+// YellowCardThreshold bans a player for a number of matches once they have collected the number of yellow cards
+// provided. Before limits the threshold to yellow cards collected within a team's first N fixtures of the season,
+// zero applies the threshold for the whole season.
+type YellowCardThreshold struct {
+	Yellows int `json:"yellows"`
+	Matches int `json:"matches"`
+	Before  int `json:"before"`
+}
+
+// SuspensionRules configures how suspensions are calculated for a competition.
+type SuspensionRules struct {
+	CompetitionID       uint64                `json:"competition_id"`
+	YellowThresholds    []YellowCardThreshold `json:"yellow_thresholds"`
+	StraightRedMatches  int                   `json:"straight_red_matches"`
+	SecondYellowMatches int                   `json:"second_yellow_matches"`
+	CreatedAt           time.Time             `json:"created_at"`
+	UpdatedAt           time.Time             `json:"updated_at"`
+}
+
+// DefaultSuspensionRules returns the rules used for competitions without configured rules. Five, ten and fifteen
+// yellow cards carry one, two and three match bans, a straight red card three matches and a second yellow card
+// one match.
+func DefaultSuspensionRules(competitionID uint64) SuspensionRules {
+	return SuspensionRules{
+		CompetitionID: competitionID,
+		YellowThresholds: []YellowCardThreshold{
+			{Yellows: 5, Matches: 1},
+			{Yellows: 10, Matches: 2},
+			{Yellows: 15, Matches: 3},
+		},
+		StraightRedMatches:  3,
+		SecondYellowMatches: 1,
+	}
+}
+
+// Suspension domain entity. TriggerFixtureID is the fixture the card or cards leading to the suspension were
+// received in and FixtureIDs the fixtures the player is banned for. FixtureIDs can contain fewer fixtures than
+// Matches if the team has fewer fixtures remaining in the season.
+type Suspension struct {
+	PlayerID         uint64    `json:"player_id"`
+	TeamID           uint64    `json:"team_id"`
+	SeasonID         uint64    `json:"season_id"`
+	Reason           string    `json:"reason"`
+	TriggerFixtureID uint64    `json:"trigger_fixture_id"`
+	Matches          int       `json:"matches"`
+	FixtureIDs       []uint64  `json:"fixture_ids"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// TeamSuspensions is every suspension calculated for a team in a season.
+type TeamSuspensions struct {
+	TeamID      uint64
+	SeasonID    uint64
+	Suspensions []Suspension
+}
+
+// SuspensionRulesRepository provides an interface to persist SuspensionRules domain struct objects to a storage engine.
+type SuspensionRulesRepository interface {
+	Save(r *SuspensionRules) error
+	ByCompetitionID(id uint64) (*SuspensionRules, error)
+}
+
+// SuspensionRepository provides an interface to persist Suspension domain struct objects to a storage engine.
+type SuspensionRepository interface {
+	ReplaceForTeam(t *TeamSuspensions) error
+	// ByFixtureID returns the suspensions banning players from the fixture provided.
+	ByFixtureID(id uint64) ([]Suspension, error)
+	ByPlayerID(id uint64) ([]Suspension, error)
+}
+
+// CalculateSuspensions walks a team's fixtures for a season, provided in date order, and returns the suspensions
+// resulting from the cards the team's players received. Cards are keyed by fixture ID, fixtures without cards are
+// treated as played without a booking. Postponed, cancelled, abandoned and deleted fixtures are not played so are
+// skipped, a ban is not served in them and they do not count towards the fixtures of a yellow card threshold. Yellow
+// cards received in a fixture the player was sent off in for a second yellow card do not count towards accumulation.
+// A player receiving multiple bans serves them consecutively.
+func CalculateSuspensions(teamID, seasonID uint64, fixtures []Fixture, cards map[uint64][]*CardEvent, rules SuspensionRules) []Suspension {
+	fixtures = playedFixtures(fixtures)
+	yellows := map[uint64]int{}
+	available := map[uint64]int{}
+	suspensions := []Suspension{}
+
+	ban := func(playerID uint64, index int, reason string, matches int) {
+		if matches <= 0 {
+			return
+		}
+
+		start := index + 1
+
+		if available[playerID] > start {
+			start = available[playerID]
+		}
+
+		end := start + matches
+
+		if end > len(fixtures) {
+			end = len(fixtures)
+		}
+
+		if start > end {
+			start = end
+		}
+
+		s := Suspension{
+			PlayerID:         playerID,
+			TeamID:           teamID,
+			SeasonID:         seasonID,
+			Reason:           reason,
+			TriggerFixtureID: fixtures[index].ID,
+			Matches:          matches,
+			FixtureIDs:       []uint64{},
+		}
+
+		for _, f := range fixtures[start:end] {
+			s.FixtureIDs = append(s.FixtureIDs, f.ID)
+		}
+
+		available[playerID] = start + matches
+		suspensions = append(suspensions, s)
+	}
+
+	for i, f := range fixtures {
+		events := teamCards(teamID, cards[f.ID])
+		sentOff := map[uint64]bool{}
+
+		for _, c := range events {
+			if c.Type == CardTypeSecondYellow {
+				sentOff[c.PlayerID] = true
+			}
+		}
+
+		for _, c := range events {
+			switch c.Type {
+			case CardTypeYellow:
+				if sentOff[c.PlayerID] {
+					continue
+				}
+
+				yellows[c.PlayerID]++
+
+				for _, t := range rules.YellowThresholds {
+					if t.Yellows == yellows[c.PlayerID] && (t.Before == 0 || i < t.Before) {
+						ban(c.PlayerID, i, SuspensionReasonYellowAccumulation, t.Matches)
+					}
+				}
+			case CardTypeRed:
+				ban(c.PlayerID, i, SuspensionReasonStraightRed, rules.StraightRedMatches)
+			case CardTypeSecondYellow:
+				ban(c.PlayerID, i, SuspensionReasonSecondYellow, rules.SecondYellowMatches)
+			}
+		}
+	}
+
+	return suspensions
+}
+
+// playedFixtures returns the fixtures that have been or are still to be played, keeping the order provided.
+func playedFixtures(fixtures []Fixture) []Fixture {
+	played := make([]Fixture, 0, len(fixtures))
+
+	for _, f := range fixtures {
+		if f.StatusGroup() != FixtureStatusGroupCancelled {
+			played = append(played, f)
+		}
+	}
+
+	return played
+}
+
+// teamCards returns the cards received by the team's players ordered by the minute they were received.
+func teamCards(teamID uint64, cards []*CardEvent) []*CardEvent {
+	var events []*CardEvent
+
+	for _, c := range cards {
+		if c.TeamID == teamID {
+			events = append(events, c)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].Minute == events[j].Minute {
+			return events[i].ID < events[j].ID
+		}
+
+		return events[i].Minute < events[j].Minute
+	})
+
+	return events
+}
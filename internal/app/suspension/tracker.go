@@ -0,0 +1,113 @@
+package suspension
+
+import (
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"sort"
+)
+
+// Tracker calculates player suspensions from the card events held in storage using the SuspensionRules
+// configured for the competition a season belongs to.
+type Tracker struct {
+	fixtureRepo app.FixtureRepository
+	eventRepo   app.EventRepository
+	seasonRepo  app.SeasonRepository
+	rulesRepo   app.SuspensionRulesRepository
+	clock       clockwork.Clock
+}
+
+// ForSeason walks the fixture list of every team playing in a season and returns the suspensions resulting from
+// cards received in fixtures kicking off on or before the current time.
+func (t *Tracker) ForSeason(seasonID uint64) ([]app.TeamSuspensions, error) {
+	season, err := t.seasonRepo.ByID(seasonID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := t.Rules(season.CompetitionID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	order := "date_asc"
+
+	fixtures, err := t.fixtureRepo.Get(app.FixtureRepositoryQuery{SeasonIDs: []uint64{seasonID}, SortBy: &order})
+
+	if err != nil {
+		return nil, err
+	}
+
+	cards := map[uint64][]*app.CardEvent{}
+	teams := map[uint64][]app.Fixture{}
+
+	for _, f := range fixtures {
+		teams[f.HomeTeamID] = append(teams[f.HomeTeamID], f)
+		teams[f.AwayTeamID] = append(teams[f.AwayTeamID], f)
+
+		if f.Date.After(t.clock.Now()) {
+			continue
+		}
+
+		events, err := t.eventRepo.CardEventsForFixture(f.ID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		cards[f.ID] = events
+	}
+
+	var suspensions []app.TeamSuspensions
+
+	for _, id := range teamIDs(teams) {
+		suspensions = append(suspensions, app.TeamSuspensions{
+			TeamID:      id,
+			SeasonID:    seasonID,
+			Suspensions: app.CalculateSuspensions(id, seasonID, teams[id], cards, rules),
+		})
+	}
+
+	return suspensions, nil
+}
+
+// Rules returns the rules configured for a competition falling back to the default rules if none are configured.
+func (t *Tracker) Rules(competitionID uint64) (app.SuspensionRules, error) {
+	rules, err := t.rulesRepo.ByCompetitionID(competitionID)
+
+	if err == errors.ErrorNotFound {
+		return app.DefaultSuspensionRules(competitionID), nil
+	}
+
+	if err != nil {
+		return app.SuspensionRules{}, err
+	}
+
+	return *rules, nil
+}
+
+func teamIDs(teams map[uint64][]app.Fixture) []uint64 {
+	var ids []uint64
+
+	for id := range teams {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	return ids
+}
+
+func NewTracker(
+	f app.FixtureRepository,
+	e app.EventRepository,
+	s app.SeasonRepository,
+	r app.SuspensionRulesRepository,
+	c clockwork.Clock,
+) *Tracker {
+	return &Tracker{fixtureRepo: f, eventRepo: e, seasonRepo: s, rulesRepo: r, clock: c}
+}
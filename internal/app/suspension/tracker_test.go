@@ -0,0 +1,207 @@
+package suspension_test
+
+import (
+	e "errors"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/suspension"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestTracker_ForSeason(t *testing.T) {
+	t.Run("calculates suspensions for each team using configured rules", func(t *testing.T) {
+		t.Helper()
+
+		tracker, fixtureRepo, eventRepo, seasonRepo, rulesRepo := newTracker()
+
+		rules := app.SuspensionRules{
+			CompetitionID: 8,
+			YellowThresholds: []app.YellowCardThreshold{
+				{Yellows: 2, Matches: 1},
+				{Yellows: 3, Matches: 2, Before: 2},
+			},
+			StraightRedMatches:  2,
+			SecondYellowMatches: 1,
+		}
+
+		seasonRepo.On("ByID", uint64(16036)).Return(&app.Season{ID: 16036, CompetitionID: 8}, nil)
+		rulesRepo.On("ByCompetitionID", uint64(8)).Return(&rules, nil)
+		fixtureRepo.On("Get", m.MatchedBy(func(q app.FixtureRepositoryQuery) bool {
+			return q.SeasonIDs[0] == 16036 && *q.SortBy == "date_asc"
+		})).Return(seasonFixtures(), nil)
+		mockCards(eventRepo)
+
+		suspensions, err := tracker.ForSeason(16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(4, len(suspensions))
+		a.Equal(uint64(10), suspensions[0].TeamID)
+		a.Equal(uint64(16036), suspensions[0].SeasonID)
+		a.Equal(uint64(20), suspensions[1].TeamID)
+		a.Equal(0, len(suspensions[2].Suspensions))
+		a.Equal(0, len(suspensions[3].Suspensions))
+
+		home := suspensions[0].Suspensions
+
+		a.Equal(6, len(home))
+		assertSuspension(t, home[0], 100, app.SuspensionReasonYellowAccumulation, 2, 1, []uint64{3})
+		assertSuspension(t, home[1], 105, app.SuspensionReasonYellowAccumulation, 2, 1, []uint64{3})
+		assertSuspension(t, home[2], 101, app.SuspensionReasonSecondYellow, 2, 1, []uint64{3})
+		assertSuspension(t, home[3], 100, app.SuspensionReasonStraightRed, 2, 2, []uint64{4, 5})
+		assertSuspension(t, home[4], 102, app.SuspensionReasonStraightRed, 3, 2, []uint64{4, 5})
+		assertSuspension(t, home[5], 103, app.SuspensionReasonStraightRed, 4, 2, []uint64{5, 6})
+
+		away := suspensions[1].Suspensions
+
+		a.Equal(1, len(away))
+		assertSuspension(t, away[0], 200, app.SuspensionReasonYellowAccumulation, 4, 1, []uint64{})
+		eventRepo.AssertNotCalled(t, "CardEventsForFixture", uint64(5))
+		eventRepo.AssertNotCalled(t, "CardEventsForFixture", uint64(6))
+	})
+
+	t.Run("uses default rules if competition has no configured rules", func(t *testing.T) {
+		t.Helper()
+
+		tracker, fixtureRepo, eventRepo, seasonRepo, rulesRepo := newTracker()
+
+		seasonRepo.On("ByID", uint64(16036)).Return(&app.Season{ID: 16036, CompetitionID: 8}, nil)
+		rulesRepo.On("ByCompetitionID", uint64(8)).Return(&app.SuspensionRules{}, errors.ErrorNotFound)
+		fixtureRepo.On("Get", m.AnythingOfType("app.FixtureRepositoryQuery")).Return(seasonFixtures(), nil)
+		mockCards(eventRepo)
+
+		suspensions, err := tracker.ForSeason(16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		home := suspensions[0].Suspensions
+
+		assert.Equal(t, 4, len(home))
+		assertSuspension(t, home[0], 101, app.SuspensionReasonSecondYellow, 2, 1, []uint64{3})
+		assertSuspension(t, home[1], 100, app.SuspensionReasonStraightRed, 2, 3, []uint64{3, 4, 5})
+		assertSuspension(t, home[2], 102, app.SuspensionReasonStraightRed, 3, 3, []uint64{4, 5, 6})
+		assertSuspension(t, home[3], 103, app.SuspensionReasonStraightRed, 4, 3, []uint64{5, 6})
+	})
+
+	t.Run("does not serve bans in fixtures that are not played", func(t *testing.T) {
+		t.Helper()
+
+		tracker, fixtureRepo, eventRepo, seasonRepo, rulesRepo := newTracker()
+
+		postponed := app.FixtureStatusPostponed
+		fixtures := seasonFixtures()
+		fixtures[3].Status = &postponed
+
+		seasonRepo.On("ByID", uint64(16036)).Return(&app.Season{ID: 16036, CompetitionID: 8}, nil)
+		rulesRepo.On("ByCompetitionID", uint64(8)).Return(&app.SuspensionRules{}, errors.ErrorNotFound)
+		fixtureRepo.On("Get", m.AnythingOfType("app.FixtureRepositoryQuery")).Return(fixtures, nil)
+		mockCards(eventRepo)
+
+		suspensions, err := tracker.ForSeason(16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		home := suspensions[0].Suspensions
+
+		assert.Equal(t, 3, len(home))
+		assertSuspension(t, home[0], 101, app.SuspensionReasonSecondYellow, 2, 1, []uint64{3})
+		assertSuspension(t, home[1], 100, app.SuspensionReasonStraightRed, 2, 3, []uint64{3, 5, 6})
+		assertSuspension(t, home[2], 102, app.SuspensionReasonStraightRed, 3, 3, []uint64{5, 6})
+	})
+
+	t.Run("returns error if season does not exist", func(t *testing.T) {
+		t.Helper()
+
+		tracker, _, _, seasonRepo, _ := newTracker()
+
+		seasonRepo.On("ByID", uint64(16036)).Return(&app.Season{}, e.New("not found"))
+
+		_, err := tracker.ForSeason(16036)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "not found", err.Error())
+	})
+}
+
+func newTracker() (*suspension.Tracker, *mock.FixtureRepository, *mock.EventRepository, *mock.SeasonRepository, *mock.SuspensionRulesRepository) {
+	fixtureRepo := new(mock.FixtureRepository)
+	eventRepo := new(mock.EventRepository)
+	seasonRepo := new(mock.SeasonRepository)
+	rulesRepo := new(mock.SuspensionRulesRepository)
+	clock := clockwork.NewFakeClockAt(time.Date(2020, 3, 4, 18, 0, 0, 0, time.UTC))
+
+	return suspension.NewTracker(fixtureRepo, eventRepo, seasonRepo, rulesRepo, clock), fixtureRepo, eventRepo, seasonRepo, rulesRepo
+}
+
+func seasonFixtures() []app.Fixture {
+	date := func(day int) time.Time {
+		return time.Date(2020, 3, day, 15, 0, 0, 0, time.UTC)
+	}
+
+	return []app.Fixture{
+		{ID: 1, HomeTeamID: 10, AwayTeamID: 20, Date: date(1)},
+		{ID: 2, HomeTeamID: 30, AwayTeamID: 10, Date: date(2)},
+		{ID: 3, HomeTeamID: 10, AwayTeamID: 40, Date: date(3)},
+		{ID: 4, HomeTeamID: 20, AwayTeamID: 10, Date: date(4)},
+		{ID: 5, HomeTeamID: 10, AwayTeamID: 30, Date: date(5)},
+		{ID: 6, HomeTeamID: 40, AwayTeamID: 10, Date: date(6)},
+	}
+}
+
+func mockCards(repo *mock.EventRepository) {
+	card := func(id, fixtureID, teamID, playerID uint64, cardType string, minute uint8) *app.CardEvent {
+		return &app.CardEvent{ID: id, FixtureID: fixtureID, TeamID: teamID, PlayerID: playerID, Type: cardType, Minute: minute}
+	}
+
+	repo.On("CardEventsForFixture", uint64(1)).Return([]*app.CardEvent{
+		card(1, 1, 20, 200, app.CardTypeYellow, 25),
+		card(2, 1, 10, 100, app.CardTypeYellow, 5),
+		card(3, 1, 10, 105, app.CardTypeYellow, 15),
+	}, nil)
+
+	repo.On("CardEventsForFixture", uint64(2)).Return([]*app.CardEvent{
+		card(4, 2, 10, 100, app.CardTypeYellow, 10),
+		card(5, 2, 10, 105, app.CardTypeYellow, 20),
+		card(6, 2, 10, 101, app.CardTypeYellow, 30),
+		card(7, 2, 10, 101, app.CardTypeSecondYellow, 60),
+		card(8, 2, 10, 100, app.CardTypeRed, 80),
+	}, nil)
+
+	repo.On("CardEventsForFixture", uint64(3)).Return([]*app.CardEvent{
+		card(9, 3, 10, 102, app.CardTypeRed, 50),
+	}, nil)
+
+	repo.On("CardEventsForFixture", uint64(4)).Return([]*app.CardEvent{
+		card(10, 4, 10, 105, app.CardTypeYellow, 10),
+		card(11, 4, 20, 200, app.CardTypeYellow, 30),
+		card(12, 4, 10, 103, app.CardTypeRed, 70),
+	}, nil)
+}
+
+func assertSuspension(t *testing.T, s app.Suspension, player uint64, reason string, trigger uint64, matches int, fixtures []uint64) {
+	t.Helper()
+
+	a := assert.New(t)
+
+	a.Equal(player, s.PlayerID)
+	a.Equal(reason, s.Reason)
+	a.Equal(trigger, s.TriggerFixtureID)
+	a.Equal(matches, s.Matches)
+	a.Equal(fixtures, s.FixtureIDs)
+}
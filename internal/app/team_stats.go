@@ -56,10 +56,11 @@ type TeamAttacks struct {
 	Dangerous *int `json:"dangerous"`
 }
 
-// TeamStatsRepository provides an interface to persist TeamStats domain struct objects to a storage engine.
+// TeamStatsRepository provides an interface to persist TeamStats domain struct objects to a storage engine. The
+// DomainEvent structs provided to InsertTeamStats and UpdateTeamStats are published atomically with the write.
 type TeamStatsRepository interface {
-	InsertTeamStats(m *TeamStats) error
-	UpdateTeamStats(m *TeamStats) error
+	InsertTeamStats(m *TeamStats, events ...DomainEvent) error
+	UpdateTeamStats(m *TeamStats, events ...DomainEvent) error
 	ByFixtureAndTeam(fixtureID, teamID uint64) (*TeamStats, error)
 	StatByFixtureAndTeam(stat string, fixtureID, teamID uint64) (*TeamStat, error)
 	Get() ([]*TeamStats, error)
@@ -71,5 +72,7 @@ type TeamStatsRepository interface {
 type TeamStatsRequester interface {
 	TeamStatsByFixtureIDs(ids []uint64) <-chan TeamStats
 	TeamStatsBySeasonIDs(ids []uint64) <-chan TeamStats
+	// TeamStatsBySeasonID requests the team stats of a single season, returning an error if the request fails.
+	TeamStatsBySeasonID(seasonID uint64) ([]TeamStats, error)
 	TeamStatsByDate(date time.Time, competitionIDs []uint64) <-chan TeamStats
 }
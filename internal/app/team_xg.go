@@ -12,9 +12,10 @@ type FixtureTeamXG struct {
 }
 
 // FixtureTeamXGRepository provides an interface to persist FixtureTeamXG domain struct objects to a storage engine.
+// The DomainEvent structs provided to Insert and Update are published atomically with the write.
 type FixtureTeamXGRepository interface {
-	Insert(f *FixtureTeamXG) error
-	Update(f *FixtureTeamXG) error
+	Insert(f *FixtureTeamXG, events ...DomainEvent) error
+	Update(f *FixtureTeamXG, events ...DomainEvent) error
 	ByID(id uint64) (*FixtureTeamXG, error)
 	ByFixtureID(id uint64) (*FixtureTeamXG, error)
 }
@@ -14,14 +14,16 @@ var (
 	Clock = clockwork.NewFakeClockAt(now)
 )
 
-func GetConnection(t *testing.T, table string) (*sql.DB, func()) {
+func DSN() string {
 	db := bootstrap.BuildConfig().Database
 
 	dsn := "host=%s port=%s user=%s " + "password=%s dbname=%s sslmode=disable"
 
-	psqlInfo := fmt.Sprintf(dsn, db.Host, db.Port, db.User, db.Password, db.Name)
+	return fmt.Sprintf(dsn, db.Host, db.Port, db.User, db.Password, db.Name)
+}
 
-	conn, err := sql.Open(db.Driver, psqlInfo)
+func GetConnection(t *testing.T, table string) (*sql.DB, func()) {
+	conn, err := sql.Open(bootstrap.BuildConfig().Database.Driver, DSN())
 
 	if err != nil {
 		panic(err)
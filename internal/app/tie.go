@@ -0,0 +1,144 @@
+package app
+
+import "sort"
+
+// Methods a knockout tie can be decided by.
+const (
+	TieDecidedByAggregate = "aggregate"
+	TieDecidedByAwayGoals = "away_goals"
+	TieDecidedByPenalties = "penalties"
+)
+
+// Tie is a knockout tie played over one or two legs. HomeTeamID is the home team of the first leg and scores are
+// aggregated across every leg from the perspective of the first leg.
+type Tie struct {
+	AggregateID *uint64  `json:"aggregate_id"`
+	StageID     *uint64  `json:"stage_id"`
+	FixtureIDs  []uint64 `json:"fixture_ids"`
+	HomeTeamID  uint64   `json:"home_team_id"`
+	AwayTeamID  uint64   `json:"away_team_id"`
+	HomeScore   int      `json:"home_score"`
+	AwayScore   int      `json:"away_score"`
+	Complete    bool     `json:"complete"`
+	WinnerID    *uint64  `json:"winner_id"`
+	DecidedBy   string   `json:"decided_by"`
+}
+
+// CalculateTie aggregates the results of the legs of a knockout tie. Legs without a result are treated as not yet
+// played and a tie with an aggregate ID is only complete once both legs have been played. The winner of a complete
+// tie is decided by aggregate score, then by a penalty shoot out in the final leg and finally by away goals.
+func CalculateTie(legs []Fixture, results map[uint64]*Result) Tie {
+	sorted := make([]Fixture, len(legs))
+	copy(sorted, legs)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date.Before(sorted[j].Date)
+	})
+
+	var tie Tie
+
+	if len(sorted) == 0 {
+		return tie
+	}
+
+	first := sorted[0]
+
+	tie.AggregateID = first.AggregateID
+	tie.StageID = first.StageID
+	tie.HomeTeamID = first.HomeTeamID
+	tie.AwayTeamID = first.AwayTeamID
+	tie.Complete = first.AggregateID == nil || len(sorted) == 2
+
+	var homeAwayGoals, awayAwayGoals int
+
+	for _, leg := range sorted {
+		tie.FixtureIDs = append(tie.FixtureIDs, leg.ID)
+
+		res, ok := results[leg.ID]
+
+		if !ok || res == nil || res.HomeScore == nil || res.AwayScore == nil {
+			tie.Complete = false
+			continue
+		}
+
+		if leg.HomeTeamID == tie.HomeTeamID {
+			tie.HomeScore += *res.HomeScore
+			tie.AwayScore += *res.AwayScore
+			awayAwayGoals += *res.AwayScore
+			continue
+		}
+
+		tie.HomeScore += *res.AwayScore
+		tie.AwayScore += *res.HomeScore
+		homeAwayGoals += *res.AwayScore
+	}
+
+	if !tie.Complete {
+		return tie
+	}
+
+	if tie.HomeScore != tie.AwayScore {
+		tie.decide(tie.HomeScore > tie.AwayScore, TieDecidedByAggregate)
+		return tie
+	}
+
+	last := sorted[len(sorted)-1]
+
+	if res := results[last.ID]; res.HomePenScore != nil && res.AwayPenScore != nil && *res.HomePenScore != *res.AwayPenScore {
+		lastHomeWon := *res.HomePenScore > *res.AwayPenScore
+		tie.decide(lastHomeWon == (last.HomeTeamID == tie.HomeTeamID), TieDecidedByPenalties)
+		return tie
+	}
+
+	if len(sorted) == 2 && homeAwayGoals != awayAwayGoals {
+		tie.decide(homeAwayGoals > awayAwayGoals, TieDecidedByAwayGoals)
+	}
+
+	return tie
+}
+
+func (t *Tie) decide(homeWon bool, by string) {
+	winner := t.AwayTeamID
+
+	if homeWon {
+		winner = t.HomeTeamID
+	}
+
+	t.WinnerID = &winner
+	t.DecidedBy = by
+}
+
+// GroupTies groups the fixtures of a knockout stage into ties using each fixture's aggregate ID. Fixtures without
+// an aggregate ID are single leg ties. Ties are ordered by the date of their first leg.
+func GroupTies(fixtures []Fixture) [][]Fixture {
+	var ties [][]Fixture
+
+	index := map[uint64]int{}
+
+	for _, f := range fixtures {
+		if f.AggregateID == nil {
+			ties = append(ties, []Fixture{f})
+			continue
+		}
+
+		if i, ok := index[*f.AggregateID]; ok {
+			ties[i] = append(ties[i], f)
+			continue
+		}
+
+		index[*f.AggregateID] = len(ties)
+		ties = append(ties, []Fixture{f})
+	}
+
+	for _, legs := range ties {
+		sort.SliceStable(legs, func(i, j int) bool {
+			return legs[i].Date.Before(legs[j].Date)
+		})
+	}
+
+	sort.SliceStable(ties, func(i, j int) bool {
+		return ties[i][0].Date.Before(ties[j][0].Date)
+	})
+
+	return ties
+}
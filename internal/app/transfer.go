@@ -0,0 +1,74 @@
+package app
+
+import (
+	"sort"
+	"time"
+)
+
+// Transfer domain entity. Type is the description provided by the data provider i.e. "Transfer" or "Loan" and
+// Fee the amount as reported by the data provider, nil if the fee was undisclosed.
+type Transfer struct {
+	PlayerID   uint64    `json:"player_id"`
+	FromTeamID uint64    `json:"from_team_id"`
+	ToTeamID   uint64    `json:"to_team_id"`
+	SeasonID   *uint64   `json:"season_id"`
+	Date       time.Time `json:"date"`
+	Type       string    `json:"type"`
+	Fee        *string   `json:"fee"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// ClubSpell is a period a player spent at a team. A nil LeftDate means the player remains at the team.
+type ClubSpell struct {
+	TeamID     uint64     `json:"team_id"`
+	JoinedDate time.Time  `json:"joined_date"`
+	LeftDate   *time.Time `json:"left_date"`
+	Type       string     `json:"type"`
+	Fee        *string    `json:"fee"`
+}
+
+// TransferRepository provides an interface to persist Transfer domain struct objects to a storage engine.
+type TransferRepository interface {
+	// Save inserts the transfer or updates the existing transfer of the player between the same teams on the same date.
+	Save(t *Transfer) error
+	ByPlayerID(id uint64) ([]Transfer, error)
+}
+
+// TransferRequester provides an interface allowing this application to request data from an external
+// data provider. The requester implementation is responsible for creating the channel, filtering struct data into
+// the channel before closing the channel once successful execution is complete.
+type TransferRequester interface {
+	TransfersBySeasonIDs(ids []uint64) <-chan Transfer
+}
+
+// ClubHistory builds the clubs a player has played for from their transfers, ordered by the date the player joined.
+// Each transfer starts a spell at the team the player moved to which ends on the date of the player's next transfer.
+func ClubHistory(transfers []Transfer) []ClubSpell {
+	sorted := make([]Transfer, len(transfers))
+	copy(sorted, transfers)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date.Before(sorted[j].Date)
+	})
+
+	history := []ClubSpell{}
+
+	for i, t := range sorted {
+		spell := ClubSpell{
+			TeamID:     t.ToTeamID,
+			JoinedDate: t.Date,
+			Type:       t.Type,
+			Fee:        t.Fee,
+		}
+
+		if i < len(sorted)-1 {
+			left := sorted[i+1].Date
+			spell.LeftDate = &left
+		}
+
+		history = append(history, spell)
+	}
+
+	return history
+}
@@ -0,0 +1,78 @@
+package understat
+
+import (
+	"encoding/json"
+	"fmt"
+	usParser "github.com/statistico/statistico-understat-parser"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Fixture is the fixture data parsed from Understat league pages.
+type Fixture = usParser.Fixture
+
+var datesData = regexp.MustCompile(`datesData\s+=\s+JSON.parse\('(.*?)'\)`)
+
+// Parser parses data embedded in Understat pages, requesting pages using the HTTP client provided rather than the
+// default HTTP client so the client transport can be configured without affecting other requests.
+type Parser struct {
+	HTTPClient *http.Client
+	BaseURL    string
+}
+
+func (p Parser) LeagueFixtures(league, season string) ([]Fixture, error) {
+	body, err := p.get(fmt.Sprintf("%s/league/%s/%s", p.BaseURL, league, season))
+
+	if err != nil {
+		return nil, err
+	}
+
+	matches := datesData.FindStringSubmatch(body)
+
+	if len(matches) < 2 {
+		return nil, fmt.Errorf("no fixture data found for league %s and season %s", league, season)
+	}
+
+	data, err := strconv.Unquote(`"` + strings.NewReplacer(" ", "", "\n", "").Replace(matches[1]) + `"`)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var fixtures []Fixture
+
+	if err := json.Unmarshal([]byte(data), &fixtures); err != nil {
+		return nil, err
+	}
+
+	return fixtures, nil
+}
+
+func (p Parser) get(url string) (string, error) {
+	resp, err := p.HTTPClient.Get(url)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("request to %s returned status code %d", url, resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(body), nil
+}
+
+func NewParser(client *http.Client, baseURL string) *Parser {
+	return &Parser{HTTPClient: client, BaseURL: baseURL}
+}
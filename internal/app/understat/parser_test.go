@@ -0,0 +1,83 @@
+package understat_test
+
+import (
+	"bytes"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/understat"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestParser_LeagueFixtures(t *testing.T) {
+	t.Run("parses fixtures using the client provided", func(t *testing.T) {
+		t.Helper()
+
+		var url string
+
+		client := mock.HttpClient(func(req *http.Request) (*http.Response, error) {
+			url = req.URL.String()
+
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(leaguePage)),
+			}, nil
+		})
+
+		parser := understat.NewParser(client, "https://understat.com")
+
+		fixtures, err := parser.LeagueFixtures("EPL", "2020")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal("https://understat.com/league/EPL/2020", url)
+		a.Equal(1, len(fixtures))
+		a.Equal("14086", fixtures[0].ID)
+		a.Equal("1.2", *fixtures[0].XG.Home)
+		a.Equal("0.8", *fixtures[0].XG.Away)
+		a.True(fixtures[0].IsResult)
+	})
+
+	t.Run("returns error if the page does not contain fixture data", func(t *testing.T) {
+		t.Helper()
+
+		client := mock.HttpClient(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString("<html></html>")),
+			}, nil
+		})
+
+		parser := understat.NewParser(client, "https://understat.com")
+
+		_, err := parser.LeagueFixtures("EPL", "2020")
+
+		assert.Equal(t, "no fixture data found for league EPL and season 2020", err.Error())
+	})
+
+	t.Run("returns error if the request is unsuccessful", func(t *testing.T) {
+		t.Helper()
+
+		client := mock.HttpClient(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 503,
+				Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+			}, nil
+		})
+
+		parser := understat.NewParser(client, "https://understat.com")
+
+		_, err := parser.LeagueFixtures("EPL", "2020")
+
+		assert.Equal(t, "request to https://understat.com/league/EPL/2020 returned status code 503", err.Error())
+	})
+}
+
+var leaguePage = `<script>
+	var datesData = JSON.parse('\x5B\x7B\x22id\x22\x3A\x2214086\x22,\x22isResult\x22\x3Atrue,\x22h\x22\x3A\x7B\x22id\x22\x3A\x2289\x22\x7D,\x22a\x22\x3A\x7B\x22id\x22\x3A\x2287\x22\x7D,\x22xG\x22\x3A\x7B\x22h\x22\x3A\x221.2\x22,\x22a\x22\x3A\x220.8\x22\x7D\x7D\x5D');
+</script>`
@@ -0,0 +1,99 @@
+package app
+
+import "time"
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// WebhookSubscription registers a URL to be sent DomainEvent notifications. Subscriptions receive the event types
+// listed, optionally limited to fixtures in the competitions listed. Payloads are signed using the secret so the
+// receiver can verify they were sent by this application.
+type WebhookSubscription struct {
+	ID             uint64    `json:"id"`
+	URL            string    `json:"url"`
+	EventTypes     []string  `json:"event_types"`
+	CompetitionIDs []uint64  `json:"competition_ids"`
+	Secret         string    `json:"secret"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// SubscribesTo returns true if the subscription includes the event type provided.
+func (w WebhookSubscription) SubscribesTo(event string) bool {
+	for _, t := range w.EventTypes {
+		if t == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Matches returns true if the subscription should be sent the event for a fixture in the competition provided.
+func (w WebhookSubscription) Matches(event string, competitionID uint64) bool {
+	if !w.SubscribesTo(event) {
+		return false
+	}
+
+	if len(w.CompetitionIDs) == 0 {
+		return true
+	}
+
+	for _, id := range w.CompetitionIDs {
+		if id == competitionID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WebhookSubscriptionRepository provides an interface to persist WebhookSubscription domain struct objects to a
+// storage engine.
+type WebhookSubscriptionRepository interface {
+	Insert(s *WebhookSubscription) error
+	Delete(id uint64) error
+	All() ([]WebhookSubscription, error)
+}
+
+// WebhookDelivery is a DomainEvent payload queued for sending to a WebhookSubscription. Deliveries remain pending
+// until the subscription URL accepts the payload or the maximum number of attempts is reached.
+type WebhookDelivery struct {
+	ID             uint64
+	SubscriptionID uint64
+	EventName      string
+	Payload        []byte
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// WebhookDeliveryAttempt records the outcome of a single attempt to send a WebhookDelivery.
+type WebhookDeliveryAttempt struct {
+	DeliveryID  uint64
+	Attempt     int
+	StatusCode  *int
+	Error       *string
+	AttemptedAt time.Time
+}
+
+// WebhookDeliveryRepository provides an interface to persist WebhookDelivery and WebhookDeliveryAttempt domain
+// struct objects to a storage engine.
+type WebhookDeliveryRepository interface {
+	Insert(d *WebhookDelivery) error
+	Update(d *WebhookDelivery) error
+	// Due returns pending deliveries with an ID greater than the ID provided whose next attempt is due ordered by
+	// ID ascending.
+	Due(afterID uint64, limit uint64) ([]WebhookDelivery, error)
+	InsertAttempt(a *WebhookDeliveryAttempt) error
+}
+
+// WebhookSender sends the payload of a WebhookDelivery to the URL of a WebhookSubscription, returning the HTTP
+// status code of the response, or zero if no response was received, and an error if the payload was not accepted.
+type WebhookSender interface {
+	Send(s WebhookSubscription, d WebhookDelivery) (int, error)
+}
@@ -0,0 +1,115 @@
+package webhook
+
+import (
+	"encoding/json"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"time"
+)
+
+// Dispatcher queues a WebhookDelivery for each subscription matching the domain events it handles. Deliveries are
+// sent separately by the webhook delivery processor so a slow or unavailable receiver does not hold up the relay of
+// domain events.
+type Dispatcher struct {
+	subscriptionRepo app.WebhookSubscriptionRepository
+	deliveryRepo     app.WebhookDeliveryRepository
+	fixtureRepo      app.FixtureRepository
+	seasonRepo       app.SeasonRepository
+	clock            clockwork.Clock
+}
+
+type payload struct {
+	Event         string          `json:"event"`
+	FixtureID     uint64          `json:"fixture_id"`
+	CompetitionID uint64          `json:"competition_id"`
+	Data          app.DomainEvent `json:"data"`
+	CreatedAt     string          `json:"created_at"`
+}
+
+// Handle is an app.EventHandler queueing deliveries for the event provided. An error is returned if the deliveries
+// could not be queued so the event is handled again.
+func (d *Dispatcher) Handle(e app.DomainEvent) error {
+	subs, err := d.subscriptionRepo.All()
+
+	if err != nil {
+		return err
+	}
+
+	var candidates []app.WebhookSubscription
+
+	for _, s := range subs {
+		if s.SubscribesTo(e.Name()) {
+			candidates = append(candidates, s)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	competitionID, err := d.competitionID(e.AggregateID())
+
+	if err != nil {
+		return err
+	}
+
+	now := d.clock.Now()
+
+	body, err := json.Marshal(payload{
+		Event:         e.Name(),
+		FixtureID:     e.AggregateID(),
+		CompetitionID: competitionID,
+		Data:          e,
+		CreatedAt:     now.UTC().Format(time.RFC3339),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, s := range candidates {
+		if !s.Matches(e.Name(), competitionID) {
+			continue
+		}
+
+		delivery := app.WebhookDelivery{
+			SubscriptionID: s.ID,
+			EventName:      e.Name(),
+			Payload:        body,
+			Status:         app.WebhookDeliveryPending,
+			NextAttemptAt:  now,
+		}
+
+		if err := d.deliveryRepo.Insert(&delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) competitionID(fixtureID uint64) (uint64, error) {
+	f, err := d.fixtureRepo.ByID(fixtureID)
+
+	if err != nil {
+		return 0, err
+	}
+
+	s, err := d.seasonRepo.ByID(f.SeasonID)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return s.CompetitionID, nil
+}
+
+func NewDispatcher(
+	s app.WebhookSubscriptionRepository,
+	d app.WebhookDeliveryRepository,
+	f app.FixtureRepository,
+	r app.SeasonRepository,
+	c clockwork.Clock,
+) *Dispatcher {
+	return &Dispatcher{subscriptionRepo: s, deliveryRepo: d, fixtureRepo: f, seasonRepo: r, clock: c}
+}
@@ -0,0 +1,111 @@
+package webhook_test
+
+import (
+	"errors"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/webhook"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestDispatcher_Handle(t *testing.T) {
+	clock := clockwork.NewFakeClockAt(time.Date(2021, 2, 10, 15, 0, 0, 0, time.UTC))
+
+	t.Run("queues delivery for each subscription matching the event type and competition", func(t *testing.T) {
+		t.Helper()
+
+		subRepo := new(mock.WebhookSubscriptionRepository)
+		deliveryRepo := new(mock.WebhookDeliveryRepository)
+		fixtureRepo := new(mock.FixtureRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		dispatcher := webhook.NewDispatcher(subRepo, deliveryRepo, fixtureRepo, seasonRepo, clock)
+
+		subs := []app.WebhookSubscription{
+			{ID: 1, EventTypes: []string{"result.finalised"}},
+			{ID: 2, EventTypes: []string{"result.finalised"}, CompetitionIDs: []uint64{8}},
+			{ID: 3, EventTypes: []string{"result.finalised"}, CompetitionIDs: []uint64{564}},
+			{ID: 4, EventTypes: []string{"team-stats.updated"}},
+		}
+
+		subRepo.On("All").Return(subs, nil)
+		fixtureRepo.On("ByID", uint64(45)).Return(&app.Fixture{ID: 45, SeasonID: 17420}, nil)
+		seasonRepo.On("ByID", uint64(17420)).Return(&app.Season{ID: 17420, CompetitionID: 8}, nil)
+
+		var queued []*app.WebhookDelivery
+
+		deliveryRepo.On("Insert", m.AnythingOfType("*app.WebhookDelivery")).
+			Run(func(args m.Arguments) { queued = append(queued, args.Get(0).(*app.WebhookDelivery)) }).
+			Return(nil)
+
+		home, away := 2, 1
+
+		if err := dispatcher.Handle(app.ResultFinalised{FixtureID: 45, HomeScore: &home, AwayScore: &away}); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(queued))
+		a.Equal(uint64(1), queued[0].SubscriptionID)
+		a.Equal(uint64(2), queued[1].SubscriptionID)
+		a.Equal("result.finalised", queued[0].EventName)
+		a.Equal("pending", queued[0].Status)
+		a.Equal(clock.Now(), queued[0].NextAttemptAt)
+		a.JSONEq(
+			`{
+				"event": "result.finalised",
+				"fixture_id": 45,
+				"competition_id": 8,
+				"data": {"fixture_id": 45, "home_score": 2, "away_score": 1},
+				"created_at": "2021-02-10T15:00:00Z"
+			}`,
+			string(queued[0].Payload),
+		)
+	})
+
+	t.Run("does not look up fixture if no subscription includes the event type", func(t *testing.T) {
+		t.Helper()
+
+		subRepo := new(mock.WebhookSubscriptionRepository)
+		deliveryRepo := new(mock.WebhookDeliveryRepository)
+		fixtureRepo := new(mock.FixtureRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		dispatcher := webhook.NewDispatcher(subRepo, deliveryRepo, fixtureRepo, seasonRepo, clock)
+
+		subRepo.On("All").Return([]app.WebhookSubscription{{ID: 4, EventTypes: []string{"team-stats.updated"}}}, nil)
+
+		if err := dispatcher.Handle(app.FixtureRescheduled{FixtureID: 45}); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		fixtureRepo.AssertNotCalled(t, "ByID", m.Anything)
+		deliveryRepo.AssertNotCalled(t, "Insert", m.Anything)
+	})
+
+	t.Run("returns error if delivery cannot be queued", func(t *testing.T) {
+		t.Helper()
+
+		subRepo := new(mock.WebhookSubscriptionRepository)
+		deliveryRepo := new(mock.WebhookDeliveryRepository)
+		fixtureRepo := new(mock.FixtureRepository)
+		seasonRepo := new(mock.SeasonRepository)
+		dispatcher := webhook.NewDispatcher(subRepo, deliveryRepo, fixtureRepo, seasonRepo, clock)
+
+		subRepo.On("All").Return([]app.WebhookSubscription{{ID: 1, EventTypes: []string{"team-stats.updated"}}}, nil)
+		fixtureRepo.On("ByID", uint64(45)).Return(&app.Fixture{ID: 45, SeasonID: 17420}, nil)
+		seasonRepo.On("ByID", uint64(17420)).Return(&app.Season{ID: 17420, CompetitionID: 8}, nil)
+		deliveryRepo.On("Insert", m.AnythingOfType("*app.WebhookDelivery")).Return(errors.New("oh no"))
+
+		err := dispatcher.Handle(app.TeamStatsUpdated{FixtureID: 45, TeamID: 1})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "oh no", err.Error())
+	})
+}
@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+)
+
+const (
+	DeliveryHeader  = "X-Statistico-Delivery"
+	EventHeader     = "X-Statistico-Event"
+	SignatureHeader = "X-Statistico-Signature"
+)
+
+// Sign returns the signature sent in the SignatureHeader, a hex encoded HMAC-SHA256 of the request body using the
+// subscription secret as the key prefixed with the hash algorithm i.e. "sha256=5d5d13...". Receivers should compute
+// the same value from the raw request body and compare the two using a constant time comparison.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// HTTPSender sends webhook deliveries as signed JSON POST requests. Any 2xx response is treated as accepted.
+type HTTPSender struct {
+	client *http.Client
+}
+
+func (h *HTTPSender) Send(s app.WebhookSubscription, d app.WebhookDelivery) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, s.URL, bytes.NewReader(d.Payload))
+
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "statistico-football-data")
+	req.Header.Set(DeliveryHeader, strconv.FormatUint(d.ID, 10))
+	req.Header.Set(EventHeader, d.EventName)
+	req.Header.Set(SignatureHeader, Sign(s.Secret, d.Payload))
+
+	res, err := h.client.Do(req)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer res.Body.Close()
+
+	// Drain a limited amount of the body so the connection can be reused.
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(res.Body, 64*1024))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("webhook endpoint responded with status %d", res.StatusCode)
+	}
+
+	return res.StatusCode, nil
+}
+
+func NewHTTPSender(client *http.Client) *HTTPSender {
+	return &HTTPSender{client: client}
+}
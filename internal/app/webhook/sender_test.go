@@ -0,0 +1,94 @@
+package webhook_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/webhook"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPSender_Send(t *testing.T) {
+	sub := app.WebhookSubscription{ID: 3, Secret: "s3cr3t"}
+	delivery := app.WebhookDelivery{ID: 901, EventName: "result.finalised", Payload: []byte(`{"event":"result.finalised"}`)}
+
+	t.Run("posts signed payload to the subscription URL", func(t *testing.T) {
+		t.Helper()
+
+		var received *http.Request
+		var body []byte
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = ioutil.ReadAll(r.Body)
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		sub.URL = server.URL
+
+		code, err := webhook.NewHTTPSender(server.Client()).Send(sub, delivery)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(http.StatusAccepted, code)
+		a.Equal(http.MethodPost, received.Method)
+		a.Equal("application/json", received.Header.Get("Content-Type"))
+		a.Equal("901", received.Header.Get("X-Statistico-Delivery"))
+		a.Equal("result.finalised", received.Header.Get("X-Statistico-Event"))
+		a.Equal(webhook.Sign("s3cr3t", body), received.Header.Get("X-Statistico-Signature"))
+		a.Equal(`{"event":"result.finalised"}`, string(body))
+	})
+
+	t.Run("returns status code and error if the receiver does not accept the payload", func(t *testing.T) {
+		t.Helper()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		sub.URL = server.URL
+
+		code, err := webhook.NewHTTPSender(server.Client()).Send(sub, delivery)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, "webhook endpoint responded with status 503", err.Error())
+	})
+
+	t.Run("returns zero status code if no response is received", func(t *testing.T) {
+		t.Helper()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		sub.URL = server.URL
+		server.Close()
+
+		code, err := webhook.NewHTTPSender(http.DefaultClient).Send(sub, delivery)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, 0, code)
+	})
+}
+
+func TestSign(t *testing.T) {
+	t.Run("returns hex encoded HMAC-SHA256 of the body prefixed with the algorithm", func(t *testing.T) {
+		t.Helper()
+
+		sig := webhook.Sign("key", []byte("The quick brown fox jumps over the lazy dog"))
+
+		assert.Equal(t, "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", sig)
+	})
+}
@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"net/url"
+	"strings"
+)
+
+// Prepare validates a new subscription, generating a random secret if one has not been provided.
+func Prepare(s *app.WebhookSubscription) error {
+	u, err := url.Parse(s.URL)
+
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url '%s' must be an absolute http or https URL", s.URL)
+	}
+
+	if len(s.EventTypes) == 0 {
+		return fmt.Errorf("at least one event type must be provided")
+	}
+
+	for _, t := range s.EventTypes {
+		if !isDomainEvent(t) {
+			return fmt.Errorf(
+				"event type '%s' is not supported, event types available are %s",
+				t,
+				strings.Join(app.DomainEventNames, ", "),
+			)
+		}
+	}
+
+	if s.Secret != "" {
+		return nil
+	}
+
+	b := make([]byte, 32)
+
+	if _, err := rand.Read(b); err != nil {
+		return err
+	}
+
+	s.Secret = hex.EncodeToString(b)
+
+	return nil
+}
+
+func isDomainEvent(name string) bool {
+	for _, n := range app.DomainEventNames {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
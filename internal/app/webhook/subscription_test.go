@@ -0,0 +1,71 @@
+package webhook_test
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/webhook"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPrepare(t *testing.T) {
+	t.Run("generates secret if one is not provided", func(t *testing.T) {
+		t.Helper()
+
+		s := app.WebhookSubscription{URL: "https://example.com/hooks", EventTypes: []string{"result.finalised"}}
+
+		if err := webhook.Prepare(&s); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 64, len(s.Secret))
+	})
+
+	t.Run("keeps secret provided", func(t *testing.T) {
+		t.Helper()
+
+		s := app.WebhookSubscription{URL: "http://localhost:8080", EventTypes: []string{"fixture-xg.updated"}, Secret: "abc"}
+
+		if err := webhook.Prepare(&s); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "abc", s.Secret)
+	})
+
+	t.Run("returns error if subscription is invalid", func(t *testing.T) {
+		t.Helper()
+
+		tests := []struct {
+			sub app.WebhookSubscription
+			err string
+		}{
+			{
+				app.WebhookSubscription{URL: "/hooks", EventTypes: []string{"result.finalised"}},
+				"url '/hooks' must be an absolute http or https URL",
+			},
+			{
+				app.WebhookSubscription{URL: "ftp://example.com", EventTypes: []string{"result.finalised"}},
+				"url 'ftp://example.com' must be an absolute http or https URL",
+			},
+			{
+				app.WebhookSubscription{URL: "https://example.com"},
+				"at least one event type must be provided",
+			},
+			{
+				app.WebhookSubscription{URL: "https://example.com", EventTypes: []string{"goal.scored"}},
+				"event type 'goal.scored' is not supported, event types available are fixture.rescheduled, " +
+					"fixture-xg.updated, result.finalised, team-stats.updated",
+			},
+		}
+
+		for _, tc := range tests {
+			err := webhook.Prepare(&tc.sub)
+
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+
+			assert.Equal(t, tc.err, err.Error())
+		}
+	})
+}
@@ -0,0 +1,33 @@
+package worker
+
+import (
+	"context"
+	"io"
+	"os/exec"
+)
+
+// Runner runs a console command, returning an error if the command does not complete successfully.
+type Runner interface {
+	Run(ctx context.Context, command, option string) error
+}
+
+// ConsoleRunner runs commands using the console binary in a child process, so a processor exiting the process on a
+// fatal error is recorded as a failed run rather than stopping the worker. The child process is killed if the
+// context is cancelled.
+type ConsoleRunner struct {
+	path   string
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func (c *ConsoleRunner) Run(ctx context.Context, command, option string) error {
+	cmd := exec.CommandContext(ctx, c.path, "-command="+command, "-option="+option)
+	cmd.Stdout = c.stdout
+	cmd.Stderr = c.stderr
+
+	return cmd.Run()
+}
+
+func NewConsoleRunner(path string, stdout, stderr io.Writer) *ConsoleRunner {
+	return &ConsoleRunner{path: path, stdout: stdout, stderr: stderr}
+}
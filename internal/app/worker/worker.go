@@ -0,0 +1,172 @@
+package worker
+
+import (
+	"context"
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"time"
+)
+
+const pollInterval = 5 * time.Second
+
+const heartbeatInterval = 30 * time.Second
+
+// staleAfter is how long after its last heartbeat a running job is considered abandoned by its worker, i.e. because
+// the worker was killed, and can be claimed by another worker.
+const staleAfter = 2 * time.Minute
+
+// retryBackoff is the delay before a failed job is retried, doubling with each attempt.
+const retryBackoff = time.Minute
+
+// Worker claims jobs from the JobQueue and runs them using the Runner, one job at a time. Heartbeats are sent whilst
+// a job is running and failed jobs are retried with an exponential backoff until the job's attempts are exhausted.
+type Worker struct {
+	id     string
+	queue  app.JobQueue
+	runner Runner
+	clock  clockwork.Clock
+	logger *logrus.Logger
+}
+
+// Run claims and runs jobs until the context is cancelled. A job in progress when the context is cancelled is run to
+// completion before Run returns.
+func (w *Worker) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		if w.next() {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.clock.After(pollInterval):
+		}
+	}
+}
+
+// next claims and runs the next job due, returning false if no job was claimed.
+func (w *Worker) next() bool {
+	j, err := w.queue.Claim(w.id, w.clock.Now().Add(-staleAfter))
+
+	if err == errors.ErrorNotFound {
+		return false
+	}
+
+	if err != nil {
+		w.logger.Errorf("Error '%s' occurred when claiming job on worker %s", err.Error(), w.id)
+		return false
+	}
+
+	w.run(j)
+
+	return true
+}
+
+func (w *Worker) run(j *app.Job) {
+	// A job abandoned by its worker during the final attempt is claimed again with its attempts exhausted.
+	if j.Attempts > j.MaxAttempts {
+		w.fail(j, "worker stopped sending heartbeats")
+		return
+	}
+
+	w.logger.Infof("Running job %d '%s %s' on worker %s, attempt %d of %d", j.ID, j.Command, j.Option, w.id, j.Attempts, j.MaxAttempts)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go w.heartbeat(ctx, cancel, j.ID)
+
+	err := w.runner.Run(ctx, j.Command, j.Option)
+
+	// The context is only cancelled before the run completes if the job has been claimed by another worker.
+	lost := ctx.Err() != nil
+
+	cancel()
+
+	if lost {
+		return
+	}
+
+	if err != nil {
+		w.retryOrFail(j, err.Error())
+		return
+	}
+
+	if err := w.queue.Complete(j.ID, w.id); err != nil {
+		w.logOutcomeError(err, j, "Error '%s' occurred when marking job %d as complete")
+		return
+	}
+
+	w.logger.Infof("Job %d '%s %s' complete", j.ID, j.Command, j.Option)
+}
+
+// heartbeat records the job as running on the worker until the context is cancelled, cancelling the context if the
+// job has been claimed by another worker.
+func (w *Worker) heartbeat(ctx context.Context, cancel context.CancelFunc, id uint64) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.clock.After(heartbeatInterval):
+		}
+
+		err := w.queue.Heartbeat(id, w.id)
+
+		if err == errors.ErrorNotFound {
+			w.logger.Warningf("Job %d is no longer claimed by worker %s, stopping job", id, w.id)
+			cancel()
+			return
+		}
+
+		if err != nil {
+			w.logger.Warningf("Error '%s' occurred when sending heartbeat for job %d", err.Error(), id)
+		}
+	}
+}
+
+func (w *Worker) retryOrFail(j *app.Job, reason string) {
+	if j.Attempts >= j.MaxAttempts {
+		w.fail(j, reason)
+		return
+	}
+
+	at := w.clock.Now().Add(retryBackoff << uint(j.Attempts-1))
+
+	if err := w.queue.Retry(j.ID, w.id, reason, at); err != nil {
+		w.logOutcomeError(err, j, "Error '%s' occurred when queueing job %d for retry")
+		return
+	}
+
+	w.logger.Warningf("Error '%s' occurred when running job %d, retrying at %s", reason, j.ID, at.Format(time.RFC3339))
+}
+
+func (w *Worker) fail(j *app.Job, reason string) {
+	if err := w.queue.Fail(j.ID, w.id, reason); err != nil {
+		w.logOutcomeError(err, j, "Error '%s' occurred when marking job %d as failed")
+		return
+	}
+
+	w.logger.Errorf("Job %d '%s %s' failed after %d attempts: %s", j.ID, j.Command, j.Option, j.MaxAttempts, reason)
+}
+
+// logOutcomeError logs an error recording the outcome of a job, warning that the outcome is discarded if the job
+// has been claimed by another worker in the meantime.
+func (w *Worker) logOutcomeError(err error, j *app.Job, format string) {
+	if err == errors.ErrorNotFound {
+		w.logger.Warningf("Job %d is no longer claimed by worker %s, outcome not recorded", j.ID, w.id)
+		return
+	}
+
+	w.logger.Errorf(format, err.Error(), j.ID)
+}
+
+func NewWorker(id string, q app.JobQueue, r Runner, c clockwork.Clock, log *logrus.Logger) *Worker {
+	return &Worker{id: id, queue: q, runner: r, clock: c, logger: log}
+}
@@ -0,0 +1,207 @@
+package worker_test
+
+import (
+	"context"
+	e "errors"
+	"github.com/jonboulle/clockwork"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/errors"
+	"github.com/statistico/statistico-football-data/internal/app/mock"
+	"github.com/statistico/statistico-football-data/internal/app/worker"
+	"github.com/stretchr/testify/assert"
+	m "github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestWorker_Run(t *testing.T) {
+	now := time.Date(2021, 2, 12, 10, 0, 0, 0, time.UTC)
+	staleBefore := now.Add(-2 * time.Minute)
+
+	t.Run("runs claimed job and marks it as complete", func(t *testing.T) {
+		t.Helper()
+
+		queue := new(mock.JobQueue)
+		logger, hook := test.NewNullLogger()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		var ran []string
+
+		runner := runnerFunc(func(ctx context.Context, command, option string) error {
+			ran = append(ran, command, option)
+			return nil
+		})
+
+		w := worker.NewWorker("worker-1", queue, runner, clockwork.NewFakeClockAt(now), logger)
+
+		job := app.Job{ID: 45, Command: "team-stats:by-season-id", Option: "16036", Attempts: 1, MaxAttempts: 3}
+
+		queue.On("Claim", "worker-1", staleBefore).Return(&job, nil).Once()
+		queue.On("Complete", uint64(45), "worker-1").Run(func(args m.Arguments) { cancel() }).Return(nil)
+
+		w.Run(ctx)
+
+		queue.AssertExpectations(t)
+		assert.Equal(t, []string{"team-stats:by-season-id", "16036"}, ran)
+		assert.Equal(t, "Job 45 'team-stats:by-season-id 16036' complete", hook.LastEntry().Message)
+	})
+
+	t.Run("retries failed job with an exponential backoff", func(t *testing.T) {
+		t.Helper()
+
+		queue := new(mock.JobQueue)
+		logger, hook := test.NewNullLogger()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		runner := runnerFunc(func(ctx context.Context, command, option string) error {
+			return e.New("exit status 1")
+		})
+
+		w := worker.NewWorker("worker-1", queue, runner, clockwork.NewFakeClockAt(now), logger)
+
+		job := app.Job{ID: 45, Command: "results:by-season-id", Option: "16036", Attempts: 2, MaxAttempts: 3}
+
+		queue.On("Claim", "worker-1", staleBefore).Return(&job, nil).Once()
+		queue.On("Retry", uint64(45), "worker-1", "exit status 1", now.Add(2*time.Minute)).
+			Run(func(args m.Arguments) { cancel() }).
+			Return(nil)
+
+		w.Run(ctx)
+
+		queue.AssertExpectations(t)
+		queue.AssertNotCalled(t, "Complete", m.Anything, m.Anything)
+		assert.Equal(t, "Error 'exit status 1' occurred when running job 45, retrying at 2021-02-12T10:02:00Z", hook.LastEntry().Message)
+	})
+
+	t.Run("marks job as failed once attempts are exhausted", func(t *testing.T) {
+		t.Helper()
+
+		queue := new(mock.JobQueue)
+		logger, hook := test.NewNullLogger()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		runner := runnerFunc(func(ctx context.Context, command, option string) error {
+			return e.New("exit status 1")
+		})
+
+		w := worker.NewWorker("worker-1", queue, runner, clockwork.NewFakeClockAt(now), logger)
+
+		job := app.Job{ID: 45, Command: "results:by-season-id", Option: "16036", Attempts: 3, MaxAttempts: 3}
+
+		queue.On("Claim", "worker-1", staleBefore).Return(&job, nil).Once()
+		queue.On("Fail", uint64(45), "worker-1", "exit status 1").Run(func(args m.Arguments) { cancel() }).Return(nil)
+
+		w.Run(ctx)
+
+		queue.AssertExpectations(t)
+		queue.AssertNotCalled(t, "Retry", m.Anything, m.Anything, m.Anything, m.Anything)
+		assert.Equal(t, "Job 45 'results:by-season-id 16036' failed after 3 attempts: exit status 1", hook.LastEntry().Message)
+	})
+
+	t.Run("logs warning if job is claimed by another worker before it is marked as complete", func(t *testing.T) {
+		t.Helper()
+
+		queue := new(mock.JobQueue)
+		logger, hook := test.NewNullLogger()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		runner := runnerFunc(func(ctx context.Context, command, option string) error {
+			return nil
+		})
+
+		w := worker.NewWorker("worker-1", queue, runner, clockwork.NewFakeClockAt(now), logger)
+
+		job := app.Job{ID: 45, Command: "results:by-season-id", Option: "16036", Attempts: 1, MaxAttempts: 3}
+
+		queue.On("Claim", "worker-1", staleBefore).Return(&job, nil).Once()
+		queue.On("Complete", uint64(45), "worker-1").
+			Run(func(args m.Arguments) { cancel() }).
+			Return(errors.ErrorNotFound)
+
+		w.Run(ctx)
+
+		queue.AssertExpectations(t)
+		assert.Equal(t, "Job 45 is no longer claimed by worker worker-1, outcome not recorded", hook.LastEntry().Message)
+	})
+
+	t.Run("marks job abandoned on its final attempt as failed without running it", func(t *testing.T) {
+		t.Helper()
+
+		queue := new(mock.JobQueue)
+		logger, _ := test.NewNullLogger()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		runner := runnerFunc(func(ctx context.Context, command, option string) error {
+			t.Fatal("Expected job not to be run")
+			return nil
+		})
+
+		w := worker.NewWorker("worker-1", queue, runner, clockwork.NewFakeClockAt(now), logger)
+
+		job := app.Job{ID: 45, Command: "results:by-season-id", Option: "16036", Attempts: 4, MaxAttempts: 3}
+
+		queue.On("Claim", "worker-1", staleBefore).Return(&job, nil).Once()
+		queue.On("Fail", uint64(45), "worker-1", "worker stopped sending heartbeats").Run(func(args m.Arguments) { cancel() }).Return(nil)
+
+		w.Run(ctx)
+
+		queue.AssertExpectations(t)
+	})
+
+	t.Run("stops job if it is no longer claimed by the worker", func(t *testing.T) {
+		t.Helper()
+
+		queue := new(mock.JobQueue)
+		logger, hook := test.NewNullLogger()
+		clock := clockwork.NewFakeClockAt(now)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		runner := runnerFunc(func(c context.Context, command, option string) error {
+			clock.BlockUntil(1)
+			clock.Advance(30 * time.Second)
+			<-c.Done()
+			cancel()
+			return c.Err()
+		})
+
+		w := worker.NewWorker("worker-1", queue, runner, clock, logger)
+
+		job := app.Job{ID: 45, Command: "results:by-season-id", Option: "16036", Attempts: 1, MaxAttempts: 3}
+
+		queue.On("Claim", "worker-1", staleBefore).Return(&job, nil).Once()
+		queue.On("Heartbeat", uint64(45), "worker-1").Return(errors.ErrorNotFound)
+
+		w.Run(ctx)
+
+		queue.AssertExpectations(t)
+		queue.AssertNotCalled(t, "Complete", m.Anything, m.Anything)
+		queue.AssertNotCalled(t, "Retry", m.Anything, m.Anything, m.Anything, m.Anything)
+		assert.Equal(t, "Job 45 is no longer claimed by worker worker-1, stopping job", hook.LastEntry().Message)
+	})
+
+	t.Run("returns when context is cancelled whilst waiting for a job", func(t *testing.T) {
+		t.Helper()
+
+		queue := new(mock.JobQueue)
+		logger, hook := test.NewNullLogger()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		w := worker.NewWorker("worker-1", queue, new(runnerFunc), clockwork.NewFakeClockAt(now), logger)
+
+		queue.On("Claim", "worker-1", staleBefore).
+			Run(func(args m.Arguments) { cancel() }).
+			Return((*app.Job)(nil), errors.ErrorNotFound)
+
+		w.Run(ctx)
+
+		queue.AssertNumberOfCalls(t, "Claim", 1)
+		assert.Nil(t, hook.LastEntry())
+	})
+}
+
+type runnerFunc func(ctx context.Context, command, option string) error
+
+func (r runnerFunc) Run(ctx context.Context, command, option string) error {
+	return r(ctx, command, option)
+}
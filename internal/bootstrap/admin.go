@@ -0,0 +1,69 @@
+package bootstrap
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app/admin"
+	"github.com/statistico/statistico-football-data/internal/app/grpc"
+)
+
+func (c Container) AdminService() *grpc.AdminService {
+	return grpc.NewAdminService(c.AdminManager(), c.Config.Admin.Token, c.Logger)
+}
+
+// AdminManager returns the manager running console commands in-process on behalf of the admin service. Commands
+// managing application data such as jobs, overrides and webhooks print to the console so are not supported.
+func (c Container) AdminManager() *admin.Manager {
+	var commands []string
+
+	for _, name := range Commands() {
+		if _, ok := CommandLockStrategy(name); ok {
+			commands = append(commands, name)
+		}
+	}
+
+	return admin.NewManager(
+		commands,
+		c.adminProcessor,
+		CommandLockStrategy,
+		c.Locker(),
+		c.CommandRunRepository(),
+		c.Clock,
+		c.Logger,
+	)
+}
+
+// adminProcessor builds the processor for a run from a copy of the container using the run logger, its own database
+// connection pool and a SportMonks client whose requests are aborted when the run is stopped. The pool is separate
+// from the shared pool so stopping the run can close it, failing the queries of the processor once those in flight
+// complete. The pool is closed straight away if the command has no processor.
+func (c Container) adminProcessor(command string, logger *logrus.Logger) (admin.Processor, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	client := *c.SportMonksClient
+	httpClient := *c.SportMonksClient.HTTPClient
+	httpClient.Transport = admin.NewCancelTransport(ctx, httpClient.Transport)
+	client.HTTPClient = &httpClient
+
+	run := c
+	run.Database = databaseConnection(c.Config)
+	run.Logger = logger
+	run.SportMonksClient = &client
+
+	stop := func() {
+		cancel()
+
+		if err := run.Database.Close(); err != nil {
+			c.Logger.Errorf("Error '%s' occurred when closing database connection for '%s'", err.Error(), command)
+		}
+	}
+
+	processor := run.CommandProcessor(command)
+
+	if processor == nil {
+		stop()
+		return nil, func() {}
+	}
+
+	return processor, stop
+}
@@ -0,0 +1,81 @@
+package bootstrap
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"github.com/statistico/statistico-football-data/internal/app/archive"
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+	"net/http"
+)
+
+func (c Container) RawPayloadStore() app.RawPayloadStore {
+	switch c.Config.RawPayload.Store {
+	case "postgres":
+		return postgres.NewRawPayloadStore(c.Database)
+	case "filesystem":
+		return archive.NewFilesystemStore(c.Config.RawPayload.Path)
+	default:
+		return nil
+	}
+}
+
+// archivePayloads wraps the transports of the HTTP clients used to communicate with data providers so response
+// bodies are archived, or served from the archive when replaying. The transports only act on requests to the
+// provider host.
+func (c Container) archivePayloads(config *Config) {
+	store := c.RawPayloadStore()
+
+	if store == nil {
+		if config.RawPayload.Replay {
+			c.Logger.Fatal("A raw payload store must be configured to replay archived payloads")
+		}
+
+		return
+	}
+
+	sportMonks := c.SportMonksClient.HTTPClient
+	understat := c.UnderstatParser.HTTPClient
+
+	if sportMonks.Transport == nil {
+		sportMonks.Transport = http.DefaultTransport
+	}
+
+	if understat.Transport == nil {
+		understat.Transport = http.DefaultTransport
+	}
+
+	if config.RawPayload.Replay {
+		sportMonks.Transport = archive.NewReplayTransport(
+			sportMonks.Transport,
+			store,
+			app.ProviderSportMonks,
+			c.SportMonksClient.BaseURL,
+		)
+
+		understat.Transport = archive.NewReplayTransport(
+			understat.Transport,
+			store,
+			app.ProviderUnderstat,
+			c.UnderstatParser.BaseURL,
+		)
+
+		return
+	}
+
+	sportMonks.Transport = archive.NewTransport(
+		sportMonks.Transport,
+		store,
+		app.ProviderSportMonks,
+		c.SportMonksClient.BaseURL,
+		c.Clock,
+		c.Logger,
+	)
+
+	understat.Transport = archive.NewTransport(
+		understat.Transport,
+		store,
+		app.ProviderUnderstat,
+		c.UnderstatParser.BaseURL,
+		c.Clock,
+		c.Logger,
+	)
+}
@@ -0,0 +1,289 @@
+package bootstrap
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app"
+	"sort"
+)
+
+const cmdCompetition = "competition"
+const cmdCountry = "country"
+const cmdEvents = "events"
+const cmdEventsCurrentSeason = "events:current-season"
+const cmdEventsBySeasonId = "events:by-season-id"
+const cmdFixturesCurrentSeason = "fixtures:current-season"
+const cmdFixturesBySeasonId = "fixtures:by-season-id"
+const cmdFixturesByCompetitionId = "fixtures:by-competition-id"
+const cmdFixtureXG = "fixture-xg"
+const cmdFixtureXGCurrentSeason = "fixture-xg:current-season"
+const cmdImportCSV = "import:csv"
+const cmdIngestionSet = "ingestion:set"
+const cmdIngestionList = "ingestion:list"
+const cmdIngestionRemove = "ingestion:remove"
+const cmdJobEnqueue = "job:enqueue"
+const cmdJobList = "job:list"
+const cmdLineupByDate = "lineup:by-date"
+const cmdLineupByFixtureId = "lineup:by-fixture-id"
+const cmdLineupBySeasonId = "lineup:by-season-id"
+const cmdLineupCurrentSeason = "lineup:current-season"
+const cmdLive = "live"
+const cmdManager = "manager"
+const cmdManagerCurrentSeason = "manager:current-season"
+const cmdManagerBySeasonId = "manager:by-season-id"
+const cmdOddsByFixtureId = "odds:by-fixture-id"
+const cmdOddsUpcoming = "odds:upcoming"
+const cmdOddsCSV = "odds:csv"
+const cmdOutboxRelay = "outbox:relay"
+const cmdOverrideAdd = "override:add"
+const cmdOverrideList = "override:list"
+const cmdOverrideExpire = "override:expire"
+const cmdPlayer = "player"
+const cmdPlayerStatsByDate = "player-stats:by-date"
+const cmdPlayerStatsBySeasonId = "player-stats:by-season-id"
+const cmdPlayerStatsByCompetitionId = "player-stats:by-competition-id"
+const cmdReferee = "referee"
+const cmdRefereeCurrentSeason = "referee:current-season"
+const cmdRefereeBySeasonId = "referee:by-season-id"
+const cmdResultsCurrentSeason = "results:current-season"
+const cmdResultsBySeasonId = "results:by-season-id"
+const cmdResultsByCompetitionId = "results:by-competition-id"
+const cmdRound = "round"
+const cmdRoundCurrentSeason = "round:current-season"
+const cmdSeason = "season"
+const cmdSidelined = "sidelined"
+const cmdSidelinedCurrentSeason = "sidelined:current-season"
+const cmdSidelinedBySeasonId = "sidelined:by-season-id"
+const cmdSquad = "squad"
+const cmdSquadCurrentSeason = "squad:current-season"
+const cmdStage = "stage"
+const cmdStageCurrentSeason = "stage:current-season"
+const cmdStageBySeasonId = "stage:by-season-id"
+const cmdStandings = "standings"
+const cmdStandingsCurrentSeason = "standings:current-season"
+const cmdStandingsBySeasonId = "standings:by-season-id"
+const cmdStandingsRules = "standings:rules"
+const cmdSuspension = "suspension"
+const cmdSuspensionCurrentSeason = "suspension:current-season"
+const cmdSuspensionBySeasonId = "suspension:by-season-id"
+const cmdSuspensionRules = "suspension:rules"
+const cmdTeam = "team"
+const cmdTeamCurrentSeason = "team:current-season"
+const cmdTeamStatsByDate = "team-stats:by-date"
+const cmdTeamStatsBySeasonId = "team-stats:by-season-id"
+const cmdTeamStatsByCompetitionId = "team-stats:by-competition-id"
+const cmdTransfer = "transfer"
+const cmdTransferCurrentSeason = "transfer:current-season"
+const cmdTransferBySeasonId = "transfer:by-season-id"
+const cmdVenue = "venue"
+const cmdVenueCurrentSeason = "venue:current-season"
+const cmdWebhookAdd = "webhook:add"
+const cmdWebhookDeliver = "webhook:deliver"
+const cmdWebhookList = "webhook:list"
+const cmdWebhookRemove = "webhook:remove"
+
+var commands = []string{
+	cmdCompetition,
+	cmdCountry,
+	cmdEvents,
+	cmdEventsCurrentSeason,
+	cmdEventsBySeasonId,
+	cmdFixturesCurrentSeason,
+	cmdFixturesBySeasonId,
+	cmdFixturesByCompetitionId,
+	cmdFixtureXG,
+	cmdFixtureXGCurrentSeason,
+	cmdImportCSV,
+	cmdIngestionSet,
+	cmdIngestionList,
+	cmdIngestionRemove,
+	cmdJobEnqueue,
+	cmdJobList,
+	cmdLineupByDate,
+	cmdLineupByFixtureId,
+	cmdLineupBySeasonId,
+	cmdLineupCurrentSeason,
+	cmdLive,
+	cmdManager,
+	cmdManagerCurrentSeason,
+	cmdManagerBySeasonId,
+	cmdOddsByFixtureId,
+	cmdOddsUpcoming,
+	cmdOddsCSV,
+	cmdOutboxRelay,
+	cmdOverrideAdd,
+	cmdOverrideList,
+	cmdOverrideExpire,
+	cmdPlayer,
+	cmdPlayerStatsByDate,
+	cmdPlayerStatsBySeasonId,
+	cmdPlayerStatsByCompetitionId,
+	cmdReferee,
+	cmdRefereeCurrentSeason,
+	cmdRefereeBySeasonId,
+	cmdResultsCurrentSeason,
+	cmdResultsBySeasonId,
+	cmdResultsByCompetitionId,
+	cmdRound,
+	cmdRoundCurrentSeason,
+	cmdSeason,
+	cmdSidelined,
+	cmdSidelinedCurrentSeason,
+	cmdSidelinedBySeasonId,
+	cmdSquad,
+	cmdSquadCurrentSeason,
+	cmdStage,
+	cmdStageCurrentSeason,
+	cmdStageBySeasonId,
+	cmdStandings,
+	cmdStandingsCurrentSeason,
+	cmdStandingsBySeasonId,
+	cmdStandingsRules,
+	cmdSuspension,
+	cmdSuspensionCurrentSeason,
+	cmdSuspensionBySeasonId,
+	cmdSuspensionRules,
+	cmdTeam,
+	cmdTeamCurrentSeason,
+	cmdTeamStatsByDate,
+	cmdTeamStatsBySeasonId,
+	cmdTeamStatsByCompetitionId,
+	cmdTransfer,
+	cmdTransferCurrentSeason,
+	cmdTransferBySeasonId,
+	cmdVenue,
+	cmdVenueCurrentSeason,
+	cmdWebhookAdd,
+	cmdWebhookDeliver,
+	cmdWebhookList,
+	cmdWebhookRemove,
+}
+
+// Commands returns the name of every command supported by CommandProcessor ordered by name.
+func Commands() []string {
+	c := make([]string, len(commands))
+	copy(c, commands)
+	sort.Strings(c)
+
+	return c
+}
+
+// CommandProcessor returns the processor responsible for the command provided or nil if the command is not supported.
+func (c Container) CommandProcessor(name string) Processor {
+	var processor Processor
+
+	switch name {
+	case cmdCompetition:
+		processor = c.CompetitionProcessor()
+		break
+	case cmdCountry:
+		processor = c.CountryProcessor()
+		break
+	case cmdEvents, cmdEventsCurrentSeason, cmdEventsBySeasonId:
+		processor = c.EventProcessor()
+		break
+	case cmdFixturesCurrentSeason, cmdFixturesBySeasonId, cmdFixturesByCompetitionId:
+		processor = c.FixtureProcessor()
+		break
+	case cmdFixtureXG, cmdFixtureXGCurrentSeason:
+		processor = c.FixtureTeamXGProcessor()
+	case cmdImportCSV:
+		processor = c.CSVImportProcessor()
+		break
+	case cmdIngestionSet, cmdIngestionList, cmdIngestionRemove:
+		processor = c.IngestionPolicyProcessor()
+		break
+	case cmdJobEnqueue, cmdJobList:
+		processor = c.JobProcessor()
+		break
+	case cmdLineupByDate, cmdLineupByFixtureId, cmdLineupBySeasonId, cmdLineupCurrentSeason:
+		processor = c.LineupProcessor()
+		break
+	case cmdLive:
+		processor = c.LiveProcessor()
+		break
+	case cmdManager, cmdManagerCurrentSeason, cmdManagerBySeasonId:
+		processor = c.ManagerProcessor()
+		break
+	case cmdOddsByFixtureId, cmdOddsUpcoming, cmdOddsCSV:
+		processor = c.OddsProcessor()
+		break
+	case cmdOutboxRelay:
+		processor = c.OutboxRelayProcessor()
+		break
+	case cmdOverrideAdd, cmdOverrideList, cmdOverrideExpire:
+		processor = c.DataOverrideProcessor()
+		break
+	case cmdPlayer:
+		processor = c.PlayerProcessor()
+		break
+	case cmdPlayerStatsByDate, cmdPlayerStatsBySeasonId, cmdPlayerStatsByCompetitionId:
+		processor = c.PlayerStatsProcessor()
+		break
+	case cmdReferee, cmdRefereeCurrentSeason, cmdRefereeBySeasonId:
+		processor = c.RefereeProcessor()
+		break
+	case cmdResultsCurrentSeason, cmdResultsBySeasonId, cmdResultsByCompetitionId:
+		processor = c.ResultProcessor()
+		break
+	case cmdRound, cmdRoundCurrentSeason:
+		processor = c.RoundProcessor()
+		break
+	case cmdSeason:
+		processor = c.SeasonProcessor()
+		break
+	case cmdSidelined, cmdSidelinedCurrentSeason, cmdSidelinedBySeasonId:
+		processor = c.SidelinedProcessor()
+		break
+	case cmdSquad, cmdSquadCurrentSeason:
+		processor = c.SquadProcessor()
+		break
+	case cmdStage, cmdStageCurrentSeason, cmdStageBySeasonId:
+		processor = c.StageProcessor()
+		break
+	case cmdStandings, cmdStandingsCurrentSeason, cmdStandingsBySeasonId, cmdStandingsRules:
+		processor = c.StandingsProcessor()
+		break
+	case cmdSuspension, cmdSuspensionCurrentSeason, cmdSuspensionBySeasonId, cmdSuspensionRules:
+		processor = c.SuspensionProcessor()
+		break
+	case cmdTeam, cmdTeamCurrentSeason:
+		processor = c.TeamProcessor()
+		break
+	case cmdTeamStatsByDate, cmdTeamStatsBySeasonId, cmdTeamStatsByCompetitionId:
+		processor = c.TeamStatsProcessor()
+		break
+	case cmdTransfer, cmdTransferCurrentSeason, cmdTransferBySeasonId:
+		processor = c.TransferProcessor()
+		break
+	case cmdVenue, cmdVenueCurrentSeason:
+		processor = c.VenueProcessor()
+		break
+	case cmdWebhookAdd, cmdWebhookDeliver, cmdWebhookList, cmdWebhookRemove:
+		processor = c.WebhookProcessor()
+		break
+	}
+
+	return processor
+}
+
+// CommandLockStrategy returns the strategy used when the command provided is already running in another process,
+// or false if the command is safe to run concurrently and does not require a lock.
+func CommandLockStrategy(name string) (app.LockStrategy, bool) {
+	switch name {
+	case cmdIngestionSet,
+		cmdIngestionList,
+		cmdIngestionRemove,
+		cmdJobEnqueue,
+		cmdJobList,
+		cmdOverrideAdd,
+		cmdOverrideList,
+		cmdOverrideExpire,
+		cmdWebhookAdd,
+		cmdWebhookList,
+		cmdWebhookRemove:
+		return "", false
+	case cmdImportCSV, cmdOddsCSV:
+		return app.LockStrategyWait, true
+	default:
+		return app.LockStrategySkip, true
+	}
+}
@@ -1,14 +1,30 @@
 package bootstrap
 
 import (
+	"github.com/statistico/statistico-football-data/internal/app"
 	"os"
 )
 
 type Config struct {
+	Admin
+	Checkpoint
 	Database
+	Ingestion
+	RawPayload
 	Services
 }
 
+// Admin configures access to the admin gRPC service. The service rejects every request if no token is configured.
+type Admin struct {
+	Token string
+}
+
+// Checkpoint configures whether processors resume from the checkpoints recorded by a previous run of the same command
+// and option. Resume is enabled by the console resume flag.
+type Checkpoint struct {
+	Resume bool
+}
+
 type Database struct {
 	Driver   string
 	Host     string
@@ -18,6 +34,21 @@ type Database struct {
 	Name     string
 }
 
+// Ingestion configures the tier of competitions processed, only competitions whose ingestion policy has the tier
+// are processed. Every tier is processed if none is configured. Tier is set by the console tier flag.
+type Ingestion struct {
+	Tier app.IngestionTier
+}
+
+// RawPayload configures where provider response bodies are archived. Store is either "postgres" or
+// "filesystem", archiving is disabled if no store is configured. Replay serves archived payloads in place of
+// provider requests and is enabled by the console reprocess command.
+type RawPayload struct {
+	Store  string
+	Path   string
+	Replay bool
+}
+
 type Services struct {
 	Sentry
 	SportsMonks
@@ -39,6 +70,8 @@ type Understat struct {
 func BuildConfig() *Config {
 	config := Config{}
 
+	config.Admin = Admin{Token: os.Getenv("ADMIN_TOKEN")}
+
 	config.Database = Database{
 		Driver:   os.Getenv("DB_DRIVER"),
 		Host:     os.Getenv("DB_HOST"),
@@ -48,6 +81,11 @@ func BuildConfig() *Config {
 		Name:     os.Getenv("DB_NAME"),
 	}
 
+	config.RawPayload = RawPayload{
+		Store: os.Getenv("RAW_PAYLOAD_STORE"),
+		Path:  os.Getenv("RAW_PAYLOAD_PATH"),
+	}
+
 	config.Sentry = Sentry{DSN: os.Getenv("SENTRY_DSN")}
 
 	config.SportsMonks = SportsMonks{
@@ -6,8 +6,8 @@ import (
 	"github.com/evalphobia/logrus_sentry"
 	"github.com/jonboulle/clockwork"
 	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-football-data/internal/app/understat"
 	spClient "github.com/statistico/statistico-sportmonks-go-client"
-	understat "github.com/statistico/statistico-understat-parser"
 	"net"
 	"net/http"
 	"os"
@@ -34,18 +34,13 @@ func BuildContainer(config *Config) *Container {
 	c.SportMonksClient = sportMonksClient(config)
 	c.UnderstatParser = understatParser(config)
 
+	c.archivePayloads(config)
+
 	return &c
 }
 
 func databaseConnection(config *Config) *sql.DB {
-	db := config.Database
-
-	dsn := "host=%s port=%s user=%s " +
-		"password=%s dbname=%s sslmode=disable"
-
-	psqlInfo := fmt.Sprintf(dsn, db.Host, db.Port, db.User, db.Password, db.Name)
-
-	conn, err := sql.Open(db.Driver, psqlInfo)
+	conn, err := sql.Open(config.Database.Driver, databaseDSN(config))
 
 	if err != nil {
 		panic(err)
@@ -57,6 +52,15 @@ func databaseConnection(config *Config) *sql.DB {
 	return conn
 }
 
+func databaseDSN(config *Config) string {
+	db := config.Database
+
+	dsn := "host=%s port=%s user=%s " +
+		"password=%s dbname=%s sslmode=disable"
+
+	return fmt.Sprintf(dsn, db.Host, db.Port, db.User, db.Password, db.Name)
+}
+
 func sportMonksClient(config *Config) *spClient.HTTPClient {
 	s := config.Services.SportsMonks
 
@@ -80,8 +84,15 @@ func sportMonksClient(config *Config) *spClient.HTTPClient {
 	return c
 }
 
+// understatParser builds a parser with a dedicated HTTP client so configuring the client transport, such as when
+// archiving payloads, does not affect other requests made using the default HTTP client.
 func understatParser(config *Config) *understat.Parser {
-	return &understat.Parser{BaseURL: config.Understat.BaseURL}
+	client := &http.Client{
+		Timeout:   time.Second * 60,
+		Transport: http.DefaultTransport.(*http.Transport).Clone(),
+	}
+
+	return understat.NewParser(client, config.Understat.BaseURL)
 }
 
 func logger(config *Config) *logrus.Logger {
@@ -0,0 +1,34 @@
+package bootstrap
+
+import "github.com/statistico/statistico-football-data/internal/app/ingestion"
+
+// IngestionSeasonRepository returns a SeasonRepository limited to the seasons the dataset is ingested for by the
+// ingestion policy of each competition.
+func (c Container) IngestionSeasonRepository(dataset string) *ingestion.SeasonRepository {
+	return ingestion.NewSeasonRepository(
+		c.SeasonRepository(),
+		c.CompetitionRepository(),
+		c.IngestionPolicyRepository(),
+		dataset,
+		c.Config.Ingestion.Tier,
+	)
+}
+
+func (c Container) IngestionCompetitionRepository(dataset string) *ingestion.CompetitionRepository {
+	return ingestion.NewCompetitionRepository(
+		c.CompetitionRepository(),
+		c.IngestionPolicyRepository(),
+		dataset,
+		c.Config.Ingestion.Tier,
+	)
+}
+
+func (c Container) IngestionFixtureRepository(dataset string) *ingestion.FixtureRepository {
+	return ingestion.NewFixtureRepository(
+		c.FixtureRepository(),
+		c.SeasonRepository(),
+		c.IngestionPolicyRepository(),
+		dataset,
+		c.Config.Ingestion.Tier,
+	)
+}
@@ -0,0 +1,10 @@
+package bootstrap
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app/postgres"
+)
+
+// Locker returns the locker used by the console to prevent the same command running in two processes at once.
+func (c Container) Locker() *postgres.AdvisoryLocker {
+	return postgres.NewAdvisoryLocker(c.Database, c.Clock, c.Logger)
+}
@@ -1,7 +1,9 @@
 package bootstrap
 
 import (
+	"github.com/statistico/statistico-football-data/internal/app"
 	"github.com/statistico/statistico-football-data/internal/app/process"
+	"os"
 )
 
 type Processor interface {
@@ -24,10 +26,32 @@ func (c Container) CountryProcessor() *process.CountryProcessor {
 	)
 }
 
+func (c Container) CSVImportProcessor() *process.CSVImportProcessor {
+	return process.NewCSVImportProcessor(
+		c.FixtureRepository(),
+		c.ResultRepository(),
+		c.TeamStatsRepository(),
+		c.FixtureIDGenerator(),
+		c.ExternalIDResolver(),
+		c.Logger,
+	)
+}
+
+func (c Container) DataOverrideProcessor() *process.DataOverrideProcessor {
+	return process.NewDataOverrideProcessor(
+		c.DataOverrideRepository(),
+		c.FixtureRepository(),
+		c.ResultRepository(),
+		c.TeamStatsRepository(),
+		os.Stdout,
+		c.Logger,
+	)
+}
+
 func (c Container) EventProcessor() *process.EventProcessor {
 	return process.NewEventProcessor(
 		c.EventRepository(),
-		c.SeasonRepository(),
+		c.IngestionSeasonRepository(app.DatasetEvents),
 		c.EventRequester(),
 		c.Clock,
 		c.Logger,
@@ -37,8 +61,11 @@ func (c Container) EventProcessor() *process.EventProcessor {
 func (c Container) FixtureProcessor() *process.FixtureProcessor {
 	return process.NewFixtureProcessor(
 		c.FixtureRepository(),
-		c.SeasonRepository(),
+		c.IngestionSeasonRepository(app.DatasetFixtures),
 		c.FixtureRequester(),
+		c.FixtureStatusAnomalyRepository(),
+		c.CheckpointRepository(),
+		c.Config.Checkpoint.Resume,
 		c.Logger,
 	)
 }
@@ -47,11 +74,83 @@ func (c Container) FixtureTeamXGProcessor() *process.FixtureTeamXGProcessor {
 	return process.NewFixtureTeamXGProcessor(
 		c.FixtureTeamXGRepository(),
 		c.FixtureRepository(),
+		c.IngestionSeasonRepository(app.DatasetFixtureXG),
+		c.ExternalIDResolver(),
 		c.UnderstatParser,
 		c.Logger,
 	)
 }
 
+func (c Container) IngestionPolicyProcessor() *process.IngestionPolicyProcessor {
+	return process.NewIngestionPolicyProcessor(
+		c.IngestionPolicyRepository(),
+		c.CompetitionRepository(),
+		os.Stdout,
+		c.Logger,
+	)
+}
+
+func (c Container) JobProcessor() *process.JobProcessor {
+	return process.NewJobProcessor(c.JobQueue(), os.Stdout, c.Logger)
+}
+
+func (c Container) LineupProcessor() *process.LineupProcessor {
+	return process.NewLineupProcessor(
+		c.LineupRepository(),
+		c.IngestionCompetitionRepository(app.DatasetLineups),
+		c.IngestionSeasonRepository(app.DatasetLineups),
+		c.LineupRequester(),
+		c.Logger,
+	)
+}
+
+func (c Container) LiveProcessor() *process.LiveProcessor {
+	return process.NewLiveProcessor(
+		c.IngestionFixtureRepository(app.DatasetLive),
+		c.ResultRepository(),
+		c.TeamStatsRepository(),
+		c.EventRepository(),
+		c.FixtureStatusAnomalyRepository(),
+		c.LiveRequester(),
+		c.Clock,
+		c.Logger,
+	)
+}
+
+func (c Container) ManagerProcessor() *process.ManagerProcessor {
+	return process.NewManagerProcessor(
+		c.ManagerRepository(),
+		c.ManagerSightingRepository(),
+		c.ManagerTenureRepository(),
+		c.IngestionSeasonRepository(app.DatasetManagers),
+		c.ManagerRequester(),
+		c.Logger,
+	)
+}
+
+func (c Container) OddsProcessor() *process.OddsProcessor {
+	return process.NewOddsProcessor(
+		c.OddsRepository(),
+		c.IngestionFixtureRepository(app.DatasetOdds),
+		c.OddsRequester(),
+		c.Clock,
+		c.Logger,
+	)
+}
+
+func (c Container) OutboxRelayProcessor() *process.OutboxRelayProcessor {
+	return process.NewOutboxRelayProcessor(c.OutboxRepository(), c.EventBus(), c.Logger)
+}
+
+func (c Container) RefereeProcessor() *process.RefereeProcessor {
+	return process.NewRefereeProcessor(
+		c.RefereeRepository(),
+		c.IngestionSeasonRepository(app.DatasetReferees),
+		c.RefereeRequester(),
+		c.Logger,
+	)
+}
+
 func (c Container) PlayerProcessor() *process.PlayerProcessor {
 	return process.NewPlayerProcessor(
 		c.PlayerRepository(),
@@ -64,9 +163,11 @@ func (c Container) PlayerProcessor() *process.PlayerProcessor {
 func (c Container) PlayerStatsProcessor() *process.PlayerStatsProcessor {
 	return process.NewPlayerStatsProcessor(
 		c.PlayerStatsRepository(),
-		c.CompetitionRepository(),
-		c.SeasonRepository(),
+		c.IngestionCompetitionRepository(app.DatasetPlayerStats),
+		c.IngestionSeasonRepository(app.DatasetPlayerStats),
 		c.PlayerStatsRequester(),
+		c.CheckpointRepository(),
+		c.Config.Checkpoint.Resume,
 		c.Clock,
 		c.Logger,
 	)
@@ -75,8 +176,10 @@ func (c Container) PlayerStatsProcessor() *process.PlayerStatsProcessor {
 func (c Container) ResultProcessor() *process.ResultProcessor {
 	return process.NewResultProcessor(
 		c.ResultRepository(),
-		c.SeasonRepository(),
+		c.IngestionSeasonRepository(app.DatasetResults),
 		c.ResultRequester(),
+		c.CheckpointRepository(),
+		c.Config.Checkpoint.Resume,
 		c.Clock,
 		c.Logger,
 	)
@@ -85,7 +188,7 @@ func (c Container) ResultProcessor() *process.ResultProcessor {
 func (c Container) RoundProcessor() *process.RoundProcessor {
 	return process.NewRoundProcessor(
 		c.RoundRepository(),
-		c.SeasonRepository(),
+		c.IngestionSeasonRepository(app.DatasetRounds),
 		c.RoundRequester(),
 		c.Logger,
 	)
@@ -99,11 +202,53 @@ func (c Container) SeasonProcessor() *process.SeasonProcessor {
 	)
 }
 
+func (c Container) SidelinedProcessor() *process.SidelinedProcessor {
+	return process.NewSidelinedProcessor(
+		c.SidelinedRepository(),
+		c.IngestionSeasonRepository(app.DatasetSidelined),
+		c.SidelinedRequester(),
+		c.Logger,
+	)
+}
+
 func (c Container) SquadProcessor() *process.SquadProcessor {
 	return process.NewSquadProcessor(
 		c.SquadRepository(),
-		c.SeasonRepository(),
+		c.IngestionSeasonRepository(app.DatasetSquads),
 		c.SquadRequester(),
+		c.Clock,
+		c.Logger,
+	)
+}
+
+func (c Container) StageProcessor() *process.StageProcessor {
+	return process.NewStageProcessor(
+		c.StageRepository(),
+		c.GroupRepository(),
+		c.IngestionSeasonRepository(app.DatasetStages),
+		c.StageRequester(),
+		c.Logger,
+	)
+}
+
+func (c Container) StandingsProcessor() *process.StandingsProcessor {
+	return process.NewStandingsProcessor(
+		c.StandingsEngine(),
+		c.RoundRepository(),
+		c.IngestionSeasonRepository(app.DatasetStandings),
+		c.StandingsSnapshotRepository(),
+		c.StandingsRulesRepository(),
+		c.Clock,
+		c.Logger,
+	)
+}
+
+func (c Container) SuspensionProcessor() *process.SuspensionProcessor {
+	return process.NewSuspensionProcessor(
+		c.SuspensionTracker(),
+		c.IngestionSeasonRepository(app.DatasetSuspensions),
+		c.SuspensionRepository(),
+		c.SuspensionRulesRepository(),
 		c.Logger,
 	)
 }
@@ -111,7 +256,7 @@ func (c Container) SquadProcessor() *process.SquadProcessor {
 func (c Container) TeamProcessor() *process.TeamProcessor {
 	return process.NewTeamProcessor(
 		c.TeamRepository(),
-		c.SeasonRepository(),
+		c.IngestionSeasonRepository(app.DatasetTeams),
 		c.TeamRequester(),
 		c.Logger,
 	)
@@ -120,19 +265,41 @@ func (c Container) TeamProcessor() *process.TeamProcessor {
 func (c Container) TeamStatsProcessor() *process.TeamStatsProcessor {
 	return process.NewTeamStatsProcessor(
 		c.TeamStatsRepository(),
-		c.CompetitionRepository(),
-		c.SeasonRepository(),
+		c.IngestionCompetitionRepository(app.DatasetTeamStats),
+		c.IngestionSeasonRepository(app.DatasetTeamStats),
 		c.TeamStatsRequester(),
+		c.CheckpointRepository(),
+		c.Config.Checkpoint.Resume,
 		c.Clock,
 		c.Logger,
 	)
 }
 
+func (c Container) TransferProcessor() *process.TransferProcessor {
+	return process.NewTransferProcessor(
+		c.TransferRepository(),
+		c.IngestionSeasonRepository(app.DatasetTransfers),
+		c.TransferRequester(),
+		c.Logger,
+	)
+}
+
 func (c Container) VenueProcessor() *process.VenueProcessor {
 	return process.NewVenueProcessor(
 		c.VenueRepository(),
-		c.SeasonRepository(),
+		c.IngestionSeasonRepository(app.DatasetVenues),
 		c.VenueRequester(),
 		c.Logger,
 	)
 }
+
+func (c Container) WebhookProcessor() *process.WebhookProcessor {
+	return process.NewWebhookProcessor(
+		c.WebhookSubscriptionRepository(),
+		c.WebhookDeliveryRepository(),
+		c.WebhookSender(),
+		os.Stdout,
+		c.Clock,
+		c.Logger,
+	)
+}
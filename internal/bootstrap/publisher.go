@@ -0,0 +1,31 @@
+package bootstrap
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app/bus"
+	"github.com/statistico/statistico-football-data/internal/app/webhook"
+	"net/http"
+	"time"
+)
+
+// EventBus returns the in-memory publisher domain events stored in the outbox are relayed to.
+func (c Container) EventBus() *bus.MemoryPublisher {
+	b := bus.NewMemoryPublisher()
+	b.Subscribe(bus.LogHandler(c.Logger))
+	b.Subscribe(c.WebhookDispatcher().Handle)
+
+	return b
+}
+
+func (c Container) WebhookDispatcher() *webhook.Dispatcher {
+	return webhook.NewDispatcher(
+		c.WebhookSubscriptionRepository(),
+		c.WebhookDeliveryRepository(),
+		c.FixtureRepository(),
+		c.SeasonRepository(),
+		c.Clock,
+	)
+}
+
+func (c Container) WebhookSender() *webhook.HTTPSender {
+	return webhook.NewHTTPSender(&http.Client{Timeout: 10 * time.Second})
+}
@@ -1,9 +1,18 @@
 package bootstrap
 
 import (
+	"github.com/statistico/statistico-football-data/internal/app/override"
 	"github.com/statistico/statistico-football-data/internal/app/postgres"
 )
 
+func (c Container) CheckpointRepository() *postgres.CheckpointRepository {
+	return postgres.NewCheckpointRepository(c.Database, c.Clock)
+}
+
+func (c Container) CommandRunRepository() *postgres.CommandRunRepository {
+	return postgres.NewCommandRunRepository(c.Database)
+}
+
 func (c Container) CompetitionRepository() *postgres.CompetitionRepository {
 	return postgres.NewCompetitionRepository(c.Database, c.Clock)
 }
@@ -12,18 +21,82 @@ func (c Container) CountryRepository() *postgres.CountryRepository {
 	return postgres.NewCountryRepository(c.Database, c.Clock)
 }
 
+func (c Container) DataOverrideRepository() *postgres.DataOverrideRepository {
+	return postgres.NewDataOverrideRepository(c.Database, c.Clock)
+}
+
 func (c Container) EventRepository() *postgres.EventRepository {
 	return postgres.NewEventRepository(c.Database, c.Clock)
 }
 
-func (c Container) FixtureRepository() *postgres.FixtureRepository {
-	return postgres.NewFixtureRepository(c.Database, c.Clock)
+func (c Container) ExternalIDRepository() *postgres.ExternalIDRepository {
+	return postgres.NewExternalIDRepository(c.Database, c.Clock)
+}
+
+func (c Container) FixtureRepository() *override.FixtureRepository {
+	return override.NewFixtureRepository(
+		postgres.NewFixtureRepository(c.Database, c.Clock),
+		c.DataOverrideRepository(),
+		c.Logger,
+	)
+}
+
+func (c Container) FixtureIDGenerator() *postgres.FixtureIDGenerator {
+	return postgres.NewFixtureIDGenerator(c.Database)
+}
+
+func (c Container) FixtureStatusAnomalyRepository() *postgres.FixtureStatusAnomalyRepository {
+	return postgres.NewFixtureStatusAnomalyRepository(c.Database, c.Clock)
+}
+
+func (c Container) FixtureUpdateListener() *postgres.FixtureUpdateListener {
+	return postgres.NewFixtureUpdateListener(databaseDSN(c.Config), c.Logger)
 }
 
 func (c Container) FixtureTeamXGRepository() *postgres.FixtureTeamXGRepository {
 	return postgres.NewFixtureTeamXGRepository(c.Database, c.Clock)
 }
 
+func (c Container) IngestionPolicyRepository() *postgres.IngestionPolicyRepository {
+	return postgres.NewIngestionPolicyRepository(c.Database, c.Clock)
+}
+
+func (c Container) GroupRepository() *postgres.GroupRepository {
+	return postgres.NewGroupRepository(c.Database, c.Clock)
+}
+
+func (c Container) JobQueue() *postgres.JobQueue {
+	return postgres.NewJobQueue(c.Database, c.Clock)
+}
+
+func (c Container) LineupRepository() *postgres.LineupRepository {
+	return postgres.NewLineupRepository(c.Database, c.Clock)
+}
+
+func (c Container) ManagerRepository() *postgres.ManagerRepository {
+	return postgres.NewManagerRepository(c.Database, c.Clock)
+}
+
+func (c Container) ManagerSightingRepository() *postgres.ManagerSightingRepository {
+	return postgres.NewManagerSightingRepository(c.Database, c.Clock)
+}
+
+func (c Container) ManagerTenureRepository() *postgres.ManagerTenureRepository {
+	return postgres.NewManagerTenureRepository(c.Database, c.Clock)
+}
+
+func (c Container) OddsRepository() *postgres.OddsRepository {
+	return postgres.NewOddsRepository(c.Database, c.Clock)
+}
+
+func (c Container) OutboxRepository() *postgres.OutboxRepository {
+	return postgres.NewOutboxRepository(c.Database, c.Clock)
+}
+
+func (c Container) RefereeRepository() *postgres.RefereeRepository {
+	return postgres.NewRefereeRepository(c.Database, c.Clock)
+}
+
 func (c Container) PlayerRepository() *postgres.PlayerRepository {
 	return postgres.NewPlayerRepository(c.Database, c.Clock)
 }
@@ -36,26 +109,70 @@ func (c Container) RoundRepository() *postgres.RoundRepository {
 	return postgres.NewRoundRepository(c.Database, c.Clock)
 }
 
-func (c Container) ResultRepository() *postgres.ResultRepository {
-	return postgres.NewResultRepository(c.Database, c.Clock)
+func (c Container) ResultRepository() *override.ResultRepository {
+	return override.NewResultRepository(
+		postgres.NewResultRepository(c.Database, c.Clock),
+		c.DataOverrideRepository(),
+		c.Logger,
+	)
 }
 
 func (c Container) SeasonRepository() *postgres.SeasonRepository {
 	return postgres.NewSeasonRepository(c.Database, c.Clock)
 }
 
+func (c Container) SidelinedRepository() *postgres.SidelinedRepository {
+	return postgres.NewSidelinedRepository(c.Database, c.Clock)
+}
+
 func (c Container) SquadRepository() *postgres.SquadRepository {
 	return postgres.NewSquadRepository(c.Database, c.Clock)
 }
 
+func (c Container) StageRepository() *postgres.StageRepository {
+	return postgres.NewStageRepository(c.Database, c.Clock)
+}
+
+func (c Container) StandingsRulesRepository() *postgres.StandingsRulesRepository {
+	return postgres.NewStandingsRulesRepository(c.Database, c.Clock)
+}
+
+func (c Container) StandingsSnapshotRepository() *postgres.StandingsSnapshotRepository {
+	return postgres.NewStandingsSnapshotRepository(c.Database, c.Clock)
+}
+
+func (c Container) SuspensionRepository() *postgres.SuspensionRepository {
+	return postgres.NewSuspensionRepository(c.Database, c.Clock)
+}
+
+func (c Container) SuspensionRulesRepository() *postgres.SuspensionRulesRepository {
+	return postgres.NewSuspensionRulesRepository(c.Database, c.Clock)
+}
+
 func (c Container) TeamRepository() *postgres.TeamRepository {
 	return postgres.NewTeamRepository(c.Database, c.Clock)
 }
 
-func (c Container) TeamStatsRepository() *postgres.TeamStatsRepository {
-	return postgres.NewTeamStatsRepository(c.Database, c.Clock)
+func (c Container) TeamStatsRepository() *override.TeamStatsRepository {
+	return override.NewTeamStatsRepository(
+		postgres.NewTeamStatsRepository(c.Database, c.Clock),
+		c.DataOverrideRepository(),
+		c.Logger,
+	)
+}
+
+func (c Container) TransferRepository() *postgres.TransferRepository {
+	return postgres.NewTransferRepository(c.Database, c.Clock)
 }
 
 func (c Container) VenueRepository() *postgres.VenueRepository {
 	return postgres.NewVenueRepository(c.Database, c.Clock)
 }
+
+func (c Container) WebhookDeliveryRepository() *postgres.WebhookDeliveryRepository {
+	return postgres.NewWebhookDeliveryRepository(c.Database, c.Clock)
+}
+
+func (c Container) WebhookSubscriptionRepository() *postgres.WebhookSubscriptionRepository {
+	return postgres.NewWebhookSubscriptionRepository(c.Database, c.Clock)
+}
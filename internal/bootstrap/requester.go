@@ -29,6 +29,26 @@ func (c Container) ResultRequester() app.ResultRequester {
 	return sportmonks.NewResultRequester(c.SportMonksClient, c.Logger)
 }
 
+func (c Container) LineupRequester() app.LineupRequester {
+	return sportmonks.NewLineupRequester(c.SportMonksClient, c.Logger)
+}
+
+func (c Container) LiveRequester() app.LiveRequester {
+	return sportmonks.NewLiveRequester(c.SportMonksClient, c.Logger)
+}
+
+func (c Container) ManagerRequester() app.ManagerRequester {
+	return sportmonks.NewManagerRequester(c.SportMonksClient, c.Logger)
+}
+
+func (c Container) OddsRequester() app.OddsRequester {
+	return sportmonks.NewOddsRequester(c.SportMonksClient, c.Logger)
+}
+
+func (c Container) RefereeRequester() app.RefereeRequester {
+	return sportmonks.NewRefereeRequester(c.SportMonksClient, c.Logger)
+}
+
 func (c Container) PlayerRequester() app.PlayerRequester {
 	return sportmonks.NewPlayerRequester(c.SportMonksClient, c.Logger)
 }
@@ -41,10 +61,18 @@ func (c Container) SeasonRequester() app.SeasonRequester {
 	return sportmonks.NewSeasonRequester(c.SportMonksClient, c.Logger)
 }
 
+func (c Container) SidelinedRequester() app.SidelinedRequester {
+	return sportmonks.NewSidelinedRequester(c.SportMonksClient, c.Logger)
+}
+
 func (c Container) SquadRequester() app.SquadRequester {
 	return sportmonks.NewSquadRequester(c.SportMonksClient, c.Logger)
 }
 
+func (c Container) StageRequester() app.StageRequester {
+	return sportmonks.NewStageRequester(c.SportMonksClient, c.Logger)
+}
+
 func (c Container) TeamRequester() app.TeamRequester {
 	return sportmonks.NewTeamRequester(c.SportMonksClient, c.Logger)
 }
@@ -53,6 +81,10 @@ func (c Container) TeamStatsRequester() app.TeamStatsRequester {
 	return sportmonks.NewTeamStatsRequester(c.SportMonksClient, c.Logger)
 }
 
+func (c Container) TransferRequester() app.TransferRequester {
+	return sportmonks.NewTransferRequester(c.SportMonksClient, c.Logger)
+}
+
 func (c Container) VenueRequester() app.VenueRequester {
 	return sportmonks.NewVenueRequester(c.SportMonksClient, c.Logger)
 }
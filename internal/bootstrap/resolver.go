@@ -0,0 +1,7 @@
+package bootstrap
+
+import "github.com/statistico/statistico-football-data/internal/app/crosswalk"
+
+func (c Container) ExternalIDResolver() *crosswalk.Resolver {
+	return crosswalk.NewResolver(c.ExternalIDRepository())
+}
@@ -4,6 +4,15 @@ import (
 	"github.com/statistico/statistico-football-data/internal/app/grpc"
 )
 
+func (c Container) AvailabilityService() *grpc.AvailabilityService {
+	return grpc.NewAvailabilityService(
+		c.FixtureRepository(),
+		c.SidelinedRepository(),
+		c.SuspensionRepository(),
+		c.Logger,
+	)
+}
+
 func (c Container) CompetitionService() *grpc.CompetitionService {
 	return grpc.NewCompetitionService(c.CompetitionRepository(), c.Logger)
 }
@@ -12,21 +21,80 @@ func (c Container) EventService() *grpc.EventService {
 	return grpc.NewEventService(c.EventRepository(), c.Logger)
 }
 
+func (c Container) FixtureSearchService() *grpc.FixtureSearchService {
+	return grpc.NewFixtureSearchService(c.FixtureRepository(), c.Logger)
+}
+
 func (c Container) FixtureService() *grpc.FixtureService {
 	return grpc.NewFixtureService(c.FixtureRepository(), c.ProtoFixtureFactory(), c.Logger)
 }
 
+func (c Container) FixtureStageService() *grpc.FixtureStageService {
+	return grpc.NewFixtureStageService(c.FixtureRepository(), c.StageRepository(), c.GroupRepository(), c.Logger)
+}
+
 func (c Container) ResultService() *grpc.ResultService {
 	return grpc.NewResultService(c.FixtureRepository(), c.ProtoResultFactory(), c.Logger)
 }
 
+func (c Container) ResultTieService() *grpc.ResultTieService {
+	return grpc.NewResultTieService(c.FixtureRepository(), c.ResultRepository(), c.Logger)
+}
+
+func (c Container) LineupService() *grpc.LineupService {
+	return grpc.NewLineupService(c.FixtureRepository(), c.LineupRepository(), c.Logger)
+}
+
+func (c Container) LiveService() *grpc.LiveService {
+	return grpc.NewLiveService(
+		c.FixtureRepository(),
+		c.SeasonRepository(),
+		c.ResultRepository(),
+		c.EventRepository(),
+		c.FixtureUpdateListener(),
+		c.Logger,
+	)
+}
+
+func (c Container) ManagerService() *grpc.ManagerService {
+	return grpc.NewManagerService(
+		c.ManagerRepository(),
+		c.ManagerTenureRepository(),
+		c.FixtureRepository(),
+		c.ResultRepository(),
+		c.Logger,
+	)
+}
+
+func (c Container) OddsService() *grpc.OddsService {
+	return grpc.NewOddsService(c.FixtureRepository(), c.OddsRepository(), c.Logger)
+}
+
+func (c Container) PlayerService() *grpc.PlayerService {
+	return grpc.NewPlayerService(c.PlayerRepository(), c.TransferRepository(), c.Logger)
+}
+
 func (c Container) PlayerStatsService() *grpc.PlayerStatsService {
 	return grpc.NewPlayerStatsService(c.FixtureRepository(), c.ProtoPlayerStatsFactory(), c.Logger)
 }
 
+func (c Container) RefereeService() *grpc.RefereeService {
+	return grpc.NewRefereeService(c.RefereeRepository(), c.Logger)
+}
+
 func (c Container) SeasonService() *grpc.SeasonService {
 	return grpc.NewSeasonService(c.SeasonRepository(), c.Logger)
 }
+func (c Container) StandingsService() *grpc.StandingsService {
+	return grpc.NewStandingsService(
+		c.StandingsEngine(),
+		c.RoundRepository(),
+		c.StandingsSnapshotRepository(),
+		c.Clock,
+		c.Logger,
+	)
+}
+
 func (c Container) TeamService() *grpc.TeamService {
 	return grpc.NewTeamService(c.TeamRepository(), c.Logger)
 }
@@ -39,3 +107,7 @@ func (c Container) TeamStatsService() *grpc.TeamStatsService {
 		c.Logger,
 	)
 }
+
+func (c Container) WebhookService() *grpc.WebhookService {
+	return grpc.NewWebhookService(c.WebhookSubscriptionRepository(), c.Config.Admin.Token, c.Logger)
+}
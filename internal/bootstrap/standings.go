@@ -0,0 +1,12 @@
+package bootstrap
+
+import "github.com/statistico/statistico-football-data/internal/app/standings"
+
+func (c Container) StandingsEngine() *standings.Engine {
+	return standings.NewEngine(
+		c.FixtureRepository(),
+		c.ResultRepository(),
+		c.SeasonRepository(),
+		c.StandingsRulesRepository(),
+	)
+}
@@ -0,0 +1,13 @@
+package bootstrap
+
+import "github.com/statistico/statistico-football-data/internal/app/suspension"
+
+func (c Container) SuspensionTracker() *suspension.Tracker {
+	return suspension.NewTracker(
+		c.FixtureRepository(),
+		c.EventRepository(),
+		c.SeasonRepository(),
+		c.SuspensionRulesRepository(),
+		c.Clock,
+	)
+}
@@ -0,0 +1,17 @@
+package bootstrap
+
+import (
+	"github.com/statistico/statistico-football-data/internal/app/worker"
+	"os"
+)
+
+// Worker returns a worker identified by the ID provided running jobs using the console binary at the path provided.
+func (c Container) Worker(id string, consolePath string) *worker.Worker {
+	return worker.NewWorker(
+		id,
+		c.JobQueue(),
+		worker.NewConsoleRunner(consolePath, os.Stdout, os.Stderr),
+		c.Clock,
+		c.Logger,
+	)
+}